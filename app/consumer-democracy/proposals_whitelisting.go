@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	consumertypes "github.com/cosmos/interchain-security/x/ccv/consumer/types"
 )
 
 func IsProposalWhitelisted(content govtypes.Content) bool {
@@ -16,6 +17,9 @@ func IsProposalWhitelisted(content govtypes.Content) bool {
 	case *proposal.ParameterChangeProposal:
 		return isParamChangeWhitelisted(c.Changes)
 
+	case *consumertypes.ConsumerRemovalRequestProposal:
+		return true
+
 	default:
 		return false
 	}
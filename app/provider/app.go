@@ -139,6 +139,14 @@ var (
 			ibcproviderclient.ConsumerAdditionProposalHandler,
 			ibcproviderclient.ConsumerRemovalProposalHandler,
 			ibcproviderclient.EquivocationProposalHandler,
+			ibcproviderclient.ConsumerValidatorListsProposalHandler,
+			ibcproviderclient.ConsumerParametersUpdateProposalHandler,
+			ibcproviderclient.ForceCompleteUnbondingProposalHandler,
+			ibcproviderclient.ConsumerAdditionCancellationProposalHandler,
+			ibcproviderclient.ChangeRewardDenomsProposalHandler,
+			ibcproviderclient.ConsumerPauseProposalHandler,
+			ibcproviderclient.ConsumerMetadataUpdateProposalHandler,
+			ibcproviderclient.ChangeConsumerRewardFractionProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -154,13 +162,14 @@ var (
 
 	// module account permissions
 	maccPerms = map[string][]string{
-		authtypes.FeeCollectorName:     nil,
-		distrtypes.ModuleName:          nil,
-		minttypes.ModuleName:           {authtypes.Minter},
-		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
-		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
-		govtypes.ModuleName:            {authtypes.Burner},
-		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
+		authtypes.FeeCollectorName:        nil,
+		distrtypes.ModuleName:             nil,
+		minttypes.ModuleName:              {authtypes.Minter},
+		stakingtypes.BondedPoolName:       {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName:    {authtypes.Burner, authtypes.Staking},
+		govtypes.ModuleName:               {authtypes.Burner},
+		ibctransfertypes.ModuleName:       {authtypes.Minter, authtypes.Burner},
+		providertypes.ConsumerRewardsPool: nil,
 	}
 )
 
@@ -309,12 +318,14 @@ func New(
 		maccPerms,
 	)
 
-	// Remove the fee-pool from the group of blocked recipient addresses in bank
-	// this is required for the provider chain to be able to receive tokens from
-	// the consumer chain
+	// Remove the fee-pool and the consumer rewards pool from the group of blocked
+	// recipient addresses in bank; this is required for the provider chain
+	// to be able to receive tokens from the consumer chain
 	bankBlockedAddrs := app.ModuleAccountAddrs()
 	delete(bankBlockedAddrs, authtypes.NewModuleAddress(
 		authtypes.FeeCollectorName).String())
+	delete(bankBlockedAddrs, authtypes.NewModuleAddress(
+		providertypes.ConsumerRewardsPool).String())
 
 	app.BankKeeper = bankkeeper.NewBaseKeeper(
 		appCodec,
@@ -409,6 +420,7 @@ func New(
 		app.StakingKeeper,
 		app.SlashingKeeper,
 		app.AccountKeeper,
+		app.BankKeeper,
 		app.EvidenceKeeper,
 		authtypes.FeeCollectorName,
 	)
@@ -448,7 +460,8 @@ func New(
 		scopedTransferKeeper,
 	)
 	transferModule := transfer.NewAppModule(app.TransferKeeper)
-	ibcmodule := transfer.NewIBCModule(app.TransferKeeper)
+	// wrap the transfer module in order to account for the rewards received from consumer chains
+	ibcmodule := ibcprovider.NewIBCMiddleware(transfer.NewIBCModule(app.TransferKeeper), &app.ProviderKeeper)
 
 	// create static IBC router, add transfer route, then set and seal it
 	ibcRouter := porttypes.NewRouter()
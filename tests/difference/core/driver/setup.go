@@ -558,6 +558,11 @@ func GetZeroState(
 
 	consumerGenesis := b.createConsumerGenesis(clientState)
 
+	// Store the consumer genesis on the provider, as done by CreateConsumerClient,
+	// since the provider verifies its hash on the channel handshake.
+	err := b.providerKeeper().SetConsumerGenesis(b.providerCtx(), b.consumer().ChainID, *consumerGenesis)
+	b.suite.Require().NoError(err)
+
 	b.consumerKeeper().InitGenesis(b.consumerCtx(), consumerGenesis)
 
 	// Client ID is set in InitGenesis and we treat it as a block box. So
@@ -10,71 +10,105 @@ import (
 	ccv "github.com/cosmos/interchain-security/x/ccv/types"
 )
 
-// This test is valid for minimal viable consumer chain
+// This test is valid for minimal viable consumer chain.
+// The rewards sent by the consumer chain are distributed only if their denom is registered on the provider,
+// otherwise they are rejected by the provider and refunded to the consumer chain.
 func (s *CCVTestSuite) TestRewardsDistribution() {
+	testCases := []struct {
+		name          string
+		registerDenom bool
+	}{
+		{"rewards of a registered denom are distributed", true},
+		{"rewards of an unregistered denom are refunded to the consumer chain", false},
+	}
 
-	//set up channel and delegate some tokens in order for validator set update to be sent to the consumer chain
-	s.SetupCCVChannel(s.path)
-	s.SetupTransferChannel()
-	bondAmt := sdk.NewInt(10000000)
-	delAddr := s.providerChain.SenderAccount.GetAddress()
-	delegate(s, delAddr, bondAmt)
-	s.providerChain.NextBlock()
+	for _, tc := range testCases {
+		s.SetupTest()
 
-	// relay VSC packets from provider to consumer
-	relayAllCommittedPackets(s, s.providerChain, s.path, ccv.ProviderPortID, s.path.EndpointB.ChannelID, 1)
+		//set up channel and delegate some tokens in order for validator set update to be sent to the consumer chain
+		s.SetupCCVChannel(s.path)
+		s.SetupTransferChannel()
+		bondAmt := sdk.NewInt(10000000)
+		delAddr := s.providerChain.SenderAccount.GetAddress()
+		delegate(s, delAddr, bondAmt)
+		s.providerChain.NextBlock()
 
-	//reward for the provider chain will be sent after each 2 blocks
-	consumerParams := s.consumerApp.GetSubspace(consumertypes.ModuleName)
-	consumerParams.Set(s.consumerCtx(), consumertypes.KeyBlocksPerDistributionTransmission, int64(2))
-	s.consumerChain.NextBlock()
+		// relay VSC packets from provider to consumer
+		relayAllCommittedPackets(s, s.providerChain, s.path, ccv.ProviderPortID, s.path.EndpointB.ChannelID, 1)
 
-	consumerAccountKeeper := s.consumerApp.GetE2eAccountKeeper()
-	consumerBankKeeper := s.consumerApp.GetE2eBankKeeper()
+		//reward for the provider chain will be sent after each 2 blocks
+		consumerParams := s.consumerApp.GetSubspace(consumertypes.ModuleName)
+		consumerParams.Set(s.consumerCtx(), consumertypes.KeyBlocksPerDistributionTransmission, int64(2))
+		s.consumerChain.NextBlock()
 
-	//send coins to the fee pool which is used for reward distribution
-	consumerFeePoolAddr := consumerAccountKeeper.GetModuleAccount(s.consumerCtx(), authtypes.FeeCollectorName).GetAddress()
-	feePoolTokensOld := consumerBankKeeper.GetAllBalances(s.consumerCtx(), consumerFeePoolAddr)
-	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
-	err := consumerBankKeeper.SendCoinsFromAccountToModule(s.consumerCtx(), s.consumerChain.SenderAccount.GetAddress(), authtypes.FeeCollectorName, fees)
-	s.Require().NoError(err)
-	feePoolTokens := consumerBankKeeper.GetAllBalances(s.consumerCtx(), consumerFeePoolAddr)
-	s.Require().Equal(sdk.NewInt(100).Add(feePoolTokensOld.AmountOf(sdk.DefaultBondDenom)), feePoolTokens.AmountOf(sdk.DefaultBondDenom))
+		consumerAccountKeeper := s.consumerApp.GetE2eAccountKeeper()
+		consumerBankKeeper := s.consumerApp.GetE2eBankKeeper()
 
-	//calculate the reward for consumer and provider chain. Consumer will receive ConsumerRedistributeFrac, the rest is going to provider
-	frac, err := sdk.NewDecFromStr(s.consumerApp.GetConsumerKeeper().GetConsumerRedistributionFrac(s.consumerCtx()))
-	s.Require().NoError(err)
-	consumerExpectedRewards, _ := sdk.NewDecCoinsFromCoins(feePoolTokens...).MulDec(frac).TruncateDecimal()
-	providerExpectedRewards := feePoolTokens.Sub(consumerExpectedRewards)
-	s.consumerChain.NextBlock()
+		//send coins to the fee pool which is used for reward distribution
+		consumerFeePoolAddr := consumerAccountKeeper.GetModuleAccount(s.consumerCtx(), authtypes.FeeCollectorName).GetAddress()
+		feePoolTokensOld := consumerBankKeeper.GetAllBalances(s.consumerCtx(), consumerFeePoolAddr)
+		fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+		err := consumerBankKeeper.SendCoinsFromAccountToModule(s.consumerCtx(), s.consumerChain.SenderAccount.GetAddress(), authtypes.FeeCollectorName, fees)
+		s.Require().NoError(err)
+		feePoolTokens := consumerBankKeeper.GetAllBalances(s.consumerCtx(), consumerFeePoolAddr)
+		s.Require().Equal(sdk.NewInt(100).Add(feePoolTokensOld.AmountOf(sdk.DefaultBondDenom)), feePoolTokens.AmountOf(sdk.DefaultBondDenom))
 
-	//amount from the fee pool is devided between consumer redistribute address and address reserved for provider chain
-	feePoolTokens = consumerBankKeeper.GetAllBalances(s.consumerCtx(), consumerFeePoolAddr)
-	s.Require().Equal(0, len(feePoolTokens))
-	consumerRedistributeAddr := consumerAccountKeeper.GetModuleAccount(s.consumerCtx(), consumertypes.ConsumerRedistributeName).GetAddress()
-	consumerTokens := consumerBankKeeper.GetAllBalances(s.consumerCtx(), consumerRedistributeAddr)
-	s.Require().Equal(consumerExpectedRewards.AmountOf(sdk.DefaultBondDenom), consumerTokens.AmountOf(sdk.DefaultBondDenom))
-	providerRedistributeAddr := consumerAccountKeeper.GetModuleAccount(s.consumerCtx(), consumertypes.ConsumerToSendToProviderName).GetAddress()
-	providerTokens := consumerBankKeeper.GetAllBalances(s.consumerCtx(), providerRedistributeAddr)
-	s.Require().Equal(providerExpectedRewards.AmountOf(sdk.DefaultBondDenom), providerTokens.AmountOf(sdk.DefaultBondDenom))
+		//calculate the reward for consumer and provider chain. Consumer will receive ConsumerRedistributeFrac, the rest is going to provider
+		frac, err := sdk.NewDecFromStr(s.consumerApp.GetConsumerKeeper().GetConsumerRedistributionFrac(s.consumerCtx()))
+		s.Require().NoError(err)
+		consumerExpectedRewards, _ := sdk.NewDecCoinsFromCoins(feePoolTokens...).MulDec(frac).TruncateDecimal()
+		providerExpectedRewards := feePoolTokens.Sub(consumerExpectedRewards)
+		s.consumerChain.NextBlock()
 
-	//send the reward to provider chain after 2 blocks
+		//amount from the fee pool is devided between consumer redistribute address and address reserved for provider chain
+		feePoolTokens = consumerBankKeeper.GetAllBalances(s.consumerCtx(), consumerFeePoolAddr)
+		s.Require().Equal(0, len(feePoolTokens))
+		consumerRedistributeAddr := consumerAccountKeeper.GetModuleAccount(s.consumerCtx(), consumertypes.ConsumerRedistributeName).GetAddress()
+		consumerTokens := consumerBankKeeper.GetAllBalances(s.consumerCtx(), consumerRedistributeAddr)
+		s.Require().Equal(consumerExpectedRewards.AmountOf(sdk.DefaultBondDenom), consumerTokens.AmountOf(sdk.DefaultBondDenom))
+		providerRedistributeAddr := consumerAccountKeeper.GetModuleAccount(s.consumerCtx(), consumertypes.ConsumerToSendToProviderName).GetAddress()
+		providerTokens := consumerBankKeeper.GetAllBalances(s.consumerCtx(), providerRedistributeAddr)
+		s.Require().Equal(providerExpectedRewards.AmountOf(sdk.DefaultBondDenom), providerTokens.AmountOf(sdk.DefaultBondDenom))
+
+		// the rewards are received on the provider as IBC vouchers
+		providerKeeper := s.providerApp.GetProviderKeeper()
+		rewardDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(
+			transfertypes.PortID, s.transferPath.EndpointB.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+		if tc.registerDenom {
+			providerKeeper.SetConsumerRewardDenom(s.providerCtx(), rewardDenom)
+		}
 
-	s.consumerChain.NextBlock()
-	providerTokens = consumerBankKeeper.GetAllBalances(s.consumerCtx(), providerRedistributeAddr)
-	s.Require().Equal(0, len(providerTokens))
+		//send the reward to provider chain after 2 blocks
 
-	relayAllCommittedPackets(s, s.consumerChain, s.transferPath, transfertypes.PortID, s.transferPath.EndpointA.ChannelID, 1)
-	s.providerChain.NextBlock()
-	communityCoins := s.providerApp.GetE2eDistributionKeeper().GetFeePoolCommunityCoins(s.providerCtx())
-	ibcCoinIndex := -1
-	for i, coin := range communityCoins {
-		if strings.HasPrefix(coin.Denom, "ibc") {
-			ibcCoinIndex = i
+		s.consumerChain.NextBlock()
+		providerTokens = consumerBankKeeper.GetAllBalances(s.consumerCtx(), providerRedistributeAddr)
+		s.Require().Equal(0, len(providerTokens))
+
+		relayAllCommittedPackets(s, s.consumerChain, s.transferPath, transfertypes.PortID, s.transferPath.EndpointA.ChannelID, 1)
+		s.providerChain.NextBlock()
+		communityCoins := s.providerApp.GetE2eDistributionKeeper().GetFeePoolCommunityCoins(s.providerCtx())
+		ibcCoinIndex := -1
+		for i, coin := range communityCoins {
+			if strings.HasPrefix(coin.Denom, "ibc") {
+				ibcCoinIndex = i
+			}
+		}
+
+		if !tc.registerDenom {
+			s.Require().Equal(-1, ibcCoinIndex, tc.name)
+			s.Require().True(providerKeeper.GetConsumerRewardsAllocation(s.providerCtx(), s.consumerChain.ChainID).Rewards.IsZero(), tc.name)
+			rewardsPoolAddr, err := sdk.AccAddressFromBech32(providerKeeper.GetConsumerRewardsPoolAddressStr(s.providerCtx()))
+			s.Require().NoError(err)
+			heldRewards := s.providerApp.GetE2eBankKeeper().GetBalance(s.providerCtx(), rewardsPoolAddr, rewardDenom)
+			s.Require().True(heldRewards.IsZero(), tc.name)
+			refundedTokens := consumerBankKeeper.GetAllBalances(s.consumerCtx(), providerRedistributeAddr)
+			s.Require().Equal(providerExpectedRewards.AmountOf(sdk.DefaultBondDenom), refundedTokens.AmountOf(sdk.DefaultBondDenom), tc.name)
+			continue
 		}
+		s.Require().Greater(ibcCoinIndex, -1, tc.name)
+		s.Require().Equal(rewardDenom, communityCoins[ibcCoinIndex].Denom, tc.name)
+		s.Require().True(communityCoins[ibcCoinIndex].Amount.Equal(sdk.NewDecCoinFromCoin(providerExpectedRewards[0]).Amount), tc.name)
 	}
-	s.Require().Greater(ibcCoinIndex, -1)
-	s.Require().True(communityCoins[ibcCoinIndex].Amount.Equal(sdk.NewDecCoinFromCoin(providerExpectedRewards[0]).Amount))
 }
 
 // TestSendRewardsRetries tests that failed reward transmissions are retried every BlocksPerDistributionTransmission blocks
@@ -37,6 +37,10 @@ func (s *CCVTestSuite) TestVSCPacketSendExpiredClient() {
 	s.Require().NotEmpty(packets, "no pending VSC packets found")
 	s.Require().Equal(1, len(packets), "unexpected number of pending VSC packets")
 
+	// check that the provider observed the expired client
+	_, found := providerKeeper.GetClientInactiveTimestamp(s.providerCtx(), s.consumerChain.ChainID)
+	s.Require().True(found, "client inactive timestamp not found")
+
 	// try again to send CCV packet to consumer
 	s.providerChain.NextBlock()
 
@@ -66,6 +70,10 @@ func (s *CCVTestSuite) TestVSCPacketSendExpiredClient() {
 	packets = providerKeeper.GetPendingVSCPackets(s.providerCtx(), s.consumerChain.ChainID)
 	s.Require().Empty(packets, "unexpected pending VSC packets found")
 
+	// check that the provider observed the recovered client
+	_, found = providerKeeper.GetClientInactiveTimestamp(s.providerCtx(), s.consumerChain.ChainID)
+	s.Require().False(found, "unexpected client inactive timestamp found")
+
 	// check that validator updates work
 	// - bond more tokens on provider to change validator powers
 	delegate(s, delAddr, bondAmt)
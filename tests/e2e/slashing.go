@@ -111,9 +111,15 @@ func (s *CCVTestSuite) TestRelayAndApplyDowntimePacket() {
 	s.Require().Len(s.providerChain.Vals.Validators, validatorsPerChain-1)
 
 	for _, bundle := range s.consumerBundles {
-		// Relay VSC packets from provider to each consumer
+		// Relay VSC packets from provider to each consumer.
+		// The first consumer also received the slash ack during block N,
+		// since the VSC packet removing the validator is only sent during block N+1
+		expectedPackets := 1
+		if bundle.Chain.ChainID == s.getFirstBundle().Chain.ChainID {
+			expectedPackets = 2
+		}
 		relayAllCommittedPackets(s, s.providerChain, bundle.Path,
-			ccv.ProviderPortID, bundle.Path.EndpointB.ChannelID, 1)
+			ccv.ProviderPortID, bundle.Path.EndpointB.ChannelID, expectedPackets)
 
 		// check that each consumer updated its VSC ID for the subsequent block
 		consumerKeeper := bundle.GetKeeper()
@@ -241,6 +247,89 @@ func (s *CCVTestSuite) TestRelayAndApplyDoubleSignPacket() {
 	s.Require().NoError(err)
 }
 
+// TestRelayAndApplyOptedInDoubleSignPacket is similar to TestRelayAndApplyDoubleSignPacket,
+// but the consumer chain opted in to have its double-sign slash packets applied.
+// Note that the validator is slashed, jailed and tombstoned on the provider.
+func (s *CCVTestSuite) TestRelayAndApplyOptedInDoubleSignPacket() {
+
+	// Setup CCV channel for all instantiated consumers
+	s.SetupAllCCVChannels()
+
+	providerStakingKeeper := s.providerApp.GetE2eStakingKeeper()
+	providerKeeper := s.providerApp.GetProviderKeeper()
+	providerSlashingKeeper := s.providerApp.GetE2eSlashingKeeper()
+
+	// the consumer chain opts in to have its double-sign slash packets applied
+	providerKeeper.SetSlashDoubleSigns(s.providerCtx(), s.consumerChain.ChainID, true)
+
+	validatorsPerChain := len(s.consumerChain.Vals.Validators)
+
+	// pick first consumer validator
+	tmVal := s.consumerChain.Vals.Validators[0]
+	val, err := tmVal.ToProto()
+	s.Require().NoError(err)
+	pubkey, err := cryptocodec.FromTmProtoPublicKey(val.GetPubKey())
+	s.Require().Nil(err)
+	consumerConsAddr := providertypes.NewConsumerConsAddress(sdk.GetConsAddress(pubkey))
+	// map consumer consensus address to provider consensus address
+	providerConsAddr, found := providerKeeper.GetValidatorByConsumerAddr(
+		s.providerCtx(),
+		s.consumerChain.ChainID,
+		consumerConsAddr)
+	s.Require().True(found)
+
+	stakingVal, found := providerStakingKeeper.GetValidatorByConsAddr(s.providerCtx(), providerConsAddr.ToSdkConsAddr())
+	s.Require().True(found)
+	valOldBalance := stakingVal.Tokens
+
+	// Setup first val with mapped consensus addresss to be slashed on provider by setting signing info
+	// convert validator to TM type
+	pk, err := stakingVal.ConsPubKey()
+	s.Require().NoError(err)
+	tmPk, err := cryptocodec.ToTmPubKeyInterface(pk)
+	s.Require().NoError(err)
+	s.setDefaultValSigningInfo(*tmtypes.NewValidator(tmPk, stakingVal.ConsensusPower(sdk.DefaultPowerReduction)))
+
+	// Send slash packet from the first consumer chain
+	packet := s.constructSlashPacketFromConsumer(s.getFirstBundle(), *tmVal, stakingtypes.DoubleSign, 1)
+	err = s.getFirstBundle().Path.EndpointA.SendPacket(packet)
+	s.Require().NoError(err)
+
+	// receive the slash packet on the provider chain. RecvPacket() advances two blocks
+	err = s.path.EndpointB.RecvPacket(packet)
+	s.Require().NoError(err)
+
+	// Call next block so that the validator set update is in effect for the provider
+	s.providerChain.NextBlock()
+
+	// check that the validator was removed from the provider validator set
+	s.Require().Len(s.providerChain.Vals.Validators, validatorsPerChain-1)
+
+	// Get staking keeper's validator obj after the relayed slash packet
+	stakingValAfter, ok := providerStakingKeeper.GetValidatorByConsAddr(s.providerCtx(), providerConsAddr.ToSdkConsAddr())
+	s.Require().True(ok)
+
+	// check that the validator's tokens were slashed on provider
+	s.Require().True(stakingValAfter.GetTokens().LT(valOldBalance))
+
+	// Get signing info for the validator
+	valSignInfo, found := providerSlashingKeeper.GetValidatorSigningInfo(s.providerCtx(), providerConsAddr.ToSdkConsAddr())
+	s.Require().True(found)
+
+	// check that the validator is jailed permanently and tombstoned on provider
+	s.Require().True(stakingValAfter.Jailed)
+	s.Require().True(evidencetypes.DoubleSignJailEndTime.Equal(valSignInfo.JailedUntil))
+	s.Require().True(valSignInfo.Tombstoned)
+
+	// check that the double-sign infraction is recorded in the slash log
+	s.Require().True(providerKeeper.GetSlashLog(s.providerCtx(), providerConsAddr))
+
+	// check that slashing packet gets acknowledged successfully
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	err = s.path.EndpointA.AcknowledgePacket(packet, ack.Acknowledgement())
+	s.Require().NoError(err)
+}
+
 func (s *CCVTestSuite) TestSlashPacketAcknowledgement() {
 	providerKeeper := s.providerApp.GetProviderKeeper()
 	consumerKeeper := s.consumerApp.GetConsumerKeeper()
@@ -335,7 +424,7 @@ func (suite *CCVTestSuite) TestOnRecvSlashPacketErrors() {
 	suite.Require().False(errAck.Success())
 	errAckCast := errAck.(channeltypes.Acknowledgement)
 	// TODO: see if there's a way to get error reason like before
-	suite.Require().Equal("ABCI code: 1: error handling packet: see events for details", errAckCast.GetError())
+	suite.Require().Equal(fmt.Sprintf("ABCI code: %d: error handling packet: see events for details", providertypes.ErrUnknownInfractionHeight.ABCICode()), errAckCast.GetError())
 
 	// Restore init chain height
 	providerKeeper.SetInitChainHeight(ctx, consumerChainID, initChainHeight)
@@ -346,7 +435,7 @@ func (suite *CCVTestSuite) TestOnRecvSlashPacketErrors() {
 	suite.Require().False(errAck.Success())
 	errAckCast = errAck.(channeltypes.Acknowledgement)
 	// TODO: see if there's a way to get error reason like before
-	suite.Require().Equal("ABCI code: 1: error handling packet: see events for details", errAckCast.GetError())
+	suite.Require().Equal(fmt.Sprintf("ABCI code: %d: error handling packet: see events for details", providertypes.ErrInvalidInfractionType.ABCICode()), errAckCast.GetError())
 
 	// save current VSC ID
 	vscID := providerKeeper.GetValidatorSetUpdateId(ctx)
@@ -362,7 +451,7 @@ func (suite *CCVTestSuite) TestOnRecvSlashPacketErrors() {
 	suite.Require().False(errAck.Success())
 	errAckCast = errAck.(channeltypes.Acknowledgement)
 	// TODO: see if there's a way to get error reason like before
-	suite.Require().Equal("ABCI code: 1: error handling packet: see events for details", errAckCast.GetError())
+	suite.Require().Equal(fmt.Sprintf("ABCI code: %d: error handling packet: see events for details", providertypes.ErrUnknownInfractionHeight.ABCICode()), errAckCast.GetError())
 
 	// construct slashing packet with non existing validator
 	slashingPkt := ccv.NewSlashPacketData(
@@ -387,6 +476,15 @@ func (suite *CCVTestSuite) TestOnRecvSlashPacketErrors() {
 	ctx = suite.providerChain.GetContext()
 	suite.Require().NotZero(providerKeeper.GetValsetUpdateBlockHeight(ctx, vscID))
 
+	// the slash packet of the non existing validator is kept in the throttle queues to be retried
+	suite.Require().Equal(1, len(providerKeeper.GetAllGlobalSlashEntries(ctx)))
+	suite.Require().Equal(uint64(1), providerKeeper.GetThrottledPacketDataSize(ctx, consumerChainID))
+	suite.Require().Len(providerKeeper.GetAllSlashRetries(ctx, &consumerChainID), 1)
+	// drop it, so that the remaining cases start with empty throttle queues
+	providerKeeper.DeleteGlobalSlashEntriesForConsumer(ctx, consumerChainID)
+	providerKeeper.DeleteThrottledPacketDataForConsumer(ctx, consumerChainID)
+	providerKeeper.DeleteAllSlashRetries(ctx, consumerChainID)
+
 	// create validator signing info
 	valInfo := slashingtypes.NewValidatorSigningInfo(sdk.ConsAddress(val.Address), ctx.BlockHeight(),
 		ctx.BlockHeight()-1, time.Time{}.UTC(), false, int64(0))
@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	consumertypes "github.com/cosmos/interchain-security/x/ccv/consumer/types"
 	"github.com/cosmos/interchain-security/x/ccv/provider/types"
 	ccv "github.com/cosmos/interchain-security/x/ccv/types"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -132,6 +133,36 @@ func (s *CCVTestSuite) TestStopConsumerChain() {
 	s.Require().Len(vscMaturedData, 1)
 }
 
+// TestConsumerRemovalRequest tests the full flow of a consumer chain leaving on its own request:
+// a passed consumer removal request proposal makes the consumer send a ConsumerRemoval packet,
+// and once the provider receives it, it stops the consumer chain in its next block
+func (s *CCVTestSuite) TestConsumerRemovalRequest() {
+	s.SetupCCVChannel(s.path)
+	s.SetupTransferChannel()
+	s.SendEmptyVSCPacket()
+
+	consumerKeeper := s.consumerApp.GetConsumerKeeper()
+	providerKeeper := s.providerApp.GetProviderKeeper()
+	chainID := s.consumerChain.ChainID
+
+	// the consumer handles a passed consumer removal request proposal
+	prop := consumertypes.NewConsumerRemovalRequestProposal(
+		"title", "description", "sunsetting the chain").(*consumertypes.ConsumerRemovalRequestProposal)
+	err := consumerKeeper.HandleConsumerRemovalRequestProposal(s.consumerCtx(), prop)
+	s.Require().NoError(err)
+
+	// the ConsumerRemoval packet is sent in the EndBlock of the consumer
+	s.consumerChain.NextBlock()
+	s.Require().Empty(consumerKeeper.GetPendingPackets(s.consumerCtx()).List)
+
+	// relay the packet to the provider, which schedules the removal of the consumer chain;
+	// note that relaying the acknowledgement back to the consumer commits the next block
+	// of the provider, in which the consumer chain is stopped
+	relayAllCommittedPackets(s, s.consumerChain, s.path, ccv.ConsumerPortID, s.path.EndpointA.ChannelID, 1)
+	s.checkConsumerChainIsRemoved(chainID, true)
+	s.Require().Empty(providerKeeper.GetAllPendingConsumerRemovalProps(s.providerCtx()))
+}
+
 // TODO Simon: implement OnChanCloseConfirm in IBC-GO testing to close the consumer chain's channel end
 func (s *CCVTestSuite) TestStopConsumerOnChannelClosed() {
 	// init the CCV channel states
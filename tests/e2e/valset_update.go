@@ -24,6 +24,13 @@ func (s *CCVTestSuite) TestPacketRoundtrip() {
 	// Send CCV packet to consumer
 	s.providerChain.NextBlock()
 
+	// Check that the provider stored the validator set sent to the consumer
+	providerKeeper := s.providerApp.GetProviderKeeper()
+	valsetUpdateID, found := providerKeeper.GetConsumerValSetUpdateId(s.providerCtx(), s.consumerChain.ChainID)
+	s.Require().True(found)
+	s.Require().Equal(providerKeeper.GetValidatorSetUpdateId(s.providerCtx())-1, valsetUpdateID)
+	s.Require().Len(providerKeeper.GetConsumerValSet(s.providerCtx(), s.consumerChain.ChainID), len(s.providerChain.Vals.Validators))
+
 	// Relay 1 VSC packet from provider to consumer
 	relayAllCommittedPackets(s, s.providerChain, s.path, ccv.ProviderPortID, s.path.EndpointB.ChannelID, 1)
 
@@ -17,6 +17,7 @@ import (
 
 	"github.com/cosmos/interchain-security/x/ccv/provider/client"
 	"github.com/cosmos/interchain-security/x/ccv/provider/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 	"github.com/tidwall/gjson"
 )
 
@@ -220,8 +221,8 @@ func (tr TestRun) submitConsumerAdditionProposal(
 		Description:                       "Gonna be a great chain",
 		ChainId:                           string(tr.chainConfigs[action.consumerChain].chainId),
 		InitialHeight:                     action.initialHeight,
-		GenesisHash:                       []byte("gen_hash"),
-		BinaryHash:                        []byte("bin_hash"),
+		GenesisHash:                       tmhash.Sum([]byte("gen_hash")),
+		BinaryHash:                        tmhash.Sum([]byte("bin_hash")),
 		SpawnTime:                         spawnTime,
 		ConsumerRedistributionFraction:    params.ConsumerRedistributionFraction,
 		BlocksPerDistributionTransmission: params.BlocksPerDistributionTransmission,
@@ -116,6 +116,10 @@ func TestRelayAndApplyDoubleSignPacket(t *testing.T) {
 	runCCVTestByName(t, "TestRelayAndApplyDoubleSignPacket")
 }
 
+func TestRelayAndApplyOptedInDoubleSignPacket(t *testing.T) {
+	runCCVTestByName(t, "TestRelayAndApplyOptedInDoubleSignPacket")
+}
+
 func TestSlashPacketAcknowledgement(t *testing.T) {
 	runCCVTestByName(t, "TestSlashPacketAcknowledgement")
 }
@@ -148,6 +152,10 @@ func TestStopConsumerChain(t *testing.T) {
 	runCCVTestByName(t, "TestStopConsumerChain")
 }
 
+func TestConsumerRemovalRequest(t *testing.T) {
+	runCCVTestByName(t, "TestConsumerRemovalRequest")
+}
+
 func TestStopConsumerOnChannelClosed(t *testing.T) {
 	runCCVTestByName(t, "TestStopConsumerOnChannelClosed")
 }
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	conntypes "github.com/cosmos/ibc-go/v4/modules/core/03-connection/types"
@@ -29,8 +30,9 @@ import (
 func GetMocksForCreateConsumerClient(ctx sdk.Context, mocks *MockedKeepers,
 	expectedChainID string, expectedLatestHeight clienttypes.Height) []*gomock.Call {
 
-	// append MakeConsumerGenesis and CreateClient expectations
-	expectations := GetMocksForMakeConsumerGenesis(ctx, mocks, time.Hour)
+	// append MakeConsumerGenesis and CreateClient expectations,
+	// the injected provider unbonding period is longer than the one of the test consumer chains
+	expectations := GetMocksForMakeConsumerGenesis(ctx, mocks, 21*24*time.Hour)
 	createClientExp := mocks.MockClientKeeper.EXPECT().CreateClient(
 		gomock.Any(),
 		// Allows us to expect a match by field. These are the only two client state values
@@ -67,6 +69,7 @@ func GetMocksForSetConsumerChain(ctx sdk.Context, mocks *MockedKeepers,
 		mocks.MockChannelKeeper.EXPECT().GetChannel(ctx, ccv.ProviderPortID, gomock.Any()).Return(
 			channeltypes.Channel{
 				State:          channeltypes.OPEN,
+				Counterparty:   channeltypes.NewCounterparty(ccv.ConsumerPortID, "consumerChannelID"),
 				ConnectionHops: []string{"connectionID"},
 			},
 			true,
@@ -123,6 +126,35 @@ func GetMocksForHandleSlashPacket(ctx sdk.Context, mocks MockedKeepers,
 	return calls
 }
 
+// GetMocksForHandleDoubleSignSlashPacket returns mock expectations needed to call HandleSlashPacket()
+// for a double-sign infraction, i.e., the validator is slashed, jailed and tombstoned.
+func GetMocksForHandleDoubleSignSlashPacket(ctx sdk.Context, mocks MockedKeepers,
+	expectedProviderValConsAddr providertypes.ProviderConsAddress,
+	valToReturn stakingtypes.Validator, expectedInfractionHeight int64) []*gomock.Call {
+
+	consAddr := expectedProviderValConsAddr.ToSdkConsAddr()
+	calls := []*gomock.Call{
+		mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, consAddr).Return(valToReturn, true).Times(1),
+		mocks.MockSlashingKeeper.EXPECT().IsTombstoned(ctx, consAddr).Return(false).Times(1),
+		mocks.MockStakingKeeper.EXPECT().PowerReduction(ctx).Return(sdk.DefaultPowerReduction).Times(1),
+		// the double-sign slash fraction of the provider params is applied
+		mocks.MockStakingKeeper.EXPECT().Slash(ctx, consAddr, expectedInfractionHeight,
+			valToReturn.ConsensusPower(sdk.DefaultPowerReduction),
+			sdk.MustNewDecFromStr(providertypes.DefaultSlashFractionDoubleSign), stakingtypes.DoubleSign).Times(1),
+	}
+
+	if !valToReturn.IsJailed() {
+		calls = append(calls, mocks.MockStakingKeeper.EXPECT().Jail(ctx, consAddr).Times(1))
+	}
+
+	calls = append(calls,
+		mocks.MockSlashingKeeper.EXPECT().JailUntil(ctx, consAddr, evidencetypes.DoubleSignJailEndTime).Times(1),
+		mocks.MockSlashingKeeper.EXPECT().Tombstone(ctx, consAddr).Times(1),
+	)
+
+	return calls
+}
+
 func ExpectLatestConsensusStateMock(ctx sdk.Context, mocks MockedKeepers, clientID string, consState *ibctmtypes.ConsensusState) *gomock.Call {
 	return mocks.MockClientKeeper.EXPECT().
 		GetLatestClientConsensusState(ctx, clientID).Return(consState, true).Times(1)
@@ -25,6 +25,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	tmdb "github.com/tendermint/tm-db"
@@ -122,6 +123,7 @@ func NewInMemProviderKeeper(params InMemKeeperParams, mocks MockedKeepers) provi
 		mocks.MockStakingKeeper,
 		mocks.MockSlashingKeeper,
 		mocks.MockAccountKeeper,
+		mocks.MockBankKeeper,
 		mocks.MockEvidenceKeeper,
 		authtypes.FeeCollectorName,
 	)
@@ -156,7 +158,10 @@ func GetProviderKeeperAndCtx(t *testing.T, params InMemKeeperParams) (
 ) {
 	ctrl := gomock.NewController(t)
 	mocks := NewMockedKeepers(ctrl)
-	return NewInMemProviderKeeper(params, mocks), params.Ctx, ctrl, mocks
+	providerKeeper := NewInMemProviderKeeper(params, mocks)
+	// the port is set as on InitChain, since the keeper reads it in all channel operations
+	providerKeeper.SetPort(params.Ctx, ccvtypes.ProviderPortID)
+	return providerKeeper, params.Ctx, ctrl, mocks
 }
 
 // Return an in-memory consumer keeper, context, controller, and mocks, given a test instance and parameters.
@@ -243,8 +248,8 @@ func GetTestConsumerAdditionProp() *providertypes.ConsumerAdditionProposal {
 		"description",
 		"chainID",
 		clienttypes.NewHeight(4, 5),
-		[]byte("gen_hash"),
-		[]byte("bin_hash"),
+		tmhash.Sum([]byte("gen_hash")),
+		tmhash.Sum([]byte("bin_hash")),
 		time.Now(),
 		consumertypes.DefaultConsumerRedistributeFrac,
 		consumertypes.DefaultBlocksPerDistributionTransmission,
@@ -19,6 +19,7 @@ func NewQueryCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdNextFeeDistribution())
+	cmd.AddCommand(CmdLastReceivedValset())
 
 	return cmd
 }
@@ -49,3 +50,30 @@ func CmdNextFeeDistribution() *cobra.Command {
 
 	return cmd
 }
+
+func CmdLastReceivedValset() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "last-received-valset",
+		Short: "Query the valset update ID of the last VSC packet received from the provider chain",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryLastReceivedValsetRequest{}
+			res, err := queryClient.QueryLastReceivedValset(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,141 @@
+package client
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/interchain-security/x/ccv/consumer/types"
+	"github.com/spf13/cobra"
+)
+
+var ConsumerRemovalRequestProposalHandler = govclient.NewProposalHandler(SubmitConsumerRemovalRequestProposalTxCmd, ConsumerRemovalRequestProposalRESTHandler)
+
+// SubmitConsumerRemovalRequestProposalTxCmd returns a CLI command handler for submitting
+// a consumer removal request proposal via a transaction.
+func SubmitConsumerRemovalRequestProposalTxCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "consumer-removal-request [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a consumer removal request proposal",
+		Long: `Submit a proposal to ask the provider chain to remove this consumer chain, along with an initial deposit.
+If the proposal passes, a ConsumerRemoval packet is sent to the provider, which then stops the consumer chain.
+The reason is optional and cannot be longer than 256 characters.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ <appd> tx gov submit-proposal consumer-removal-request <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+{
+	 "title": "Sunset FooChain",
+	 "description": "FooChain stops being a consumer chain",
+	 "reason": "sunsetting the chain",
+	 "deposit": "10000stake"
+}
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseConsumerRemovalRequestProposalJSON(args[0])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewConsumerRemovalRequestProposal(proposal.Title, proposal.Description, proposal.Reason)
+
+			from := clientCtx.GetFromAddress()
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+type ConsumerRemovalRequestProposalJSON struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Reason      string `json:"reason"`
+	Deposit     string `json:"deposit"`
+}
+
+type ConsumerRemovalRequestProposalReq struct {
+	BaseReq  rest.BaseReq   `json:"base_req"`
+	Proposer sdk.AccAddress `json:"proposer"`
+
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Reason      string `json:"reason"`
+
+	Deposit sdk.Coins `json:"deposit"`
+}
+
+func ParseConsumerRemovalRequestProposalJSON(proposalFile string) (ConsumerRemovalRequestProposalJSON, error) {
+	proposal := ConsumerRemovalRequestProposalJSON{}
+
+	contents, err := ioutil.ReadFile(filepath.Clean(proposalFile))
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := json.Unmarshal(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ConsumerRemovalRequestProposalRESTHandler returns a ProposalRESTHandler that exposes the consumer removal request rest handler.
+func ConsumerRemovalRequestProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "consumer_removal_request",
+		Handler:  postConsumerRemovalRequestProposalHandlerFn(clientCtx),
+	}
+}
+
+func postConsumerRemovalRequestProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ConsumerRemovalRequestProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewConsumerRemovalRequestProposal(req.Title, req.Description, req.Reason)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
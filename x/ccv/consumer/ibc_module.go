@@ -1,6 +1,7 @@
 package consumer
 
 import (
+	"encoding/hex"
 	"fmt"
 	"strings"
 
@@ -52,9 +53,9 @@ func (am AppModule) OnChanOpenInit(
 	}
 
 	// ensure the counterparty port ID matches the expected provider port ID
-	if counterparty.PortId != ccv.ProviderPortID {
+	if providerPortID := am.keeper.GetProviderPortID(ctx); counterparty.PortId != providerPortID {
 		return "", sdkerrors.Wrapf(porttypes.ErrInvalidPort,
-			"invalid counterparty port: %s, expected %s", counterparty.PortId, ccv.ProviderPortID)
+			"invalid counterparty port: %s, expected %s", counterparty.PortId, providerPortID)
 	}
 
 	// Claim channel capability passed back by IBC module
@@ -68,7 +69,24 @@ func (am AppModule) OnChanOpenInit(
 		return "", err
 	}
 
-	return version, nil
+	genesisHash := am.keeper.GetGenesisHash(ctx)
+	if len(genesisHash) == 0 {
+		// a consumer chain without genesis hash, e.g., restarted from a genesis
+		// exported after the handshake, proposes a plain version
+		return version, nil
+	}
+	md := providertypes.ConsumerHandshakeMetadata{
+		Version: version,
+		// the provider verifies that the consumer chain booted from
+		// the consumer genesis generated by the provider
+		GenesisHash: hex.EncodeToString(genesisHash),
+	}
+	mdBz, err := (&md).Marshal()
+	if err != nil {
+		return "", sdkerrors.Wrapf(ccv.ErrInvalidHandshakeMetadata,
+			"error marshalling ibc-init metadata: %v", err)
+	}
+	return string(mdBz), nil
 }
 
 // validateCCVChannelParams validates a ccv channel
@@ -90,9 +108,9 @@ func validateCCVChannelParams(
 		return sdkerrors.Wrapf(porttypes.ErrInvalidPort, "invalid port: %s, expected %s", portID, boundPort)
 	}
 
-	// the version must match the expected version
-	if version != ccv.Version {
-		return sdkerrors.Wrapf(ccv.ErrInvalidVersion, "got %s, expected %s", version, ccv.Version)
+	// the version must be well-formed and be one of the compatible versions
+	if err := ccv.ValidateVersion(version); err != nil {
+		return err
 	}
 	return nil
 }
@@ -131,9 +149,8 @@ func (am AppModule) OnChanOpenAck(
 			"error unmarshalling ibc-ack metadata: \n%v; \nmetadata: %v", err, counterpartyMetadata)
 	}
 
-	if md.Version != ccv.Version {
-		return sdkerrors.Wrapf(ccv.ErrInvalidVersion,
-			"invalid counterparty version: %s, expected %s", md.Version, ccv.Version)
+	if err := ccv.ValidateVersion(md.Version); err != nil {
+		return sdkerrors.Wrap(err, "invalid counterparty version")
 	}
 
 	am.keeper.SetProviderFeePoolAddrStr(ctx, md.ProviderFeePoolAddr)
@@ -1,6 +1,7 @@
 package consumer_test
 
 import (
+	"encoding/hex"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -68,6 +69,41 @@ func TestOnChanOpenInit(t *testing.T) {
 				)
 			}, true,
 		},
+		{
+			"success: no genesis hash stored, plain version sent",
+			func(keeper *consumerkeeper.Keeper, params *params, mocks testkeeper.MockedKeepers) {
+				keeper.SetGenesisHash(params.ctx, []byte{})
+				gomock.InOrder(
+					mocks.MockScopedKeeper.EXPECT().ClaimCapability(
+						params.ctx, params.chanCap, host.ChannelCapabilityPath(
+							params.portID, params.channelID)).Return(nil).Times(1),
+					mocks.MockConnectionKeeper.EXPECT().GetConnection(
+						params.ctx, "connectionIDToProvider").Return(
+						conntypes.ConnectionEnd{ClientId: "clientIDToProvider"}, true).Times(1),
+				)
+			}, true,
+		},
+		{
+			"success: counterparty port is the non-default provider port",
+			func(keeper *consumerkeeper.Keeper, params *params, mocks testkeeper.MockedKeepers) {
+				keeper.SetProviderPortID(params.ctx, "provider-1")
+				params.counterparty.PortId = "provider-1"
+				gomock.InOrder(
+					mocks.MockScopedKeeper.EXPECT().ClaimCapability(
+						params.ctx, params.chanCap, host.ChannelCapabilityPath(
+							params.portID, params.channelID)).Return(nil).Times(1),
+					mocks.MockConnectionKeeper.EXPECT().GetConnection(
+						params.ctx, "connectionIDToProvider").Return(
+						conntypes.ConnectionEnd{ClientId: "clientIDToProvider"}, true).Times(1),
+				)
+			}, true,
+		},
+		{
+			"invalid: counterparty port is the default port while the provider port is not",
+			func(keeper *consumerkeeper.Keeper, params *params, mocks testkeeper.MockedKeepers) {
+				keeper.SetProviderPortID(params.ctx, "provider-1")
+			}, false,
+		},
 		{
 			"invalid non-empty IBC module version",
 			func(keeper *consumerkeeper.Keeper, params *params, mocks testkeeper.MockedKeepers) {
@@ -128,6 +164,8 @@ func TestOnChanOpenInit(t *testing.T) {
 
 		consumerKeeper.SetPort(ctx, ccv.ConsumerPortID)
 		consumerKeeper.SetProviderClientID(ctx, "clientIDToProvider")
+		genesisHash := []byte("genesis-hash")
+		consumerKeeper.SetGenesisHash(ctx, genesisHash)
 
 		// Instantiate valid params as default. Individual test cases mutate these as needed.
 		params := params{
@@ -155,9 +193,17 @@ func TestOnChanOpenInit(t *testing.T) {
 		)
 
 		if tc.expPass {
-			// assert correct version
-			require.Equal(t, ccv.Version, version)
 			require.NoError(t, err)
+			if len(consumerKeeper.GetGenesisHash(ctx)) == 0 {
+				// without a genesis hash the plain version is sent
+				require.Equal(t, ccv.Version, version)
+			} else {
+				// assert correct version and genesis hash
+				md := &providertypes.ConsumerHandshakeMetadata{}
+				require.NoError(t, md.Unmarshal([]byte(version)))
+				require.Equal(t, ccv.Version, md.Version)
+				require.Equal(t, hex.EncodeToString(genesisHash), md.GenesisHash)
+			}
 		} else {
 			require.Error(t, err)
 			// assert version string is empty
@@ -38,6 +38,11 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state *consumertypes.GenesisState)
 		}
 	}
 
+	// set the port of the provider CCV module, if it is not the default provider port
+	if state.ProviderPortId != "" {
+		k.SetProviderPortID(ctx, state.ProviderPortId)
+	}
+
 	// initialValSet is checked in NewChain case by ValidateGenesis
 	// start a new chain
 	if state.NewChain {
@@ -51,6 +56,13 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state *consumertypes.GenesisState)
 		// set provider client id.
 		k.SetProviderClientID(ctx, clientID)
 
+		// set the hash of the genesis, which is verified by the provider on the CCV channel handshake
+		genesisHash, err := state.Hash()
+		if err != nil {
+			panic(fmt.Errorf("failed to hash the consumer genesis: %w", err))
+		}
+		k.SetGenesisHash(ctx, genesisHash)
+
 		// set default value for valset update ID
 		k.SetHeightValsetUpdateID(ctx, uint64(ctx.BlockHeight()), uint64(0))
 
@@ -84,10 +96,23 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state *consumertypes.GenesisState)
 		for _, h2v := range state.HeightToValsetUpdateId {
 			k.SetHeightValsetUpdateID(ctx, h2v.Height, h2v.ValsetUpdateId)
 		}
+		// restore the last received valset, i.e., the first height mapped to the last valset update ID
+		if h2vs := k.GetAllHeightToValsetUpdateIDs(ctx); len(h2vs) > 0 && h2vs[len(h2vs)-1].ValsetUpdateId != 0 {
+			last := len(h2vs) - 1
+			for last > 0 && h2vs[last-1].ValsetUpdateId == h2vs[last].ValsetUpdateId {
+				last--
+			}
+			k.SetLastReceivedValset(ctx, h2vs[last].Height, h2vs[last].ValsetUpdateId)
+		}
 
 		// set provider client id
 		k.SetProviderClientID(ctx, state.ProviderClientId)
 
+		// set the hash of the genesis the chain was started from
+		if len(state.GenesisHash) != 0 {
+			k.SetGenesisHash(ctx, state.GenesisHash)
+		}
+
 	}
 
 	// populate cross chain validators states with initial valset
@@ -146,6 +171,10 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (genesis *consumertypes.GenesisSt
 			params,
 		)
 	}
+	genesis.GenesisHash = k.GetGenesisHash(ctx)
+	if providerPortID := k.GetProviderPortID(ctx); providerPortID != ccv.ProviderPortID {
+		genesis.ProviderPortId = providerPortID
+	}
 
 	return
 }
@@ -124,6 +124,11 @@ func TestInitGenesis(t *testing.T) {
 				assertProviderClientID(t, ctx, &ck, provClientID)
 				assertHeightValsetUpdateIDs(t, ctx, &ck, defaultHeightValsetUpdateIDs)
 
+				// the hash of the genesis is stored to be verified on the CCV channel handshake
+				genesisHash, err := gs.Hash()
+				require.NoError(t, err)
+				require.Equal(t, genesisHash, ck.GetGenesisHash(ctx))
+
 				require.Equal(t, validator.Address.Bytes(), ck.GetAllCCValidator(ctx)[0].Address)
 				require.Equal(t, gs.Params, ck.GetParams(ctx))
 			},
@@ -134,23 +139,31 @@ func TestInitGenesis(t *testing.T) {
 					testkeeper.ExpectGetCapabilityMock(ctx, mocks, 2),
 				)
 			},
-			consumertypes.NewRestartGenesisState(
-				provClientID,
-				"",
-				matPackets,
-				valset,
-				defaultHeightValsetUpdateIDs,
-				pendingDataPackets,
-				nil,
-				consumertypes.LastTransmissionBlockHeight{},
-				params,
-			),
+			func() *consumertypes.GenesisState {
+				gs := consumertypes.NewRestartGenesisState(
+					provClientID,
+					"",
+					matPackets,
+					valset,
+					defaultHeightValsetUpdateIDs,
+					pendingDataPackets,
+					nil,
+					consumertypes.LastTransmissionBlockHeight{},
+					params,
+				)
+				gs.GenesisHash = []byte("genesis-hash")
+				return gs
+			}(),
 			func(ctx sdk.Context, ck consumerkeeper.Keeper, gs *consumertypes.GenesisState) {
 				assertConsumerPortIsBound(t, ctx, &ck)
+				require.Equal(t, gs.GenesisHash, ck.GetGenesisHash(ctx))
 
 				require.Equal(t, pendingDataPackets, ck.GetPendingPackets(ctx))
 				assertHeightValsetUpdateIDs(t, ctx, &ck, defaultHeightValsetUpdateIDs)
 				assertProviderClientID(t, ctx, &ck, provClientID)
+				// no VSC packet was received yet
+				_, found := ck.GetLastReceivedValset(ctx)
+				require.False(t, found)
 				require.Equal(t, validator.Address.Bytes(), ck.GetAllCCValidator(ctx)[0].Address)
 				require.Equal(t, gs.Params, ck.GetParams(ctx))
 			},
@@ -195,6 +208,9 @@ func TestInitGenesis(t *testing.T) {
 
 				assertHeightValsetUpdateIDs(t, ctx, &ck, updatedHeightValsetUpdateIDs)
 				assertProviderClientID(t, ctx, &ck, provClientID)
+				lastReceived, found := ck.GetLastReceivedValset(ctx)
+				require.True(t, found)
+				require.Equal(t, updatedHeightValsetUpdateIDs[1], lastReceived)
 
 				require.Equal(t, gs.Params, ck.GetParams(ctx))
 			},
@@ -296,19 +312,24 @@ func TestExportGenesis(t *testing.T) {
 
 				ck.AppendPendingPacket(ctx, consPackets.List...)
 				ck.SetHeightValsetUpdateID(ctx, defaultHeightValsetUpdateIDs[0].Height, defaultHeightValsetUpdateIDs[0].ValsetUpdateId)
-
+				ck.SetGenesisHash(ctx, []byte("genesis-hash"))
 			},
-			consumertypes.NewRestartGenesisState(
-				provClientID,
-				"",
-				nil,
-				valset,
-				defaultHeightValsetUpdateIDs,
-				consPackets,
-				nil,
-				consumertypes.LastTransmissionBlockHeight{},
-				params,
-			),
+			func() *consumertypes.GenesisState {
+				gs := consumertypes.NewRestartGenesisState(
+					provClientID,
+					"",
+					nil,
+					valset,
+					defaultHeightValsetUpdateIDs,
+					consPackets,
+					nil,
+					consumertypes.LastTransmissionBlockHeight{},
+					params,
+				)
+				// the genesis hash is exported to be verified on the CCV channel handshake
+				gs.GenesisHash = []byte("genesis-hash")
+				return gs
+			}(),
 		},
 		{
 			"export a chain with an established CCV channel",
@@ -25,6 +25,23 @@ func (k Keeper) QueryNextFeeDistribution(c context.Context,
 	return &types.QueryNextFeeDistributionEstimateResponse{Data: &nextDist}, nil
 }
 
+func (k Keeper) QueryLastReceivedValset(c context.Context,
+	req *types.QueryLastReceivedValsetRequest) (*types.QueryLastReceivedValsetResponse, error) {
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	h2v, found := k.GetLastReceivedValset(ctx)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no VSC packet received from the provider chain")
+	}
+
+	return &types.QueryLastReceivedValsetResponse{Height: h2v.Height, ValsetUpdateId: h2v.ValsetUpdateId}, nil
+}
+
 func (k Keeper) QueryParams(c context.Context,
 	req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 
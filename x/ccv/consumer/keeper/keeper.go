@@ -423,6 +423,72 @@ func (k Keeper) GetAllHeightToValsetUpdateIDs(ctx sdk.Context) (heightToValsetUp
 	return heightToValsetUpdateIDs
 }
 
+// SetGenesisHash sets the hash of the consumer genesis the chain was started from
+func (k Keeper) SetGenesisHash(ctx sdk.Context, hash []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GenesisHashKey(), hash)
+}
+
+// GetGenesisHash returns the hash of the consumer genesis the chain was started from
+func (k Keeper) GetGenesisHash(ctx sdk.Context) []byte {
+	store := ctx.KVStore(k.storeKey)
+	return store.Get(types.GenesisHashKey())
+}
+
+// SetProviderPortID sets the port ID of the provider CCV module,
+// which is expected as the counterparty port of the CCV channel
+func (k Keeper) SetProviderPortID(ctx sdk.Context, portID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ProviderPortKey(), []byte(portID))
+}
+
+// GetProviderPortID returns the port ID of the provider CCV module,
+// or the default provider port if none is set
+func (k Keeper) GetProviderPortID(ctx sdk.Context) string {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ProviderPortKey())
+	if bz == nil {
+		return ccv.ProviderPortID
+	}
+	return string(bz)
+}
+
+// SetLastReceivedValset records the valset update ID of the last received VSC packet,
+// along with the block height from which its validator set applies, i.e., the height
+// the valset update ID is mapped to for infractions (see SetHeightValsetUpdateID).
+//
+// Note that the VSC packet is recorded when received; its validator updates are only
+// passed to the consensus engine in EndBlock, together with the pending changes.
+func (k Keeper) SetLastReceivedValset(ctx sdk.Context, height, valsetUpdateId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	h2v := types.HeightToValsetUpdateID{Height: height, ValsetUpdateId: valsetUpdateId}
+	bz, err := h2v.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong
+		panic(fmt.Errorf("failed to encode last received valset: %w", err))
+	}
+	store.Set(types.LastReceivedValsetKey(), bz)
+}
+
+// GetLastReceivedValset returns the valset update ID of the last received VSC packet,
+// along with the block height from which its validator set applies.
+// The height can be correlated with the provider block height returned by
+// GetValsetUpdateBlockHeight of the provider keeper for the same valset update ID.
+func (k Keeper) GetLastReceivedValset(ctx sdk.Context) (types.HeightToValsetUpdateID, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastReceivedValsetKey())
+	if bz == nil {
+		return types.HeightToValsetUpdateID{}, false
+	}
+	var h2v types.HeightToValsetUpdateID
+	if err := h2v.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the last received valset is assumed to be correctly serialized in SetLastReceivedValset.
+		panic(fmt.Errorf("failed to decode last received valset: %w", err))
+	}
+	return h2v, true
+}
+
 // OutstandingDowntime returns the outstanding downtime flag for a given validator
 func (k Keeper) OutstandingDowntime(ctx sdk.Context, address sdk.ConsAddress) bool {
 	store := ctx.KVStore(k.storeKey)
@@ -0,0 +1,17 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/interchain-security/x/ccv/consumer/types"
+)
+
+// HandleConsumerRemovalRequestProposal handles a consumer removal request proposal.
+// It queues a ConsumerRemoval packet, which is sent to the provider in the EndBlock of the
+// consumer. Once the provider receives it, it stops the consumer chain and closes the CCV channel.
+func (k Keeper) HandleConsumerRemovalRequestProposal(ctx sdk.Context, p *types.ConsumerRemovalRequestProposal) error {
+	if err := k.QueueConsumerRemovalPacket(ctx, p.Reason); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidConsumerRemovalRequestProp, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/cosmos/interchain-security/testutil/keeper"
+	consumertypes "github.com/cosmos/interchain-security/x/ccv/consumer/types"
+	ccv "github.com/cosmos/interchain-security/x/ccv/types"
+)
+
+// TestHandleConsumerRemovalRequestProposal tests that a consumer removal request proposal
+// queues a ConsumerRemoval packet with the reason of the proposal
+func TestHandleConsumerRemovalRequestProposal(t *testing.T) {
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	prop := consumertypes.NewConsumerRemovalRequestProposal(
+		"title", "description", "sunsetting the chain").(*consumertypes.ConsumerRemovalRequestProposal)
+	err := consumerKeeper.HandleConsumerRemovalRequestProposal(ctx, prop)
+	require.NoError(t, err)
+
+	pendingPackets := consumerKeeper.GetPendingPackets(ctx)
+	require.Len(t, pendingPackets.List, 1)
+	require.Equal(t, ccv.ConsumerRemovalPacket, pendingPackets.List[0].Type)
+	require.Equal(t, "sunsetting the chain", pendingPackets.List[0].GetConsumerRemovalPacketData().Reason)
+
+	// a reason longer than the max length is rejected and nothing is queued
+	prop.Reason = strings.Repeat("a", ccv.MaxConsumerRemovalReasonLength+1)
+	err = consumerKeeper.HandleConsumerRemovalRequestProposal(ctx, prop)
+	require.ErrorIs(t, err, consumertypes.ErrInvalidConsumerRemovalRequestProp)
+	require.Len(t, consumerKeeper.GetPendingPackets(ctx).List, 1)
+}
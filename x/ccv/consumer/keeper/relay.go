@@ -73,6 +73,7 @@ func (k Keeper) OnRecvVSCPacket(ctx sdk.Context, packet channeltypes.Packet, new
 	blockHeight := uint64(ctx.BlockHeight()) + 1
 	k.SetHeightValsetUpdateID(ctx, blockHeight, newChanges.ValsetUpdateId)
 	k.Logger(ctx).Debug("block height was mapped to vscID", "height", blockHeight, "vscID", newChanges.ValsetUpdateId)
+	k.SetLastReceivedValset(ctx, blockHeight, newChanges.ValsetUpdateId)
 
 	// remove outstanding slashing flags of the validators
 	// for which the slashing was acknowledged by the provider chain
@@ -169,6 +170,37 @@ func (k Keeper) QueueSlashPacket(ctx sdk.Context, validator abci.Validator, vals
 	)
 }
 
+// QueueConsumerRemovalPacket appends a ConsumerRemoval packet to the queue, i.e., a request
+// to the provider chain to remove the consumer chain. Once the provider receives the packet,
+// it stops the consumer chain and closes the CCV channel.
+func (k Keeper) QueueConsumerRemovalPacket(ctx sdk.Context, reason string) error {
+	removalPacket := ccv.NewConsumerRemovalPacketData(reason)
+	if err := removalPacket.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// append the ConsumerRemoval packet data to pending data packets
+	// to be sent once the CCV channel is established
+	k.AppendPendingPacket(ctx, ccv.ConsumerPacketData{
+		Type: ccv.ConsumerRemovalPacket,
+		Data: &ccv.ConsumerPacketData_ConsumerRemovalPacketData{
+			ConsumerRemovalPacketData: removalPacket,
+		},
+	})
+
+	k.Logger(ctx).Info("ConsumerRemovalPacket enqueued", "reason", reason)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			ccv.EventTypeConsumerRemovalRequest,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(ccv.AttributeChainID, ctx.ChainID()),
+			sdk.NewAttribute(ccv.AttributeRemovalReason, reason),
+		),
+	)
+	return nil
+}
+
 // SendPackets iterates queued packets and sends them in FIFO order.
 // received VSC packets in order, and write acknowledgements for all matured VSC packets.
 //
@@ -188,7 +220,7 @@ func (k Keeper) SendPackets(ctx sdk.Context) {
 	for _, p := range pending.GetList() {
 
 		// send packet over IBC
-		err := utils.SendIBCPacket(
+		_, err := utils.SendIBCPacket(
 			ctx,
 			k.scopedKeeper,
 			k.channelKeeper,
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"fmt"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -153,6 +154,39 @@ func TestOnRecvVSCPacket(t *testing.T) {
 	}
 }
 
+// TestLastReceivedValset tests that the consumer records the valset update ID of the last
+// received VSC packet, along with the block height from which its validator set applies
+func TestLastReceivedValset(t *testing.T) {
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	consumerKeeper.SetParams(ctx, consumertypes.DefaultParams())
+	consumerKeeper.SetProviderChannel(ctx, "consumerCCVChannelID")
+
+	_, found := consumerKeeper.GetLastReceivedValset(ctx)
+	require.False(t, found)
+
+	// VSC packets are received at heights 10, 12 and 12 again
+	updates := []struct {
+		height int64
+		vscID  uint64
+	}{{10, 3}, {12, 4}, {12, 7}}
+	for i, update := range updates {
+		ctx = ctx.WithBlockHeight(update.height)
+		pd := types.NewValidatorSetChangePacketData(nil, update.vscID, nil)
+		packet := channeltypes.NewPacket(pd.GetBytes(), uint64(i+1), ccv.ProviderPortID, "providerCCVChannelID",
+			ccv.ConsumerPortID, "consumerCCVChannelID", clienttypes.NewHeight(1, 0), 0)
+		ack := consumerKeeper.OnRecvVSCPacket(ctx, packet, pd)
+		require.True(t, ack.Success())
+
+		// the validator set applies from the next block
+		lastReceived, found := consumerKeeper.GetLastReceivedValset(ctx)
+		require.True(t, found)
+		require.Equal(t, uint64(update.height+1), lastReceived.Height)
+		require.Equal(t, update.vscID, lastReceived.ValsetUpdateId)
+		require.Equal(t, update.vscID, consumerKeeper.GetHeightValsetUpdateID(ctx, lastReceived.Height))
+	}
+}
+
 // TestOnAcknowledgementPacket tests application logic for acknowledgments of sent VSCMatured and Slash packets
 // in conjunction with the ibc module's execution of "acknowledgePacket",
 // according to https://github.com/cosmos/ibc/tree/main/spec/core/ics-004-channel-and-packet-semantics#processing-acknowledgements
@@ -227,3 +261,23 @@ func TestOnAcknowledgementPacket(t *testing.T) {
 	err = consumerKeeper.OnAcknowledgementPacket(ctx, packet, ack)
 	require.Nil(t, err)
 }
+
+// TestQueueConsumerRemovalPacket tests that a consumer removal packet is appended
+// to the pending packets, and that a request with an oversized reason is rejected
+func TestQueueConsumerRemovalPacket(t *testing.T) {
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	err := consumerKeeper.QueueConsumerRemovalPacket(ctx, "sunsetting the chain")
+	require.NoError(t, err)
+
+	pendingPackets := consumerKeeper.GetPendingPackets(ctx)
+	require.Len(t, pendingPackets.List, 1)
+	require.Equal(t, ccv.ConsumerRemovalPacket, pendingPackets.List[0].Type)
+	require.Equal(t, "sunsetting the chain", pendingPackets.List[0].GetConsumerRemovalPacketData().Reason)
+
+	// a reason longer than the max length is rejected and nothing is queued
+	err = consumerKeeper.QueueConsumerRemovalPacket(ctx, strings.Repeat("a", ccv.MaxConsumerRemovalReasonLength+1))
+	require.ErrorIs(t, err, ccv.ErrInvalidPacketData)
+	require.Len(t, consumerKeeper.GetPendingPackets(ctx).List, 1)
+}
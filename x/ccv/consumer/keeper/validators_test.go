@@ -9,6 +9,7 @@ import (
 	testkeeper "github.com/cosmos/interchain-security/testutil/keeper"
 	"github.com/cosmos/interchain-security/x/ccv/consumer/keeper"
 	"github.com/cosmos/interchain-security/x/ccv/consumer/types"
+	providerkeeper "github.com/cosmos/interchain-security/x/ccv/provider/keeper"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmrand "github.com/tendermint/tendermint/libs/rand"
@@ -104,6 +105,57 @@ func TestApplyCCValidatorChanges(t *testing.T) {
 	}
 }
 
+// TestApplyValsetDiff tests that applying the validator set diffs computed by the provider
+// to the cross-chain validators of the consumer reconstructs the provider validator set
+func TestApplyValsetDiff(t *testing.T) {
+	keeperParams := testkeeper.NewInMemKeeperParams(t)
+	keeperParams.RegisterSdkCryptoCodecInterfaces()
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, keeperParams)
+	defer ctrl.Finish()
+
+	vals := []abci.ValidatorUpdate{}
+	for _, v := range GenerateValidators(t) {
+		vals = append(vals, tmtypes.TM2PB.ValidatorUpdate(v))
+	}
+
+	valsets := [][]abci.ValidatorUpdate{
+		// the initial validator set
+		{vals[0], vals[1], vals[2]},
+		// a validator is added
+		{vals[0], vals[1], vals[2], vals[3]},
+		// a validator changes power and another is removed
+		{vals[0], {PubKey: vals[1].PubKey, Power: 10}, vals[3]},
+		// the same validator set
+		{vals[0], {PubKey: vals[1].PubKey, Power: 10}, vals[3]},
+		// all the validators are replaced
+		{vals[2], {PubKey: vals[3].PubKey, Power: 7}},
+	}
+
+	for i, valset := range valsets {
+		var old []abci.ValidatorUpdate
+		if i > 0 {
+			old = valsets[i-1]
+		}
+		diff := providerkeeper.ComputeValsetDiff(old, valset)
+		if i == 3 {
+			require.Empty(t, diff)
+		}
+		consumerKeeper.ApplyCCValidatorChanges(ctx, diff)
+
+		gotVals := map[string]int64{}
+		for _, ccVal := range consumerKeeper.GetAllCCValidator(ctx) {
+			gotVals[sdk.ConsAddress(ccVal.Address).String()] = ccVal.Power
+		}
+		expVals := map[string]int64{}
+		for _, val := range valset {
+			pubKey, err := cryptocodec.FromTmProtoPublicKey(val.PubKey)
+			require.NoError(t, err)
+			expVals[sdk.ConsAddress(pubKey.Address()).String()] = val.Power
+		}
+		require.Equal(t, expVals, gotVals, "validator set %d not reconstructed", i)
+	}
+}
+
 // Tests the getter and setter behavior for historical info
 func TestHistoricalInfo(t *testing.T) {
 
@@ -0,0 +1,22 @@
+package consumer
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/interchain-security/x/ccv/consumer/keeper"
+	"github.com/cosmos/interchain-security/x/ccv/consumer/types"
+)
+
+// NewConsumerProposalHandler defines the handler for consumer removal request proposals.
+// It can only be registered by consumer chains with a governance module, e.g., the democracy consumer.
+func NewConsumerProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.ConsumerRemovalRequestProposal:
+			return k.HandleConsumerRemovalRequestProposal(ctx, c)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized ccv consumer proposal content type: %T", c)
+		}
+	}
+}
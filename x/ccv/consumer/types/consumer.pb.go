@@ -322,11 +322,78 @@ func (m *MaturingVSCPacket) GetMaturityTime() time.Time {
 	return time.Time{}
 }
 
+// ConsumerRemovalRequestProposal is a governance proposal on the consumer chain to ask the
+// provider chain to remove it. If it passes, a ConsumerRemoval packet is sent to the provider,
+// which then stops the consumer chain and closes the CCV channel.
+type ConsumerRemovalRequestProposal struct {
+	// the title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// the description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// the reason of the removal request, sent to the provider in the ConsumerRemoval packet
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *ConsumerRemovalRequestProposal) Reset()         { *m = ConsumerRemovalRequestProposal{} }
+func (m *ConsumerRemovalRequestProposal) String() string { return proto.CompactTextString(m) }
+func (*ConsumerRemovalRequestProposal) ProtoMessage()    {}
+func (*ConsumerRemovalRequestProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b27a82b276e7f93, []int{4}
+}
+func (m *ConsumerRemovalRequestProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerRemovalRequestProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerRemovalRequestProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumerRemovalRequestProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerRemovalRequestProposal.Merge(m, src)
+}
+func (m *ConsumerRemovalRequestProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerRemovalRequestProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerRemovalRequestProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerRemovalRequestProposal proto.InternalMessageInfo
+
+func (m *ConsumerRemovalRequestProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *ConsumerRemovalRequestProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *ConsumerRemovalRequestProposal) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "interchain_security.ccv.consumer.v1.Params")
 	proto.RegisterType((*LastTransmissionBlockHeight)(nil), "interchain_security.ccv.consumer.v1.LastTransmissionBlockHeight")
 	proto.RegisterType((*CrossChainValidator)(nil), "interchain_security.ccv.consumer.v1.CrossChainValidator")
 	proto.RegisterType((*MaturingVSCPacket)(nil), "interchain_security.ccv.consumer.v1.MaturingVSCPacket")
+	proto.RegisterType((*ConsumerRemovalRequestProposal)(nil), "interchain_security.ccv.consumer.v1.ConsumerRemovalRequestProposal")
 }
 
 func init() {
@@ -334,52 +401,55 @@ func init() {
 }
 
 var fileDescriptor_5b27a82b276e7f93 = []byte{
-	// 709 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcf, 0x4e, 0xdb, 0x48,
-	0x18, 0x8f, 0x17, 0x08, 0x30, 0xb0, 0x5a, 0xf0, 0x66, 0xc1, 0x64, 0x25, 0x27, 0x64, 0x39, 0xe4,
-	0x82, 0x23, 0x82, 0xf6, 0xc2, 0x8d, 0x84, 0x45, 0xb0, 0xfd, 0x97, 0x9a, 0x88, 0x43, 0x7b, 0xb0,
-	0xc6, 0xe3, 0x89, 0x33, 0xc2, 0x9e, 0xb1, 0x66, 0xc6, 0x6e, 0xfd, 0x16, 0x1c, 0xfb, 0x08, 0x7d,
-	0x80, 0x3e, 0x04, 0xea, 0x89, 0x63, 0x4f, 0xb4, 0x82, 0x37, 0xa8, 0xfa, 0x00, 0x95, 0x3d, 0x76,
-	0x20, 0x50, 0x24, 0x6e, 0xdf, 0xa7, 0xdf, 0x1f, 0xcf, 0xf7, 0xf3, 0x37, 0x03, 0xba, 0x84, 0x4a,
-	0xcc, 0xd1, 0x18, 0x12, 0xea, 0x08, 0x8c, 0x62, 0x4e, 0x64, 0xda, 0x41, 0x28, 0xe9, 0x20, 0x46,
-	0x45, 0x1c, 0x62, 0xde, 0x49, 0x76, 0x26, 0xb5, 0x15, 0x71, 0x26, 0x99, 0xfe, 0xcf, 0x2f, 0x34,
-	0x16, 0x42, 0x89, 0x35, 0xe1, 0x25, 0x3b, 0xf5, 0xad, 0xc7, 0x8c, 0x33, 0x3f, 0x94, 0x28, 0xab,
-	0xfa, 0x86, 0xcf, 0x98, 0x1f, 0xe0, 0x4e, 0xde, 0xb9, 0xf1, 0xa8, 0x03, 0x69, 0x5a, 0x40, 0x35,
-	0x9f, 0xf9, 0x2c, 0x2f, 0x3b, 0x59, 0x55, 0x0a, 0x10, 0x13, 0x21, 0x13, 0x8e, 0x02, 0x54, 0x53,
-	0x40, 0xe6, 0x7d, 0x2f, 0x2f, 0xe6, 0x50, 0x12, 0x46, 0x0b, 0xbc, 0x71, 0x1f, 0x97, 0x24, 0xc4,
-	0x42, 0xc2, 0x30, 0x52, 0x84, 0xd6, 0x8f, 0x59, 0x50, 0x1d, 0x40, 0x0e, 0x43, 0xa1, 0x1b, 0x60,
-	0x1e, 0x53, 0xe8, 0x06, 0xd8, 0x33, 0xb4, 0xa6, 0xd6, 0x5e, 0xb0, 0xcb, 0x56, 0x7f, 0x05, 0xb6,
-	0xdc, 0x80, 0xa1, 0x33, 0xe1, 0x44, 0x98, 0x3b, 0x1e, 0x11, 0x92, 0x13, 0x37, 0xce, 0x3e, 0xe3,
-	0x48, 0x0e, 0xa9, 0x08, 0x89, 0x10, 0x84, 0x51, 0xe3, 0xb7, 0xa6, 0xd6, 0x9e, 0xb1, 0x37, 0x15,
-	0x77, 0x80, 0xf9, 0xc1, 0x1d, 0xe6, 0xf0, 0x0e, 0x51, 0xff, 0x1f, 0x6c, 0x3e, 0xea, 0xe2, 0xa0,
-	0x31, 0xa4, 0x14, 0x07, 0xc6, 0x4c, 0x53, 0x6b, 0x2f, 0xda, 0x0d, 0xef, 0x11, 0x93, 0xbe, 0xa2,
-	0xe9, 0x7b, 0xa0, 0x1e, 0x71, 0x96, 0x10, 0x0f, 0x73, 0x67, 0x84, 0xb1, 0x13, 0x31, 0x16, 0x38,
-	0xd0, 0xf3, 0xb8, 0x23, 0x24, 0x37, 0x66, 0x73, 0x93, 0xb5, 0x92, 0x71, 0x88, 0xf1, 0x80, 0xb1,
-	0x60, 0xdf, 0xf3, 0xf8, 0x89, 0xe4, 0xfa, 0x6b, 0xa0, 0x23, 0x94, 0x38, 0x59, 0x28, 0x2c, 0x96,
-	0xd9, 0x74, 0x84, 0x79, 0xc6, 0x5c, 0x53, 0x6b, 0x2f, 0x75, 0x37, 0x2c, 0x95, 0x9d, 0x55, 0x66,
-	0x67, 0x1d, 0x14, 0xd9, 0xf6, 0x16, 0x2e, 0xae, 0x1a, 0x95, 0x0f, 0x5f, 0x1b, 0x9a, 0xbd, 0x82,
-	0x50, 0x32, 0x54, 0xea, 0x41, 0x2e, 0xd6, 0xdf, 0x82, 0xf5, 0x7c, 0x9a, 0x11, 0xe6, 0xf7, 0x7d,
-	0xab, 0x4f, 0xf7, 0xfd, 0xab, 0xf4, 0x98, 0x36, 0x3f, 0x02, 0xcd, 0x72, 0xdf, 0x1c, 0x8e, 0xa7,
-	0x22, 0x1c, 0x71, 0x88, 0xb2, 0xc2, 0x98, 0xcf, 0x27, 0x36, 0x4b, 0x9e, 0x3d, 0x45, 0x3b, 0x2c,
-	0x58, 0xfa, 0x36, 0xd0, 0xc7, 0x44, 0x48, 0xc6, 0x09, 0x82, 0x81, 0x83, 0xa9, 0xe4, 0x04, 0x0b,
-	0x63, 0x21, 0xff, 0x81, 0xab, 0xb7, 0xc8, 0x7f, 0x0a, 0xd0, 0x5f, 0x82, 0x95, 0x98, 0xba, 0x8c,
-	0x7a, 0x84, 0xfa, 0xe5, 0x38, 0x8b, 0x4f, 0x1f, 0xe7, 0x8f, 0x89, 0x58, 0x0d, 0xd2, 0xfa, 0x17,
-	0xfc, 0xfd, 0x1c, 0x0a, 0x79, 0xf7, 0x7f, 0xf6, 0xb2, 0xad, 0x39, 0xc2, 0xc4, 0x1f, 0x4b, 0x7d,
-	0x0d, 0x54, 0xc7, 0x79, 0x95, 0x6f, 0xe2, 0x8c, 0x5d, 0x74, 0xad, 0x8f, 0x1a, 0xf8, 0xb3, 0xcf,
-	0x99, 0x10, 0xfd, 0xec, 0x8e, 0x9d, 0xc2, 0x80, 0x78, 0x50, 0x32, 0x9e, 0xad, 0x6e, 0xf6, 0xc7,
-	0xb1, 0x10, 0xb9, 0x60, 0xd9, 0x2e, 0x5b, 0xbd, 0x06, 0xe6, 0x22, 0xf6, 0x0e, 0xf3, 0x62, 0x37,
-	0x55, 0xa3, 0x43, 0x50, 0x8d, 0x62, 0xf7, 0x0c, 0xa7, 0xf9, 0x92, 0x2d, 0x75, 0x6b, 0x0f, 0x86,
-	0xd8, 0xa7, 0x69, 0x6f, 0xf7, 0xfb, 0x55, 0x63, 0x3d, 0x85, 0x61, 0xb0, 0xd7, 0xca, 0xd2, 0xc4,
-	0x54, 0xc4, 0xc2, 0x51, 0xba, 0xd6, 0xe7, 0x4f, 0xdb, 0xb5, 0xe2, 0x26, 0x22, 0x9e, 0x46, 0x92,
-	0x59, 0x83, 0xd8, 0x7d, 0x86, 0x53, 0xbb, 0x30, 0x6e, 0x49, 0xb0, 0xfa, 0x02, 0xca, 0x98, 0x13,
-	0xea, 0x9f, 0x9e, 0xf4, 0x07, 0x10, 0x9d, 0x61, 0x99, 0x9d, 0x26, 0x11, 0xe8, 0x58, 0x5d, 0xb0,
-	0x59, 0x5b, 0x35, 0xfa, 0x31, 0xf8, 0x3d, 0xcc, 0xa9, 0x32, 0xcd, 0x57, 0x26, 0x3f, 0xeb, 0x52,
-	0xb7, 0xfe, 0xe0, 0x50, 0xc3, 0xf2, 0xf2, 0xaa, 0x68, 0xcf, 0xb3, 0x68, 0x97, 0x4b, 0x69, 0x06,
-	0xf6, 0x86, 0x17, 0xd7, 0xa6, 0x76, 0x79, 0x6d, 0x6a, 0xdf, 0xae, 0x4d, 0xed, 0xfc, 0xc6, 0xac,
-	0x5c, 0xde, 0x98, 0x95, 0x2f, 0x37, 0x66, 0xe5, 0xcd, 0x9e, 0x4f, 0xe4, 0x38, 0x76, 0x2d, 0xc4,
-	0xc2, 0xe2, 0x09, 0xe9, 0xdc, 0xbe, 0x56, 0xdb, 0x93, 0xd7, 0xea, 0xfd, 0xf4, 0x43, 0x28, 0xd3,
-	0x08, 0x0b, 0xb7, 0x9a, 0x9f, 0x60, 0xf7, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0xde, 0xaf, 0xcf,
-	0x76, 0x39, 0x05, 0x00, 0x00,
+	// 762 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4d, 0x6e, 0xdb, 0x46,
+	0x14, 0x36, 0x6b, 0x47, 0xb1, 0xc7, 0x29, 0x9a, 0x4c, 0xdd, 0x84, 0x71, 0x01, 0x4a, 0x51, 0xb3,
+	0xd0, 0xc6, 0x14, 0xa2, 0xa0, 0x1b, 0xef, 0x22, 0xa5, 0x41, 0xd2, 0x5f, 0x95, 0x11, 0xb2, 0x68,
+	0x17, 0xc4, 0x70, 0xf8, 0x44, 0x0d, 0x4c, 0xce, 0xb0, 0x33, 0x43, 0xb6, 0xbc, 0x45, 0x96, 0x3d,
+	0x42, 0x0f, 0xd0, 0x43, 0x04, 0x5d, 0x65, 0xd9, 0x55, 0x5a, 0xd8, 0x37, 0x28, 0x7a, 0x80, 0x62,
+	0x66, 0x48, 0x45, 0x72, 0x6a, 0x20, 0xbb, 0xf7, 0xf1, 0x7d, 0xef, 0xe3, 0xfb, 0x1d, 0x34, 0x61,
+	0x5c, 0x83, 0xa4, 0x2b, 0xc2, 0x78, 0xac, 0x80, 0x56, 0x92, 0xe9, 0x66, 0x4c, 0x69, 0x3d, 0xa6,
+	0x82, 0xab, 0xaa, 0x00, 0x39, 0xae, 0x1f, 0xac, 0xed, 0xb0, 0x94, 0x42, 0x0b, 0xfc, 0xd9, 0xff,
+	0xc4, 0x84, 0x94, 0xd6, 0xe1, 0x9a, 0x57, 0x3f, 0x38, 0xbe, 0x7f, 0x95, 0xb0, 0xd1, 0xa3, 0xb5,
+	0x93, 0x3a, 0xbe, 0x9b, 0x09, 0x91, 0xe5, 0x30, 0xb6, 0x28, 0xa9, 0x96, 0x63, 0xc2, 0x9b, 0xd6,
+	0x75, 0x94, 0x89, 0x4c, 0x58, 0x73, 0x6c, 0xac, 0x2e, 0x80, 0x0a, 0x55, 0x08, 0x15, 0x3b, 0x87,
+	0x03, 0xad, 0x2b, 0xb8, 0xac, 0x95, 0x56, 0x92, 0x68, 0x26, 0x78, 0xeb, 0xef, 0x5f, 0xf6, 0x6b,
+	0x56, 0x80, 0xd2, 0xa4, 0x28, 0x1d, 0x61, 0xf8, 0xef, 0x1e, 0xea, 0xcd, 0x89, 0x24, 0x85, 0xc2,
+	0x3e, 0xba, 0x0e, 0x9c, 0x24, 0x39, 0xa4, 0xbe, 0x37, 0xf0, 0x46, 0xfb, 0x51, 0x07, 0xf1, 0x77,
+	0xe8, 0x7e, 0x92, 0x0b, 0x7a, 0xa6, 0xe2, 0x12, 0x64, 0x9c, 0x32, 0xa5, 0x25, 0x4b, 0x2a, 0xf3,
+	0x9b, 0x58, 0x4b, 0xc2, 0x55, 0xc1, 0x94, 0x62, 0x82, 0xfb, 0x1f, 0x0c, 0xbc, 0xd1, 0x6e, 0x74,
+	0xcf, 0x71, 0xe7, 0x20, 0x1f, 0x6f, 0x30, 0x17, 0x1b, 0x44, 0xfc, 0x25, 0xba, 0x77, 0xa5, 0x4a,
+	0x4c, 0x57, 0x84, 0x73, 0xc8, 0xfd, 0xdd, 0x81, 0x37, 0x3a, 0x88, 0xfa, 0xe9, 0x15, 0x22, 0x33,
+	0x47, 0xc3, 0xa7, 0xe8, 0xb8, 0x94, 0xa2, 0x66, 0x29, 0xc8, 0x78, 0x09, 0x10, 0x97, 0x42, 0xe4,
+	0x31, 0x49, 0x53, 0x19, 0x2b, 0x2d, 0xfd, 0x3d, 0x2b, 0x72, 0xbb, 0x63, 0x3c, 0x01, 0x98, 0x0b,
+	0x91, 0x3f, 0x4a, 0x53, 0xf9, 0x5c, 0x4b, 0xfc, 0x3d, 0xc2, 0x94, 0xd6, 0xb1, 0x69, 0x8a, 0xa8,
+	0xb4, 0xa9, 0x8e, 0x89, 0xd4, 0xbf, 0x36, 0xf0, 0x46, 0x87, 0x93, 0xbb, 0xa1, 0xeb, 0x5d, 0xd8,
+	0xf5, 0x2e, 0x7c, 0xdc, 0xf6, 0x76, 0xba, 0xff, 0xea, 0x4d, 0x7f, 0xe7, 0xd7, 0xbf, 0xfa, 0x5e,
+	0x74, 0x93, 0xd2, 0x7a, 0xe1, 0xa2, 0xe7, 0x36, 0x18, 0xff, 0x88, 0xee, 0xd8, 0x6a, 0x96, 0x20,
+	0x2f, 0xeb, 0xf6, 0xde, 0x5f, 0xf7, 0x93, 0x4e, 0x63, 0x5b, 0xfc, 0x29, 0x1a, 0x74, 0xfb, 0x16,
+	0x4b, 0xd8, 0x6a, 0xe1, 0x52, 0x12, 0x6a, 0x0c, 0xff, 0xba, 0xad, 0x38, 0xe8, 0x78, 0xd1, 0x16,
+	0xed, 0x49, 0xcb, 0xc2, 0x27, 0x08, 0xaf, 0x98, 0xd2, 0x42, 0x32, 0x4a, 0xf2, 0x18, 0xb8, 0x96,
+	0x0c, 0x94, 0xbf, 0x6f, 0x07, 0x78, 0xeb, 0xad, 0xe7, 0x0b, 0xe7, 0xc0, 0xdf, 0xa2, 0x9b, 0x15,
+	0x4f, 0x04, 0x4f, 0x19, 0xcf, 0xba, 0x72, 0x0e, 0xde, 0xbf, 0x9c, 0x8f, 0xd6, 0xc1, 0xae, 0x90,
+	0xe1, 0xe7, 0xe8, 0xd3, 0xaf, 0x89, 0xd2, 0x9b, 0xf3, 0x9c, 0x9a, 0xad, 0x79, 0x0a, 0x2c, 0x5b,
+	0x69, 0x7c, 0x1b, 0xf5, 0x56, 0xd6, 0xb2, 0x9b, 0xb8, 0x1b, 0xb5, 0x68, 0xf8, 0x9b, 0x87, 0x3e,
+	0x9e, 0x49, 0xa1, 0xd4, 0xcc, 0xdc, 0xd8, 0x0b, 0x92, 0xb3, 0x94, 0x68, 0x21, 0xcd, 0xea, 0x9a,
+	0x89, 0x83, 0x52, 0x36, 0xe0, 0x46, 0xd4, 0x41, 0x7c, 0x84, 0xae, 0x95, 0xe2, 0x67, 0x90, 0xed,
+	0x6e, 0x3a, 0x80, 0x09, 0xea, 0x95, 0x55, 0x72, 0x06, 0x8d, 0x5d, 0xb2, 0xc3, 0xc9, 0xd1, 0x3b,
+	0x45, 0x3c, 0xe2, 0xcd, 0xf4, 0xe1, 0x3f, 0x6f, 0xfa, 0x77, 0x1a, 0x52, 0xe4, 0xa7, 0x43, 0xd3,
+	0x4d, 0xe0, 0xaa, 0x52, 0xb1, 0x8b, 0x1b, 0xfe, 0xf1, 0xfb, 0xc9, 0x51, 0x7b, 0x89, 0x54, 0x36,
+	0xa5, 0x16, 0xe1, 0xbc, 0x4a, 0xbe, 0x82, 0x26, 0x6a, 0x85, 0x87, 0x1a, 0xdd, 0xfa, 0x86, 0xe8,
+	0x4a, 0x32, 0x9e, 0xbd, 0x78, 0x3e, 0x9b, 0x13, 0x7a, 0x06, 0xda, 0x64, 0x53, 0x2b, 0xfa, 0xcc,
+	0x1d, 0xd8, 0x5e, 0xe4, 0x00, 0x7e, 0x86, 0x3e, 0x2c, 0x2c, 0x55, 0x37, 0x76, 0x65, 0x6c, 0xae,
+	0x87, 0x93, 0xe3, 0x77, 0x92, 0x5a, 0x74, 0xc7, 0xeb, 0x5a, 0xfb, 0xd2, 0xb4, 0xf6, 0x46, 0x17,
+	0x6a, 0x9c, 0xc3, 0x12, 0x05, 0xb3, 0xf5, 0xe0, 0x0b, 0x51, 0x93, 0x3c, 0x82, 0x9f, 0x2a, 0x50,
+	0x7a, 0x2e, 0x45, 0x29, 0x14, 0xc9, 0x4d, 0x0a, 0x9a, 0xe9, 0x1c, 0x6c, 0x0a, 0x07, 0x91, 0x03,
+	0x78, 0x80, 0x0e, 0x53, 0x50, 0x54, 0xb2, 0x52, 0x77, 0x87, 0x7c, 0x10, 0x6d, 0x7e, 0x32, 0x23,
+	0x91, 0x40, 0x94, 0xe0, 0xed, 0x5d, 0xb6, 0x68, 0xba, 0x78, 0x75, 0x1e, 0x78, 0xaf, 0xcf, 0x03,
+	0xef, 0xef, 0xf3, 0xc0, 0x7b, 0x79, 0x11, 0xec, 0xbc, 0xbe, 0x08, 0x76, 0xfe, 0xbc, 0x08, 0x76,
+	0x7e, 0x38, 0xcd, 0x98, 0x5e, 0x55, 0x49, 0x48, 0x45, 0xd1, 0x3e, 0x5a, 0xe3, 0xb7, 0xef, 0xe3,
+	0xc9, 0xfa, 0x7d, 0xfc, 0x65, 0xfb, 0xe9, 0xd5, 0x4d, 0x09, 0x2a, 0xe9, 0xd9, 0x9a, 0x1f, 0xfe,
+	0x37, 0x00, 0xe0, 0xc4, 0xcf, 0xcf, 0xab, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -581,6 +651,50 @@ func (m *MaturingVSCPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ConsumerRemovalRequestProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerRemovalRequestProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerRemovalRequestProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintConsumer(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintConsumer(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintConsumer(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintConsumer(dAtA []byte, offset int, v uint64) int {
 	offset -= sovConsumer(v)
 	base := offset
@@ -674,6 +788,27 @@ func (m *MaturingVSCPacket) Size() (n int) {
 	return n
 }
 
+func (m *ConsumerRemovalRequestProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovConsumer(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovConsumer(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovConsumer(uint64(l))
+	}
+	return n
+}
+
 func sovConsumer(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1293,6 +1428,152 @@ func (m *MaturingVSCPacket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ConsumerRemovalRequestProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsumer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerRemovalRequestProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerRemovalRequestProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsumer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipConsumer(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
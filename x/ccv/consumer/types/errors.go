@@ -6,5 +6,6 @@ import (
 
 // Consumer sentinel errors
 var (
-	ErrNoProposerChannelId = sdkerrors.Register(ModuleName, 1, "no established CCV channel")
+	ErrNoProposerChannelId               = sdkerrors.Register(ModuleName, 1, "no established CCV channel")
+	ErrInvalidConsumerRemovalRequestProp = sdkerrors.Register(ModuleName, 2, "invalid consumer removal request proposal")
 )
@@ -1,7 +1,10 @@
 package types
 
 import (
+	"crypto/sha256"
+
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 	ibctmtypes "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
 	ccv "github.com/cosmos/interchain-security/x/ccv/types"
 
@@ -47,6 +50,28 @@ func NewRestartGenesisState(
 	}
 }
 
+// Hash returns the SHA-256 hash of the parts of the consumer genesis state that the provider generates,
+// i.e., the params, the provider client state, the provider consensus state and the initial validator set.
+// Thus, a new consumer chain must be started with the params set by the provider, e.g., the redistribution
+// fraction and the CCV timeout period agreed on in the consumer addition proposal.
+// The hash of the consumer genesis a new consumer chain is started from is sent to the provider
+// on the CCV channel handshake, where it is compared to the hash of the consumer genesis
+// generated by the provider.
+func (gs GenesisState) Hash() ([]byte, error) {
+	hashed := GenesisState{
+		Params:                 gs.Params,
+		ProviderClientState:    gs.ProviderClientState,
+		ProviderConsensusState: gs.ProviderConsensusState,
+		InitialValSet:          gs.InitialValSet,
+	}
+	bz, err := hashed.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(bz)
+	return hash[:], nil
+}
+
 // DefaultGenesisState returns a default disabled consumer chain genesis state. This allows the module to be hooked up to app without getting use
 // unless explicitly specified in genesis.
 func DefaultGenesisState() *GenesisState {
@@ -82,6 +107,11 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+	if gs.ProviderPortId != "" {
+		if err := host.PortIdentifierValidator(gs.ProviderPortId); err != nil {
+			return sdkerrors.Wrapf(ccv.ErrInvalidGenesis, "invalid provider port id: %s", err.Error())
+		}
+	}
 
 	if gs.NewChain {
 		if gs.ProviderClientState == nil {
@@ -111,6 +141,9 @@ func (gs GenesisState) Validate() error {
 		if gs.LastTransmissionBlockHeight.Height != 0 {
 			return sdkerrors.Wrap(ccv.ErrInvalidGenesis, "last transmission block height must be empty for new chain")
 		}
+		if len(gs.GenesisHash) != 0 {
+			return sdkerrors.Wrap(ccv.ErrInvalidGenesis, "genesis hash must be empty for new chain")
+		}
 	} else {
 		// NOTE: For restart genesis, we will verify initial validator set in InitGenesis.
 		if gs.ProviderClientId == "" {
@@ -50,6 +50,12 @@ type GenesisState struct {
 	PendingConsumerPackets types2.ConsumerPacketDataList `protobuf:"bytes,11,opt,name=pending_consumer_packets,json=pendingConsumerPackets,proto3" json:"pending_consumer_packets"`
 	// LastTransmissionBlockHeight nil on new chain, filled in on restart.
 	LastTransmissionBlockHeight LastTransmissionBlockHeight `protobuf:"bytes,12,opt,name=last_transmission_block_height,json=lastTransmissionBlockHeight,proto3" json:"last_transmission_block_height"`
+	// GenesisHash nil on new chain, filled in on restart.
+	// The hash of the consumer genesis the chain was started from with new_chain set to true.
+	GenesisHash []byte `protobuf:"bytes,13,opt,name=genesis_hash,json=genesisHash,proto3" json:"genesis_hash,omitempty"`
+	// The port ID the provider CCV module is bound to, expected as the counterparty
+	// port of the CCV channel. Defaults to the provider port if empty.
+	ProviderPortId string `protobuf:"bytes,14,opt,name=provider_port_id,json=providerPortId,proto3" json:"provider_port_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -169,6 +175,20 @@ func (m *GenesisState) GetLastTransmissionBlockHeight() LastTransmissionBlockHei
 	return LastTransmissionBlockHeight{}
 }
 
+func (m *GenesisState) GetGenesisHash() []byte {
+	if m != nil {
+		return m.GenesisHash
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProviderPortId() string {
+	if m != nil {
+		return m.ProviderPortId
+	}
+	return ""
+}
+
 // HeightValsetUpdateID defines the genesis information for the mapping
 // of each block height to a valset update id
 type HeightToValsetUpdateID struct {
@@ -280,56 +300,58 @@ func init() {
 }
 
 var fileDescriptor_2db73a6057a27482 = []byte{
-	// 773 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcf, 0x6f, 0xdb, 0x36,
-	0x14, 0xb6, 0x92, 0xcc, 0xb3, 0x99, 0x6c, 0xc9, 0x98, 0xcd, 0xd0, 0x6c, 0x4c, 0xf3, 0xb2, 0x1d,
-	0x0c, 0x6c, 0x93, 0x60, 0x0f, 0x18, 0x86, 0x15, 0x28, 0xda, 0x24, 0x40, 0x6b, 0x20, 0x6d, 0x03,
-	0x3b, 0xf1, 0x21, 0x17, 0x81, 0xa6, 0x58, 0x89, 0x88, 0x44, 0x1a, 0x22, 0xa5, 0x34, 0x87, 0x5e,
-	0x7a, 0xed, 0xa5, 0x7f, 0x56, 0x8e, 0x39, 0xf6, 0x14, 0x14, 0xc9, 0x3f, 0x52, 0x88, 0xa4, 0xfc,
-	0xa3, 0x71, 0x50, 0x9f, 0x4c, 0xf2, 0x7d, 0xef, 0xfb, 0xde, 0x7b, 0x1f, 0x4d, 0x81, 0x2e, 0x65,
-	0x92, 0xa4, 0x38, 0x42, 0x94, 0xf9, 0x82, 0xe0, 0x2c, 0xa5, 0xf2, 0xd2, 0xc3, 0x38, 0xf7, 0x30,
-	0x67, 0x22, 0x4b, 0x48, 0xea, 0xe5, 0x5d, 0x2f, 0x24, 0x8c, 0x08, 0x2a, 0xdc, 0x49, 0xca, 0x25,
-	0x87, 0xbf, 0x2f, 0x49, 0x71, 0x31, 0xce, 0xdd, 0x32, 0xc5, 0xcd, 0xbb, 0xcd, 0x3f, 0x1e, 0xe2,
-	0xcd, 0xbb, 0xc5, 0x8f, 0xa6, 0x6a, 0xf6, 0x56, 0x51, 0x9f, 0xd2, 0xea, 0x9c, 0x96, 0x24, 0x2c,
-	0x20, 0x69, 0x42, 0x99, 0xf4, 0xd0, 0x18, 0x53, 0x4f, 0x5e, 0x4e, 0x88, 0xa9, 0xad, 0xe9, 0xd1,
-	0x31, 0xf6, 0x62, 0x1a, 0x46, 0x12, 0xc7, 0x94, 0x30, 0x29, 0xbc, 0x39, 0x74, 0xde, 0x9d, 0xdb,
-	0x99, 0x84, 0xdf, 0x8a, 0x04, 0xcc, 0x53, 0xe2, 0xe1, 0x08, 0x31, 0x46, 0x62, 0xa5, 0xa8, 0x97,
-	0x06, 0xe2, 0x84, 0x9c, 0x87, 0x31, 0xf1, 0xd4, 0x6e, 0x9c, 0xbd, 0xf6, 0x82, 0x2c, 0x45, 0x92,
-	0x72, 0x66, 0xe2, 0x3f, 0x86, 0x3c, 0xe4, 0x6a, 0xe9, 0x15, 0x2b, 0x7d, 0xba, 0x77, 0x53, 0x03,
-	0x5b, 0xcf, 0xf4, 0xdc, 0x86, 0x12, 0x49, 0x02, 0xfb, 0xa0, 0x3a, 0x41, 0x29, 0x4a, 0x84, 0x6d,
-	0xb5, 0xad, 0xce, 0x66, 0xef, 0x4f, 0x77, 0x85, 0x39, 0xba, 0xc7, 0x2a, 0x65, 0x7f, 0xe3, 0xea,
-	0xe6, 0xd7, 0xca, 0xc0, 0x10, 0xc0, 0xbf, 0x00, 0x9c, 0xa4, 0x3c, 0xa7, 0x01, 0x49, 0x7d, 0xdd,
-	0xa7, 0x4f, 0x03, 0x7b, 0xad, 0x6d, 0x75, 0xea, 0x83, 0x9d, 0x32, 0x72, 0xa0, 0x02, 0xfd, 0x00,
-	0xba, 0x60, 0x77, 0x86, 0xd6, 0x9d, 0x15, 0xf0, 0x75, 0x05, 0xff, 0x61, 0x0a, 0xd7, 0x91, 0x7e,
-	0x00, 0x5b, 0xa0, 0xce, 0xc8, 0x85, 0xaf, 0x0a, 0xb3, 0x37, 0xda, 0x56, 0xa7, 0x36, 0xa8, 0x31,
-	0x72, 0x71, 0x50, 0xec, 0xa1, 0x0f, 0x7e, 0xfa, 0x52, 0x5a, 0x14, 0xed, 0xd9, 0xdf, 0x94, 0x4d,
-	0x8d, 0xb1, 0x3b, 0x6f, 0x80, 0x3b, 0x37, 0xf2, 0xbc, 0xeb, 0xea, 0xaa, 0xd4, 0x44, 0x06, 0xbb,
-	0x8b, 0xa5, 0xea, 0x31, 0x45, 0xc0, 0x9e, 0x09, 0x70, 0x26, 0x08, 0x13, 0x99, 0x30, 0x1a, 0x55,
-	0xa5, 0xe1, 0x7e, 0x55, 0xa3, 0x4c, 0xd3, 0x32, 0x8d, 0xa9, 0xcc, 0xc2, 0x39, 0x0c, 0xc1, 0x4e,
-	0x82, 0x64, 0x96, 0x52, 0x16, 0xfa, 0x13, 0x84, 0xcf, 0x89, 0x14, 0xf6, 0xb7, 0xed, 0xf5, 0xce,
-	0x66, 0xef, 0xdf, 0x95, 0xac, 0x79, 0x61, 0x92, 0x47, 0xc3, 0x83, 0x63, 0x95, 0x6e, 0x5c, 0xda,
-	0x2e, 0x59, 0xf5, 0xa9, 0x80, 0x2f, 0xc1, 0x36, 0x65, 0x54, 0x52, 0x14, 0xfb, 0x39, 0x8a, 0x7d,
-	0x41, 0xa4, 0x5d, 0x53, 0x3a, 0xed, 0xf9, 0xc2, 0x8b, 0xbb, 0xec, 0x8e, 0x50, 0x4c, 0x03, 0x24,
-	0x79, 0x7a, 0x3a, 0x09, 0x90, 0x24, 0x86, 0xf1, 0x3b, 0x93, 0x3e, 0x42, 0xf1, 0x90, 0x48, 0xf8,
-	0x16, 0x34, 0x23, 0x52, 0xb4, 0xef, 0x4b, 0x5e, 0x30, 0x0a, 0x22, 0xfd, 0x4c, 0xe1, 0x0b, 0x5f,
-	0xeb, 0x8a, 0xfa, 0xd1, 0x4a, 0x2d, 0x3c, 0x57, 0x34, 0x27, 0x7c, 0xa4, 0x48, 0xb4, 0x66, 0xff,
-	0xd0, 0xa8, 0x36, 0xa2, 0x65, 0xd1, 0x00, 0xbe, 0xb3, 0xc0, 0x2f, 0x3c, 0x93, 0x42, 0x22, 0x16,
-	0x14, 0xb3, 0x0b, 0xf8, 0x05, 0x93, 0x34, 0x21, 0xbe, 0x88, 0x91, 0x88, 0x28, 0x0b, 0x6d, 0xa0,
-	0x4a, 0xf8, 0x6f, 0xa5, 0x12, 0x5e, 0xcd, 0x98, 0x0e, 0x0d, 0x91, 0xd1, 0x6f, 0xf1, 0xfb, 0xa1,
-	0xa1, 0x91, 0x80, 0x29, 0xb0, 0x27, 0x44, 0xeb, 0x97, 0x6c, 0x53, 0x13, 0x37, 0xd5, 0x35, 0xe9,
-	0x3d, 0x28, 0x6f, 0xae, 0x48, 0x91, 0xa3, 0x2d, 0x3a, 0x44, 0x12, 0x1d, 0x51, 0x51, 0x1a, 0xd8,
-	0x30, 0xcc, 0x8b, 0x20, 0x01, 0xdf, 0x5b, 0xc0, 0x89, 0x91, 0x90, 0xbe, 0x4c, 0x11, 0x13, 0x09,
-	0x15, 0x82, 0x72, 0xe6, 0x8f, 0x63, 0x8e, 0xcf, 0x7d, 0x3d, 0x2b, 0x7b, 0x4b, 0x49, 0x3f, 0x59,
-	0xa9, 0xf3, 0x23, 0x24, 0xe4, 0xc9, 0x1c, 0xd3, 0x7e, 0x41, 0xa4, 0x1d, 0x29, 0x27, 0x10, 0x3f,
-	0x0c, 0xd9, 0x3b, 0x03, 0x8d, 0xe5, 0xf6, 0xc1, 0x06, 0xa8, 0x9a, 0x72, 0x8a, 0x97, 0x66, 0x63,
-	0x60, 0x76, 0xb0, 0x03, 0x76, 0xee, 0xdd, 0x96, 0x35, 0x85, 0xf8, 0x3e, 0x5f, 0xb0, 0x78, 0xef,
-	0x14, 0xec, 0x2e, 0xf1, 0x05, 0x3e, 0x06, 0xad, 0xbc, 0xbc, 0xa0, 0x73, 0x7f, 0x4e, 0x14, 0x04,
-	0x29, 0x11, 0xfa, 0x5d, 0xab, 0x0f, 0x7e, 0x9e, 0x42, 0xa6, 0xff, 0xb7, 0xa7, 0x1a, 0xb0, 0x7f,
-	0x72, 0x75, 0xeb, 0x58, 0xd7, 0xb7, 0x8e, 0xf5, 0xe9, 0xd6, 0xb1, 0x3e, 0xdc, 0x39, 0x95, 0xeb,
-	0x3b, 0xa7, 0xf2, 0xf1, 0xce, 0xa9, 0x9c, 0xfd, 0x1f, 0x52, 0x19, 0x65, 0x63, 0x17, 0xf3, 0xc4,
-	0xc3, 0x5c, 0x24, 0x5c, 0x78, 0xb3, 0x11, 0xfe, 0x3d, 0xfd, 0x34, 0xbc, 0x59, 0xfc, 0x38, 0xa8,
-	0x97, 0x7f, 0x5c, 0x55, 0x0f, 0xee, 0x3f, 0x9f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xb4, 0x5a, 0x50,
-	0x50, 0xcb, 0x06, 0x00, 0x00,
+	// 811 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0xaf, 0x77, 0x4b, 0x69, 0xa6, 0xd9, 0xee, 0x32, 0x85, 0xc8, 0x24, 0xc2, 0x64, 0x0b, 0x87,
+	0x48, 0x80, 0xad, 0x04, 0x09, 0x21, 0x90, 0x10, 0xb4, 0x95, 0xd8, 0x48, 0x0b, 0x54, 0x49, 0x37,
+	0x87, 0xbd, 0x8c, 0x26, 0xe3, 0xc1, 0x1e, 0xad, 0x3d, 0x63, 0xcd, 0x8c, 0x5d, 0xf6, 0xc0, 0x85,
+	0x2b, 0x17, 0xbe, 0x07, 0x5f, 0x64, 0x8f, 0x7b, 0xe4, 0x84, 0x50, 0xfb, 0x45, 0x90, 0x67, 0xc6,
+	0x4e, 0xc2, 0xa6, 0x22, 0x27, 0x7b, 0xe6, 0xfd, 0xde, 0xef, 0xf7, 0xfe, 0xf9, 0x19, 0x8c, 0x19,
+	0xd7, 0x54, 0x92, 0x14, 0x33, 0x8e, 0x14, 0x25, 0xa5, 0x64, 0xfa, 0x65, 0x44, 0x48, 0x15, 0x11,
+	0xc1, 0x55, 0x99, 0x53, 0x19, 0x55, 0xe3, 0x28, 0xa1, 0x9c, 0x2a, 0xa6, 0xc2, 0x42, 0x0a, 0x2d,
+	0xe0, 0x47, 0x5b, 0x5c, 0x42, 0x42, 0xaa, 0xb0, 0x71, 0x09, 0xab, 0x71, 0xff, 0xe3, 0xbb, 0x78,
+	0xab, 0x71, 0xfd, 0xb0, 0x54, 0xfd, 0xc9, 0x2e, 0xea, 0x2d, 0xad, 0xf5, 0x19, 0x68, 0xca, 0x63,
+	0x2a, 0x73, 0xc6, 0x75, 0x84, 0x97, 0x84, 0x45, 0xfa, 0x65, 0x41, 0x5d, 0x6c, 0xfd, 0x88, 0x2d,
+	0x49, 0x94, 0xb1, 0x24, 0xd5, 0x24, 0x63, 0x94, 0x6b, 0x15, 0xad, 0xa1, 0xab, 0xf1, 0xda, 0xc9,
+	0x39, 0x3c, 0xae, 0x1d, 0x88, 0x90, 0x34, 0x22, 0x29, 0xe6, 0x9c, 0x66, 0x46, 0xd1, 0xbe, 0x3a,
+	0x48, 0x90, 0x08, 0x91, 0x64, 0x34, 0x32, 0xa7, 0x65, 0xf9, 0x73, 0x14, 0x97, 0x12, 0x6b, 0x26,
+	0xb8, 0xb3, 0xbf, 0x9b, 0x88, 0x44, 0x98, 0xd7, 0xa8, 0x7e, 0xb3, 0xb7, 0xa7, 0x7f, 0x76, 0x40,
+	0xf7, 0x7b, 0x5b, 0xb7, 0xb9, 0xc6, 0x9a, 0xc2, 0x29, 0x38, 0x28, 0xb0, 0xc4, 0xb9, 0xf2, 0xbd,
+	0xa1, 0x37, 0x3a, 0x9a, 0x7c, 0x12, 0xee, 0x50, 0xc7, 0xf0, 0xd2, 0xb8, 0x9c, 0xed, 0xbf, 0xfa,
+	0xfb, 0xc3, 0xbd, 0x99, 0x23, 0x80, 0x9f, 0x02, 0x58, 0x48, 0x51, 0xb1, 0x98, 0x4a, 0x64, 0xf3,
+	0x44, 0x2c, 0xf6, 0xef, 0x0d, 0xbd, 0x51, 0x67, 0xf6, 0xa8, 0xb1, 0x9c, 0x1b, 0xc3, 0x34, 0x86,
+	0x21, 0x38, 0x59, 0xa1, 0x6d, 0x66, 0x35, 0xfc, 0xbe, 0x81, 0xbf, 0xd3, 0xc2, 0xad, 0x65, 0x1a,
+	0xc3, 0x01, 0xe8, 0x70, 0x7a, 0x8d, 0x4c, 0x60, 0xfe, 0xfe, 0xd0, 0x1b, 0x1d, 0xce, 0x0e, 0x39,
+	0xbd, 0x3e, 0xaf, 0xcf, 0x10, 0x81, 0xf7, 0xfe, 0x2b, 0xad, 0xea, 0xf4, 0xfc, 0xb7, 0x9a, 0xa4,
+	0x96, 0x24, 0x5c, 0x6f, 0x40, 0xb8, 0x56, 0xf2, 0x6a, 0x1c, 0xda, 0xa8, 0x4c, 0x45, 0x66, 0x27,
+	0x9b, 0xa1, 0xda, 0x32, 0xa5, 0xc0, 0x5f, 0x09, 0x08, 0xae, 0x28, 0x57, 0xa5, 0x72, 0x1a, 0x07,
+	0x46, 0x23, 0xfc, 0x5f, 0x8d, 0xc6, 0xcd, 0xca, 0xf4, 0x5a, 0x99, 0x8d, 0x7b, 0x98, 0x80, 0x47,
+	0x39, 0xd6, 0xa5, 0x64, 0x3c, 0x41, 0x05, 0x26, 0x2f, 0xa8, 0x56, 0xfe, 0xdb, 0xc3, 0xfb, 0xa3,
+	0xa3, 0xc9, 0x17, 0x3b, 0xb5, 0xe6, 0x07, 0xe7, 0xbc, 0x98, 0x9f, 0x5f, 0x1a, 0x77, 0xd7, 0xa5,
+	0x87, 0x0d, 0xab, 0xbd, 0x55, 0xf0, 0x47, 0xf0, 0x90, 0x71, 0xa6, 0x19, 0xce, 0x50, 0x85, 0x33,
+	0xa4, 0xa8, 0xf6, 0x0f, 0x8d, 0xce, 0x70, 0x3d, 0xf0, 0x7a, 0x96, 0xc3, 0x05, 0xce, 0x58, 0x8c,
+	0xb5, 0x90, 0xcf, 0x8a, 0x18, 0x6b, 0xea, 0x18, 0x1f, 0x38, 0xf7, 0x05, 0xce, 0xe6, 0x54, 0xc3,
+	0x5f, 0x41, 0x3f, 0xa5, 0x75, 0xfa, 0x48, 0x8b, 0x9a, 0x51, 0x51, 0x8d, 0x4a, 0x83, 0xaf, 0xfb,
+	0xda, 0x31, 0xd4, 0x5f, 0xef, 0x94, 0xc2, 0x13, 0x43, 0x73, 0x25, 0x16, 0x86, 0xc4, 0x6a, 0x4e,
+	0x2f, 0x9c, 0x6a, 0x2f, 0xdd, 0x66, 0x8d, 0xe1, 0x6f, 0x1e, 0xf8, 0x40, 0x94, 0x5a, 0x69, 0xcc,
+	0xe3, 0xba, 0x76, 0xb1, 0xb8, 0xe6, 0x9a, 0xe5, 0x14, 0xa9, 0x0c, 0xab, 0x94, 0xf1, 0xc4, 0x07,
+	0x26, 0x84, 0x2f, 0x77, 0x0a, 0xe1, 0xa7, 0x15, 0xd3, 0x85, 0x23, 0x72, 0xfa, 0x03, 0xf1, 0xa6,
+	0x69, 0xee, 0x24, 0xa0, 0x04, 0x7e, 0x41, 0xad, 0x7e, 0xc3, 0xd6, 0x36, 0xf1, 0xc8, 0x8c, 0xc9,
+	0xe4, 0x4e, 0x79, 0x37, 0x22, 0xb5, 0x8f, 0x6d, 0xd1, 0x05, 0xd6, 0xf8, 0x29, 0x53, 0x4d, 0x03,
+	0x7b, 0x8e, 0x79, 0x13, 0xa4, 0xe0, 0xef, 0x1e, 0x08, 0x32, 0xac, 0x34, 0xd2, 0x12, 0x73, 0x95,
+	0x33, 0xa5, 0x98, 0xe0, 0x68, 0x99, 0x09, 0xf2, 0x02, 0xd9, 0x5a, 0xf9, 0x5d, 0x23, 0xfd, 0xed,
+	0x4e, 0x99, 0x3f, 0xc5, 0x4a, 0x5f, 0xad, 0x31, 0x9d, 0xd5, 0x44, 0xb6, 0x23, 0x4d, 0x05, 0xb2,
+	0xbb, 0x21, 0xf0, 0x31, 0xe8, 0xba, 0xbd, 0x8c, 0x52, 0xac, 0x52, 0xff, 0xc1, 0xd0, 0x1b, 0x75,
+	0x67, 0x47, 0xee, 0xee, 0x09, 0x56, 0x29, 0x1c, 0x81, 0x76, 0x1b, 0xa0, 0x42, 0x48, 0xb3, 0x25,
+	0x8e, 0xcd, 0x67, 0x7f, 0xdc, 0xdc, 0x5f, 0x0a, 0xa9, 0xa7, 0xf1, 0xe9, 0x73, 0xd0, 0xdb, 0x3e,
+	0x0b, 0xb0, 0x07, 0x0e, 0x5c, 0x6e, 0xf5, 0xda, 0xda, 0x9f, 0xb9, 0x53, 0xcd, 0xfd, 0xc6, 0xe8,
+	0xdd, 0x33, 0x88, 0xe3, 0x6a, 0x63, 0x5e, 0x4e, 0x9f, 0x81, 0x93, 0x2d, 0x4d, 0x86, 0xdf, 0x80,
+	0x41, 0xd5, 0x4c, 0xfb, 0xda, 0x97, 0x8e, 0xe3, 0x58, 0x52, 0x65, 0x97, 0x64, 0x67, 0xf6, 0x7e,
+	0x0b, 0x69, 0x3f, 0xde, 0xef, 0x2c, 0xe0, 0xec, 0xea, 0xd5, 0x4d, 0xe0, 0xbd, 0xbe, 0x09, 0xbc,
+	0x7f, 0x6e, 0x02, 0xef, 0x8f, 0xdb, 0x60, 0xef, 0xf5, 0x6d, 0xb0, 0xf7, 0xd7, 0x6d, 0xb0, 0xf7,
+	0xfc, 0xab, 0x84, 0xe9, 0xb4, 0x5c, 0x86, 0x44, 0xe4, 0x11, 0x11, 0x2a, 0x17, 0x2a, 0x5a, 0xf5,
+	0xe3, 0xb3, 0xf6, 0x3f, 0xf3, 0xcb, 0xe6, 0x9f, 0xc6, 0xfc, 0x46, 0x96, 0x07, 0x66, 0x7b, 0x7f,
+	0xfe, 0xef, 0x00, 0x19, 0xef, 0x14, 0xe8, 0x18, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -352,6 +374,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ProviderPortId) > 0 {
+		i -= len(m.ProviderPortId)
+		copy(dAtA[i:], m.ProviderPortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ProviderPortId)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.GenesisHash) > 0 {
+		i -= len(m.GenesisHash)
+		copy(dAtA[i:], m.GenesisHash)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.GenesisHash)))
+		i--
+		dAtA[i] = 0x6a
+	}
 	{
 		size, err := m.LastTransmissionBlockHeight.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -618,6 +654,14 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.LastTransmissionBlockHeight.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.GenesisHash)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ProviderPortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -1075,6 +1119,72 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisHash = append(m.GenesisHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.GenesisHash == nil {
+				m.GenesisHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -100,6 +100,8 @@ func TestValidateInitialGenesisState(t *testing.T) {
 				nil,
 				ccv.ConsumerPacketDataList{},
 				types.LastTransmissionBlockHeight{},
+				nil,
+				"",
 			},
 			true,
 		},
@@ -118,6 +120,28 @@ func TestValidateInitialGenesisState(t *testing.T) {
 				nil,
 				ccv.ConsumerPacketDataList{},
 				types.LastTransmissionBlockHeight{},
+				nil,
+				"",
+			},
+			true,
+		},
+		{
+			"invalid new consumer genesis state: genesis hash not empty",
+			&types.GenesisState{
+				params,
+				"",
+				"",
+				true,
+				cs,
+				consensusState,
+				nil,
+				valUpdates,
+				nil,
+				nil,
+				ccv.ConsumerPacketDataList{},
+				types.LastTransmissionBlockHeight{},
+				[]byte("genesis-hash"),
+				"",
 			},
 			true,
 		},
@@ -136,6 +160,8 @@ func TestValidateInitialGenesisState(t *testing.T) {
 				nil,
 				ccv.ConsumerPacketDataList{},
 				types.LastTransmissionBlockHeight{},
+				nil,
+				"",
 			},
 			true,
 		},
@@ -154,6 +180,8 @@ func TestValidateInitialGenesisState(t *testing.T) {
 				nil,
 				ccv.ConsumerPacketDataList{},
 				types.LastTransmissionBlockHeight{Height: 1},
+				nil,
+				"",
 			},
 			true,
 		},
@@ -172,6 +200,8 @@ func TestValidateInitialGenesisState(t *testing.T) {
 				nil,
 				ccv.ConsumerPacketDataList{List: []ccv.ConsumerPacketData{{}}},
 				types.LastTransmissionBlockHeight{},
+				nil,
+				"",
 			},
 			true,
 		},
@@ -336,6 +366,8 @@ func TestValidateRestartGenesisState(t *testing.T) {
 				nil,
 				ccv.ConsumerPacketDataList{},
 				types.LastTransmissionBlockHeight{},
+				nil,
+				"",
 			},
 			true,
 		},
@@ -354,6 +386,8 @@ func TestValidateRestartGenesisState(t *testing.T) {
 				nil,
 				ccv.ConsumerPacketDataList{},
 				types.LastTransmissionBlockHeight{},
+				nil,
+				"",
 			},
 			true,
 		},
@@ -426,3 +460,32 @@ func TestValidateRestartGenesisState(t *testing.T) {
 		}
 	}
 }
+
+// TestGenesisStateHash tests that the hash of a consumer genesis only commits to
+// the provider client and consensus states and the initial validator set
+func TestGenesisStateHash(t *testing.T) {
+	pubKey, err := testutil.GenPubKey()
+	require.NoError(t, err)
+	valSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{tmtypes.NewValidator(pubKey, 1)})
+	valHash := valSet.Hash()
+
+	cs := ibctmtypes.NewClientState(chainID, ibctmtypes.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, height, commitmenttypes.GetSDKSpecs(), upgradePath, false, false)
+	consensusState := ibctmtypes.NewConsensusState(time.Now(), commitmenttypes.NewMerkleRoot([]byte("apphash")), valHash[:])
+	gs := types.NewInitialGenesisState(cs, consensusState, tmtypes.TM2PB.ValidatorUpdates(valSet), types.DefaultParams())
+	hash, err := gs.Hash()
+	require.NoError(t, err)
+
+	// changing the params changes the hash
+	gsWithOtherParams := *gs
+	gsWithOtherParams.Params.BlocksPerDistributionTransmission++
+	otherHash, err := gsWithOtherParams.Hash()
+	require.NoError(t, err)
+	require.NotEqual(t, hash, otherHash)
+
+	// changing the initial validator set changes the hash
+	gsWithOtherValSet := *gs
+	gsWithOtherValSet.InitialValSet = nil
+	otherHash, err = gsWithOtherValSet.Hash()
+	require.NoError(t, err)
+	require.NotEqual(t, hash, otherHash)
+}
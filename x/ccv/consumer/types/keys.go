@@ -70,6 +70,17 @@ const (
 
 	// CrossChainValidatorPrefix is the byte prefix that will store cross-chain validators by consensus address
 	CrossChainValidatorBytePrefix
+
+	// LastReceivedValsetByteKey is the byte key that will store the valset update ID of the last
+	// received VSC packet, along with the block height from which its validator set applies
+	LastReceivedValsetByteKey
+
+	// GenesisHashByteKey is the byte key that will store the hash of the
+	// consumer genesis the chain was started from
+	GenesisHashByteKey
+
+	// ProviderPortByteKey is the byte key that will store the port ID of the provider CCV module
+	ProviderPortByteKey
 )
 
 // PortKey returns the key to the port ID in the store
@@ -132,6 +143,21 @@ func CrossChainValidatorKey(addr []byte) []byte {
 	return append([]byte{CrossChainValidatorBytePrefix}, addr...)
 }
 
+// LastReceivedValsetKey returns the key to the valset update ID of the last received VSC packet
+func LastReceivedValsetKey() []byte {
+	return []byte{LastReceivedValsetByteKey}
+}
+
+// GenesisHashKey returns the key to the hash of the consumer genesis the chain was started from
+func GenesisHashKey() []byte {
+	return []byte{GenesisHashByteKey}
+}
+
+// ProviderPortKey returns the key to the port ID of the provider CCV module
+func ProviderPortKey() []byte {
+	return []byte{ProviderPortByteKey}
+}
+
 // HistoricalInfoKey returns the key to historical info to a given block height
 func HistoricalInfoKey(height int64) []byte {
 	hBytes := make([]byte, 8)
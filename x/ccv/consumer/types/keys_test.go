@@ -47,6 +47,9 @@ func getSingleByteKeys() [][]byte {
 	keys[i], i = []byte{OutstandingDowntimeBytePrefix}, i+1
 	keys[i], i = []byte{PendingDataPacketsBytePrefix}, i+1
 	keys[i], i = []byte{CrossChainValidatorBytePrefix}, i+1
+	keys[i], i = LastReceivedValsetKey(), i+1
+	keys[i], i = GenesisHashKey(), i+1
+	keys[i], i = ProviderPortKey(), i+1
 
 	return keys[:i]
 }
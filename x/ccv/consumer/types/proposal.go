@@ -0,0 +1,49 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	ccvtypes "github.com/cosmos/interchain-security/x/ccv/types"
+)
+
+const (
+	ProposalTypeConsumerRemovalRequest = "ConsumerRemovalRequest"
+)
+
+var (
+	_ govtypes.Content = &ConsumerRemovalRequestProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeConsumerRemovalRequest)
+}
+
+// NewConsumerRemovalRequestProposal creates a new consumer removal request proposal.
+func NewConsumerRemovalRequestProposal(title, description, reason string) govtypes.Content {
+	return &ConsumerRemovalRequestProposal{
+		Title:       title,
+		Description: description,
+		Reason:      reason,
+	}
+}
+
+// ProposalRoute returns the routing key of a consumer removal request proposal.
+func (crp *ConsumerRemovalRequestProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a consumer removal request proposal.
+func (crp *ConsumerRemovalRequestProposal) ProposalType() string {
+	return ProposalTypeConsumerRemovalRequest
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (crp *ConsumerRemovalRequestProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(crp); err != nil {
+		return err
+	}
+
+	if len(crp.Reason) > ccvtypes.MaxConsumerRemovalReasonLength {
+		return sdkerrors.Wrapf(ErrInvalidConsumerRemovalRequestProp,
+			"reason cannot be longer than %d characters, got %d", ccvtypes.MaxConsumerRemovalReasonLength, len(crp.Reason))
+	}
+	return nil
+}
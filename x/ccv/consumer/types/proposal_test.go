@@ -0,0 +1,49 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/interchain-security/x/ccv/consumer/types"
+	ccv "github.com/cosmos/interchain-security/x/ccv/types"
+)
+
+func TestConsumerRemovalRequestProposalValidateBasic(t *testing.T) {
+	tests := []struct {
+		name          string
+		proposal      govtypes.Content
+		expectedError bool
+	}{
+		{
+			name:          "fail: validate abstract - empty title",
+			proposal:      types.NewConsumerRemovalRequestProposal("", "desc", "reason"),
+			expectedError: true,
+		},
+		{
+			name:          "fail: reason longer than the max length",
+			proposal:      types.NewConsumerRemovalRequestProposal("title", "desc", strings.Repeat("a", ccv.MaxConsumerRemovalReasonLength+1)),
+			expectedError: true,
+		},
+		{
+			name:     "ok: empty reason",
+			proposal: types.NewConsumerRemovalRequestProposal("title", "desc", ""),
+		},
+		{
+			name:     "ok",
+			proposal: types.NewConsumerRemovalRequestProposal("title", "desc", "reason"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.proposal.ValidateBasic()
+			if tt.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -213,6 +213,96 @@ func (m *QueryNextFeeDistributionEstimateResponse) GetData() *NextFeeDistributio
 	return nil
 }
 
+type QueryLastReceivedValsetRequest struct {
+}
+
+func (m *QueryLastReceivedValsetRequest) Reset()         { *m = QueryLastReceivedValsetRequest{} }
+func (m *QueryLastReceivedValsetRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLastReceivedValsetRequest) ProtoMessage()    {}
+func (*QueryLastReceivedValsetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f627751d3cc10225, []int{3}
+}
+func (m *QueryLastReceivedValsetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLastReceivedValsetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLastReceivedValsetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLastReceivedValsetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLastReceivedValsetRequest.Merge(m, src)
+}
+func (m *QueryLastReceivedValsetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLastReceivedValsetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLastReceivedValsetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLastReceivedValsetRequest proto.InternalMessageInfo
+
+type QueryLastReceivedValsetResponse struct {
+	// the consumer block height from which the validator set applies
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// the valset update ID of the last received VSC packet
+	ValsetUpdateId uint64 `protobuf:"varint,2,opt,name=valset_update_id,json=valsetUpdateId,proto3" json:"valset_update_id,omitempty"`
+}
+
+func (m *QueryLastReceivedValsetResponse) Reset()         { *m = QueryLastReceivedValsetResponse{} }
+func (m *QueryLastReceivedValsetResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLastReceivedValsetResponse) ProtoMessage()    {}
+func (*QueryLastReceivedValsetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f627751d3cc10225, []int{4}
+}
+func (m *QueryLastReceivedValsetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLastReceivedValsetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLastReceivedValsetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLastReceivedValsetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLastReceivedValsetResponse.Merge(m, src)
+}
+func (m *QueryLastReceivedValsetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLastReceivedValsetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLastReceivedValsetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLastReceivedValsetResponse proto.InternalMessageInfo
+
+func (m *QueryLastReceivedValsetResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryLastReceivedValsetResponse) GetValsetUpdateId() uint64 {
+	if m != nil {
+		return m.ValsetUpdateId
+	}
+	return 0
+}
+
 type QueryParamsRequest struct {
 }
 
@@ -220,7 +310,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f627751d3cc10225, []int{3}
+	return fileDescriptor_f627751d3cc10225, []int{5}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -259,7 +349,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f627751d3cc10225, []int{4}
+	return fileDescriptor_f627751d3cc10225, []int{6}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -299,6 +389,8 @@ func init() {
 	proto.RegisterType((*NextFeeDistributionEstimate)(nil), "interchain_security.ccv.consumer.v1.NextFeeDistributionEstimate")
 	proto.RegisterType((*QueryNextFeeDistributionEstimateRequest)(nil), "interchain_security.ccv.consumer.v1.QueryNextFeeDistributionEstimateRequest")
 	proto.RegisterType((*QueryNextFeeDistributionEstimateResponse)(nil), "interchain_security.ccv.consumer.v1.QueryNextFeeDistributionEstimateResponse")
+	proto.RegisterType((*QueryLastReceivedValsetRequest)(nil), "interchain_security.ccv.consumer.v1.QueryLastReceivedValsetRequest")
+	proto.RegisterType((*QueryLastReceivedValsetResponse)(nil), "interchain_security.ccv.consumer.v1.QueryLastReceivedValsetResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "interchain_security.ccv.consumer.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "interchain_security.ccv.consumer.v1.QueryParamsResponse")
 }
@@ -308,40 +400,46 @@ func init() {
 }
 
 var fileDescriptor_f627751d3cc10225 = []byte{
-	// 519 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x8b, 0xd3, 0x40,
-	0x14, 0x6e, 0xb6, 0x3f, 0xc4, 0x59, 0xbc, 0x8c, 0x15, 0x42, 0x95, 0xb8, 0x44, 0xc1, 0xaa, 0x34,
-	0x71, 0xbb, 0x87, 0x55, 0x2f, 0xca, 0xaa, 0x8b, 0x82, 0xca, 0x5a, 0xf6, 0xe4, 0x65, 0x9d, 0x4e,
-	0xdf, 0xa6, 0x03, 0xcd, 0x4c, 0x76, 0x66, 0x12, 0xda, 0x9b, 0xf8, 0x07, 0x88, 0xe0, 0x7f, 0xe2,
-	0xc5, 0x7f, 0x61, 0x8f, 0x0b, 0x5e, 0x3c, 0x89, 0xb4, 0xfe, 0x11, 0x1e, 0x25, 0x93, 0x64, 0x4d,
-	0x41, 0xb7, 0x11, 0xbc, 0x4d, 0xbf, 0xef, 0xbd, 0xef, 0x7d, 0xf3, 0xcd, 0x6b, 0x90, 0xcf, 0xb8,
-	0x06, 0x49, 0xc7, 0x84, 0xf1, 0x03, 0x05, 0x34, 0x96, 0x4c, 0xcf, 0x7c, 0x4a, 0x13, 0x9f, 0x0a,
-	0xae, 0xe2, 0x10, 0xa4, 0x9f, 0x6c, 0xfa, 0x47, 0x31, 0xc8, 0x99, 0x17, 0x49, 0xa1, 0x05, 0xbe,
-	0xf6, 0x87, 0x06, 0x8f, 0xd2, 0xc4, 0x2b, 0x1a, 0xbc, 0x64, 0xb3, 0xd3, 0x0e, 0x44, 0x20, 0x4c,
-	0xbd, 0x9f, 0x9e, 0xb2, 0xd6, 0xce, 0x95, 0x40, 0x88, 0x60, 0x02, 0x3e, 0x89, 0x98, 0x4f, 0x38,
-	0x17, 0x9a, 0x68, 0x26, 0xb8, 0xca, 0xd9, 0x7e, 0x15, 0x27, 0xa7, 0x43, 0x4c, 0x8f, 0xfb, 0x7e,
-	0x0d, 0x5d, 0x7e, 0x09, 0x53, 0xbd, 0x0b, 0xf0, 0x98, 0x29, 0x2d, 0xd9, 0x30, 0x4e, 0x25, 0x9f,
-	0x28, 0xcd, 0x42, 0xa2, 0x01, 0x5f, 0x47, 0x17, 0x68, 0x2c, 0x25, 0x70, 0xfd, 0x14, 0x58, 0x30,
-	0xd6, 0xb6, 0xb5, 0x61, 0x75, 0xeb, 0x83, 0x65, 0x10, 0x3b, 0x08, 0x4d, 0x88, 0x2a, 0x4a, 0xd6,
-	0x4c, 0x49, 0x09, 0x49, 0x79, 0x0e, 0xd3, 0x82, 0xaf, 0x67, 0xfc, 0x6f, 0x04, 0x6f, 0xa1, 0x4b,
-	0xa3, 0xd2, 0xf4, 0x83, 0x43, 0x49, 0x68, 0x7a, 0xb0, 0x1b, 0x1b, 0x56, 0xf7, 0xfc, 0xa0, 0x5d,
-	0x26, 0x77, 0x73, 0x0e, 0xb7, 0x51, 0x53, 0x0b, 0x4d, 0x26, 0x76, 0xd3, 0x14, 0x65, 0x3f, 0xd2,
-	0x51, 0x5a, 0xec, 0x49, 0x91, 0xb0, 0x11, 0x48, 0xbb, 0x65, 0xa8, 0x12, 0x92, 0xf1, 0x8f, 0xf2,
-	0x10, 0xec, 0x73, 0x05, 0x5f, 0x20, 0xee, 0x4d, 0x74, 0xe3, 0x55, 0xfa, 0x58, 0x67, 0x84, 0x32,
-	0x80, 0xa3, 0x18, 0x94, 0x76, 0xdf, 0x5a, 0xa8, 0xbb, 0xba, 0x56, 0x45, 0x82, 0x2b, 0xc0, 0xfb,
-	0xa8, 0x31, 0x22, 0x9a, 0x98, 0xfc, 0xd6, 0xfb, 0x0f, 0xbd, 0x0a, 0x4b, 0xe0, 0x9d, 0xa5, 0x6b,
-	0xd4, 0xdc, 0x36, 0xc2, 0xc6, 0xc1, 0x1e, 0x91, 0x24, 0x54, 0x85, 0xb1, 0x37, 0xe8, 0xe2, 0x12,
-	0x9a, 0x5b, 0x78, 0x86, 0x5a, 0x91, 0x41, 0x72, 0x13, 0xb7, 0x2b, 0x99, 0xc8, 0x44, 0x76, 0x1a,
-	0xc7, 0xdf, 0xae, 0xd6, 0x06, 0xb9, 0x40, 0xff, 0x73, 0x1d, 0x35, 0xcd, 0x08, 0xfc, 0xd3, 0x42,
-	0xf6, 0xdf, 0x42, 0xc0, 0xcf, 0x2b, 0x4d, 0xa8, 0x98, 0x77, 0xe7, 0xc5, 0x7f, 0x52, 0xcb, 0xe2,
-	0x70, 0x1f, 0xbc, 0xfb, 0xf2, 0xe3, 0xe3, 0xda, 0x3d, 0xbc, 0xbd, 0xfa, 0x1f, 0x9c, 0xae, 0x6a,
-	0xef, 0x10, 0xa0, 0x57, 0x5e, 0x44, 0xfc, 0xc9, 0x42, 0xeb, 0xa5, 0x9c, 0xf1, 0x76, 0x75, 0x7f,
-	0x4b, 0xef, 0xd5, 0xb9, 0xfb, 0xef, 0x8d, 0xf9, 0x1d, 0xee, 0x98, 0x3b, 0xdc, 0xc2, 0xdd, 0xd5,
-	0x77, 0xc8, 0x5e, 0x6e, 0x67, 0xff, 0x78, 0xee, 0x58, 0x27, 0x73, 0xc7, 0xfa, 0x3e, 0x77, 0xac,
-	0x0f, 0x0b, 0xa7, 0x76, 0xb2, 0x70, 0x6a, 0x5f, 0x17, 0x4e, 0xed, 0xf5, 0xfd, 0x80, 0xe9, 0x71,
-	0x3c, 0xf4, 0xa8, 0x08, 0x7d, 0x2a, 0x54, 0x28, 0x54, 0x49, 0xb4, 0x77, 0x2a, 0x3a, 0x5d, 0x96,
-	0xd5, 0xb3, 0x08, 0xd4, 0xb0, 0x65, 0xbe, 0x26, 0x5b, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x1c,
-	0x94, 0x9c, 0x7a, 0x0d, 0x05, 0x00, 0x00,
+	// 614 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x6a, 0xd4, 0x40,
+	0x18, 0xdf, 0xb4, 0xbb, 0x2b, 0x4e, 0x51, 0x64, 0x5c, 0x75, 0x59, 0x25, 0x2d, 0x51, 0x70, 0x55,
+	0x36, 0xb1, 0x2d, 0xd8, 0xea, 0x41, 0xa5, 0xad, 0xc5, 0x42, 0x95, 0x1a, 0xaa, 0x07, 0x2f, 0xeb,
+	0x74, 0xf2, 0x35, 0x3b, 0xb0, 0x9b, 0x49, 0x67, 0x26, 0xa1, 0xbd, 0x89, 0x0f, 0x20, 0x82, 0x77,
+	0x1f, 0xc2, 0xa7, 0xe8, 0xb1, 0xe0, 0xc5, 0x93, 0x48, 0xdb, 0x87, 0xf0, 0x28, 0x99, 0x24, 0x6d,
+	0x16, 0xdc, 0x6e, 0x44, 0x6f, 0x33, 0xbf, 0xdf, 0xf7, 0xfd, 0xbe, 0xbf, 0x33, 0xc8, 0x61, 0x81,
+	0x02, 0x41, 0x7b, 0x84, 0x05, 0x5d, 0x09, 0x34, 0x12, 0x4c, 0xed, 0x39, 0x94, 0xc6, 0x0e, 0xe5,
+	0x81, 0x8c, 0x06, 0x20, 0x9c, 0x78, 0xd6, 0xd9, 0x89, 0x40, 0xec, 0xd9, 0xa1, 0xe0, 0x8a, 0xe3,
+	0x9b, 0x7f, 0x70, 0xb0, 0x29, 0x8d, 0xed, 0xdc, 0xc1, 0x8e, 0x67, 0x5b, 0x0d, 0x9f, 0xfb, 0x5c,
+	0xdb, 0x3b, 0xc9, 0x29, 0x75, 0x6d, 0xdd, 0xf0, 0x39, 0xf7, 0xfb, 0xe0, 0x90, 0x90, 0x39, 0x24,
+	0x08, 0xb8, 0x22, 0x8a, 0xf1, 0x40, 0x66, 0xec, 0x5c, 0x99, 0x4c, 0x4e, 0x82, 0x68, 0x1f, 0xeb,
+	0xe3, 0x04, 0xba, 0xfe, 0x12, 0x76, 0xd5, 0x2a, 0xc0, 0x0a, 0x93, 0x4a, 0xb0, 0xad, 0x28, 0x91,
+	0x7c, 0x26, 0x15, 0x1b, 0x10, 0x05, 0xf8, 0x16, 0xba, 0x40, 0x23, 0x21, 0x20, 0x50, 0xcf, 0x81,
+	0xf9, 0x3d, 0xd5, 0x34, 0x66, 0x8c, 0xf6, 0xa4, 0x3b, 0x0c, 0x62, 0x13, 0xa1, 0x3e, 0x91, 0xb9,
+	0xc9, 0x84, 0x36, 0x29, 0x20, 0x09, 0x1f, 0xc0, 0x6e, 0xce, 0x4f, 0xa6, 0xfc, 0x29, 0x82, 0xe7,
+	0xd1, 0x15, 0xaf, 0x10, 0xbd, 0xbb, 0x2d, 0x08, 0x4d, 0x0e, 0xcd, 0xea, 0x8c, 0xd1, 0x3e, 0xef,
+	0x36, 0x8a, 0xe4, 0x6a, 0xc6, 0xe1, 0x06, 0xaa, 0x29, 0xae, 0x48, 0xbf, 0x59, 0xd3, 0x46, 0xe9,
+	0x25, 0x09, 0xa5, 0xf8, 0x86, 0xe0, 0x31, 0xf3, 0x40, 0x34, 0xeb, 0x9a, 0x2a, 0x20, 0x29, 0xbf,
+	0x9c, 0x35, 0xa1, 0x79, 0x2e, 0xe7, 0x73, 0xc4, 0xba, 0x83, 0x6e, 0xbf, 0x4a, 0x86, 0x75, 0x46,
+	0x53, 0x5c, 0xd8, 0x89, 0x40, 0x2a, 0xeb, 0xbd, 0x81, 0xda, 0xe3, 0x6d, 0x65, 0xc8, 0x03, 0x09,
+	0x78, 0x13, 0x55, 0x3d, 0xa2, 0x88, 0xee, 0xdf, 0xd4, 0xdc, 0x53, 0xbb, 0xc4, 0x12, 0xd8, 0x67,
+	0xe9, 0x6a, 0x35, 0x6b, 0x06, 0x99, 0x3a, 0x83, 0x75, 0x22, 0x95, 0x0b, 0x14, 0x58, 0x0c, 0xde,
+	0x1b, 0xd2, 0x97, 0xa0, 0xf2, 0x24, 0x29, 0x9a, 0x1e, 0x69, 0x91, 0xa5, 0x76, 0x15, 0xd5, 0x7b,
+	0xa7, 0xc3, 0xad, 0xba, 0xd9, 0x0d, 0xb7, 0xd1, 0xa5, 0x58, 0x5b, 0x76, 0xa3, 0xd0, 0x23, 0x0a,
+	0xba, 0xcc, 0xd3, 0xb3, 0xad, 0xba, 0x17, 0x53, 0xfc, 0xb5, 0x86, 0xd7, 0x3c, 0xab, 0x81, 0xb0,
+	0x0e, 0xb2, 0x41, 0x04, 0x19, 0xc8, 0x3c, 0xf4, 0x3b, 0x74, 0x79, 0x08, 0xcd, 0xc2, 0xad, 0xa1,
+	0x7a, 0xa8, 0x91, 0xac, 0x17, 0xf7, 0x4a, 0xf5, 0x22, 0x15, 0x59, 0xaa, 0xee, 0xff, 0x98, 0xae,
+	0xb8, 0x99, 0xc0, 0xdc, 0x97, 0x1a, 0xaa, 0xe9, 0x10, 0xf8, 0x97, 0x81, 0x9a, 0xa3, 0x66, 0x81,
+	0xd7, 0x4b, 0x45, 0x28, 0x39, 0xf6, 0xd6, 0x8b, 0xff, 0xa4, 0x96, 0xb6, 0xc3, 0x7a, 0xf2, 0xe1,
+	0xdb, 0xf1, 0xe7, 0x89, 0x87, 0x78, 0x61, 0xfc, 0x47, 0x92, 0xbc, 0x98, 0xce, 0x36, 0x40, 0xa7,
+	0xf8, 0x1e, 0xf0, 0xb1, 0x81, 0xae, 0x8d, 0x18, 0x31, 0x5e, 0x2e, 0x9f, 0xeb, 0xc8, 0x15, 0x6a,
+	0xad, 0xfc, 0x9b, 0x48, 0x56, 0xe7, 0x63, 0x5d, 0xe7, 0x22, 0x7e, 0x30, 0xbe, 0xce, 0xe4, 0xe7,
+	0xe8, 0x88, 0x4c, 0xa6, 0x93, 0xee, 0x1a, 0xfe, 0x6a, 0xa0, 0xa9, 0xc2, 0x3a, 0xe1, 0x85, 0xf2,
+	0x59, 0x0d, 0xad, 0x65, 0x6b, 0xf1, 0xef, 0x1d, 0xb3, 0x12, 0xee, 0xeb, 0x12, 0xee, 0xe2, 0xf6,
+	0xf8, 0x12, 0xd2, 0x05, 0x5d, 0xda, 0xdc, 0x3f, 0x34, 0x8d, 0x83, 0x43, 0xd3, 0xf8, 0x79, 0x68,
+	0x1a, 0x9f, 0x8e, 0xcc, 0xca, 0xc1, 0x91, 0x59, 0xf9, 0x7e, 0x64, 0x56, 0xde, 0x3e, 0xf2, 0x99,
+	0xea, 0x45, 0x5b, 0x36, 0xe5, 0x03, 0x87, 0x72, 0x39, 0xe0, 0xb2, 0x20, 0xda, 0x39, 0x11, 0xdd,
+	0x1d, 0x96, 0x55, 0x7b, 0x21, 0xc8, 0xad, 0xba, 0xfe, 0xbb, 0xe7, 0x7f, 0x0f, 0x00, 0x04, 0x99,
+	0x8a, 0x58, 0x7b, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -359,6 +457,9 @@ type QueryClient interface {
 	// ConsumerGenesis queries the genesis state needed to start a consumer chain
 	// whose proposal has been accepted
 	QueryNextFeeDistribution(ctx context.Context, in *QueryNextFeeDistributionEstimateRequest, opts ...grpc.CallOption) (*QueryNextFeeDistributionEstimateResponse, error)
+	// QueryLastReceivedValset returns the valset update ID of the last VSC packet
+	// received from the provider chain, and the block height from which it applies
+	QueryLastReceivedValset(ctx context.Context, in *QueryLastReceivedValsetRequest, opts ...grpc.CallOption) (*QueryLastReceivedValsetResponse, error)
 	// QueryParams queries the ccv/consumer module parameters.
 	QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -380,6 +481,15 @@ func (c *queryClient) QueryNextFeeDistribution(ctx context.Context, in *QueryNex
 	return out, nil
 }
 
+func (c *queryClient) QueryLastReceivedValset(ctx context.Context, in *QueryLastReceivedValsetRequest, opts ...grpc.CallOption) (*QueryLastReceivedValsetResponse, error) {
+	out := new(QueryLastReceivedValsetResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Query/QueryLastReceivedValset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Query/QueryParams", in, out, opts...)
@@ -394,6 +504,9 @@ type QueryServer interface {
 	// ConsumerGenesis queries the genesis state needed to start a consumer chain
 	// whose proposal has been accepted
 	QueryNextFeeDistribution(context.Context, *QueryNextFeeDistributionEstimateRequest) (*QueryNextFeeDistributionEstimateResponse, error)
+	// QueryLastReceivedValset returns the valset update ID of the last VSC packet
+	// received from the provider chain, and the block height from which it applies
+	QueryLastReceivedValset(context.Context, *QueryLastReceivedValsetRequest) (*QueryLastReceivedValsetResponse, error)
 	// QueryParams queries the ccv/consumer module parameters.
 	QueryParams(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
@@ -405,6 +518,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) QueryNextFeeDistribution(ctx context.Context, req *QueryNextFeeDistributionEstimateRequest) (*QueryNextFeeDistributionEstimateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryNextFeeDistribution not implemented")
 }
+func (*UnimplementedQueryServer) QueryLastReceivedValset(ctx context.Context, req *QueryLastReceivedValsetRequest) (*QueryLastReceivedValsetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryLastReceivedValset not implemented")
+}
 func (*UnimplementedQueryServer) QueryParams(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryParams not implemented")
 }
@@ -431,6 +547,24 @@ func _Query_QueryNextFeeDistribution_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_QueryLastReceivedValset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLastReceivedValsetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryLastReceivedValset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.consumer.v1.Query/QueryLastReceivedValset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryLastReceivedValset(ctx, req.(*QueryLastReceivedValsetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_QueryParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -457,6 +591,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "QueryNextFeeDistribution",
 			Handler:    _Query_QueryNextFeeDistribution_Handler,
 		},
+		{
+			MethodName: "QueryLastReceivedValset",
+			Handler:    _Query_QueryLastReceivedValset_Handler,
+		},
 		{
 			MethodName: "QueryParams",
 			Handler:    _Query_QueryParams_Handler,
@@ -590,6 +728,62 @@ func (m *QueryNextFeeDistributionEstimateResponse) MarshalToSizedBuffer(dAtA []b
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryLastReceivedValsetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastReceivedValsetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastReceivedValsetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLastReceivedValsetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastReceivedValsetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastReceivedValsetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValsetUpdateId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ValsetUpdateId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -713,6 +907,30 @@ func (m *QueryNextFeeDistributionEstimateResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryLastReceivedValsetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLastReceivedValsetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.ValsetUpdateId != 0 {
+		n += 1 + sovQuery(uint64(m.ValsetUpdateId))
+	}
+	return n
+}
+
 func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1110,6 +1328,144 @@ func (m *QueryNextFeeDistributionEstimateResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
+func (m *QueryLastReceivedValsetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLastReceivedValsetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLastReceivedValsetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLastReceivedValsetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLastReceivedValsetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLastReceivedValsetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetUpdateId", wireType)
+			}
+			m.ValsetUpdateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValsetUpdateId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
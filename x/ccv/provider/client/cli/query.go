@@ -1,8 +1,14 @@
 package cli
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"io"
+	"os"
+	"strconv"
 	"strings"
+	"text/tabwriter"
 
 	"github.com/spf13/cobra"
 
@@ -14,6 +20,10 @@ import (
 	"github.com/cosmos/interchain-security/x/ccv/provider/types"
 )
 
+// FlagOutputFile is the flag of the consumer-genesis query that sets the file
+// the genesis state is written to; note that --output is the format of the query output
+const FlagOutputFile = "output-file"
+
 // NewQueryCmd returns a root CLI command handler for all x/ccv/provider query commands.
 func NewQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -32,6 +42,29 @@ func NewQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdProviderValidatorKey())
 	cmd.AddCommand(CmdThrottleState())
 	cmd.AddCommand(CmdThrottledConsumerPacketData())
+	cmd.AddCommand(CmdChainHeldUnbondingValue())
+	cmd.AddCommand(CmdConsumerUnbondingOps())
+	cmd.AddCommand(CmdValidatorSetUpdateId())
+	cmd.AddCommand(CmdValsetUpdateHeightRange())
+	cmd.AddCommand(CmdConsumerRewardsAllocation())
+	cmd.AddCommand(CmdConsumerClientId())
+	cmd.AddCommand(CmdPhaseSummary())
+	cmd.AddCommand(CmdEffectiveConsumerParams())
+	cmd.AddCommand(CmdConsumerValidatorSet())
+	cmd.AddCommand(CmdConsumerInitHeight())
+	cmd.AddCommand(CmdSlashAcks())
+	cmd.AddCommand(CmdAllSlashAcks())
+	cmd.AddCommand(CmdChainsBlockingUnbonding())
+	cmd.AddCommand(CmdConsumerRewardCompliance())
+	cmd.AddCommand(CmdConsumerPacketStatus())
+	cmd.AddCommand(CmdConsumerChainInfo())
+	cmd.AddCommand(CmdRegisteredConsumerRewardDenoms())
+	cmd.AddCommand(CmdConsumerChannels())
+	cmd.AddCommand(CmdConsumerTotalPower())
+	cmd.AddCommand(CmdConsumerLiveness())
+	cmd.AddCommand(CmdConsumerMetadata())
+	cmd.AddCommand(CmdOptedInValidators())
+	cmd.AddCommand(CmdParams())
 
 	return cmd
 }
@@ -42,7 +75,18 @@ func CmdConsumerGenesis() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "consumer-genesis [chainid]",
 		Short: "Query for consumer chain genesis state by chain id",
-		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the genesis state of the ccvconsumer module of a consumer chain created by the provider.
+The genesis state is only available once the consumer addition proposal passed and the spawn time elapsed.
+If --%s is set, the genesis state is written in JSON to the given file, which can be used
+as the ccvconsumer app_state of the consumer chain genesis.
+Example:
+$ %s query provider consumer-genesis foochain --%s consumer-genesis.json
+`,
+				FlagOutputFile, version.AppName, FlagOutputFile,
+			),
+		),
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -56,11 +100,31 @@ func CmdConsumerGenesis() *cobra.Command {
 				return err
 			}
 
-			return clientCtx.PrintProto(&res.GenesisState)
+			outputFile, err := cmd.Flags().GetString(FlagOutputFile)
+			if err != nil {
+				return err
+			}
+			if outputFile == "" {
+				return clientCtx.PrintProto(&res.GenesisState)
+			}
+
+			bz, err := clientCtx.Codec.MarshalJSON(&res.GenesisState)
+			if err != nil {
+				return err
+			}
+			var out bytes.Buffer
+			if err := json.Indent(&out, bz, "", "  "); err != nil {
+				return err
+			}
+			if err := os.WriteFile(outputFile, out.Bytes(), 0o600); err != nil {
+				return fmt.Errorf("failed to write the genesis of consumer chain %s to %s: %w", args[0], outputFile, err)
+			}
+			return clientCtx.PrintString(fmt.Sprintf("genesis of consumer chain %s written to %s\n", args[0], outputFile))
 		},
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagOutputFile, "", "write the consumer genesis state in JSON to the given file")
 
 	return cmd
 }
@@ -77,7 +141,12 @@ func CmdConsumerChains() *cobra.Command {
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			req := &types.QueryConsumerChainsRequest{}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryConsumerChainsRequest{Pagination: pageReq}
 			res, err := queryClient.QueryConsumerChains(cmd.Context(), req)
 			if err != nil {
 				return err
@@ -88,6 +157,7 @@ func CmdConsumerChains() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list-consumer-chains")
 
 	return cmd
 }
@@ -320,3 +390,881 @@ $ %s query provider throttled-consumer-packet-data foochain
 
 	return cmd
 }
+
+func CmdChainHeldUnbondingValue() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "held-unbonding-value [chainid]",
+		Short: "Query the total value of unbonding operations held by a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the sum of the balances of all unbonding operations that are waiting for VSCMatured packets from a consumer chainId.
+Example:
+$ %s query provider held-unbonding-value foochain
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryChainHeldUnbondingValueRequest{ChainId: args[0]}
+			res, err := queryClient.QueryChainHeldUnbondingValue(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerUnbondingOps() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbonding-ops [chainid] [vscid]",
+		Short: "Query the unbonding operations indexed under a valset update id for a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the unbonding operations that were created during the given valset update id
+and are still waiting for a VSCMatured packet from the consumer chainId, together with the
+provider block height of the valset update id and the consumer chains each operation is waiting on.
+Example:
+$ %s query provider unbonding-ops foochain 12
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			vscID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid valset update id %s: %w", args[1], err)
+			}
+
+			req := &types.QueryConsumerUnbondingOpsRequest{ChainId: args[0], VscId: vscID}
+			res, err := queryClient.QueryConsumerUnbondingOps(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			if clientCtx.OutputFormat == "json" {
+				return clientCtx.PrintProto(res)
+			}
+
+			return printUnbondingOpsTable(cmd.OutOrStdout(), res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdValidatorSetUpdateId() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "valset-update-id",
+		Short: "Query the current valset update id",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the valset update id of the validator set updates collected in the current block,
+together with the block height it is mapped to, or zero if it is not yet mapped to a block height.
+Example:
+$ %s query provider valset-update-id
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryValidatorSetUpdateIdRequest{}
+			res, err := queryClient.QueryValidatorSetUpdateId(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdValsetUpdateHeightRange() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "valset-update-height-range [vscid]",
+		Short: "Query the range of block heights in which the validator set of a valset update id was effective",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the block height mapped to the given valset update id and the block height
+mapped to the next mapped valset update id, i.e., the validator set of the valset update id was effective from
+the start height (inclusive) to the end height (exclusive). The end height is zero for the newest valset update id.
+Example:
+$ %s query provider valset-update-height-range 12
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			vscID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid valset update id %s: %w", args[0], err)
+			}
+
+			req := &types.QueryValsetUpdateHeightRangeRequest{VscId: vscID}
+			res, err := queryClient.QueryValsetUpdateHeightRange(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerRewardsAllocation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-rewards-allocation [chainid]",
+		Short: "Query the rewards received from a consumer chain that are not yet distributed",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the rewards received from a consumer chainId that are held in the consumer rewards pool
+and not yet distributed to the fee collector.
+Example:
+$ %s query provider consumer-rewards-allocation foochain
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerRewardsAllocationRequest{ChainId: args[0]}
+			res, err := queryClient.QueryConsumerRewardsAllocation(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// printUnbondingOpsTable writes the unbonding operations of a
+// QueryConsumerUnbondingOpsResponse to w as a human-readable table
+func printUnbondingOpsTable(w io.Writer, res *types.QueryConsumerUnbondingOpsResponse) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "ID\tCREATION HEIGHT\tBALANCE\tWAITING ON\n")
+	for _, op := range res.UnbondingOps {
+		fmt.Fprintf(tw, "%d\t%d\t%s\t%s\n",
+			op.Id, res.CreationHeight, op.Balance, strings.Join(op.UnbondingConsumerChains, ","))
+	}
+	return tw.Flush()
+}
+
+func CmdConsumerClientId() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-client-id [chainid]",
+		Short: "Query the client ID the provider uses to track a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the ID of the IBC client on the provider chain that tracks the consumer chainId.
+Relayers need to keep this client updated.
+Example:
+$ %s query provider consumer-client-id foochain
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerClientIdRequest{ChainId: args[0]}
+			res, err := queryClient.QueryConsumerClientId(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdPhaseSummary() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "phase-summary",
+		Short: "Query the number of consumer chains in every lifecycle phase",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the number of consumer chains that are pending, initialized, launched or stopping.
+Example:
+$ %s query provider phase-summary
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPhaseSummaryRequest{}
+			res, err := queryClient.QueryPhaseSummary(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdEffectiveConsumerParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "effective-consumer-params [chainid]",
+		Short: "Query the parameters that apply to a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the parameters that apply to the consumer chainId,
+i.e., the values set by its consumer addition proposal or the default values if none were set.
+Example:
+$ %s query provider effective-consumer-params foochain
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryEffectiveConsumerParamsRequest{ChainId: args[0]}
+			res, err := queryClient.QueryEffectiveConsumerParams(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerValidatorSet() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-validator-set [chainid]",
+		Short: "Query the last validator set the provider sent to a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the last validator set the provider sent to the consumer chainId,
+including the power and the assigned consumer key (if any) of every validator,
+and the valset update ID the validator set corresponds to.
+Example:
+$ %s query provider consumer-validator-set foochain
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryConsumerValidatorSetRequest{ChainId: args[0], Pagination: pageReq}
+			res, err := queryClient.QueryConsumerValidatorSet(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "consumer-validator-set")
+
+	return cmd
+}
+
+func CmdConsumerInitHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-init-height [chainid]",
+		Short: "Query the provider block height at which a consumer chain was initiated",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the provider block height at which the CCV channel of the consumer chainId was established.
+Slash packets for infractions committed before the consumer chain received any validator set update
+are mapped to this height. The query fails if the CCV channel is not yet established.
+Example:
+$ %s query provider consumer-init-height foochain
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerInitHeightRequest{ChainId: args[0]}
+			res, err := queryClient.QueryConsumerInitHeight(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSlashAcks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slash-acks [chainid]",
+		Short: "Query the pending slash acks of a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the consumer consensus addresses of the validators for which the provider
+handled a slash packet of the consumer chainId, and which are not yet acknowledged to the consumer chain.
+The slash acks are sent to the consumer chain in the next validator set change packet.
+Example:
+$ %s query provider slash-acks foochain
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QuerySlashAcksRequest{ChainId: args[0]}
+			res, err := queryClient.QuerySlashAcks(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdAllSlashAcks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-slash-acks",
+		Short: "Query the pending slash acks of all the consumer chains",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the pending slash acks of all the consumer chains with at least one slash ack.
+Example:
+$ %s query provider all-slash-acks
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryAllSlashAcksRequest{}
+			res, err := queryClient.QueryAllSlashAcks(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdChainsBlockingUnbonding() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "chains-blocking-unbonding [unbonding-op-id]",
+		Short: "Query the consumer chains an unbonding operation is still waiting on",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the consumer chains that did not yet send a VSCMatured packet
+for the valset update containing the given unbonding operation. The query fails
+if the unbonding operation is not found, e.g., since it already completed.
+Example:
+$ %s query provider chains-blocking-unbonding 42
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid unbonding op id %s: %w", args[0], err)
+			}
+
+			req := &types.QueryChainsBlockingUnbondingRequest{UnbondingOpId: id}
+			res, err := queryClient.QueryChainsBlockingUnbonding(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerRewardCompliance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-reward-compliance [chainid]",
+		Short: "Query whether a consumer chain sent its expected rewards",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the rewards received from the consumer chainId during the current and last
+rewards windows, and the expected rewards that were not received during the last completed window.
+The expected rewards of a consumer chain are set by a consumer parameters update proposal.
+Example:
+$ %s query provider consumer-reward-compliance foochain
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerRewardComplianceRequest{ChainId: args[0]}
+			res, err := queryClient.QueryConsumerRewardCompliance(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerPacketStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-packet-status [chainid]",
+		Short: "Query the packets sent to and acknowledged by a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the sequences of the last packet sent to and of the last packet acknowledged
+by the consumer chainId, and the number of packets that are not yet acknowledged.
+A growing gap indicates that the packets are not relayed to the consumer chain.
+Example:
+$ %s query provider consumer-packet-status foochain
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerPacketStatusRequest{ChainId: args[0]}
+			res, err := queryClient.QueryConsumerPacketStatus(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerChainInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "chain-info [chainid]",
+		Short: "Query a summary of the state of a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the client ID, the CCV channel ID and state, the init chain height,
+the number of pending slash acks and unbonding operations of the consumer chainId,
+whether its consumer genesis is stored, and its downtime jail duration, if set.
+Example:
+$ %s query provider chain-info foochain
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerChainInfoRequest{ChainId: args[0]}
+			res, err := queryClient.QueryConsumerChainInfo(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRegisteredConsumerRewardDenoms() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "registered-consumer-reward-denoms",
+		Short: "Query the registered consumer reward denoms",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the denoms registered as consumer reward denoms through change reward denoms proposals.
+Only the rewards of registered denoms received from the consumer chains are distributed to the fee collector.
+Example:
+$ %s query provider registered-consumer-reward-denoms
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryRegisteredConsumerRewardDenomsRequest{}
+			res, err := queryClient.QueryRegisteredConsumerRewardDenoms(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerChannels() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "channels",
+		Short: "Query the CCV channels of the consumer chains",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the CCV channels of the consumer chains, together with the chain IDs they are bound to
+and their states. Channels that are not open cannot be relayed on.
+Example:
+$ %s query provider channels
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryConsumerChannelsRequest{Pagination: pageReq}
+			res, err := queryClient.QueryConsumerChannels(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "channels")
+
+	return cmd
+}
+
+func CmdConsumerTotalPower() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-total-power [chainid]",
+		Short: "Query the total power of the last validator set the provider sent to a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the total power of the last validator set the provider sent to the consumer chainId,
+together with the total bonded power of the provider. The total power of the consumer chain excludes
+the validators filtered out of its validator set, e.g., the soft opted out validators.
+Example:
+$ %s query provider consumer-total-power foochain
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerTotalPowerRequest{ChainId: args[0]}
+			res, err := queryClient.QueryConsumerTotalPower(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerLiveness() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-liveness [chainid]",
+		Short: "Query when the provider last heard from a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the provider block at which the provider last received a packet or an acknowledgement
+from the consumer chainId, or completed the handshake of its CCV channel, the time elapsed since then, and whether
+the consumer chain is inactive, i.e., was not heard from within the ConsumerLivenessWindow param.
+Example:
+$ %s query provider consumer-liveness foochain
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerLivenessRequest{ChainId: args[0]}
+			res, err := queryClient.QueryConsumerLiveness(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-metadata [chainid]",
+		Short: "Query the descriptive metadata of a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the name, description, website and contact information of the consumer chainId,
+as set by its consumer addition proposal or by the last consumer metadata update proposal.
+Example:
+$ %s query provider consumer-metadata foochain
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerMetadataRequest{ChainId: args[0]}
+			res, err := queryClient.QueryConsumerMetadata(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdOptedInValidators() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "opted-in-validators [chainid]",
+		Short: "Query the validators opted in to validate a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the operator addresses of the validators opted in to validate the consumer chainId.
+Example:
+$ %s query provider opted-in-validators foochain
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryOptedInValidatorsRequest{ChainId: args[0]}
+			res, err := queryClient.QueryOptedInValidators(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the current parameters of the provider module",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the parameters of the provider module,
+including the consumer client template and the timeout periods.
+Example:
+$ %s query provider params
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryParamsRequest{}
+			res, err := queryClient.QueryParams(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -3,14 +3,19 @@ package cli
 import (
 	"github.com/spf13/cobra"
 
+	"encoding/json"
 	"fmt"
+	"os"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
-	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/version"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
+	providerclient "github.com/cosmos/interchain-security/x/ccv/provider/client"
 	"github.com/cosmos/interchain-security/x/ccv/provider/types"
 )
 
@@ -25,15 +30,29 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(NewAssignConsumerKeyCmd())
+	cmd.AddCommand(NewSubmitConsumerMisbehaviourCmd())
+	cmd.AddCommand(providerclient.ValidateConsumerAdditionPropCmd())
 
 	return cmd
 }
 
 func NewAssignConsumerKeyCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "assign-consensus-key [consumer-chain-id] [consumer-pubkey]",
-		Short: "assign a consensus public key to use for a consumer chain",
-		Args:  cobra.ExactArgs(2),
+		Use:     "assign-consumer-key [consumer-chain-id] [consumer-pubkey-json]",
+		Aliases: []string{"assign-consensus-key"},
+		Short:   "assign a consensus public key to use for a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Assign a consensus public key to use for a consumer chain. The public key is either JSON encoded,
+or a file containing the JSON encoded public key, in the format printed by either
+'%s tendermint show-validator' or 'tendermint show-validator'.
+Example:
+$ %s tx provider assign-consumer-key foochain '{"@type":"/cosmos.crypto.ed25519.PubKey","key":"..."}' --from mykey
+$ %s tx provider assign-consumer-key foochain '{"type":"tendermint/PubKeyEd25519","value":"..."}' --from mykey
+`,
+				version.AppName, version.AppName, version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -44,8 +63,15 @@ func NewAssignConsumerKeyCmd() *cobra.Command {
 				WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
 
 			providerValAddr := clientCtx.GetFromAddress()
-			var consumerPubKey cryptotypes.PubKey
-			if err := clientCtx.Codec.UnmarshalInterfaceJSON([]byte(args[1]), &consumerPubKey); err != nil {
+			bz := []byte(args[1])
+			if !json.Valid(bz) {
+				// the argument is not JSON, thus it is expected to be a key file
+				if bz, err = os.ReadFile(args[1]); err != nil {
+					return err
+				}
+			}
+			consumerPubKey, err := types.ParseConsumerKeyFromJson(clientCtx.Codec, bz)
+			if err != nil {
 				return err
 			}
 
@@ -67,3 +93,45 @@ func NewAssignConsumerKeyCmd() *cobra.Command {
 
 	return cmd
 }
+
+func NewSubmitConsumerMisbehaviourCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-consumer-misbehaviour [misbehaviour-file]",
+		Short: "submit a light client attack, i.e., two conflicting headers, on a consumer chain",
+		Long: `Submit a light client attack on a consumer chain. The misbehaviour file is a JSON encoded
+ibc.lightclients.tendermint.v1.Misbehaviour, containing the client ID of the consumer chain
+on the provider and two conflicting headers.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).
+				WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var misbehaviour ibctmtypes.Misbehaviour
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &misbehaviour); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSubmitConsumerMisbehaviour(clientCtx.GetFromAddress(), &misbehaviour)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
@@ -21,9 +21,17 @@ import (
 )
 
 var (
-	ConsumerAdditionProposalHandler = govclient.NewProposalHandler(SubmitConsumerAdditionPropTxCmd, ConsumerAdditionProposalRESTHandler)
-	ConsumerRemovalProposalHandler  = govclient.NewProposalHandler(SubmitConsumerRemovalProposalTxCmd, ConsumerRemovalProposalRESTHandler)
-	EquivocationProposalHandler     = govclient.NewProposalHandler(SubmitEquivocationProposalTxCmd, EquivocationProposalRESTHandler)
+	ConsumerAdditionProposalHandler             = govclient.NewProposalHandler(SubmitConsumerAdditionPropTxCmd, ConsumerAdditionProposalRESTHandler)
+	ConsumerRemovalProposalHandler              = govclient.NewProposalHandler(SubmitConsumerRemovalProposalTxCmd, ConsumerRemovalProposalRESTHandler)
+	EquivocationProposalHandler                 = govclient.NewProposalHandler(SubmitEquivocationProposalTxCmd, EquivocationProposalRESTHandler)
+	ConsumerValidatorListsProposalHandler       = govclient.NewProposalHandler(SubmitConsumerValidatorListsProposalTxCmd, ConsumerValidatorListsProposalRESTHandler)
+	ConsumerParametersUpdateProposalHandler     = govclient.NewProposalHandler(SubmitConsumerParametersUpdateProposalTxCmd, ConsumerParametersUpdateProposalRESTHandler)
+	ForceCompleteUnbondingProposalHandler       = govclient.NewProposalHandler(SubmitForceCompleteUnbondingProposalTxCmd, ForceCompleteUnbondingProposalRESTHandler)
+	ConsumerAdditionCancellationProposalHandler = govclient.NewProposalHandler(SubmitConsumerAdditionCancellationProposalTxCmd, ConsumerAdditionCancellationProposalRESTHandler)
+	ChangeRewardDenomsProposalHandler           = govclient.NewProposalHandler(SubmitChangeRewardDenomsProposalTxCmd, ChangeRewardDenomsProposalRESTHandler)
+	ConsumerPauseProposalHandler                = govclient.NewProposalHandler(SubmitConsumerPauseProposalTxCmd, ConsumerPauseProposalRESTHandler)
+	ConsumerMetadataUpdateProposalHandler       = govclient.NewProposalHandler(SubmitConsumerMetadataUpdateProposalTxCmd, ConsumerMetadataUpdateProposalRESTHandler)
+	ChangeConsumerRewardFractionProposalHandler = govclient.NewProposalHandler(SubmitChangeConsumerRewardFractionProposalTxCmd, ChangeConsumerRewardFractionProposalRESTHandler)
 )
 
 // SubmitConsumerAdditionPropTxCmd returns a CLI command handler for submitting
@@ -51,8 +59,8 @@ Where proposal.json contains:
         "revision_number": 2,
         "revision_height": 3
     },
-    "genesis_hash": "Z2VuZXNpcyBoYXNo",
-    "binary_hash": "YmluYXJ5IGhhc2g=",
+    "genesis_hash": "zR9M929tucHmg3mKIrUvu2ID6/AlYsdZ9jZeThMSVIQ=",
+    "binary_hash": "rfmh2NIDI8zzjftX79nc3mZmhF6reD6LyS0G00Y/F8g=",
     "spawn_time": "2022-01-27T15:59:50.121607-08:00",
     "blocks_per_distribution_transmission": 1000,
     "consumer_redistribution_fraction": "0.75",
@@ -60,6 +68,19 @@ Where proposal.json contains:
     "transfer_timeout_period": 3600000000000,
     "ccv_timeout_period": 2419200000000000,
     "unbonding_period": 1728000000000000,
+    "send_slash_confirmations": false,
+    "preferred_reward_denom": "",
+    "slash_double_signs": false,
+    "validator_allowlist": [],
+    "validator_denylist": [],
+    "downtime_jail_duration": 0,
+    "top_n": 0,
+    "metadata": {
+        "name": "FooChain",
+        "description": "Gonna be a great chain",
+        "website": "https://foochain.io",
+        "contact": "team@foochain.io"
+    },
     "deposit": "10000stake"
 }
 		`,
@@ -74,11 +95,7 @@ Where proposal.json contains:
 				return err
 			}
 
-			content := types.NewConsumerAdditionProposal(
-				proposal.Title, proposal.Description, proposal.ChainId, proposal.InitialHeight,
-				proposal.GenesisHash, proposal.BinaryHash, proposal.SpawnTime,
-				proposal.ConsumerRedistributionFraction, proposal.BlocksPerDistributionTransmission, proposal.HistoricalEntries,
-				proposal.CcvTimeoutPeriod, proposal.TransferTimeoutPeriod, proposal.UnbondingPeriod)
+			content := proposal.Content()
 
 			from := clientCtx.GetFromAddress()
 
@@ -97,6 +114,40 @@ Where proposal.json contains:
 	}
 }
 
+// ValidateConsumerAdditionPropCmd returns a CLI command handler for validating
+// a consumer addition proposal file locally, without submitting the proposal.
+func ValidateConsumerAdditionPropCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "validate-consumer-addition [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Validate a consumer addition proposal file without submitting it",
+		Long: `
+Validate a consumer addition proposal file, i.e., parse the JSON file and run the
+stateless checks of the proposal, without broadcasting a transaction.
+The proposal file has the same format as the one of the consumer-addition proposal command.
+
+Example:
+$ <appd> tx provider validate-consumer-addition <path/to/proposal.json>
+		`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			proposal, err := ParseConsumerAdditionProposalJSON(args[0])
+			if err != nil {
+				return fmt.Errorf("cannot parse proposal file: %w", err)
+			}
+
+			if err := proposal.Content().ValidateBasic(); err != nil {
+				return err
+			}
+			if _, err := sdk.ParseCoinsNormalized(proposal.Deposit); err != nil {
+				return fmt.Errorf("deposit is invalid: %w", err)
+			}
+
+			cmd.Printf("consumer addition proposal for chain %s is valid\n", proposal.ChainId)
+			return nil
+		},
+	}
+}
+
 // SubmitConsumerRemovalPropTxCmd returns a CLI command handler for submitting
 // a consumer addition proposal via a transaction.
 func SubmitConsumerRemovalProposalTxCmd() *cobra.Command {
@@ -208,186 +259,1023 @@ Where proposal.json contains:
 	}
 }
 
-type ConsumerAdditionProposalJSON struct {
-	Title         string             `json:"title"`
-	Description   string             `json:"description"`
-	ChainId       string             `json:"chain_id"`
-	InitialHeight clienttypes.Height `json:"initial_height"`
-	GenesisHash   []byte             `json:"genesis_hash"`
-	BinaryHash    []byte             `json:"binary_hash"`
-	SpawnTime     time.Time          `json:"spawn_time"`
+// SubmitConsumerValidatorListsProposalTxCmd returns a CLI command handler for submitting
+// a consumer validator lists proposal via a transaction.
+func SubmitConsumerValidatorListsProposalTxCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "consumer-validator-lists [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a consumer validator lists proposal",
+		Long: fmt.Sprintf(`Submit a proposal to replace the validator allowlist and denylist of a consumer chain,
+along with an initial deposit. The proposal details must be supplied via a JSON file.
+If the allowlist is not empty, only the listed validators validate the consumer chain.
+The validators in the denylist never validate the consumer chain.
 
-	ConsumerRedistributionFraction    string        `json:"consumer_redistribution_fraction"`
-	BlocksPerDistributionTransmission int64         `json:"blocks_per_distribution_transmission"`
-	HistoricalEntries                 int64         `json:"historical_entries"`
-	CcvTimeoutPeriod                  time.Duration `json:"ccv_timeout_period"`
-	TransferTimeoutPeriod             time.Duration `json:"transfer_timeout_period"`
-	UnbondingPeriod                   time.Duration `json:"unbonding_period"`
+Example:
+$ <appd> tx gov submit-proposal consumer-validator-lists <path/to/proposal.json> --from=<key_or_address>
 
-	Deposit string `json:"deposit"`
+Where proposal.json contains:
+{
+	 "title": "Exclude Foo validator from the FooChain",
+	 "description": "It does not run a FooChain node",
+	 "chain_id": "foochain",
+	 "validator_allowlist": [],
+	 "validator_denylist": ["%s1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq"],
+	 "deposit": "10000stake"
 }
+`, sdk.GetConfig().GetBech32ConsensusAddrPrefix()),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
 
-type ConsumerAdditionProposalReq struct {
-	BaseReq  rest.BaseReq   `json:"base_req"`
-	Proposer sdk.AccAddress `json:"proposer"`
-
-	Title         string             `json:"title"`
-	Description   string             `json:"description"`
-	ChainId       string             `json:"chainId"`
-	InitialHeight clienttypes.Height `json:"initialHeight"`
-	GenesisHash   []byte             `json:"genesisHash"`
-	BinaryHash    []byte             `json:"binaryHash"`
-	SpawnTime     time.Time          `json:"spawnTime"`
+			proposal, err := ParseConsumerValidatorListsProposalJSON(args[0])
+			if err != nil {
+				return err
+			}
 
-	ConsumerRedistributionFraction    string        `json:"consumer_redistribution_fraction"`
-	BlocksPerDistributionTransmission int64         `json:"blocks_per_distribution_transmission"`
-	HistoricalEntries                 int64         `json:"historical_entries"`
-	CcvTimeoutPeriod                  time.Duration `json:"ccv_timeout_period"`
-	TransferTimeoutPeriod             time.Duration `json:"transfer_timeout_period"`
-	UnbondingPeriod                   time.Duration `json:"unbonding_period"`
+			content := types.NewConsumerValidatorListsProposal(
+				proposal.Title, proposal.Description, proposal.ChainId, proposal.ValidatorAllowlist, proposal.ValidatorDenylist)
 
-	Deposit sdk.Coins `json:"deposit"`
-}
+			from := clientCtx.GetFromAddress()
 
-func ParseConsumerAdditionProposalJSON(proposalFile string) (ConsumerAdditionProposalJSON, error) {
-	proposal := ConsumerAdditionProposalJSON{}
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
 
-	contents, err := ioutil.ReadFile(filepath.Clean(proposalFile))
-	if err != nil {
-		return proposal, err
-	}
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
 
-	if err := json.Unmarshal(contents, &proposal); err != nil {
-		return proposal, err
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
 	}
-
-	return proposal, nil
-}
-
-type ConsumerRemovalProposalJSON struct {
-	Title       string    `json:"title"`
-	Description string    `json:"description"`
-	ChainId     string    `json:"chain_id"`
-	StopTime    time.Time `json:"stop_time"`
-	Deposit     string    `json:"deposit"`
 }
 
-type ConsumerRemovalProposalReq struct {
-	BaseReq  rest.BaseReq   `json:"base_req"`
-	Proposer sdk.AccAddress `json:"proposer"`
+// SubmitConsumerParametersUpdateProposalTxCmd returns a CLI command handler for submitting
+// a consumer parameters update proposal via a transaction.
+func SubmitConsumerParametersUpdateProposalTxCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "consumer-parameters-update [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a consumer parameters update proposal",
+		Long: `Submit a proposal to update the parameters of a running consumer chain, along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+The parameters override the provider params for the consumer chain.
+The expected rewards per window are optional, and given in the denoms
+under which the rewards of the consumer chain are received on the provider.
 
-	Title       string `json:"title"`
-	Description string `json:"description"`
-	ChainId     string `json:"chainId"`
+Example:
+$ <appd> tx gov submit-proposal consumer-parameters-update <path/to/proposal.json> --from=<key_or_address>
 
-	StopTime time.Time `json:"stopTime"`
-	Deposit  sdk.Coins `json:"deposit"`
+Where proposal.json contains:
+{
+	 "title": "Update the FooChain parameters",
+	 "description": "Distribute more rewards to the FooChain and opt out the smallest validators",
+	 "chain_id": "foochain",
+	 "consumer_redistribute_fraction": "0.5",
+	 "soft_opt_out_threshold": "0.05",
+	 "expected_rewards_per_window": "1000ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+	 "deposit": "10000stake"
 }
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
 
-type EquivocationProposalJSON struct {
-	// evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
-	types.EquivocationProposal
+			proposal, err := ParseConsumerParametersUpdateProposalJSON(args[0])
+			if err != nil {
+				return err
+			}
 
-	Deposit string `json:"deposit"`
-}
+			expectedRewards, err := sdk.ParseCoinsNormalized(proposal.ExpectedRewardsPerWindow)
+			if err != nil {
+				return err
+			}
 
-type EquivocationProposalReq struct {
-	BaseReq  rest.BaseReq   `json:"base_req"`
-	Proposer sdk.AccAddress `json:"proposer"`
+			content := types.NewConsumerParametersUpdateProposal(
+				proposal.Title, proposal.Description, proposal.ChainId,
+				proposal.ConsumerRedistributeFraction, proposal.SoftOptOutThreshold, expectedRewards)
 
-	// evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
-	types.EquivocationProposal
+			from := clientCtx.GetFromAddress()
 
-	Deposit sdk.Coins `json:"deposit"`
-}
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
 
-func ParseEquivocationProposalJSON(proposalFile string) (EquivocationProposalJSON, error) {
-	proposal := EquivocationProposalJSON{}
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
 
-	contents, err := ioutil.ReadFile(filepath.Clean(proposalFile))
-	if err != nil {
-		return proposal, err
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
 	}
+}
 
-	if err := json.Unmarshal(contents, &proposal); err != nil {
-		return proposal, err
-	}
+// SubmitForceCompleteUnbondingProposalTxCmd returns a CLI command handler for submitting
+// a force complete unbonding proposal via a transaction.
+func SubmitForceCompleteUnbondingProposalTxCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "force-complete-unbonding [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a force complete unbonding proposal",
+		Long: `Submit a proposal to stop waiting on a consumer chain for the maturity of the unbonding operations,
+along with an initial deposit. The proposal details must be supplied via a JSON file.
+The unbonding operations that are not waiting on any other consumer chain complete.
 
-	return proposal, nil
-}
+Example:
+$ <appd> tx gov submit-proposal force-complete-unbonding <path/to/proposal.json> --from=<key_or_address>
 
-// EquivocationProposalRESTHandler returns a ProposalRESTHandler that exposes the equivocation rest handler.
-func EquivocationProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
-	return govrest.ProposalRESTHandler{
-		SubRoute: "equivocation",
-		Handler:  postEquivocationProposalHandlerFn(clientCtx),
-	}
+Where proposal.json contains:
+{
+	 "title": "Release the unbondings waiting on FooChain",
+	 "description": "FooChain halted and will never mature the unbondings",
+	 "chain_id": "foochain",
+	 "deposit": "10000stake"
 }
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
 
-func ParseConsumerRemovalProposalJSON(proposalFile string) (ConsumerRemovalProposalJSON, error) {
-	proposal := ConsumerRemovalProposalJSON{}
+			proposal, err := ParseForceCompleteUnbondingProposalJSON(args[0])
+			if err != nil {
+				return err
+			}
 
-	contents, err := ioutil.ReadFile(filepath.Clean(proposalFile))
-	if err != nil {
-		return proposal, err
-	}
+			content := types.NewForceCompleteUnbondingProposal(
+				proposal.Title, proposal.Description, proposal.ChainId)
 
-	if err := json.Unmarshal(contents, &proposal); err != nil {
-		return proposal, err
-	}
+			from := clientCtx.GetFromAddress()
 
-	return proposal, nil
-}
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
 
-// ConsumerAdditionProposalRESTHandler returns a ProposalRESTHandler that exposes the consumer addition rest handler.
-func ConsumerAdditionProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
-	return govrest.ProposalRESTHandler{
-		SubRoute: "consumer_addition",
-		Handler:  postConsumerAdditionProposalHandlerFn(clientCtx),
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
 	}
 }
 
-// ConsumerRemovalProposalRESTHandler returns a ProposalRESTHandler that exposes the consumer removal rest handler.
-func ConsumerRemovalProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
-	return govrest.ProposalRESTHandler{
-		SubRoute: "consumer_removal",
-		Handler:  postConsumerRemovalProposalHandlerFn(clientCtx),
-	}
+// SubmitConsumerAdditionCancellationProposalTxCmd returns a CLI command handler for submitting
+// a consumer addition cancellation proposal via a transaction.
+func SubmitConsumerAdditionCancellationProposalTxCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "consumer-addition-cancellation [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a consumer addition cancellation proposal",
+		Long: `Submit a proposal to cancel the pending consumer addition proposal of a consumer chain
+that was not spawned yet, along with an initial deposit. The proposal details must be supplied via a JSON file.
+The pending consumer addition proposals can be listed with the list-start-proposals query.
+
+Example:
+$ <appd> tx gov submit-proposal consumer-addition-cancellation <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+{
+	 "title": "Cancel the launch of FooChain",
+	 "description": "The FooChain binary is not ready",
+	 "chain_id": "foochain",
+	 "deposit": "10000stake"
 }
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
 
-func postConsumerAdditionProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		var req ConsumerAdditionProposalReq
-		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
-			return
-		}
+			proposal, err := ParseConsumerAdditionCancellationProposalJSON(args[0])
+			if err != nil {
+				return err
+			}
 
-		req.BaseReq = req.BaseReq.Sanitize()
-		if !req.BaseReq.ValidateBasic(w) {
-			return
-		}
+			content := types.NewConsumerAdditionCancellationProposal(
+				proposal.Title, proposal.Description, proposal.ChainId)
 
-		content := types.NewConsumerAdditionProposal(
-			req.Title, req.Description, req.ChainId, req.InitialHeight,
-			req.GenesisHash, req.BinaryHash, req.SpawnTime,
-			req.ConsumerRedistributionFraction, req.BlocksPerDistributionTransmission, req.HistoricalEntries,
-			req.CcvTimeoutPeriod, req.TransferTimeoutPeriod, req.UnbondingPeriod)
+			from := clientCtx.GetFromAddress()
 
-		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
-		if rest.CheckBadRequestError(w, err) {
-			return
-		}
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
 
-		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
-			return
-		}
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
 
-		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
 	}
 }
 
-func postConsumerRemovalProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
+// SubmitChangeRewardDenomsProposalTxCmd returns a CLI command handler for submitting
+// a change reward denoms proposal via a transaction.
+func SubmitChangeRewardDenomsProposalTxCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "change-reward-denoms [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a change reward denoms proposal",
+		Long: `Submit a proposal to register and unregister consumer reward denoms, along with an initial deposit.
+Only the rewards of registered denoms received from the consumer chains are distributed to the fee collector.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ <appd> tx gov submit-proposal change-reward-denoms <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+{
+	 "title": "Register the FooChain reward denom",
+	 "description": "Distribute the FooChain rewards received over channel-0",
+	 "denoms_to_add": ["ibc/3C3D7B3BE4ECC85A0E5B52A3AEC3B7DFC2AA9CA47C37821E57020D6807043BE9"],
+	 "denoms_to_remove": [],
+	 "deposit": "10000stake"
+}
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseChangeRewardDenomsProposalJSON(args[0])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewChangeRewardDenomsProposal(
+				proposal.Title, proposal.Description, proposal.DenomsToAdd, proposal.DenomsToRemove)
+
+			from := clientCtx.GetFromAddress()
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+// SubmitConsumerPauseProposalTxCmd returns a CLI command handler for submitting
+// a consumer pause proposal via a transaction.
+func SubmitConsumerPauseProposalTxCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "consumer-pause [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a consumer pause proposal",
+		Long: `Submit a proposal to pause or resume a validating consumer chain, along with an initial deposit.
+While a consumer chain is paused, the validator set updates are not sent to it, but its CCV channel stays open.
+Set "pause" to true to pause the consumer chain and to false to resume it.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ <appd> tx gov submit-proposal consumer-pause <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+{
+	 "title": "Pause FooChain",
+	 "description": "Pause FooChain during its coordinated upgrade",
+	 "chain_id": "foochain",
+	 "pause": true,
+	 "deposit": "10000stake"
+}
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseConsumerPauseProposalJSON(args[0])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewConsumerPauseProposal(
+				proposal.Title, proposal.Description, proposal.ChainId, proposal.Pause)
+
+			from := clientCtx.GetFromAddress()
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+// SubmitConsumerMetadataUpdateProposalTxCmd returns a CLI command handler for submitting
+// a consumer metadata update proposal via a transaction.
+func SubmitConsumerMetadataUpdateProposalTxCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "consumer-metadata-update [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a consumer metadata update proposal",
+		Long: `Submit a proposal to replace the descriptive metadata of a consumer chain, along with an initial deposit.
+The metadata has no effect on the provider, it only improves the discoverability of the consumer chain.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ <appd> tx gov submit-proposal consumer-metadata-update <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+{
+	 "title": "Update the FooChain metadata",
+	 "description": "FooChain moved to a new website",
+	 "chain_id": "foochain",
+	 "metadata": {
+		 "name": "FooChain",
+		 "description": "Gonna be a great chain",
+		 "website": "https://foochain.network",
+		 "contact": "team@foochain.network"
+	 },
+	 "deposit": "10000stake"
+}
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseConsumerMetadataUpdateProposalJSON(args[0])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewConsumerMetadataUpdateProposal(
+				proposal.Title, proposal.Description, proposal.ChainId, proposal.Metadata)
+
+			from := clientCtx.GetFromAddress()
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+// SubmitChangeConsumerRewardFractionProposalTxCmd returns a CLI command handler for submitting
+// a change consumer reward fraction proposal via a transaction.
+func SubmitChangeConsumerRewardFractionProposalTxCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "change-consumer-reward-fraction [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a change consumer reward fraction proposal",
+		Long: `Submit a proposal to change the fraction of the rewards allocation of a running consumer chain
+that is distributed to the provider validators, along with an initial deposit.
+The fraction must be within [0, 1] and is applied from the next rewards distribution.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ <appd> tx gov submit-proposal change-consumer-reward-fraction <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+{
+	 "title": "Change the FooChain reward fraction",
+	 "description": "Distribute half of the FooChain rewards to the provider validators",
+	 "chain_id": "foochain",
+	 "consumer_redistribution_fraction": "0.5",
+	 "deposit": "10000stake"
+}
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseChangeConsumerRewardFractionProposalJSON(args[0])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewChangeConsumerRewardFractionProposal(
+				proposal.Title, proposal.Description, proposal.ChainId, proposal.ConsumerRedistributionFraction)
+
+			from := clientCtx.GetFromAddress()
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+type ConsumerAdditionProposalJSON struct {
+	Title         string             `json:"title"`
+	Description   string             `json:"description"`
+	ChainId       string             `json:"chain_id"`
+	InitialHeight clienttypes.Height `json:"initial_height"`
+	GenesisHash   []byte             `json:"genesis_hash"`
+	BinaryHash    []byte             `json:"binary_hash"`
+	SpawnTime     time.Time          `json:"spawn_time"`
+
+	ConsumerRedistributionFraction    string                  `json:"consumer_redistribution_fraction"`
+	BlocksPerDistributionTransmission int64                   `json:"blocks_per_distribution_transmission"`
+	HistoricalEntries                 int64                   `json:"historical_entries"`
+	CcvTimeoutPeriod                  time.Duration           `json:"ccv_timeout_period"`
+	TransferTimeoutPeriod             time.Duration           `json:"transfer_timeout_period"`
+	UnbondingPeriod                   time.Duration           `json:"unbonding_period"`
+	SendSlashConfirmations            bool                    `json:"send_slash_confirmations"`
+	PreferredRewardDenom              string                  `json:"preferred_reward_denom"`
+	SlashDoubleSigns                  bool                    `json:"slash_double_signs"`
+	ValidatorAllowlist                []string                `json:"validator_allowlist"`
+	ValidatorDenylist                 []string                `json:"validator_denylist"`
+	DowntimeJailDuration              time.Duration           `json:"downtime_jail_duration"`
+	TopN                              uint32                  `json:"top_n"`
+	Metadata                          *types.ConsumerMetadata `json:"metadata"`
+
+	Deposit string `json:"deposit"`
+}
+
+type ConsumerAdditionProposalReq struct {
+	BaseReq  rest.BaseReq   `json:"base_req"`
+	Proposer sdk.AccAddress `json:"proposer"`
+
+	Title         string             `json:"title"`
+	Description   string             `json:"description"`
+	ChainId       string             `json:"chainId"`
+	InitialHeight clienttypes.Height `json:"initialHeight"`
+	GenesisHash   []byte             `json:"genesisHash"`
+	BinaryHash    []byte             `json:"binaryHash"`
+	SpawnTime     time.Time          `json:"spawnTime"`
+
+	ConsumerRedistributionFraction    string                  `json:"consumer_redistribution_fraction"`
+	BlocksPerDistributionTransmission int64                   `json:"blocks_per_distribution_transmission"`
+	HistoricalEntries                 int64                   `json:"historical_entries"`
+	CcvTimeoutPeriod                  time.Duration           `json:"ccv_timeout_period"`
+	TransferTimeoutPeriod             time.Duration           `json:"transfer_timeout_period"`
+	UnbondingPeriod                   time.Duration           `json:"unbonding_period"`
+	SendSlashConfirmations            bool                    `json:"send_slash_confirmations"`
+	PreferredRewardDenom              string                  `json:"preferred_reward_denom"`
+	SlashDoubleSigns                  bool                    `json:"slash_double_signs"`
+	ValidatorAllowlist                []string                `json:"validator_allowlist"`
+	ValidatorDenylist                 []string                `json:"validator_denylist"`
+	DowntimeJailDuration              time.Duration           `json:"downtime_jail_duration"`
+	TopN                              uint32                  `json:"top_n"`
+	Metadata                          *types.ConsumerMetadata `json:"metadata"`
+
+	Deposit sdk.Coins `json:"deposit"`
+}
+
+// Content returns the consumer addition proposal described by the JSON proposal
+func (proposal ConsumerAdditionProposalJSON) Content() govtypes.Content {
+	content := types.NewConsumerAdditionProposal(
+		proposal.Title, proposal.Description, proposal.ChainId, proposal.InitialHeight,
+		proposal.GenesisHash, proposal.BinaryHash, proposal.SpawnTime,
+		proposal.ConsumerRedistributionFraction, proposal.BlocksPerDistributionTransmission, proposal.HistoricalEntries,
+		proposal.CcvTimeoutPeriod, proposal.TransferTimeoutPeriod, proposal.UnbondingPeriod)
+	content.(*types.ConsumerAdditionProposal).SendSlashConfirmations = proposal.SendSlashConfirmations
+	content.(*types.ConsumerAdditionProposal).PreferredRewardDenom = proposal.PreferredRewardDenom
+	content.(*types.ConsumerAdditionProposal).SlashDoubleSigns = proposal.SlashDoubleSigns
+	content.(*types.ConsumerAdditionProposal).ValidatorAllowlist = proposal.ValidatorAllowlist
+	content.(*types.ConsumerAdditionProposal).ValidatorDenylist = proposal.ValidatorDenylist
+	content.(*types.ConsumerAdditionProposal).DowntimeJailDuration = proposal.DowntimeJailDuration
+	content.(*types.ConsumerAdditionProposal).TopN = proposal.TopN
+	content.(*types.ConsumerAdditionProposal).Metadata = proposal.Metadata
+	return content
+}
+
+func ParseConsumerAdditionProposalJSON(proposalFile string) (ConsumerAdditionProposalJSON, error) {
+	proposal := ConsumerAdditionProposalJSON{}
+
+	contents, err := ioutil.ReadFile(filepath.Clean(proposalFile))
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := json.Unmarshal(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+type ConsumerRemovalProposalJSON struct {
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	ChainId     string    `json:"chain_id"`
+	StopTime    time.Time `json:"stop_time"`
+	Deposit     string    `json:"deposit"`
+}
+
+type ConsumerRemovalProposalReq struct {
+	BaseReq  rest.BaseReq   `json:"base_req"`
+	Proposer sdk.AccAddress `json:"proposer"`
+
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	ChainId     string `json:"chainId"`
+
+	StopTime time.Time `json:"stopTime"`
+	Deposit  sdk.Coins `json:"deposit"`
+}
+
+type EquivocationProposalJSON struct {
+	// evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	types.EquivocationProposal
+
+	Deposit string `json:"deposit"`
+}
+
+type EquivocationProposalReq struct {
+	BaseReq  rest.BaseReq   `json:"base_req"`
+	Proposer sdk.AccAddress `json:"proposer"`
+
+	// evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	types.EquivocationProposal
+
+	Deposit sdk.Coins `json:"deposit"`
+}
+
+func ParseEquivocationProposalJSON(proposalFile string) (EquivocationProposalJSON, error) {
+	proposal := EquivocationProposalJSON{}
+
+	contents, err := ioutil.ReadFile(filepath.Clean(proposalFile))
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := json.Unmarshal(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+type ConsumerValidatorListsProposalJSON struct {
+	Title              string   `json:"title"`
+	Description        string   `json:"description"`
+	ChainId            string   `json:"chain_id"`
+	ValidatorAllowlist []string `json:"validator_allowlist"`
+	ValidatorDenylist  []string `json:"validator_denylist"`
+	Deposit            string   `json:"deposit"`
+}
+
+type ConsumerValidatorListsProposalReq struct {
+	BaseReq  rest.BaseReq   `json:"base_req"`
+	Proposer sdk.AccAddress `json:"proposer"`
+
+	Title              string   `json:"title"`
+	Description        string   `json:"description"`
+	ChainId            string   `json:"chainId"`
+	ValidatorAllowlist []string `json:"validator_allowlist"`
+	ValidatorDenylist  []string `json:"validator_denylist"`
+
+	Deposit sdk.Coins `json:"deposit"`
+}
+
+func ParseConsumerValidatorListsProposalJSON(proposalFile string) (ConsumerValidatorListsProposalJSON, error) {
+	proposal := ConsumerValidatorListsProposalJSON{}
+
+	contents, err := ioutil.ReadFile(filepath.Clean(proposalFile))
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := json.Unmarshal(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+type ConsumerParametersUpdateProposalJSON struct {
+	Title                        string `json:"title"`
+	Description                  string `json:"description"`
+	ChainId                      string `json:"chain_id"`
+	ConsumerRedistributeFraction string `json:"consumer_redistribute_fraction"`
+	SoftOptOutThreshold          string `json:"soft_opt_out_threshold"`
+	ExpectedRewardsPerWindow     string `json:"expected_rewards_per_window"`
+	Deposit                      string `json:"deposit"`
+}
+
+type ConsumerParametersUpdateProposalReq struct {
+	BaseReq  rest.BaseReq   `json:"base_req"`
+	Proposer sdk.AccAddress `json:"proposer"`
+
+	Title                        string    `json:"title"`
+	Description                  string    `json:"description"`
+	ChainId                      string    `json:"chainId"`
+	ConsumerRedistributeFraction string    `json:"consumer_redistribute_fraction"`
+	SoftOptOutThreshold          string    `json:"soft_opt_out_threshold"`
+	ExpectedRewardsPerWindow     sdk.Coins `json:"expected_rewards_per_window"`
+
+	Deposit sdk.Coins `json:"deposit"`
+}
+
+func ParseConsumerParametersUpdateProposalJSON(proposalFile string) (ConsumerParametersUpdateProposalJSON, error) {
+	proposal := ConsumerParametersUpdateProposalJSON{}
+
+	contents, err := ioutil.ReadFile(filepath.Clean(proposalFile))
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := json.Unmarshal(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+type ForceCompleteUnbondingProposalJSON struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	ChainId     string `json:"chain_id"`
+	Deposit     string `json:"deposit"`
+}
+
+type ForceCompleteUnbondingProposalReq struct {
+	BaseReq  rest.BaseReq   `json:"base_req"`
+	Proposer sdk.AccAddress `json:"proposer"`
+
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	ChainId     string `json:"chainId"`
+
+	Deposit sdk.Coins `json:"deposit"`
+}
+
+func ParseForceCompleteUnbondingProposalJSON(proposalFile string) (ForceCompleteUnbondingProposalJSON, error) {
+	proposal := ForceCompleteUnbondingProposalJSON{}
+
+	contents, err := ioutil.ReadFile(filepath.Clean(proposalFile))
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := json.Unmarshal(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+type ConsumerAdditionCancellationProposalJSON struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	ChainId     string `json:"chain_id"`
+	Deposit     string `json:"deposit"`
+}
+
+type ConsumerAdditionCancellationProposalReq struct {
+	BaseReq  rest.BaseReq   `json:"base_req"`
+	Proposer sdk.AccAddress `json:"proposer"`
+
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	ChainId     string `json:"chainId"`
+
+	Deposit sdk.Coins `json:"deposit"`
+}
+
+func ParseConsumerAdditionCancellationProposalJSON(proposalFile string) (ConsumerAdditionCancellationProposalJSON, error) {
+	proposal := ConsumerAdditionCancellationProposalJSON{}
+
+	contents, err := ioutil.ReadFile(filepath.Clean(proposalFile))
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := json.Unmarshal(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+type ChangeRewardDenomsProposalJSON struct {
+	Title          string   `json:"title"`
+	Description    string   `json:"description"`
+	DenomsToAdd    []string `json:"denoms_to_add"`
+	DenomsToRemove []string `json:"denoms_to_remove"`
+	Deposit        string   `json:"deposit"`
+}
+
+type ChangeRewardDenomsProposalReq struct {
+	BaseReq  rest.BaseReq   `json:"base_req"`
+	Proposer sdk.AccAddress `json:"proposer"`
+
+	Title          string   `json:"title"`
+	Description    string   `json:"description"`
+	DenomsToAdd    []string `json:"denomsToAdd"`
+	DenomsToRemove []string `json:"denomsToRemove"`
+
+	Deposit sdk.Coins `json:"deposit"`
+}
+
+func ParseChangeRewardDenomsProposalJSON(proposalFile string) (ChangeRewardDenomsProposalJSON, error) {
+	proposal := ChangeRewardDenomsProposalJSON{}
+
+	contents, err := ioutil.ReadFile(filepath.Clean(proposalFile))
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := json.Unmarshal(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+type ConsumerPauseProposalJSON struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	ChainId     string `json:"chain_id"`
+	Pause       bool   `json:"pause"`
+	Deposit     string `json:"deposit"`
+}
+
+type ConsumerPauseProposalReq struct {
+	BaseReq  rest.BaseReq   `json:"base_req"`
+	Proposer sdk.AccAddress `json:"proposer"`
+
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	ChainId     string `json:"chainId"`
+	Pause       bool   `json:"pause"`
+
+	Deposit sdk.Coins `json:"deposit"`
+}
+
+func ParseConsumerPauseProposalJSON(proposalFile string) (ConsumerPauseProposalJSON, error) {
+	proposal := ConsumerPauseProposalJSON{}
+
+	contents, err := ioutil.ReadFile(filepath.Clean(proposalFile))
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := json.Unmarshal(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+type ConsumerMetadataUpdateProposalJSON struct {
+	Title       string                 `json:"title"`
+	Description string                 `json:"description"`
+	ChainId     string                 `json:"chain_id"`
+	Metadata    types.ConsumerMetadata `json:"metadata"`
+	Deposit     string                 `json:"deposit"`
+}
+
+type ConsumerMetadataUpdateProposalReq struct {
+	BaseReq  rest.BaseReq   `json:"base_req"`
+	Proposer sdk.AccAddress `json:"proposer"`
+
+	Title       string                 `json:"title"`
+	Description string                 `json:"description"`
+	ChainId     string                 `json:"chainId"`
+	Metadata    types.ConsumerMetadata `json:"metadata"`
+
+	Deposit sdk.Coins `json:"deposit"`
+}
+
+func ParseConsumerMetadataUpdateProposalJSON(proposalFile string) (ConsumerMetadataUpdateProposalJSON, error) {
+	proposal := ConsumerMetadataUpdateProposalJSON{}
+
+	contents, err := ioutil.ReadFile(filepath.Clean(proposalFile))
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := json.Unmarshal(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+type ChangeConsumerRewardFractionProposalJSON struct {
+	Title                          string `json:"title"`
+	Description                    string `json:"description"`
+	ChainId                        string `json:"chain_id"`
+	ConsumerRedistributionFraction string `json:"consumer_redistribution_fraction"`
+	Deposit                        string `json:"deposit"`
+}
+
+type ChangeConsumerRewardFractionProposalReq struct {
+	BaseReq  rest.BaseReq   `json:"base_req"`
+	Proposer sdk.AccAddress `json:"proposer"`
+
+	Title                          string `json:"title"`
+	Description                    string `json:"description"`
+	ChainId                        string `json:"chainId"`
+	ConsumerRedistributionFraction string `json:"consumer_redistribution_fraction"`
+
+	Deposit sdk.Coins `json:"deposit"`
+}
+
+func ParseChangeConsumerRewardFractionProposalJSON(proposalFile string) (ChangeConsumerRewardFractionProposalJSON, error) {
+	proposal := ChangeConsumerRewardFractionProposalJSON{}
+
+	contents, err := ioutil.ReadFile(filepath.Clean(proposalFile))
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := json.Unmarshal(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ConsumerValidatorListsProposalRESTHandler returns a ProposalRESTHandler that exposes the consumer validator lists rest handler.
+func ConsumerValidatorListsProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "consumer_validator_lists",
+		Handler:  postConsumerValidatorListsProposalHandlerFn(clientCtx),
+	}
+}
+
+// ConsumerParametersUpdateProposalRESTHandler returns a ProposalRESTHandler that exposes the consumer parameters update rest handler.
+func ConsumerParametersUpdateProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "consumer_parameters_update",
+		Handler:  postConsumerParametersUpdateProposalHandlerFn(clientCtx),
+	}
+}
+
+// ForceCompleteUnbondingProposalRESTHandler returns a ProposalRESTHandler that exposes the force complete unbonding rest handler.
+func ForceCompleteUnbondingProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "force_complete_unbonding",
+		Handler:  postForceCompleteUnbondingProposalHandlerFn(clientCtx),
+	}
+}
+
+// ConsumerAdditionCancellationProposalRESTHandler returns a ProposalRESTHandler that exposes the consumer addition cancellation rest handler.
+func ConsumerAdditionCancellationProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "consumer_addition_cancellation",
+		Handler:  postConsumerAdditionCancellationProposalHandlerFn(clientCtx),
+	}
+}
+
+// ChangeRewardDenomsProposalRESTHandler returns a ProposalRESTHandler that exposes the change reward denoms rest handler.
+func ChangeRewardDenomsProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "change_reward_denoms",
+		Handler:  postChangeRewardDenomsProposalHandlerFn(clientCtx),
+	}
+}
+
+// ConsumerPauseProposalRESTHandler returns a ProposalRESTHandler that exposes the consumer pause rest handler.
+func ConsumerPauseProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "consumer_pause",
+		Handler:  postConsumerPauseProposalHandlerFn(clientCtx),
+	}
+}
+
+// ConsumerMetadataUpdateProposalRESTHandler returns a ProposalRESTHandler that exposes the consumer metadata update rest handler.
+func ConsumerMetadataUpdateProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "consumer_metadata_update",
+		Handler:  postConsumerMetadataUpdateProposalHandlerFn(clientCtx),
+	}
+}
+
+// ChangeConsumerRewardFractionProposalRESTHandler returns a ProposalRESTHandler that exposes the change consumer reward fraction rest handler.
+func ChangeConsumerRewardFractionProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "change_consumer_reward_fraction",
+		Handler:  postChangeConsumerRewardFractionProposalHandlerFn(clientCtx),
+	}
+}
+
+// EquivocationProposalRESTHandler returns a ProposalRESTHandler that exposes the equivocation rest handler.
+func EquivocationProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "equivocation",
+		Handler:  postEquivocationProposalHandlerFn(clientCtx),
+	}
+}
+
+func ParseConsumerRemovalProposalJSON(proposalFile string) (ConsumerRemovalProposalJSON, error) {
+	proposal := ConsumerRemovalProposalJSON{}
+
+	contents, err := ioutil.ReadFile(filepath.Clean(proposalFile))
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := json.Unmarshal(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ConsumerAdditionProposalRESTHandler returns a ProposalRESTHandler that exposes the consumer addition rest handler.
+func ConsumerAdditionProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "consumer_addition",
+		Handler:  postConsumerAdditionProposalHandlerFn(clientCtx),
+	}
+}
+
+// ConsumerRemovalProposalRESTHandler returns a ProposalRESTHandler that exposes the consumer removal rest handler.
+func ConsumerRemovalProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "consumer_removal",
+		Handler:  postConsumerRemovalProposalHandlerFn(clientCtx),
+	}
+}
+
+func postConsumerAdditionProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ConsumerAdditionProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewConsumerAdditionProposal(
+			req.Title, req.Description, req.ChainId, req.InitialHeight,
+			req.GenesisHash, req.BinaryHash, req.SpawnTime,
+			req.ConsumerRedistributionFraction, req.BlocksPerDistributionTransmission, req.HistoricalEntries,
+			req.CcvTimeoutPeriod, req.TransferTimeoutPeriod, req.UnbondingPeriod)
+		content.(*types.ConsumerAdditionProposal).SendSlashConfirmations = req.SendSlashConfirmations
+		content.(*types.ConsumerAdditionProposal).PreferredRewardDenom = req.PreferredRewardDenom
+		content.(*types.ConsumerAdditionProposal).SlashDoubleSigns = req.SlashDoubleSigns
+		content.(*types.ConsumerAdditionProposal).ValidatorAllowlist = req.ValidatorAllowlist
+		content.(*types.ConsumerAdditionProposal).ValidatorDenylist = req.ValidatorDenylist
+		content.(*types.ConsumerAdditionProposal).DowntimeJailDuration = req.DowntimeJailDuration
+		content.(*types.ConsumerAdditionProposal).TopN = req.TopN
+		content.(*types.ConsumerAdditionProposal).Metadata = req.Metadata
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+func postConsumerRemovalProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
 		var req ConsumerRemovalProposalReq
 		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
 			return
@@ -441,3 +1329,223 @@ func postEquivocationProposalHandlerFn(clientCtx client.Context) http.HandlerFun
 		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
 	}
 }
+
+func postConsumerValidatorListsProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ConsumerValidatorListsProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewConsumerValidatorListsProposal(
+			req.Title, req.Description, req.ChainId, req.ValidatorAllowlist, req.ValidatorDenylist)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+func postConsumerParametersUpdateProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ConsumerParametersUpdateProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewConsumerParametersUpdateProposal(
+			req.Title, req.Description, req.ChainId, req.ConsumerRedistributeFraction, req.SoftOptOutThreshold,
+			req.ExpectedRewardsPerWindow)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+func postForceCompleteUnbondingProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ForceCompleteUnbondingProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewForceCompleteUnbondingProposal(req.Title, req.Description, req.ChainId)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+func postConsumerAdditionCancellationProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ConsumerAdditionCancellationProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewConsumerAdditionCancellationProposal(req.Title, req.Description, req.ChainId)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+func postChangeRewardDenomsProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ChangeRewardDenomsProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewChangeRewardDenomsProposal(req.Title, req.Description, req.DenomsToAdd, req.DenomsToRemove)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+func postConsumerPauseProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ConsumerPauseProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewConsumerPauseProposal(req.Title, req.Description, req.ChainId, req.Pause)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+func postConsumerMetadataUpdateProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ConsumerMetadataUpdateProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewConsumerMetadataUpdateProposal(req.Title, req.Description, req.ChainId, req.Metadata)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+func postChangeConsumerRewardFractionProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ChangeConsumerRewardFractionProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewChangeConsumerRewardFractionProposal(
+			req.Title, req.Description, req.ChainId, req.ConsumerRedistributionFraction)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
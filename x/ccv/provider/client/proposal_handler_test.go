@@ -0,0 +1,120 @@
+package client_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	providerclient "github.com/cosmos/interchain-security/x/ccv/provider/client"
+)
+
+const validConsumerAdditionProposal = `{
+    "title": "Create the FooChain",
+    "description": "Gonna be a great chain",
+    "chain_id": "foochain",
+    "initial_height": {
+        "revision_number": 2,
+        "revision_height": 3
+    },
+    "genesis_hash": "zR9M929tucHmg3mKIrUvu2ID6/AlYsdZ9jZeThMSVIQ=",
+    "binary_hash": "rfmh2NIDI8zzjftX79nc3mZmhF6reD6LyS0G00Y/F8g=",
+    "spawn_time": "2022-01-27T15:59:50.121607-08:00",
+    "blocks_per_distribution_transmission": 1000,
+    "consumer_redistribution_fraction": "0.75",
+    "historical_entries": 10000,
+    "transfer_timeout_period": 3600000000000,
+    "ccv_timeout_period": 2419200000000000,
+    "unbonding_period": 1728000000000000,
+    "deposit": "10000stake"
+}`
+
+// TestValidateConsumerAdditionPropCmd tests that the validate-consumer-addition command
+// accepts valid consumer addition proposal files and reports the errors of invalid ones
+func TestValidateConsumerAdditionPropCmd(t *testing.T) {
+	testCases := []struct {
+		name     string
+		proposal string
+		expErr   string
+	}{
+		{
+			"valid proposal",
+			validConsumerAdditionProposal,
+			"",
+		},
+		{
+			"malformed JSON",
+			`{"title": "Create the FooChain",`,
+			"cannot parse proposal file",
+		},
+		{
+			"malformed genesis hash",
+			strings.Replace(validConsumerAdditionProposal,
+				`"zR9M929tucHmg3mKIrUvu2ID6/AlYsdZ9jZeThMSVIQ="`, `"not a hash!"`, 1),
+			"cannot parse proposal file",
+		},
+		{
+			"binary hash of invalid length",
+			strings.Replace(validConsumerAdditionProposal,
+				`"rfmh2NIDI8zzjftX79nc3mZmhF6reD6LyS0G00Y/F8g="`, `"aGFzaA=="`, 1),
+			"binary hash must be a SHA-256 hash",
+		},
+		{
+			"bad spawn time",
+			strings.Replace(validConsumerAdditionProposal,
+				`"2022-01-27T15:59:50.121607-08:00"`, `"27/01/2022"`, 1),
+			"cannot parse proposal file",
+		},
+		{
+			"zero spawn time",
+			strings.Replace(validConsumerAdditionProposal,
+				`"2022-01-27T15:59:50.121607-08:00"`, `"0001-01-01T00:00:00Z"`, 1),
+			"spawn time cannot be zero",
+		},
+		{
+			"oversized metadata name",
+			strings.Replace(validConsumerAdditionProposal, `"deposit": "10000stake"`,
+				`"metadata": {"name": "`+strings.Repeat("a", 65)+`"}, "deposit": "10000stake"`, 1),
+			"name cannot be longer than 64 characters",
+		},
+		{
+			"empty chain id",
+			strings.Replace(validConsumerAdditionProposal, `"foochain"`, `""`, 1),
+			"consumer chain id must not be blank",
+		},
+		{
+			"invalid deposit",
+			strings.Replace(validConsumerAdditionProposal, `"10000stake"`, `"stake"`, 1),
+			"deposit is invalid",
+		},
+	}
+
+	for _, tc := range testCases {
+		proposalFile := filepath.Join(t.TempDir(), "proposal.json")
+		require.NoError(t, os.WriteFile(proposalFile, []byte(tc.proposal), 0o600), tc.name)
+
+		cmd := providerclient.ValidateConsumerAdditionPropCmd()
+		out := new(bytes.Buffer)
+		cmd.SetOut(out)
+		cmd.SetErr(out)
+		cmd.SetArgs([]string{proposalFile})
+
+		err := cmd.Execute()
+		if tc.expErr == "" {
+			require.NoError(t, err, tc.name)
+			require.Contains(t, out.String(), "consumer addition proposal for chain foochain is valid", tc.name)
+		} else {
+			require.ErrorContains(t, err, tc.expErr, tc.name)
+		}
+	}
+
+	// a missing proposal file cannot be validated
+	cmd := providerclient.ValidateConsumerAdditionPropCmd()
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetErr(new(bytes.Buffer))
+	cmd.SetArgs([]string{filepath.Join(t.TempDir(), "missing.json")})
+	require.Error(t, cmd.Execute())
+}
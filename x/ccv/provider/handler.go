@@ -17,6 +17,9 @@ func NewHandler(k *keeper.Keeper) sdk.Handler {
 		case *types.MsgAssignConsumerKey:
 			res, err := msgServer.AssignConsumerKey(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSubmitConsumerMisbehaviour:
+			res, err := msgServer.SubmitConsumerMisbehaviour(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 		}
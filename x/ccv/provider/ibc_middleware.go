@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+
+	"github.com/cosmos/interchain-security/x/ccv/provider/keeper"
+)
+
+var _ porttypes.IBCModule = IBCMiddleware{}
+
+// IBCMiddleware wraps the IBC transfer module in order to account
+// for the rewards that consumer chains send to the consumer rewards pool
+type IBCMiddleware struct {
+	app    porttypes.IBCModule
+	keeper *keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the keeper and the underlying application
+func NewIBCMiddleware(app porttypes.IBCModule, k *keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		app:    app,
+		keeper: k,
+	}
+}
+
+// OnChanOpenInit implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, version)
+}
+
+// OnChanOpenTry implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	return im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, counterpartyVersion)
+}
+
+// OnChanOpenAck implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+}
+
+// OnChanOpenConfirm implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit implements the IBCModule interface
+func (im IBCMiddleware) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm implements the IBCModule interface
+func (im IBCMiddleware) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnRecvPacket implements the IBCModule interface. A successful ICS20 transfer
+// to the consumer rewards pool is added to the rewards allocation of the consumer chain
+// the transfer channel belongs to. Transfers to the consumer rewards pool of denoms
+// that are not registered as consumer reward denoms, or over channels that do not belong
+// to a consumer chain, are rejected with an error acknowledgement, so that the tokens
+// are refunded on the sending chain instead of being held in the pool and never distributed.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+	if data.Receiver != im.keeper.GetConsumerRewardsPoolAddressStr(ctx) {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	chainID, found := im.keeper.GetConsumerChainByTransferChannel(ctx, packet.DestinationPort, packet.DestinationChannel)
+	if !found {
+		ack := channeltypes.NewErrorAcknowledgement(fmt.Errorf(
+			"consumer rewards pool cannot receive tokens over channel %s: channel does not belong to a consumer chain",
+			packet.DestinationChannel))
+		return &ack
+	}
+
+	denom := receivedDenom(packet, data)
+	if !im.keeper.IsConsumerRewardDenomRegistered(ctx, denom) {
+		im.keeper.Logger(ctx).Info("consumer rewards of unregistered denom rejected",
+			"chainID", chainID, "denom", denom)
+		ack := channeltypes.NewErrorAcknowledgement(fmt.Errorf(
+			"consumer rewards pool cannot receive tokens of denom %s: denom is not registered as a consumer reward denom",
+			denom))
+		return &ack
+	}
+
+	ack := im.app.OnRecvPacket(ctx, packet, relayer)
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		// An error here would indicate something is very wrong,
+		// the transfer module fails to receive packets with invalid amounts.
+		panic(fmt.Errorf("cannot parse transfer amount %s", data.Amount))
+	}
+	rewards := sdk.NewCoins(sdk.NewCoin(denom, amount))
+	im.keeper.AddConsumerRewardsAllocation(ctx, chainID, rewards)
+
+	im.keeper.Logger(ctx).Info("consumer rewards received", "chainID", chainID, "rewards", rewards.String())
+
+	return ack
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+}
+
+// OnTimeoutPacket implements the IBCModule interface
+func (im IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	return im.app.OnTimeoutPacket(ctx, packet, relayer)
+}
+
+// receivedDenom returns the denom on the provider chain of the tokens received
+// in an ICS20 packet, see the OnRecvPacket method of the IBC transfer keeper
+func receivedDenom(packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) string {
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		// the tokens were originally sent from the provider chain, thus remove the prefix
+		voucherPrefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		unprefixedDenom := data.Denom[len(voucherPrefix):]
+		denomTrace := transfertypes.ParseDenomTrace(unprefixedDenom)
+		if denomTrace.Path != "" {
+			return denomTrace.IBCDenom()
+		}
+		return unprefixedDenom
+	}
+
+	// the tokens are vouchers minted by the transfer module, thus add the prefix
+	prefixedDenom := transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), data.Denom)
+	return transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+}
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"encoding/hex"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -61,11 +62,21 @@ func (am AppModule) OnChanOpenTry(
 			"invalid counterparty port: %s, expected %s", counterparty.PortId, ccv.ConsumerPortID)
 	}
 
-	// ensure the counter party version matches the expected version
-	if counterpartyVersion != ccv.Version {
-		return "", sdkerrors.Wrapf(
-			ccv.ErrInvalidVersion, "invalid counterparty version: got: %s, expected %s",
-			counterpartyVersion, ccv.Version)
+	var consumerMd providertypes.ConsumerHandshakeMetadata
+	if err := (&consumerMd).Unmarshal([]byte(counterpartyVersion)); err != nil {
+		// the consumer chain proposes a plain version, without genesis hash
+		consumerMd = providertypes.ConsumerHandshakeMetadata{Version: counterpartyVersion}
+	}
+
+	// ensure the counter party version is well-formed and is one of the compatible versions
+	if err := ccv.ValidateVersion(consumerMd.Version); err != nil {
+		return "", sdkerrors.Wrap(err, "invalid counterparty version")
+	}
+
+	genesisHash, err := hex.DecodeString(consumerMd.GenesisHash)
+	if err != nil {
+		return "", sdkerrors.Wrapf(ccv.ErrInvalidHandshakeMetadata,
+			"invalid consumer genesis hash %s: %v", consumerMd.GenesisHash, err)
 	}
 
 	// Claim channel capability
@@ -76,18 +87,20 @@ func (am AppModule) OnChanOpenTry(
 	}
 
 	if err := am.keeper.VerifyConsumerChain(
-		ctx, channelID, connectionHops,
+		ctx, channelID, connectionHops, genesisHash,
 	); err != nil {
 		return "", err
 	}
 
 	md := providertypes.HandshakeMetadata{
-		// NOTE that the fee pool collector address string provided to the
+		// NOTE that the consumer rewards pool address string provided to the
 		// the consumer chain must be excluded from the blocked addresses
 		// blacklist or all all ibc-transfers from the consumer chain to the
 		// provider chain will fail
-		ProviderFeePoolAddr: am.keeper.GetFeeCollectorAddressStr(ctx),
-		Version:             ccv.Version,
+		ProviderFeePoolAddr: am.keeper.GetConsumerRewardsPoolAddressStr(ctx),
+		// the version proposed by the consumer chain is compatible,
+		// thus it is the version negotiated for the channel
+		Version: consumerMd.Version,
 	}
 	mdBz, err := (&md).Marshal()
 	if err != nil {
@@ -192,6 +205,9 @@ func (am AppModule) OnRecvPacket(
 		case ccv.SlashPacket:
 			// handle SlashPacket
 			ack = am.keeper.OnRecvSlashPacket(ctx, packet, *consumerPacket.GetSlashPacketData())
+		case ccv.ConsumerRemovalPacket:
+			// handle ConsumerRemovalPacket
+			ack = am.keeper.OnRecvConsumerRemovalPacket(ctx, packet, *consumerPacket.GetConsumerRemovalPacketData())
 		default:
 			errAck := channeltypes.NewErrorAcknowledgement(fmt.Errorf("invalid consumer packet type: %q", consumerPacket.Type))
 			ack = &errAck
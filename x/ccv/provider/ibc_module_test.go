@@ -1,6 +1,7 @@
 package provider_test
 
 import (
+	"encoding/hex"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -8,9 +9,11 @@ import (
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	conntypes "github.com/cosmos/ibc-go/v4/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
 	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 	ibctmtypes "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
 	testkeeper "github.com/cosmos/interchain-security/testutil/keeper"
+	consumertypes "github.com/cosmos/interchain-security/x/ccv/consumer/types"
 	"github.com/cosmos/interchain-security/x/ccv/provider"
 	providerkeeper "github.com/cosmos/interchain-security/x/ccv/provider/keeper"
 	providertypes "github.com/cosmos/interchain-security/x/ccv/provider/types"
@@ -60,35 +63,94 @@ func TestOnChanOpenTry(t *testing.T) {
 		chanCap             *capabilitytypes.Capability
 		counterparty        channeltypes.Counterparty
 		counterpartyVersion string
+		// the hash of the consumer genesis generated by the provider
+		genesisHash []byte
 	}
 
 	testCases := []struct {
 		name         string
 		mutateParams func(*params, *providerkeeper.Keeper)
 		expPass      bool
+		// the expected error, if any specific
+		expErr error
 	}{
 		{
-			"success", func(*params, *providerkeeper.Keeper) {}, true,
+			"success", func(*params, *providerkeeper.Keeper) {}, true, nil,
 		},
 		{
 			"invalid order", func(params *params, keeper *providerkeeper.Keeper) {
 				params.order = channeltypes.UNORDERED
-			}, false,
+			}, false, channeltypes.ErrInvalidChannelOrdering,
+		},
+		{
+			"unspecified order", func(params *params, keeper *providerkeeper.Keeper) {
+				params.order = channeltypes.NONE
+			}, false, channeltypes.ErrInvalidChannelOrdering,
 		},
 		{
 			"invalid port ID", func(params *params, keeper *providerkeeper.Keeper) {
 				params.portID = "bad port"
-			}, false,
+			}, false, porttypes.ErrInvalidPort,
 		},
 		{
 			"invalid counter party port ID", func(params *params, keeper *providerkeeper.Keeper) {
 				params.counterparty.PortId = "bad port"
-			}, false,
+			}, false, porttypes.ErrInvalidPort,
 		},
 		{
-			"invalid counter party version", func(params *params, keeper *providerkeeper.Keeper) {
+			"counter party port ID is the provider port ID", func(params *params, keeper *providerkeeper.Keeper) {
+				params.counterparty.PortId = ccv.ProviderPortID
+			}, false, porttypes.ErrInvalidPort,
+		},
+		{
+			"plain counter party version, without genesis hash", func(params *params, keeper *providerkeeper.Keeper) {
+				params.counterpartyVersion = ccv.Version
+			}, false, ccv.ErrInvalidGenesisHash,
+		},
+		{
+			"plain counter party version of a consumer chain exempted from sending its genesis hash",
+			func(params *params, keeper *providerkeeper.Keeper) {
+				keeper.SetGenesisHashExempt(params.ctx, "consumerChainID")
+				params.counterpartyVersion = ccv.Version
+			}, true, nil,
+		},
+		{
+			"invalid plain counter party version", func(params *params, keeper *providerkeeper.Keeper) {
 				params.counterpartyVersion = "invalidVersion"
-			}, false,
+			}, false, ccv.ErrInvalidVersion,
+		},
+		{
+			"invalid counter party genesis hash", func(params *params, keeper *providerkeeper.Keeper) {
+				md := providertypes.ConsumerHandshakeMetadata{Version: ccv.Version, GenesisHash: "not hex"}
+				bz, err := md.Marshal()
+				require.NoError(t, err)
+				params.counterpartyVersion = string(bz)
+			}, false, ccv.ErrInvalidHandshakeMetadata,
+		},
+		{
+			"invalid counter party version", func(params *params, keeper *providerkeeper.Keeper) {
+				params.counterpartyVersion = consumerHandshakeMetadata(t, "invalidVersion", params.genesisHash)
+			}, false, nil,
+		},
+		{
+			"unsupported counter party version", func(params *params, keeper *providerkeeper.Keeper) {
+				params.counterpartyVersion = consumerHandshakeMetadata(t, "2", params.genesisHash)
+			}, false, ccv.ErrInvalidVersion,
+		},
+		{
+			"mismatching consumer genesis hash", func(params *params, keeper *providerkeeper.Keeper) {
+				params.counterpartyVersion = consumerHandshakeMetadata(t, ccv.Version, []byte("tampered genesis hash"))
+			}, false, ccv.ErrInvalidGenesisHash,
+		},
+		{
+			"missing consumer genesis hash", func(params *params, keeper *providerkeeper.Keeper) {
+				params.counterpartyVersion = consumerHandshakeMetadata(t, ccv.Version, nil)
+			}, false, ccv.ErrInvalidGenesisHash,
+		},
+		{
+			"consumer genesis not found", func(params *params, keeper *providerkeeper.Keeper) {
+				keeper.DeleteConsumerGenesis(params.ctx, "consumerChainID")
+			}, false, ccv.ErrInvalidConsumerState,
 		},
 		{
 			"unexpected client ID mapped to chain ID", func(params *params, keeper *providerkeeper.Keeper) {
@@ -97,7 +159,7 @@ func TestOnChanOpenTry(t *testing.T) {
 					"consumerChainID",
 					"invalidClientID",
 				)
-			}, false,
+			}, false, ccv.ErrInvalidConsumerClient,
 		},
 		{
 			"other CCV channel exists for this consumer chain",
@@ -107,7 +169,19 @@ func TestOnChanOpenTry(t *testing.T) {
 					"consumerChainID",
 					"some existing channel ID",
 				)
-			}, false,
+			}, false, ccv.ErrDuplicateChannel,
+		},
+		{
+			"channel already validating for another consumer chain",
+			func(params *params, keeper *providerkeeper.Keeper) {
+				keeper.SetChannelToChain(params.ctx, params.channelID, "otherConsumerChainID")
+			}, false, ccv.ErrValidatingChannel,
+		},
+		{
+			"channel was invalidated",
+			func(params *params, keeper *providerkeeper.Keeper) {
+				keeper.SetInvalidatedChannel(params.ctx, params.channelID)
+			}, false, ccv.ErrInvalidatedChannel,
 		},
 	}
 
@@ -118,8 +192,10 @@ func TestOnChanOpenTry(t *testing.T) {
 			t, testkeeper.NewInMemKeeperParams(t))
 		providerModule := provider.NewAppModule(&providerKeeper)
 
+		providerKeeper.SetParams(ctx, providertypes.DefaultParams())
 		providerKeeper.SetPort(ctx, ccv.ProviderPortID)
 		providerKeeper.SetConsumerClientId(ctx, "consumerChainID", "clientIDToConsumer")
+		genesisHash := setConsumerGenesis(t, ctx, &providerKeeper, "consumerChainID")
 
 		// Instantiate valid params as default. Individual test cases mutate these as needed.
 		params := params{
@@ -130,12 +206,13 @@ func TestOnChanOpenTry(t *testing.T) {
 			channelID:           "providerChannelID",
 			chanCap:             &capabilitytypes.Capability{},
 			counterparty:        channeltypes.NewCounterparty(ccv.ConsumerPortID, "consumerChannelID"),
-			counterpartyVersion: ccv.Version,
+			counterpartyVersion: consumerHandshakeMetadata(t, ccv.Version, genesisHash),
+			genesisHash:         genesisHash,
 		}
 
 		// Expected mock calls
 		moduleAcct := authtypes.ModuleAccount{BaseAccount: &authtypes.BaseAccount{}}
-		moduleAcct.BaseAccount.Address = authtypes.NewModuleAddress(authtypes.FeeCollectorName).String()
+		moduleAcct.BaseAccount.Address = authtypes.NewModuleAddress(providertypes.ConsumerRewardsPool).String()
 
 		// Number of calls is not asserted, since not all code paths are hit for failures
 		gomock.InOrder(
@@ -147,7 +224,7 @@ func TestOnChanOpenTry(t *testing.T) {
 			mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "clientIDToConsumer").Return(
 				&ibctmtypes.ClientState{ChainId: "consumerChainID"}, true,
 			).AnyTimes(),
-			mocks.MockAccountKeeper.EXPECT().GetModuleAccount(ctx, authtypes.FeeCollectorName).Return(&moduleAcct).AnyTimes(),
+			mocks.MockAccountKeeper.EXPECT().GetModuleAccount(ctx, providertypes.ConsumerRewardsPool).Return(&moduleAcct).AnyTimes(),
 		)
 
 		tc.mutateParams(&params, &providerKeeper)
@@ -170,14 +247,89 @@ func TestOnChanOpenTry(t *testing.T) {
 			require.NoError(t, err)
 			require.Equal(t, moduleAcct.BaseAccount.Address, md.ProviderFeePoolAddr,
 				"returned dist account metadata must match expected")
-			require.Equal(t, ccv.Version, md.Version, "returned ccv version metadata must match expected")
+			require.Equal(t, ccv.Version, md.Version, "returned ccv version must be the negotiated version")
 			ctrl.Finish()
 		} else {
 			require.Error(t, err)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr, tc.name)
+			}
 		}
 	}
 }
 
+// TestNonDefaultPort tests that the provider binds to the port ID set in the genesis state on InitGenesis,
+// and that the channel handshake is only accepted on that port
+func TestNonDefaultPort(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(
+		t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerModule := provider.NewAppModule(&providerKeeper)
+
+	portID := "provider-1"
+	genState := providertypes.NewGenesisState(0, nil, nil, nil, nil, nil, nil, providertypes.DefaultParams(), nil, nil, nil)
+	genState.PortId = portID
+
+	dummyCap := &capabilitytypes.Capability{}
+	gomock.InOrder(
+		mocks.MockScopedKeeper.EXPECT().GetCapability(ctx, host.PortPath(portID)).Return(nil, false),
+		mocks.MockPortKeeper.EXPECT().BindPort(ctx, portID).Return(dummyCap),
+		mocks.MockScopedKeeper.EXPECT().ClaimCapability(ctx, dummyCap, host.PortPath(portID)).Return(nil),
+		mocks.MockStakingKeeper.EXPECT().GetLastTotalPower(ctx).Return(sdk.NewInt(100)),
+	)
+	providerKeeper.InitGenesis(ctx, genState)
+	require.Equal(t, portID, providerKeeper.GetPort(ctx))
+
+	providerKeeper.SetConsumerClientId(ctx, "consumerChainID", "clientIDToConsumer")
+	version := consumerHandshakeMetadata(t, ccv.Version, setConsumerGenesis(t, ctx, &providerKeeper, "consumerChainID"))
+	moduleAcct := authtypes.ModuleAccount{BaseAccount: &authtypes.BaseAccount{}}
+	moduleAcct.BaseAccount.Address = authtypes.NewModuleAddress(providertypes.ConsumerRewardsPool).String()
+	chanCap := &capabilitytypes.Capability{}
+	gomock.InOrder(
+		mocks.MockScopedKeeper.EXPECT().ClaimCapability(
+			ctx, chanCap, host.ChannelCapabilityPath(portID, "providerChannelID")).Times(1),
+		mocks.MockConnectionKeeper.EXPECT().GetConnection(ctx, "connectionIDToConsumer").Return(
+			conntypes.ConnectionEnd{ClientId: "clientIDToConsumer"}, true,
+		).Times(1),
+		mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "clientIDToConsumer").Return(
+			&ibctmtypes.ClientState{ChainId: "consumerChainID"}, true,
+		).Times(1),
+		mocks.MockAccountKeeper.EXPECT().GetModuleAccount(ctx, providertypes.ConsumerRewardsPool).Return(&moduleAcct).Times(1),
+	)
+
+	// the handshake is rejected on the default port
+	_, err := providerModule.OnChanOpenTry(ctx, channeltypes.ORDERED, []string{"connectionIDToConsumer"},
+		ccv.ProviderPortID, "providerChannelID", chanCap,
+		channeltypes.NewCounterparty(ccv.ConsumerPortID, "consumerChannelID"), version)
+	require.ErrorIs(t, err, porttypes.ErrInvalidPort)
+
+	// the handshake is accepted on the configured port
+	_, err = providerModule.OnChanOpenTry(ctx, channeltypes.ORDERED, []string{"connectionIDToConsumer"},
+		portID, "providerChannelID", chanCap,
+		channeltypes.NewCounterparty(ccv.ConsumerPortID, "consumerChannelID"), version)
+	require.NoError(t, err)
+}
+
+// setConsumerGenesis stores a consumer genesis for the given consumer chain
+// and returns its hash, i.e., the genesis hash expected on the CCV channel handshake
+func setConsumerGenesis(t *testing.T, ctx sdk.Context, k *providerkeeper.Keeper, chainID string) []byte {
+	t.Helper()
+	gen := *consumertypes.DefaultGenesisState()
+	require.NoError(t, k.SetConsumerGenesis(ctx, chainID, gen))
+	hash, err := gen.Hash()
+	require.NoError(t, err)
+	return hash
+}
+
+// consumerHandshakeMetadata returns the version proposed by a consumer chain on the CCV channel handshake
+func consumerHandshakeMetadata(t *testing.T, version string, genesisHash []byte) string {
+	t.Helper()
+	md := providertypes.ConsumerHandshakeMetadata{Version: version, GenesisHash: hex.EncodeToString(genesisHash)}
+	bz, err := md.Marshal()
+	require.NoError(t, err)
+	return string(bz)
+}
+
 // TestOnChanOpenAck tests the provider's OnChanOpenAck method against spec.
 //
 // See: https://github.com/cosmos/ibc/blob/main/spec/app/ics-028-cross-chain-validation/methods.md#ccv-pcf-coack1
@@ -212,7 +364,9 @@ func TestOnChanOpenConfirm(t *testing.T) {
 		name                string
 		mockExpectations    func(sdk.Context, testkeeper.MockedKeepers) []*gomock.Call
 		setDuplicateChannel bool
-		expPass             bool
+		// the client of the consumer chain, if it differs from the client of the channel
+		ccvClientID string
+		expPass     bool
 	}{
 		{
 			name: "channel not found",
@@ -233,6 +387,7 @@ func TestOnChanOpenConfirm(t *testing.T) {
 					mocks.MockChannelKeeper.EXPECT().GetChannel(
 						ctx, ccv.ProviderPortID, gomock.Any()).Return(channeltypes.Channel{
 						State:          channeltypes.OPEN,
+						Counterparty:   channeltypes.NewCounterparty(ccv.ConsumerPortID, "consumerChannelID"),
 						ConnectionHops: []string{"connectionID", "another"}, // Two hops is two many
 					}, false,
 					).Times(1),
@@ -247,6 +402,7 @@ func TestOnChanOpenConfirm(t *testing.T) {
 					mocks.MockChannelKeeper.EXPECT().GetChannel(
 						ctx, ccv.ProviderPortID, gomock.Any()).Return(channeltypes.Channel{
 						State:          channeltypes.OPEN,
+						Counterparty:   channeltypes.NewCounterparty(ccv.ConsumerPortID, "consumerChannelID"),
 						ConnectionHops: []string{"connectionID"},
 					}, true,
 					).Times(1),
@@ -264,6 +420,7 @@ func TestOnChanOpenConfirm(t *testing.T) {
 					mocks.MockChannelKeeper.EXPECT().GetChannel(ctx, ccv.ProviderPortID, gomock.Any()).Return(
 						channeltypes.Channel{
 							State:          channeltypes.OPEN,
+							Counterparty:   channeltypes.NewCounterparty(ccv.ConsumerPortID, "consumerChannelID"),
 							ConnectionHops: []string{"connectionID"},
 						},
 						true,
@@ -287,6 +444,15 @@ func TestOnChanOpenConfirm(t *testing.T) {
 			setDuplicateChannel: true, // Only case where duplicate channel is setup
 			expPass:             false,
 		},
+		{
+			name: "CCV client changed since the handshake, error returned",
+			mockExpectations: func(ctx sdk.Context, mocks testkeeper.MockedKeepers) []*gomock.Call {
+				// Error is returned after all expected mock calls are hit for SetConsumerChain
+				return testkeeper.GetMocksForSetConsumerChain(ctx, &mocks, "consumerChainID")
+			},
+			ccvClientID: "anotherClientID",
+			expPass:     false,
+		},
 		{
 			name: "success",
 			mockExpectations: func(ctx sdk.Context, mocks testkeeper.MockedKeepers) []*gomock.Call {
@@ -301,9 +467,16 @@ func TestOnChanOpenConfirm(t *testing.T) {
 
 		providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(
 			t, testkeeper.NewInMemKeeperParams(t))
+		providerKeeper.SetParams(ctx, providertypes.DefaultParams())
 
 		gomock.InOrder(tc.mockExpectations(ctx, mocks)...)
 
+		ccvClientID := "clientID"
+		if tc.ccvClientID != "" {
+			ccvClientID = tc.ccvClientID
+		}
+		providerKeeper.SetConsumerClientId(ctx, "consumerChainID", ccvClientID)
+
 		if tc.setDuplicateChannel {
 			providerKeeper.SetChainToChannel(ctx, "consumerChainID", "existingChannelID")
 		}
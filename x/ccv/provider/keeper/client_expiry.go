@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
+
+	"github.com/cosmos/interchain-security/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/x/ccv/types"
+)
+
+// SetClientExpiryWarningTimestamp sets the time at which the provider first reported
+// that the client to the consumer chain with the given chain ID is about to expire
+func (k Keeper) SetClientExpiryWarningTimestamp(ctx sdk.Context, chainID string, timestamp time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ClientExpiryWarningKey(chainID), sdk.FormatTimeBytes(timestamp))
+}
+
+// GetClientExpiryWarningTimestamp returns the time at which the provider first reported
+// that the client to the consumer chain with the given chain ID is about to expire
+func (k Keeper) GetClientExpiryWarningTimestamp(ctx sdk.Context, chainID string) (time.Time, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ClientExpiryWarningKey(chainID))
+	if bz == nil {
+		return time.Time{}, false
+	}
+	ts, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the timestamp is assumed to be correctly serialized in SetClientExpiryWarningTimestamp.
+		panic(fmt.Errorf("failed to parse client expiry warning timestamp: %w", err))
+	}
+	return ts, true
+}
+
+// DeleteClientExpiryWarningTimestamp removes the time at which the provider first reported
+// that the client to the consumer chain with the given chain ID is about to expire
+func (k Keeper) DeleteClientExpiryWarningTimestamp(ctx sdk.Context, chainID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ClientExpiryWarningKey(chainID))
+}
+
+// GetConsumerClientExpiry returns the time at which the client with the given client ID expires
+// unless it is updated, i.e., the timestamp of its latest consensus state plus its trusting period,
+// together with the trusting period of the client.
+//
+// Note that the expiry is only known for Tendermint clients.
+func (k Keeper) GetConsumerClientExpiry(ctx sdk.Context, clientID string) (expiry time.Time, trustingPeriod time.Duration, found bool) {
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return time.Time{}, 0, false
+	}
+	tmClientState, ok := clientState.(*ibctmtypes.ClientState)
+	if !ok {
+		return time.Time{}, 0, false
+	}
+	consensusState, found := k.clientKeeper.GetLatestClientConsensusState(ctx, clientID)
+	if !found {
+		return time.Time{}, 0, false
+	}
+	latestTimestamp := time.Unix(0, int64(consensusState.GetTimestamp())).UTC()
+	return latestTimestamp.Add(tmClientState.TrustingPeriod), tmClientState.TrustingPeriod, true
+}
+
+// ReportExpiringConsumerClients reports the clients to the consumer chains whose time to expiry
+// is below the ClientExpiryWarningFraction of their trusting period, so that they can be updated
+// before they expire, e.g., if the relayers of a consumer chain stopped updating its client.
+//
+// The time to expiry of every client is set in a gauge and the expiring clients are counted in every block.
+// The warning event is rate limited: it is emitted once a client enters the warning window,
+// and only again once the client was updated out of the window and enters it again.
+// Expired clients are handled separately, see checkConsumerClientActive.
+// Disabling the reporting, i.e., setting the fraction to zero, clears the records of the reported clients,
+// so that the clients still about to expire are reported again once the reporting is enabled.
+func (k Keeper) ReportExpiringConsumerClients(ctx sdk.Context) {
+	fraction, err := sdk.NewDecFromStr(k.GetClientExpiryWarningFraction(ctx))
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the param is validated when it is set
+		panic(fmt.Errorf("failed to parse client expiry warning fraction: %w", err))
+	}
+	if fraction.IsZero() {
+		for _, chain := range k.GetAllConsumerChains(ctx) {
+			k.DeleteClientExpiryWarningTimestamp(ctx, chain.ChainId)
+		}
+		return
+	}
+	for _, chain := range k.GetAllConsumerChains(ctx) {
+		expiry, trustingPeriod, found := k.GetConsumerClientExpiry(ctx, chain.ClientId)
+		if !found {
+			continue
+		}
+		timeToExpiry := expiry.Sub(ctx.BlockTime())
+		updateClientTimeToExpiryGauge(chain.ChainId, timeToExpiry)
+
+		warningWindow := time.Duration(fraction.MulInt64(int64(trustingPeriod)).TruncateInt64())
+		if timeToExpiry >= warningWindow {
+			// the client is not about to expire, or was updated since it was reported
+			k.DeleteClientExpiryWarningTimestamp(ctx, chain.ChainId)
+			continue
+		}
+		incrClientExpiringCounter(chain.ChainId)
+		if _, found := k.GetClientExpiryWarningTimestamp(ctx, chain.ChainId); found {
+			// the client was already reported
+			continue
+		}
+		k.SetClientExpiryWarningTimestamp(ctx, chain.ChainId, ctx.BlockTime())
+
+		k.Logger(ctx).Error("IBC client to consumer chain is about to expire",
+			"chainID", chain.ChainId,
+			"clientID", chain.ClientId,
+			"expiry time", expiry,
+			"time to expiry", timeToExpiry.String(),
+		)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				ccv.EventTypeConsumerClientExpiring,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(ccv.AttributeChainID, chain.ChainId),
+				sdk.NewAttribute(ccv.AttributeClientID, chain.ClientId),
+				sdk.NewAttribute(ccv.AttributeClientExpiryTime, expiry.Format(time.RFC3339)),
+				sdk.NewAttribute(ccv.AttributeTimeToExpiry, timeToExpiry.String()),
+				sdk.NewAttribute(ccv.AttributeTrustingPeriod, trustingPeriod.String()),
+			),
+		)
+	}
+}
@@ -0,0 +1,133 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/cosmos/interchain-security/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/x/ccv/types"
+)
+
+// TestClientExpiryWarningTimestamp tests the getter, setter and deletion of the client expiry warning timestamps
+func TestClientExpiryWarningTimestamp(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, found := providerKeeper.GetClientExpiryWarningTimestamp(ctx, "chain")
+	require.False(t, found)
+
+	now := time.Now().UTC()
+	providerKeeper.SetClientExpiryWarningTimestamp(ctx, "chain", now)
+	ts, found := providerKeeper.GetClientExpiryWarningTimestamp(ctx, "chain")
+	require.True(t, found)
+	require.Equal(t, now, ts)
+
+	providerKeeper.DeleteClientExpiryWarningTimestamp(ctx, "chain")
+	_, found = providerKeeper.GetClientExpiryWarningTimestamp(ctx, "chain")
+	require.False(t, found)
+}
+
+// TestReportExpiringConsumerClients tests that a consumer client is reported once its time to expiry
+// drops below the ClientExpiryWarningFraction of its trusting period, and that the report is only
+// repeated once the client was updated out of the warning window
+func TestReportExpiringConsumerClients(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+	providerKeeper.SetConsumerClientId(ctx, "chain", "client")
+
+	trustingPeriod := 100 * time.Hour
+	clientState := &ibctmtypes.ClientState{TrustingPeriod: trustingPeriod}
+	lastUpdate := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// the reporting is disabled by default, i.e., the client is not read
+	providerKeeper.ReportExpiringConsumerClients(ctx.WithBlockTime(lastUpdate.Add(99 * time.Hour)))
+	_, found := providerKeeper.GetClientExpiryWarningTimestamp(ctx, "chain")
+	require.False(t, found)
+
+	// the client is reported once less than 25 hours are left before it expires
+	providerKeeper.SetClientExpiryWarningFraction(ctx, "0.25")
+	mocks.MockClientKeeper.EXPECT().GetClientState(gomock.Any(), "client").Return(clientState, true).AnyTimes()
+	mocks.MockClientKeeper.EXPECT().GetLatestClientConsensusState(gomock.Any(), "client").DoAndReturn(
+		func(sdk.Context, string) (*ibctmtypes.ConsensusState, bool) {
+			return &ibctmtypes.ConsensusState{Timestamp: lastUpdate}, true
+		}).AnyTimes()
+
+	reportAt := func(blockTime time.Time) sdk.Events {
+		blockCtx := ctx.WithBlockTime(blockTime).WithEventManager(sdk.NewEventManager())
+		providerKeeper.ReportExpiringConsumerClients(blockCtx)
+		return blockCtx.EventManager().Events()
+	}
+
+	testCases := []struct {
+		name        string
+		blockTime   time.Time
+		expEvent    bool
+		expReported bool
+	}{
+		{"before the warning window", lastUpdate.Add(75*time.Hour - time.Nanosecond), false, false},
+		{"exactly at the warning window", lastUpdate.Add(75 * time.Hour), false, false},
+		{"within the warning window", lastUpdate.Add(75*time.Hour + time.Nanosecond), true, true},
+		{"still within the warning window", lastUpdate.Add(90 * time.Hour), false, true},
+		{"expired", lastUpdate.Add(100 * time.Hour), false, true},
+	}
+	for _, tc := range testCases {
+		events := reportAt(tc.blockTime)
+		if tc.expEvent {
+			require.Len(t, events, 1, tc.name)
+			require.Equal(t, ccv.EventTypeConsumerClientExpiring, events[0].Type, tc.name)
+		} else {
+			require.Empty(t, events, tc.name)
+		}
+		_, found := providerKeeper.GetClientExpiryWarningTimestamp(ctx, "chain")
+		require.Equal(t, tc.expReported, found, tc.name)
+	}
+
+	// the client is updated out of the warning window
+	lastUpdate = lastUpdate.Add(90 * time.Hour)
+	require.Empty(t, reportAt(lastUpdate))
+	_, found = providerKeeper.GetClientExpiryWarningTimestamp(ctx, "chain")
+	require.False(t, found)
+
+	// once the updated client enters the warning window, it is reported again
+	events := reportAt(lastUpdate.Add(80 * time.Hour))
+	require.Len(t, events, 1)
+	require.Equal(t, ccv.EventTypeConsumerClientExpiring, events[0].Type)
+
+	// disabling the reporting clears the record of the reported client
+	providerKeeper.SetClientExpiryWarningFraction(ctx, "0")
+	require.Empty(t, reportAt(lastUpdate.Add(81*time.Hour)))
+	_, found = providerKeeper.GetClientExpiryWarningTimestamp(ctx, "chain")
+	require.False(t, found)
+
+	// so that the client is reported again once the reporting is enabled
+	providerKeeper.SetClientExpiryWarningFraction(ctx, "0.25")
+	events = reportAt(lastUpdate.Add(82 * time.Hour))
+	require.Len(t, events, 1)
+	require.Equal(t, ccv.EventTypeConsumerClientExpiring, events[0].Type)
+}
+
+// TestReportExpiringConsumerClientsNonTendermint tests that the clients
+// whose expiry is not known, i.e., non-Tendermint clients, are not reported
+func TestReportExpiringConsumerClientsNonTendermint(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+	providerKeeper.SetClientExpiryWarningFraction(ctx, "1")
+	providerKeeper.SetConsumerClientId(ctx, "chain", "client")
+
+	mocks.MockClientKeeper.EXPECT().GetClientState(gomock.Any(), "client").Return(
+		mockClientState{&ibctmtypes.ClientState{TrustingPeriod: time.Hour}}, true)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	providerKeeper.ReportExpiringConsumerClients(ctx)
+	require.Empty(t, ctx.EventManager().Events())
+	_, found := providerKeeper.GetClientExpiryWarningTimestamp(ctx, "chain")
+	require.False(t, found)
+}
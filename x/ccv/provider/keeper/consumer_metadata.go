@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/x/ccv/provider/types"
+)
+
+// SetConsumerMetadata sets the descriptive metadata of the consumer chain with the given chain ID
+func (k Keeper) SetConsumerMetadata(ctx sdk.Context, chainID string, metadata types.ConsumerMetadata) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := metadata.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// metadata is instantiated in CreateConsumerClient, HandleConsumerMetadataUpdateProposal or InitGenesis.
+		panic(fmt.Errorf("failed to marshal consumer metadata: %w", err))
+	}
+	store.Set(types.ConsumerMetadataKey(chainID), bz)
+}
+
+// GetConsumerMetadata returns the descriptive metadata of the consumer chain with the given chain ID, if any
+func (k Keeper) GetConsumerMetadata(ctx sdk.Context, chainID string) (types.ConsumerMetadata, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerMetadataKey(chainID))
+	if bz == nil {
+		return types.ConsumerMetadata{}, false
+	}
+
+	var metadata types.ConsumerMetadata
+	if err := metadata.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the metadata is assumed to be correctly serialized in SetConsumerMetadata.
+		panic(fmt.Errorf("failed to unmarshal consumer metadata: %w", err))
+	}
+	return metadata, true
+}
+
+// DeleteConsumerMetadata deletes the descriptive metadata of the consumer chain with the given chain ID
+func (k Keeper) DeleteConsumerMetadata(ctx sdk.Context, chainID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerMetadataKey(chainID))
+}
@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/cosmos/interchain-security/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/x/ccv/types"
+)
+
+// TestConsumerMetadata tests the getter, setter and deletion methods for the metadata
+// of consumer chains, as well as the consumer metadata query
+func TestConsumerMetadata(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, found := providerKeeper.GetConsumerMetadata(ctx, "chain")
+	require.False(t, found)
+	_, err := providerKeeper.QueryConsumerMetadata(sdk.WrapSDKContext(ctx), &providertypes.QueryConsumerMetadataRequest{ChainId: "chain"})
+	require.Error(t, err)
+
+	metadata := providertypes.ConsumerMetadata{
+		Name:        "FooChain",
+		Description: "Gonna be a great chain",
+		Website:     "https://foochain.io",
+		Contact:     "team@foochain.io",
+	}
+	providerKeeper.SetConsumerMetadata(ctx, "chain", metadata)
+	gotMetadata, found := providerKeeper.GetConsumerMetadata(ctx, "chain")
+	require.True(t, found)
+	require.Equal(t, metadata, gotMetadata)
+	// the metadata of other consumer chains is not affected
+	_, found = providerKeeper.GetConsumerMetadata(ctx, "chain1")
+	require.False(t, found)
+
+	res, err := providerKeeper.QueryConsumerMetadata(sdk.WrapSDKContext(ctx), &providertypes.QueryConsumerMetadataRequest{ChainId: "chain"})
+	require.NoError(t, err)
+	require.Equal(t, &providertypes.QueryConsumerMetadataResponse{ChainId: "chain", Metadata: metadata}, res)
+
+	providerKeeper.DeleteConsumerMetadata(ctx, "chain")
+	_, found = providerKeeper.GetConsumerMetadata(ctx, "chain")
+	require.False(t, found)
+}
+
+// TestCreateConsumerClientMetadata tests that the metadata of a consumer addition proposal
+// is stored once the consumer client is created
+func TestCreateConsumerClientMetadata(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	gomock.InOrder(
+		testkeeper.GetMocksForCreateConsumerClient(ctx, &mocks, "chainID", clienttypes.NewHeight(4, 5))...,
+	)
+
+	prop := testkeeper.GetTestConsumerAdditionProp()
+	prop.Metadata = &providertypes.ConsumerMetadata{Name: "FooChain", Website: "https://foochain.io"}
+	require.NoError(t, providerKeeper.CreateConsumerClient(ctx, prop))
+
+	metadata, found := providerKeeper.GetConsumerMetadata(ctx, "chainID")
+	require.True(t, found)
+	require.Equal(t, *prop.Metadata, metadata)
+}
+
+// TestHandleConsumerMetadataUpdateProposal tests that a consumer metadata update proposal
+// replaces the metadata of an existing consumer chain, and is rejected for other chains
+func TestHandleConsumerMetadataUpdateProposal(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	metadata := providertypes.ConsumerMetadata{Name: "FooChain", Website: "https://foochain.network"}
+	prop := providertypes.NewConsumerMetadataUpdateProposal("title", "description", "chain",
+		metadata).(*providertypes.ConsumerMetadataUpdateProposal)
+
+	// the consumer chain does not exist
+	err := providerKeeper.HandleConsumerMetadataUpdateProposal(ctx, prop)
+	require.ErrorIs(t, err, providertypes.ErrUnknownConsumerChainId)
+	_, found := providerKeeper.GetConsumerMetadata(ctx, "chain")
+	require.False(t, found)
+
+	// the metadata of a consumer chain is replaced, even if its CCV channel is not yet established
+	providerKeeper.SetConsumerClientId(ctx, "chain", "clientID")
+	providerKeeper.SetConsumerMetadata(ctx, "chain", providertypes.ConsumerMetadata{Name: "Foo", Contact: "foo@foo.io"})
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, providerKeeper.HandleConsumerMetadataUpdateProposal(ctx, prop))
+	gotMetadata, found := providerKeeper.GetConsumerMetadata(ctx, "chain")
+	require.True(t, found)
+	require.Equal(t, metadata, gotMetadata)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, ccv.EventTypeUpdateConsumerMetadata, events[0].Type)
+
+	// oversized metadata is rejected
+	prop.Metadata.Description = strings.Repeat("a", providertypes.MaxConsumerMetadataDescriptionLength+1)
+	err = providerKeeper.HandleConsumerMetadataUpdateProposal(ctx, prop)
+	require.ErrorIs(t, err, providertypes.ErrInvalidConsumerMetadataUpdateProp)
+	gotMetadata, _ = providerKeeper.GetConsumerMetadata(ctx, "chain")
+	require.Equal(t, metadata, gotMetadata)
+}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/x/ccv/provider/types"
+)
+
+// SetConsumerParameters sets the parameters of the consumer chain with the given chain ID,
+// set by a consumer parameters update proposal
+func (k Keeper) SetConsumerParameters(ctx sdk.Context, chainID string, params types.ConsumerParameters) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := params.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// params is instantiated in HandleConsumerParametersUpdateProposal or InitGenesis.
+		panic(fmt.Errorf("failed to marshal consumer parameters: %w", err))
+	}
+	store.Set(types.ConsumerParametersKey(chainID), bz)
+}
+
+// GetConsumerParameters returns the parameters of the consumer chain with the given chain ID,
+// if they were set by a consumer parameters update proposal
+func (k Keeper) GetConsumerParameters(ctx sdk.Context, chainID string) (types.ConsumerParameters, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerParametersKey(chainID))
+	if bz == nil {
+		return types.ConsumerParameters{}, false
+	}
+
+	var params types.ConsumerParameters
+	if err := params.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the params are assumed to be correctly serialized in SetConsumerParameters.
+		panic(fmt.Errorf("failed to unmarshal consumer parameters: %w", err))
+	}
+	return params, true
+}
+
+// DeleteConsumerParameters deletes the parameters of the consumer chain with the given chain ID
+func (k Keeper) DeleteConsumerParameters(ctx sdk.Context, chainID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerParametersKey(chainID))
+}
+
+// GetConsumerChainRedistributeFraction returns the fraction of the rewards received from the consumer chain
+// with the given chain ID that is distributed to the provider validators. It defaults to the
+// ConsumerRedistributeFraction param if no proposal overrode the fraction of the chain.
+func (k Keeper) GetConsumerChainRedistributeFraction(ctx sdk.Context, chainID string) string {
+	if params, found := k.GetConsumerParameters(ctx, chainID); found && params.ConsumerRedistributeFraction != "" {
+		return params.ConsumerRedistributeFraction
+	}
+	return k.GetConsumerRedistributeFraction(ctx)
+}
+
+// GetConsumerChainSoftOptOutThreshold returns the soft opt out threshold of the consumer chain
+// with the given chain ID. It defaults to the SoftOptOutThreshold param if no consumer
+// parameters update proposal overrode the threshold of the chain.
+func (k Keeper) GetConsumerChainSoftOptOutThreshold(ctx sdk.Context, chainID string) string {
+	if params, found := k.GetConsumerParameters(ctx, chainID); found && params.SoftOptOutThreshold != "" {
+		return params.SoftOptOutThreshold
+	}
+	return k.GetSoftOptOutThreshold(ctx)
+}
+
+// GetConsumerChainExpectedRewards returns the rewards the consumer chain with the given chain ID
+// is expected to send during every rewards window. It is empty if no consumer parameters
+// update proposal set expected rewards for the chain.
+func (k Keeper) GetConsumerChainExpectedRewards(ctx sdk.Context, chainID string) sdk.Coins {
+	if params, found := k.GetConsumerParameters(ctx, chainID); found && !params.ExpectedRewardsPerWindow.Empty() {
+		return params.ExpectedRewardsPerWindow
+	}
+	return sdk.NewCoins()
+}
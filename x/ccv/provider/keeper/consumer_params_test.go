@@ -0,0 +1,216 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/cosmos/interchain-security/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/x/ccv/types"
+)
+
+// TestConsumerParameters tests the getter, setter and deletion methods for the parameters
+// of consumer chains, and that the provider params are used for chains without parameters
+func TestConsumerParameters(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	params := providertypes.DefaultParams()
+	params.ConsumerRedistributeFraction = "0.75"
+	params.SoftOptOutThreshold = "0.05"
+	providerKeeper.SetParams(ctx, params)
+
+	_, found := providerKeeper.GetConsumerParameters(ctx, "chain")
+	require.False(t, found)
+	require.Equal(t, "0.75", providerKeeper.GetConsumerChainRedistributeFraction(ctx, "chain"))
+	require.Equal(t, "0.05", providerKeeper.GetConsumerChainSoftOptOutThreshold(ctx, "chain"))
+
+	consumerParams := providertypes.ConsumerParameters{
+		ConsumerRedistributeFraction: "0.5",
+		SoftOptOutThreshold:          "0",
+	}
+	providerKeeper.SetConsumerParameters(ctx, "chain", consumerParams)
+	gotParams, found := providerKeeper.GetConsumerParameters(ctx, "chain")
+	require.True(t, found)
+	require.Equal(t, consumerParams, gotParams)
+	require.Equal(t, "0.5", providerKeeper.GetConsumerChainRedistributeFraction(ctx, "chain"))
+	require.Equal(t, "0", providerKeeper.GetConsumerChainSoftOptOutThreshold(ctx, "chain"))
+	require.False(t, providerKeeper.IsSoftOptOutEnabled(ctx, "chain"))
+	// the parameters of other consumer chains are not affected
+	require.True(t, providerKeeper.IsSoftOptOutEnabled(ctx, "chain1"))
+
+	providerKeeper.DeleteConsumerParameters(ctx, "chain")
+	_, found = providerKeeper.GetConsumerParameters(ctx, "chain")
+	require.False(t, found)
+	require.Equal(t, "0.75", providerKeeper.GetConsumerChainRedistributeFraction(ctx, "chain"))
+}
+
+// TestHandleConsumerParametersUpdateProposal tests that a consumer parameters update proposal
+// updates the parameters of a running consumer chain, and is rejected for other chains
+func TestHandleConsumerParametersUpdateProposal(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	prop := providertypes.NewConsumerParametersUpdateProposal("title", "description", "chain", "0.5", "0.05",
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))).(*providertypes.ConsumerParametersUpdateProposal)
+
+	// the consumer chain does not exist
+	err := providerKeeper.HandleConsumerParametersUpdateProposal(ctx, prop)
+	require.ErrorIs(t, err, providertypes.ErrUnknownConsumerChainId)
+	_, found := providerKeeper.GetConsumerParameters(ctx, "chain")
+	require.False(t, found)
+
+	// the consumer client exists, but the CCV channel is not yet established
+	providerKeeper.SetConsumerClientId(ctx, "chain", "clientID")
+	err = providerKeeper.HandleConsumerParametersUpdateProposal(ctx, prop)
+	require.ErrorIs(t, err, providertypes.ErrUnknownConsumerChainId)
+	_, found = providerKeeper.GetConsumerParameters(ctx, "chain")
+	require.False(t, found)
+
+	// the consumer chain is running
+	providerKeeper.SetChainToChannel(ctx, "chain", "channelID")
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	err = providerKeeper.HandleConsumerParametersUpdateProposal(ctx, prop)
+	require.NoError(t, err)
+	require.Equal(t, "0.5", providerKeeper.GetConsumerChainRedistributeFraction(ctx, "chain"))
+	require.Equal(t, "0.05", providerKeeper.GetConsumerChainSoftOptOutThreshold(ctx, "chain"))
+	require.True(t, providerKeeper.IsSoftOptOutEnabled(ctx, "chain"))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), providerKeeper.GetConsumerChainExpectedRewards(ctx, "chain"))
+	// the opted out validators are recomputed in the next block, since the threshold changed
+	require.True(t, providerKeeper.GetValidatorListsUpdated(ctx, "chain"))
+	providerKeeper.DeleteValidatorListsUpdated(ctx, "chain")
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, ccv.EventTypeUpdateConsumerParameters, events[0].Type)
+	expAttributes := map[string]string{
+		ccv.AttributeChainID:                          "chain",
+		ccv.AttributePrevConsumerRedistributeFraction: providertypes.DefaultConsumerRedistributeFraction,
+		ccv.AttributeConsumerRedistributeFraction:     "0.5",
+		ccv.AttributePrevSoftOptOutThreshold:          providertypes.DefaultSoftOptOutThreshold,
+		ccv.AttributeSoftOptOutThreshold:              "0.05",
+		ccv.AttributeExpectedRewardsPerWindow:         "1000stake",
+	}
+	require.Len(t, events[0].Attributes, len(expAttributes))
+	for _, attr := range events[0].Attributes {
+		require.Equal(t, expAttributes[string(attr.Key)], string(attr.Value), string(attr.Key))
+	}
+
+	// the opted out validators are not recomputed if the threshold is unchanged
+	prop = providertypes.NewConsumerParametersUpdateProposal("title", "description", "chain", "0.4", "",
+		sdk.NewCoins()).(*providertypes.ConsumerParametersUpdateProposal)
+	err = providerKeeper.HandleConsumerParametersUpdateProposal(ctx, prop)
+	require.NoError(t, err)
+	require.False(t, providerKeeper.GetValidatorListsUpdated(ctx, "chain"))
+
+	// the parameters left empty keep their current values
+	prop = providertypes.NewConsumerParametersUpdateProposal("title", "description", "chain", "", "0.1",
+		sdk.NewCoins()).(*providertypes.ConsumerParametersUpdateProposal)
+	err = providerKeeper.HandleConsumerParametersUpdateProposal(ctx, prop)
+	require.NoError(t, err)
+	require.Equal(t, "0.4", providerKeeper.GetConsumerChainRedistributeFraction(ctx, "chain"))
+	require.Equal(t, "0.1", providerKeeper.GetConsumerChainSoftOptOutThreshold(ctx, "chain"))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), providerKeeper.GetConsumerChainExpectedRewards(ctx, "chain"))
+
+	// invalid parameters are rejected
+	prop.SoftOptOutThreshold = "0.3"
+	err = providerKeeper.HandleConsumerParametersUpdateProposal(ctx, prop)
+	require.ErrorIs(t, err, providertypes.ErrInvalidConsumerParametersUpdateProp)
+	require.Equal(t, "0.1", providerKeeper.GetConsumerChainSoftOptOutThreshold(ctx, "chain"))
+}
+
+// TestHandleChangeConsumerRewardFractionProposal tests that a change consumer reward fraction proposal
+// only changes the reward fraction of a running consumer chain, which is then applied when distributing
+// the rewards allocation of the chain, and that it is rejected for other chains and invalid fractions
+func TestHandleChangeConsumerRewardFractionProposal(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	params := providertypes.DefaultParams()
+	params.SoftOptOutThreshold = "0.05"
+	providerKeeper.SetParams(ctx, params)
+
+	prop := providertypes.NewChangeConsumerRewardFractionProposal(
+		"title", "description", "chain", "0.25").(*providertypes.ChangeConsumerRewardFractionProposal)
+
+	// the consumer chain does not exist
+	err := providerKeeper.HandleChangeConsumerRewardFractionProposal(ctx, prop)
+	require.ErrorIs(t, err, providertypes.ErrUnknownConsumerChainId)
+	_, found := providerKeeper.GetConsumerParameters(ctx, "chain")
+	require.False(t, found)
+
+	// the consumer client exists, but the CCV channel is not yet established
+	providerKeeper.SetConsumerClientId(ctx, "chain", "clientID")
+	err = providerKeeper.HandleChangeConsumerRewardFractionProposal(ctx, prop)
+	require.ErrorIs(t, err, providertypes.ErrUnknownConsumerChainId)
+	_, found = providerKeeper.GetConsumerParameters(ctx, "chain")
+	require.False(t, found)
+
+	// the consumer chain is running
+	providerKeeper.SetChainToChannel(ctx, "chain", "channelID")
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	err = providerKeeper.HandleChangeConsumerRewardFractionProposal(ctx, prop)
+	require.NoError(t, err)
+	require.Equal(t, "0.25", providerKeeper.GetConsumerChainRedistributeFraction(ctx, "chain"))
+	// the other parameters keep the values of the provider params
+	require.Equal(t, "0.05", providerKeeper.GetConsumerChainSoftOptOutThreshold(ctx, "chain"))
+	require.True(t, providerKeeper.GetConsumerChainExpectedRewards(ctx, "chain").IsZero())
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, ccv.EventTypeUpdateConsumerParameters, events[0].Type)
+	expAttributes := map[string]string{
+		ccv.AttributeChainID:                          "chain",
+		ccv.AttributePrevConsumerRedistributeFraction: providertypes.DefaultConsumerRedistributeFraction,
+		ccv.AttributeConsumerRedistributeFraction:     "0.25",
+		ccv.AttributePrevSoftOptOutThreshold:          "0.05",
+		ccv.AttributeSoftOptOutThreshold:              "0.05",
+		ccv.AttributeExpectedRewardsPerWindow:         "",
+	}
+	require.Len(t, events[0].Attributes, len(expAttributes))
+	for _, attr := range events[0].Attributes {
+		require.Equal(t, expAttributes[string(attr.Key)], string(attr.Value), string(attr.Key))
+	}
+
+	// the new fraction is applied when the rewards allocation of the chain is distributed
+	providerKeeper.AddConsumerRewardsAllocation(ctx, "chain", sdk.NewCoins(sdk.NewInt64Coin("stake", 101)))
+	mocks.MockBankKeeper.EXPECT().SendCoinsFromModuleToModule(ctx,
+		providertypes.ConsumerRewardsPool, authtypes.FeeCollectorName,
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 25))).Return(nil).Times(1)
+	providerKeeper.BeginBlockRD(ctx)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 76)), providerKeeper.GetConsumerRewardsAllocation(ctx, "chain").Rewards)
+
+	// the soft opt out threshold is not overridden, i.e., it follows later changes of the provider params
+	params.SoftOptOutThreshold = "0.1"
+	providerKeeper.SetParams(ctx, params)
+	require.Equal(t, "0.1", providerKeeper.GetConsumerChainSoftOptOutThreshold(ctx, "chain"))
+
+	// the other parameters set by a consumer parameters update proposal are preserved
+	providerKeeper.SetConsumerParameters(ctx, "chain", providertypes.ConsumerParameters{
+		ConsumerRedistributeFraction: "0.5",
+		SoftOptOutThreshold:          "0.1",
+		ExpectedRewardsPerWindow:     sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)),
+	})
+	prop.ConsumerRedistributeFraction = "1"
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	err = providerKeeper.HandleChangeConsumerRewardFractionProposal(ctx, prop)
+	require.NoError(t, err)
+	consumerParams, found := providerKeeper.GetConsumerParameters(ctx, "chain")
+	require.True(t, found)
+	require.Equal(t, providertypes.ConsumerParameters{
+		ConsumerRedistributeFraction: "1",
+		SoftOptOutThreshold:          "0.1",
+		ExpectedRewardsPerWindow:     sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)),
+	}, consumerParams)
+
+	// fractions out of [0, 1] are rejected
+	for _, fraction := range []string{"1.1", "-0.1", ""} {
+		prop.ConsumerRedistributeFraction = fraction
+		err = providerKeeper.HandleChangeConsumerRewardFractionProposal(ctx, prop)
+		require.ErrorIs(t, err, providertypes.ErrInvalidChangeRewardFractionProp, fraction)
+		require.Equal(t, "1", providerKeeper.GetConsumerChainRedistributeFraction(ctx, "chain"))
+	}
+}
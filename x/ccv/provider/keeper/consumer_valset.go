@@ -0,0 +1,232 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/interchain-security/x/ccv/provider/types"
+	ccvutils "github.com/cosmos/interchain-security/x/ccv/utils"
+)
+
+// SetConsumerValSet replaces the last validator set sent to the consumer chain with the given chain ID
+// by the given validator updates, which correspond to the given valset update ID.
+// The validator updates are expected to already have the key assignment applied.
+func (k Keeper) SetConsumerValSet(ctx sdk.Context, chainID string, valsetUpdateID uint64, updates []abci.ValidatorUpdate) {
+	k.DeleteConsumerValSet(ctx, chainID)
+	k.ApplyConsumerValSetUpdates(ctx, chainID, valsetUpdateID, updates)
+}
+
+// ApplyConsumerValSetUpdates applies the given validator updates, sent to the consumer chain with the given
+// chain ID in the VSC packet with the given valset update ID, to the last validator set sent to that chain.
+// The validator updates are expected to already have the key assignment applied.
+func (k Keeper) ApplyConsumerValSetUpdates(ctx sdk.Context, chainID string, valsetUpdateID uint64, updates []abci.ValidatorUpdate) {
+	store := ctx.KVStore(k.storeKey)
+	for _, update := range updates {
+		addr, err := ccvutils.TMCryptoPublicKeyToConsAddr(update.PubKey)
+		if err != nil {
+			// An error here would indicate something is very wrong,
+			// the validator updates are sent to the consumer chain.
+			panic(fmt.Errorf("invalid validator update public key: %w", err))
+		}
+		consumerAddr := types.NewConsumerConsAddress(addr)
+		if update.Power == 0 {
+			store.Delete(types.ConsumerValSetKey(chainID, consumerAddr))
+			continue
+		}
+
+		providerAddr := k.GetProviderAddrFromConsumerAddr(ctx, chainID, consumerAddr)
+		val := types.ConsumerValidator{
+			ProviderAddr: &providerAddr,
+			Power:        update.Power,
+		}
+		if !providerAddr.ToSdkConsAddr().Equals(consumerAddr.ToSdkConsAddr()) {
+			consumerKey := update.PubKey
+			val.ConsumerKey = &consumerKey
+		}
+		k.SetConsumerValidator(ctx, chainID, val)
+	}
+
+	k.SetConsumerValSetUpdateId(ctx, chainID, valsetUpdateID)
+}
+
+// valsetUpdateDiff is the power change of a validator sent to a consumer chain, as logged for audit purposes
+type valsetUpdateDiff struct {
+	Validator string `json:"validator"`
+	OldPower  int64  `json:"old_power"`
+	NewPower  int64  `json:"new_power"`
+}
+
+// logValsetUpdateDiffs logs the power changes of the validators updated by the given validator updates,
+// sent to the consumer chain with the given chain ID in the VSC packet with the given valset update ID.
+// The old powers are read from the last validator set sent to the consumer chain,
+// thus this method must be called before the validator updates are applied to it.
+func (k Keeper) logValsetUpdateDiffs(ctx sdk.Context, chainID string, valsetUpdateID uint64, updates []abci.ValidatorUpdate) {
+	store := ctx.KVStore(k.storeKey)
+	diffs := make([]valsetUpdateDiff, 0, len(updates))
+	for _, update := range updates {
+		addr, err := ccvutils.TMCryptoPublicKeyToConsAddr(update.PubKey)
+		if err != nil {
+			// An error here would indicate something is very wrong,
+			// the validator updates are sent to the consumer chain.
+			panic(fmt.Errorf("invalid validator update public key: %w", err))
+		}
+		consumerAddr := types.NewConsumerConsAddress(addr)
+		providerAddr := k.GetProviderAddrFromConsumerAddr(ctx, chainID, consumerAddr)
+		diff := valsetUpdateDiff{
+			Validator: providerAddr.String(),
+			NewPower:  update.Power,
+		}
+		if bz := store.Get(types.ConsumerValSetKey(chainID, consumerAddr)); bz != nil {
+			var val types.ConsumerValidator
+			if err := val.Unmarshal(bz); err != nil {
+				// An error here would indicate something is very wrong,
+				// the ConsumerValidator is assumed to be correctly serialized in SetConsumerValidator.
+				panic(fmt.Errorf("failed to unmarshal consumer validator: %w", err))
+			}
+			diff.OldPower = val.Power
+		}
+		diffs = append(diffs, diff)
+	}
+
+	bz, err := json.Marshal(diffs)
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// valsetUpdateDiff only contains JSON serializable fields.
+		panic(fmt.Errorf("failed to marshal valset update diffs: %w", err))
+	}
+	k.Logger(ctx).Info("validator set update sent to consumer chain",
+		"chain_id", chainID,
+		"valset_update_id", valsetUpdateID,
+		"diffs", string(bz),
+	)
+}
+
+// ComputeValsetDiff returns the validator updates that bring the validator set old to the validator set new,
+// similarly to the validator diffs sent by Tendermint. The validators of old that are not in new are removed,
+// i.e., their power is set to zero, and the validators of new that are not in old or whose power changed
+// are included with their power in new. Validators are identified by their public keys.
+//
+// Note that the returned updates are ordered as the validators in old and then in new,
+// so that the diff is deterministic.
+func ComputeValsetDiff(old, new []abci.ValidatorUpdate) []abci.ValidatorUpdate {
+	oldPowers := make(map[string]int64, len(old))
+	for _, val := range old {
+		oldPowers[val.PubKey.String()] = val.Power
+	}
+	newPowers := make(map[string]int64, len(new))
+	for _, val := range new {
+		newPowers[val.PubKey.String()] = val.Power
+	}
+
+	diff := []abci.ValidatorUpdate{}
+	for _, val := range old {
+		if _, found := newPowers[val.PubKey.String()]; !found {
+			diff = append(diff, abci.ValidatorUpdate{PubKey: val.PubKey, Power: 0})
+		}
+	}
+	for _, val := range new {
+		if power, found := oldPowers[val.PubKey.String()]; !found || power != val.Power {
+			diff = append(diff, val)
+		}
+	}
+	return diff
+}
+
+// SetConsumerValidator stores a validator of the last validator set sent to the consumer chain
+// with the given chain ID. The validator is stored under its consumer address, i.e., the address
+// of its consumer key if set, or its provider address otherwise.
+func (k Keeper) SetConsumerValidator(ctx sdk.Context, chainID string, val types.ConsumerValidator) {
+	consumerAddr := types.NewConsumerConsAddress(val.ProviderAddr.ToSdkConsAddr())
+	if val.ConsumerKey != nil {
+		addr, err := ccvutils.TMCryptoPublicKeyToConsAddr(*val.ConsumerKey)
+		if err != nil {
+			// An error here would indicate something is very wrong,
+			// the consumer key is either sent to the consumer chain or validated in genesis.
+			panic(fmt.Errorf("invalid consumer key: %w", err))
+		}
+		consumerAddr = types.NewConsumerConsAddress(addr)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz, err := val.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// ConsumerValidator is instantiated only by the provider.
+		panic(fmt.Errorf("failed to marshal consumer validator: %w", err))
+	}
+	store.Set(types.ConsumerValSetKey(chainID, consumerAddr), bz)
+}
+
+// SetConsumerValSetUpdateId sets the valset update ID of the last validator set
+// sent to the consumer chain with the given chain ID
+func (k Keeper) SetConsumerValSetUpdateId(ctx sdk.Context, chainID string, valsetUpdateID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, valsetUpdateID)
+	store.Set(types.ConsumerValSetUpdateIdKey(chainID), bz)
+}
+
+// GetConsumerValSet returns the last validator set sent to the consumer chain with the given chain ID.
+//
+// Note that the validators are stored under keys with the following format:
+// ConsumerValSetBytePrefix | len(chainID) | chainID | consumerAddress
+// Thus, the returned array is in ascending order of consumer addresses.
+func (k Keeper) GetConsumerValSet(ctx sdk.Context, chainID string) (vals []types.ConsumerValidator) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ChainIdWithLenKey(types.ConsumerValSetBytePrefix, chainID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ConsumerValidator
+		if err := val.Unmarshal(iterator.Value()); err != nil {
+			// An error here would indicate something is very wrong,
+			// the ConsumerValidator is assumed to be correctly serialized in SetConsumerValidator.
+			panic(fmt.Errorf("failed to unmarshal consumer validator: %w", err))
+		}
+		vals = append(vals, val)
+	}
+
+	return vals
+}
+
+// GetConsumerTotalPower returns the total power of the last validator set sent to the consumer chain
+// with the given chain ID. Since the validators filtered out of the validator set of the consumer chain,
+// e.g., the soft opted out validators, are not sent to it, their power is not included.
+func (k Keeper) GetConsumerTotalPower(ctx sdk.Context, chainID string) int64 {
+	totalPower := int64(0)
+	for _, val := range k.GetConsumerValSet(ctx, chainID) {
+		totalPower += val.Power
+	}
+	return totalPower
+}
+
+// GetConsumerValSetUpdateId returns the valset update ID of the last validator set
+// sent to the consumer chain with the given chain ID
+func (k Keeper) GetConsumerValSetUpdateId(ctx sdk.Context, chainID string) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerValSetUpdateIdKey(chainID))
+	if bz == nil {
+		return 0, false
+	}
+	return binary.BigEndian.Uint64(bz), true
+}
+
+// DeleteConsumerValSet removes the last validator set sent to the consumer chain with the given chain ID
+func (k Keeper) DeleteConsumerValSet(ctx sdk.Context, chainID string) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ChainIdWithLenKey(types.ConsumerValSetBytePrefix, chainID))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	store.Delete(types.ConsumerValSetUpdateIdKey(chainID))
+}
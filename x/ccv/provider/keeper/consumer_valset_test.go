@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"testing"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/interchain-security/testutil/crypto"
+	testkeeper "github.com/cosmos/interchain-security/testutil/keeper"
+	providerkeeper "github.com/cosmos/interchain-security/x/ccv/provider/keeper"
+	providertypes "github.com/cosmos/interchain-security/x/ccv/provider/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestConsumerValSet tests the getter, setter, update and deletion methods
+// for the last validator set sent to a consumer chain
+func TestConsumerValSet(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	chainID := "consumer"
+	valA := crypto.NewCryptoIdentityFromIntSeed(1)
+	valB := crypto.NewCryptoIdentityFromIntSeed(2)
+	// the consumer key assigned by validator B
+	valBConsumer := crypto.NewCryptoIdentityFromIntSeed(3)
+	providerKeeper.SetValidatorByConsumerAddr(ctx, chainID, valBConsumer.ConsumerConsAddress(), valB.ProviderConsAddress())
+	valC := crypto.NewCryptoIdentityFromIntSeed(4)
+
+	_, found := providerKeeper.GetConsumerValSetUpdateId(ctx, chainID)
+	require.False(t, found)
+	require.Empty(t, providerKeeper.GetConsumerValSet(ctx, chainID))
+
+	// the initial validator set
+	providerKeeper.SetConsumerValSet(ctx, chainID, 0, []abci.ValidatorUpdate{
+		{PubKey: valA.TMProtoCryptoPublicKey(), Power: 1},
+		{PubKey: valBConsumer.TMProtoCryptoPublicKey(), Power: 2},
+	})
+	valsetUpdateID, found := providerKeeper.GetConsumerValSetUpdateId(ctx, chainID)
+	require.True(t, found)
+	require.Equal(t, uint64(0), valsetUpdateID)
+
+	providerAddrA := valA.ProviderConsAddress()
+	providerAddrB := valB.ProviderConsAddress()
+	consumerKeyB := valBConsumer.TMProtoCryptoPublicKey()
+	require.ElementsMatch(t, []providertypes.ConsumerValidator{
+		{ProviderAddr: &providerAddrA, Power: 1},
+		{ProviderAddr: &providerAddrB, ConsumerKey: &consumerKeyB, Power: 2},
+	}, providerKeeper.GetConsumerValSet(ctx, chainID))
+
+	// validator A is removed, validator B changes power and validator C is added
+	providerKeeper.ApplyConsumerValSetUpdates(ctx, chainID, 5, []abci.ValidatorUpdate{
+		{PubKey: valA.TMProtoCryptoPublicKey(), Power: 0},
+		{PubKey: valBConsumer.TMProtoCryptoPublicKey(), Power: 3},
+		{PubKey: valC.TMProtoCryptoPublicKey(), Power: 4},
+	})
+	valsetUpdateID, found = providerKeeper.GetConsumerValSetUpdateId(ctx, chainID)
+	require.True(t, found)
+	require.Equal(t, uint64(5), valsetUpdateID)
+
+	providerAddrC := valC.ProviderConsAddress()
+	require.ElementsMatch(t, []providertypes.ConsumerValidator{
+		{ProviderAddr: &providerAddrB, ConsumerKey: &consumerKeyB, Power: 3},
+		{ProviderAddr: &providerAddrC, Power: 4},
+	}, providerKeeper.GetConsumerValSet(ctx, chainID))
+
+	// the validator sets of other consumer chains are not affected
+	providerKeeper.SetConsumerValSet(ctx, "other", 1, []abci.ValidatorUpdate{
+		{PubKey: valA.TMProtoCryptoPublicKey(), Power: 1},
+	})
+	require.Len(t, providerKeeper.GetConsumerValSet(ctx, chainID), 2)
+
+	providerKeeper.DeleteConsumerValSet(ctx, chainID)
+	require.Empty(t, providerKeeper.GetConsumerValSet(ctx, chainID))
+	_, found = providerKeeper.GetConsumerValSetUpdateId(ctx, chainID)
+	require.False(t, found)
+	require.Len(t, providerKeeper.GetConsumerValSet(ctx, "other"), 1)
+}
+
+// TestComputeValsetDiff tests that ComputeValsetDiff returns
+// only the changes between two validator sets
+func TestComputeValsetDiff(t *testing.T) {
+	valA := crypto.NewCryptoIdentityFromIntSeed(1).TMProtoCryptoPublicKey()
+	valB := crypto.NewCryptoIdentityFromIntSeed(2).TMProtoCryptoPublicKey()
+	valC := crypto.NewCryptoIdentityFromIntSeed(3).TMProtoCryptoPublicKey()
+
+	testCases := []struct {
+		name    string
+		old     []abci.ValidatorUpdate
+		new     []abci.ValidatorUpdate
+		expDiff []abci.ValidatorUpdate
+	}{
+		{
+			name:    "no previous validator set, full set",
+			old:     nil,
+			new:     []abci.ValidatorUpdate{{PubKey: valA, Power: 1}, {PubKey: valB, Power: 2}},
+			expDiff: []abci.ValidatorUpdate{{PubKey: valA, Power: 1}, {PubKey: valB, Power: 2}},
+		},
+		{
+			name:    "same validator set",
+			old:     []abci.ValidatorUpdate{{PubKey: valA, Power: 1}, {PubKey: valB, Power: 2}},
+			new:     []abci.ValidatorUpdate{{PubKey: valB, Power: 2}, {PubKey: valA, Power: 1}},
+			expDiff: []abci.ValidatorUpdate{},
+		},
+		{
+			name:    "power changed",
+			old:     []abci.ValidatorUpdate{{PubKey: valA, Power: 1}, {PubKey: valB, Power: 2}},
+			new:     []abci.ValidatorUpdate{{PubKey: valA, Power: 1}, {PubKey: valB, Power: 3}},
+			expDiff: []abci.ValidatorUpdate{{PubKey: valB, Power: 3}},
+		},
+		{
+			name:    "validator removed and validator added",
+			old:     []abci.ValidatorUpdate{{PubKey: valA, Power: 1}, {PubKey: valB, Power: 2}},
+			new:     []abci.ValidatorUpdate{{PubKey: valB, Power: 2}, {PubKey: valC, Power: 3}},
+			expDiff: []abci.ValidatorUpdate{{PubKey: valA, Power: 0}, {PubKey: valC, Power: 3}},
+		},
+		{
+			name:    "all validators removed",
+			old:     []abci.ValidatorUpdate{{PubKey: valA, Power: 1}},
+			new:     nil,
+			expDiff: []abci.ValidatorUpdate{{PubKey: valA, Power: 0}},
+		},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expDiff, providerkeeper.ComputeValsetDiff(tc.old, tc.new), tc.name)
+	}
+}
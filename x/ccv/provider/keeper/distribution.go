@@ -1,10 +1,368 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+
+	"github.com/cosmos/interchain-security/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/x/ccv/types"
 )
 
 func (k Keeper) GetFeeCollectorAddressStr(ctx sdk.Context) string {
 	return k.accountKeeper.GetModuleAccount(
 		ctx, k.feeCollectorName).GetAddress().String()
 }
+
+// GetConsumerRewardsPoolAddressStr returns the address of the consumer rewards pool,
+// i.e., the account to which consumer chains send their rewards
+func (k Keeper) GetConsumerRewardsPoolAddressStr(ctx sdk.Context) string {
+	return k.accountKeeper.GetModuleAccount(
+		ctx, types.ConsumerRewardsPool).GetAddress().String()
+}
+
+// SetConsumerRewardsAllocation sets the rewards allocation of a consumer chain,
+// i.e., the rewards received from that chain that are not yet distributed
+func (k Keeper) SetConsumerRewardsAllocation(ctx sdk.Context, chainID string, pool types.ConsumerRewardsAllocation) {
+	store := ctx.KVStore(k.storeKey)
+	b, err := pool.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// ConsumerRewardsAllocation is instantiated only by the provider.
+		panic(fmt.Errorf("failed to marshal consumer rewards allocation: %w", err))
+	}
+	store.Set(types.ConsumerRewardsAllocationKey(chainID), b)
+}
+
+// GetConsumerRewardsAllocation returns the rewards allocation of a consumer chain
+func (k Keeper) GetConsumerRewardsAllocation(ctx sdk.Context, chainID string) (pool types.ConsumerRewardsAllocation) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.ConsumerRewardsAllocationKey(chainID))
+	if b == nil {
+		return pool
+	}
+	if err := pool.Unmarshal(b); err != nil {
+		// An error here would indicate something is very wrong,
+		// the ConsumerRewardsAllocation is assumed to be correctly serialized in SetConsumerRewardsAllocation.
+		panic(fmt.Errorf("failed to unmarshal consumer rewards allocation: %w", err))
+	}
+	return pool
+}
+
+// DeleteConsumerRewardsAllocation deletes the rewards allocation of a consumer chain
+func (k Keeper) DeleteConsumerRewardsAllocation(ctx sdk.Context, chainID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerRewardsAllocationKey(chainID))
+}
+
+// AddConsumerRewardsAllocation adds rewards received from a consumer chain
+// to the rewards allocation of that chain and to the rewards received during its current rewards window.
+// The redistribute fraction of the consumer chain is applied once to the received rewards, and the resulting
+// amount, truncated to integer amounts, is distributed to the fee collector in the next block; the rest of
+// the rewards remain allocated to the consumer chain until it is stopped.
+func (k Keeper) AddConsumerRewardsAllocation(ctx sdk.Context, chainID string, rewards sdk.Coins) {
+	pool := k.GetConsumerRewardsAllocation(ctx, chainID)
+	pool.Rewards = pool.Rewards.Add(rewards...)
+	pool.ToDistribute = pool.ToDistribute.Add(k.redistributedRewards(ctx, chainID, rewards)...)
+	k.SetConsumerRewardsAllocation(ctx, chainID, pool)
+
+	window, found := k.GetConsumerRewardsWindow(ctx, chainID)
+	if !found {
+		window.StartTime = ctx.BlockTime()
+	}
+	window.Received = window.Received.Add(rewards...)
+	k.SetConsumerRewardsWindow(ctx, chainID, window)
+}
+
+// SetConsumerRewardsWindow sets the rewards received from a consumer chain
+// during the current and last rewards windows
+func (k Keeper) SetConsumerRewardsWindow(ctx sdk.Context, chainID string, window types.ConsumerRewardsWindow) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := window.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// ConsumerRewardsWindow is instantiated only by the provider.
+		panic(fmt.Errorf("failed to marshal consumer rewards window: %w", err))
+	}
+	store.Set(types.ConsumerRewardsWindowKey(chainID), bz)
+}
+
+// GetConsumerRewardsWindow returns the rewards received from a consumer chain
+// during the current and last rewards windows
+func (k Keeper) GetConsumerRewardsWindow(ctx sdk.Context, chainID string) (types.ConsumerRewardsWindow, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerRewardsWindowKey(chainID))
+	if bz == nil {
+		return types.ConsumerRewardsWindow{}, false
+	}
+
+	var window types.ConsumerRewardsWindow
+	if err := window.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the ConsumerRewardsWindow is assumed to be correctly serialized in SetConsumerRewardsWindow.
+		panic(fmt.Errorf("failed to unmarshal consumer rewards window: %w", err))
+	}
+	return window, true
+}
+
+// DeleteConsumerRewardsWindow deletes the rewards received from a consumer chain
+// during the current and last rewards windows
+func (k Keeper) DeleteConsumerRewardsWindow(ctx sdk.Context, chainID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerRewardsWindowKey(chainID))
+}
+
+// GetConsumerRewardsShortfall returns the expected rewards of a consumer chain
+// that were not received during its last completed rewards window.
+// It returns nil if no rewards window of the consumer chain was completed.
+func (k Keeper) GetConsumerRewardsShortfall(ctx sdk.Context, chainID string) sdk.Coins {
+	window, found := k.GetConsumerRewardsWindow(ctx, chainID)
+	if !found || !window.LastWindowCompleted {
+		return nil
+	}
+	return RewardsShortfall(k.GetConsumerChainExpectedRewards(ctx, chainID), window.LastWindowReceived)
+}
+
+// RewardsShortfall returns, for every denom of the expected rewards,
+// the amount by which the received rewards fall short of the expected rewards
+func RewardsShortfall(expected, received sdk.Coins) sdk.Coins {
+	shortfall := sdk.NewCoins()
+	for _, coin := range expected {
+		if amount := received.AmountOf(coin.Denom); amount.LT(coin.Amount) {
+			shortfall = shortfall.Add(sdk.NewCoin(coin.Denom, coin.Amount.Sub(amount)))
+		}
+	}
+	return shortfall
+}
+
+// updateConsumerRewardsWindows starts a new rewards window for every consumer chain whose
+// current rewards window elapsed, and emits an event for every consumer chain that did not
+// send its expected rewards during the elapsed window
+func (k Keeper) updateConsumerRewardsWindows(ctx sdk.Context) {
+	for _, channelToChain := range k.GetAllChannelToChains(ctx) {
+		chainID := channelToChain.ChainId
+		window, found := k.GetConsumerRewardsWindow(ctx, chainID)
+		if !found {
+			// the CCV channel was established before the rewards windows were tracked
+			k.SetConsumerRewardsWindow(ctx, chainID, types.ConsumerRewardsWindow{StartTime: ctx.BlockTime()})
+			continue
+		}
+		if ctx.BlockTime().Before(window.StartTime.Add(k.GetConsumerRewardsWindowPeriod(ctx))) {
+			continue
+		}
+
+		expected := k.GetConsumerChainExpectedRewards(ctx, chainID)
+		if shortfall := RewardsShortfall(expected, window.Received); !shortfall.IsZero() {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					ccv.EventTypeConsumerRewardsShortfall,
+					sdk.NewAttribute(ccv.AttributeChainID, chainID),
+					sdk.NewAttribute(ccv.AttributeExpectedRewardsPerWindow, expected.String()),
+					sdk.NewAttribute(ccv.AttributeReceivedRewards, window.Received.String()),
+					sdk.NewAttribute(ccv.AttributeRewardsShortfall, shortfall.String()),
+				),
+			)
+			k.Logger(ctx).Info("consumer chain did not send the expected rewards",
+				"chainID", chainID,
+				"expected", expected.String(),
+				"received", window.Received.String(),
+				"shortfall", shortfall.String(),
+			)
+		}
+
+		k.SetConsumerRewardsWindow(ctx, chainID, types.ConsumerRewardsWindow{
+			StartTime:           ctx.BlockTime(),
+			LastWindowCompleted: true,
+			LastWindowReceived:  window.Received,
+		})
+	}
+}
+
+// SetPreferredRewardDenom sets the denom under which the rewards
+// received from the consumer chain with the given chain ID are tracked
+func (k Keeper) SetPreferredRewardDenom(ctx sdk.Context, chainID, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	if denom == "" {
+		store.Delete(types.PreferredRewardDenomKey(chainID))
+		return
+	}
+	store.Set(types.PreferredRewardDenomKey(chainID), []byte(denom))
+}
+
+// GetPreferredRewardDenom returns the preferred reward denom
+// of the consumer chain with the given chain ID
+func (k Keeper) GetPreferredRewardDenom(ctx sdk.Context, chainID string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PreferredRewardDenomKey(chainID))
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// DeletePreferredRewardDenom deletes the preferred reward denom
+// of the consumer chain with the given chain ID
+func (k Keeper) DeletePreferredRewardDenom(ctx sdk.Context, chainID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PreferredRewardDenomKey(chainID))
+}
+
+// SetConsumerRewardDenom registers the given denom as a consumer reward denom
+func (k Keeper) SetConsumerRewardDenom(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ConsumerRewardDenomKey(denom), []byte{})
+}
+
+// DeleteConsumerRewardDenom unregisters the given consumer reward denom
+func (k Keeper) DeleteConsumerRewardDenom(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerRewardDenomKey(denom))
+}
+
+// IsConsumerRewardDenomRegistered returns whether the given denom is registered as a consumer reward denom,
+// i.e., whether the tokens of this denom received from consumer chains are added to their rewards allocation
+func (k Keeper) IsConsumerRewardDenomRegistered(ctx sdk.Context, denom string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.ConsumerRewardDenomKey(denom))
+}
+
+// GetAllConsumerRewardDenoms returns all the registered consumer reward denoms.
+//
+// Note that the consumer reward denoms are stored under keys with the following format:
+// ConsumerRewardDenomsBytePrefix | denom
+// Thus, the returned array is in ascending order of denoms.
+func (k Keeper) GetAllConsumerRewardDenoms(ctx sdk.Context) (denoms []string) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{types.ConsumerRewardDenomsBytePrefix})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		denoms = append(denoms, string(iterator.Key()[1:]))
+	}
+
+	return denoms
+}
+
+// GetLabelledConsumerRewardsAllocation returns the rewards allocation of a consumer chain
+// with the IBC vouchers of its preferred reward denom tracked under that denom, i.e., the vouchers
+// whose denom trace is the preferred reward denom received over a channel on the connection of the
+// CCV channel of that chain. All the other rewards, including the IBC vouchers of other denoms,
+// are tracked as received. If the chain has no preferred reward denom, the rewards allocation
+// is returned as is.
+//
+// Note that this method iterates over all the channels of the provider chain,
+// thus it must only be used by queries.
+func (k Keeper) GetLabelledConsumerRewardsAllocation(ctx sdk.Context, chainID string) sdk.Coins {
+	rewards := k.GetConsumerRewardsAllocation(ctx, chainID).Rewards
+	denom, found := k.GetPreferredRewardDenom(ctx, chainID)
+	if !found {
+		return rewards
+	}
+	voucherDenoms := k.getConsumerVoucherDenoms(ctx, chainID, denom)
+	labelled := sdk.NewCoins()
+	for _, coin := range rewards {
+		if voucherDenoms[coin.Denom] {
+			coin.Denom = denom
+		}
+		labelled = labelled.Add(coin)
+	}
+	return labelled
+}
+
+// getConsumerVoucherDenoms returns the denoms of the IBC vouchers that the provider mints for tokens
+// of the given base denom received from the consumer chain with the given chain ID, i.e., over any
+// channel, other than the CCV channel, on the connection of the CCV channel of the consumer chain
+func (k Keeper) getConsumerVoucherDenoms(ctx sdk.Context, chainID, baseDenom string) map[string]bool {
+	voucherDenoms := map[string]bool{}
+	ccvChannelID, found := k.GetChainToChannel(ctx, chainID)
+	if !found {
+		return voucherDenoms
+	}
+	ccvChannel, found := k.channelKeeper.GetChannel(ctx, k.GetPort(ctx), ccvChannelID)
+	if !found || len(ccvChannel.ConnectionHops) == 0 {
+		return voucherDenoms
+	}
+	for _, channel := range k.channelKeeper.GetAllChannels(ctx) {
+		if len(channel.ConnectionHops) == 0 || channel.ConnectionHops[0] != ccvChannel.ConnectionHops[0] {
+			continue
+		}
+		if channel.PortId == k.GetPort(ctx) && channel.ChannelId == ccvChannelID {
+			continue
+		}
+		prefixedDenom := ibctransfertypes.GetPrefixedDenom(channel.PortId, channel.ChannelId, baseDenom)
+		voucherDenoms[ibctransfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()] = true
+	}
+	return voucherDenoms
+}
+
+// GetConsumerChainByTransferChannel returns the ID of the consumer chain
+// whose CCV channel is on the same connection as the given transfer channel
+func (k Keeper) GetConsumerChainByTransferChannel(ctx sdk.Context, portID, channelID string) (string, bool) {
+	transferChannel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found || len(transferChannel.ConnectionHops) == 0 {
+		return "", false
+	}
+	for _, channelToChain := range k.GetAllChannelToChains(ctx) {
+		ccvChannel, found := k.channelKeeper.GetChannel(ctx, k.GetPort(ctx), channelToChain.ChannelId)
+		if !found || len(ccvChannel.ConnectionHops) == 0 {
+			continue
+		}
+		if ccvChannel.ConnectionHops[0] == transferChannel.ConnectionHops[0] {
+			return channelToChain.ChainId, true
+		}
+	}
+	return "", false
+}
+
+// BeginBlockRD contains the BeginBlock logic needed for the Reward Distribution sub-protocol,
+// i.e., it tracks the rewards windows of the consumer chains and it distributes to the fee collector
+// the rewards of every consumer chain that are to be distributed, see AddConsumerRewardsAllocation
+func (k Keeper) BeginBlockRD(ctx sdk.Context) {
+	k.updateConsumerRewardsWindows(ctx)
+
+	for _, chain := range k.GetAllConsumerChains(ctx) {
+		k.distributeConsumerRewards(ctx, chain.ChainId, k.GetConsumerRewardsAllocation(ctx, chain.ChainId).ToDistribute)
+	}
+}
+
+// redistributedRewards returns the redistribute fraction of the consumer chain
+// with the given chain ID of the given rewards, truncated to integer amounts
+func (k Keeper) redistributedRewards(ctx sdk.Context, chainID string, rewards sdk.Coins) sdk.Coins {
+	frac, err := sdk.NewDecFromStr(k.GetConsumerChainRedistributeFraction(ctx, chainID))
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the fraction is validated in Params.Validate() and ConsumerParameters.Validate().
+		panic(fmt.Errorf("invalid consumer redistribute fraction: %w", err))
+	}
+	redistributed, _ := sdk.NewDecCoinsFromCoins(rewards...).MulDec(frac).TruncateDecimal()
+	return redistributed
+}
+
+// distributeConsumerRewards sends the given rewards of a consumer chain from the consumer
+// rewards pool to the fee collector and removes them from the rewards allocation of that chain
+func (k Keeper) distributeConsumerRewards(ctx sdk.Context, chainID string, rewards sdk.Coins) {
+	if rewards.IsZero() {
+		return
+	}
+	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ConsumerRewardsPool, k.feeCollectorName, rewards)
+	if err != nil {
+		k.Logger(ctx).Error("cannot distribute consumer rewards, rewards remain allocated",
+			"chainID", chainID,
+			"rewards", rewards.String(),
+			"error", err.Error(),
+		)
+		return
+	}
+
+	pool := k.GetConsumerRewardsAllocation(ctx, chainID)
+	pool.Rewards = pool.Rewards.Sub(rewards)
+	pool.ToDistribute = pool.ToDistribute.Sub(pool.ToDistribute.Min(rewards))
+	if pool.Rewards.IsZero() {
+		k.DeleteConsumerRewardsAllocation(ctx, chainID)
+	} else {
+		k.SetConsumerRewardsAllocation(ctx, chainID, pool)
+	}
+
+	k.Logger(ctx).Debug("consumer rewards distributed", "chainID", chainID, "rewards", rewards.String())
+}
@@ -0,0 +1,260 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/cosmos/interchain-security/testutil/keeper"
+	providerkeeper "github.com/cosmos/interchain-security/x/ccv/provider/keeper"
+	providertypes "github.com/cosmos/interchain-security/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/x/ccv/types"
+)
+
+// TestConsumerRewardsAllocation tests the getter, setter and deletion methods
+// for the rewards allocation of consumer chains
+func TestConsumerRewardsAllocation(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	require.True(t, providerKeeper.GetConsumerRewardsAllocation(ctx, "chain").Rewards.IsZero())
+
+	providerKeeper.AddConsumerRewardsAllocation(ctx, "chain", sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	providerKeeper.AddConsumerRewardsAllocation(ctx, "chain", sdk.NewCoins(sdk.NewInt64Coin("stake", 50), sdk.NewInt64Coin("atom", 7)))
+	providerKeeper.AddConsumerRewardsAllocation(ctx, "chain1", sdk.NewCoins(sdk.NewInt64Coin("stake", 3)))
+
+	expected := sdk.NewCoins(sdk.NewInt64Coin("stake", 150), sdk.NewInt64Coin("atom", 7))
+	require.Equal(t, expected, providerKeeper.GetConsumerRewardsAllocation(ctx, "chain").Rewards)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 3)), providerKeeper.GetConsumerRewardsAllocation(ctx, "chain1").Rewards)
+
+	providerKeeper.DeleteConsumerRewardsAllocation(ctx, "chain")
+	require.True(t, providerKeeper.GetConsumerRewardsAllocation(ctx, "chain").Rewards.IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 3)), providerKeeper.GetConsumerRewardsAllocation(ctx, "chain1").Rewards)
+}
+
+// TestConsumerRewardDenoms tests the registration of the consumer reward denoms
+func TestConsumerRewardDenoms(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	require.False(t, providerKeeper.IsConsumerRewardDenomRegistered(ctx, "ibc/denom"))
+	require.Empty(t, providerKeeper.GetAllConsumerRewardDenoms(ctx))
+
+	providerKeeper.SetConsumerRewardDenom(ctx, "ibc/denom")
+	providerKeeper.SetConsumerRewardDenom(ctx, "atom")
+	require.True(t, providerKeeper.IsConsumerRewardDenomRegistered(ctx, "ibc/denom"))
+	require.True(t, providerKeeper.IsConsumerRewardDenomRegistered(ctx, "atom"))
+	require.Equal(t, []string{"atom", "ibc/denom"}, providerKeeper.GetAllConsumerRewardDenoms(ctx))
+
+	providerKeeper.DeleteConsumerRewardDenom(ctx, "ibc/denom")
+	require.False(t, providerKeeper.IsConsumerRewardDenomRegistered(ctx, "ibc/denom"))
+	require.Equal(t, []string{"atom"}, providerKeeper.GetAllConsumerRewardDenoms(ctx))
+}
+
+// TestBeginBlockRD tests that the redistribute fraction is applied once to the rewards received
+// from every consumer chain, and that the resulting rewards are distributed in full to the fee collector
+func TestBeginBlockRD(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	params := providertypes.DefaultParams()
+	params.ConsumerRedistributeFraction = "0.75"
+	providerKeeper.SetParams(ctx, params)
+
+	providerKeeper.SetConsumerClientId(ctx, "chain", "client")
+	providerKeeper.SetConsumerClientId(ctx, "chain1", "client1")
+	providerKeeper.SetConsumerClientId(ctx, "chain2", "client2")
+	// the fraction is truncated for every received rewards, i.e., 75 + 30 stake are to be distributed
+	providerKeeper.AddConsumerRewardsAllocation(ctx, "chain", sdk.NewCoins(sdk.NewInt64Coin("stake", 101)))
+	providerKeeper.AddConsumerRewardsAllocation(ctx, "chain", sdk.NewCoins(sdk.NewInt64Coin("stake", 40)))
+	providerKeeper.AddConsumerRewardsAllocation(ctx, "chain1", sdk.NewCoins(sdk.NewInt64Coin("atom", 1)))
+	providerKeeper.AddConsumerRewardsAllocation(ctx, "chain2", sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	require.Equal(t, providertypes.ConsumerRewardsAllocation{
+		Rewards:      sdk.NewCoins(sdk.NewInt64Coin("stake", 141)),
+		ToDistribute: sdk.NewCoins(sdk.NewInt64Coin("stake", 105)),
+	}, providerKeeper.GetConsumerRewardsAllocation(ctx, "chain"))
+
+	gomock.InOrder(
+		mocks.MockBankKeeper.EXPECT().SendCoinsFromModuleToModule(ctx,
+			providertypes.ConsumerRewardsPool, authtypes.FeeCollectorName,
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 105))).Return(nil).Times(1),
+		// the distribution to the fee collector fails for chain2
+		mocks.MockBankKeeper.EXPECT().SendCoinsFromModuleToModule(ctx,
+			providertypes.ConsumerRewardsPool, authtypes.FeeCollectorName,
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 7))).Return(fmt.Errorf("insufficient funds")).Times(1),
+		// the distribution is retried in the next block, while nothing is left to distribute for chain
+		mocks.MockBankKeeper.EXPECT().SendCoinsFromModuleToModule(ctx,
+			providertypes.ConsumerRewardsPool, authtypes.FeeCollectorName,
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 7))).Return(nil).Times(1),
+	)
+
+	providerKeeper.BeginBlockRD(ctx)
+
+	// the rewards to distribute reach zero, while the rest of the rewards remain allocated
+	require.Equal(t, providertypes.ConsumerRewardsAllocation{
+		Rewards: sdk.NewCoins(sdk.NewInt64Coin("stake", 36)),
+	}, providerKeeper.GetConsumerRewardsAllocation(ctx, "chain"))
+	// nothing is distributed if the fraction of the received rewards is truncated to zero
+	require.Equal(t, providertypes.ConsumerRewardsAllocation{
+		Rewards: sdk.NewCoins(sdk.NewInt64Coin("atom", 1)),
+	}, providerKeeper.GetConsumerRewardsAllocation(ctx, "chain1"))
+	// the allocation remains unchanged if the distribution fails
+	require.Equal(t, providertypes.ConsumerRewardsAllocation{
+		Rewards:      sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+		ToDistribute: sdk.NewCoins(sdk.NewInt64Coin("stake", 7)),
+	}, providerKeeper.GetConsumerRewardsAllocation(ctx, "chain2"))
+
+	providerKeeper.BeginBlockRD(ctx)
+
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 36)), providerKeeper.GetConsumerRewardsAllocation(ctx, "chain").Rewards)
+	require.Equal(t, providertypes.ConsumerRewardsAllocation{
+		Rewards: sdk.NewCoins(sdk.NewInt64Coin("stake", 3)),
+	}, providerKeeper.GetConsumerRewardsAllocation(ctx, "chain2"))
+}
+
+// TestConsumerRewardsWindows tests that the rewards received from the consumer chains are tracked
+// over the rewards windows, and that a shortfall is reported once a window elapsed
+func TestConsumerRewardsWindows(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	params := providertypes.DefaultParams()
+	params.ConsumerRewardsWindowPeriod = time.Hour
+	providerKeeper.SetParams(ctx, params)
+
+	start := time.Now().UTC()
+	ctx = ctx.WithBlockTime(start)
+	providerKeeper.SetChannelToChain(ctx, "channel", "chain")
+	providerKeeper.SetChannelToChain(ctx, "channel1", "chain1")
+	expected := sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("atom", 10))
+	providerKeeper.SetConsumerParameters(ctx, "chain", providertypes.ConsumerParameters{
+		ConsumerRedistributeFraction: "1",
+		SoftOptOutThreshold:          "0",
+		ExpectedRewardsPerWindow:     expected,
+	})
+
+	// the windows are started, and no shortfall is reported before a window is completed
+	providerKeeper.BeginBlockRD(ctx)
+	window, found := providerKeeper.GetConsumerRewardsWindow(ctx, "chain")
+	require.True(t, found)
+	require.Equal(t, start, window.StartTime)
+	require.False(t, window.LastWindowCompleted)
+	require.Nil(t, providerKeeper.GetConsumerRewardsShortfall(ctx, "chain"))
+
+	// the received rewards are tracked, while they are distributed
+	ctx = ctx.WithBlockTime(start.Add(time.Minute))
+	providerKeeper.AddConsumerRewardsAllocation(ctx, "chain", sdk.NewCoins(sdk.NewInt64Coin("stake", 60)))
+	providerKeeper.AddConsumerRewardsAllocation(ctx, "chain", sdk.NewCoins(sdk.NewInt64Coin("stake", 20), sdk.NewInt64Coin("atom", 10)))
+	providerKeeper.AddConsumerRewardsAllocation(ctx, "chain1", sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+	providerKeeper.DeleteConsumerRewardsAllocation(ctx, "chain")
+	window, _ = providerKeeper.GetConsumerRewardsWindow(ctx, "chain")
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 80), sdk.NewInt64Coin("atom", 10)), window.Received)
+
+	// the window elapsed, a shortfall is reported for chain only
+	ctx = ctx.WithBlockTime(start.Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	providerKeeper.BeginBlockRD(ctx)
+	window, _ = providerKeeper.GetConsumerRewardsWindow(ctx, "chain")
+	require.Equal(t, start.Add(time.Hour), window.StartTime)
+	require.True(t, window.LastWindowCompleted)
+	require.True(t, window.Received.IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 80), sdk.NewInt64Coin("atom", 10)), window.LastWindowReceived)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 20)), providerKeeper.GetConsumerRewardsShortfall(ctx, "chain"))
+	// no rewards are expected from chain1
+	require.True(t, providerKeeper.GetConsumerRewardsShortfall(ctx, "chain1").IsZero())
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, ccv.EventTypeConsumerRewardsShortfall, events[0].Type)
+}
+
+// TestRewardsShortfall tests the shortfall of the received rewards with respect to the expected rewards
+func TestRewardsShortfall(t *testing.T) {
+	testCases := []struct {
+		name     string
+		expected sdk.Coins
+		received sdk.Coins
+		expShort sdk.Coins
+	}{
+		{"no expected rewards", nil, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)), sdk.NewCoins()},
+		{"no received rewards", sdk.NewCoins(sdk.NewInt64Coin("stake", 5)), nil, sdk.NewCoins(sdk.NewInt64Coin("stake", 5))},
+		{"expected rewards received", sdk.NewCoins(sdk.NewInt64Coin("stake", 5)), sdk.NewCoins(sdk.NewInt64Coin("stake", 5)), sdk.NewCoins()},
+		{"more rewards received", sdk.NewCoins(sdk.NewInt64Coin("stake", 5)), sdk.NewCoins(sdk.NewInt64Coin("stake", 9)), sdk.NewCoins()},
+		{
+			"other denoms do not compensate the shortfall",
+			sdk.NewCoins(sdk.NewInt64Coin("atom", 3), sdk.NewInt64Coin("stake", 5)),
+			sdk.NewCoins(sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin("stake", 4)),
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 1)),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expShort, providerkeeper.RewardsShortfall(tc.expected, tc.received), tc.name)
+	}
+}
+
+// TestLabelledConsumerRewardsAllocation tests that the IBC vouchers of the preferred reward denom
+// received as rewards from a consumer chain are tracked under the preferred reward denom of that chain
+func TestLabelledConsumerRewardsAllocation(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	voucher := func(channelID, denom string) string {
+		return transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom("transfer", channelID, denom)).IBCDenom()
+	}
+	// the transfer channel-1 is on the connection of the CCV channel of the consumer chain,
+	// while the transfer channel-2 is on the connection to another chain
+	providerKeeper.SetPort(ctx, ccv.ProviderPortID)
+	providerKeeper.SetChainToChannel(ctx, "chain", "channel-0")
+	ccvChannel := channeltypes.IdentifiedChannel{
+		PortId: ccv.ProviderPortID, ChannelId: "channel-0", ConnectionHops: []string{"connection-0"},
+	}
+	mocks.MockChannelKeeper.EXPECT().GetChannel(ctx, ccv.ProviderPortID, "channel-0").Return(
+		channeltypes.Channel{ConnectionHops: ccvChannel.ConnectionHops}, true,
+	).AnyTimes()
+	mocks.MockChannelKeeper.EXPECT().GetAllChannels(ctx).Return([]channeltypes.IdentifiedChannel{
+		ccvChannel,
+		{PortId: "transfer", ChannelId: "channel-1", ConnectionHops: []string{"connection-0"}},
+		{PortId: "transfer", ChannelId: "channel-2", ConnectionHops: []string{"connection-1"}},
+	}).AnyTimes()
+
+	rewards := sdk.NewCoins(
+		sdk.NewInt64Coin(voucher("channel-1", "ucon"), 100),
+		sdk.NewInt64Coin(voucher("channel-1", "uother"), 3),
+		sdk.NewInt64Coin(voucher("channel-2", "ucon"), 5),
+		sdk.NewInt64Coin("stake", 7),
+	)
+	providerKeeper.AddConsumerRewardsAllocation(ctx, "chain", rewards)
+
+	// without a preferred reward denom, the rewards are tracked as received
+	_, found := providerKeeper.GetPreferredRewardDenom(ctx, "chain")
+	require.False(t, found)
+	require.Equal(t, rewards, providerKeeper.GetLabelledConsumerRewardsAllocation(ctx, "chain"))
+
+	providerKeeper.SetPreferredRewardDenom(ctx, "chain", "ucon")
+	denom, found := providerKeeper.GetPreferredRewardDenom(ctx, "chain")
+	require.True(t, found)
+	require.Equal(t, "ucon", denom)
+
+	// only the vouchers of the preferred reward denom received from the consumer chain are tracked
+	// under the preferred reward denom, while the actual allocation keeps the voucher denom
+	require.Equal(t, sdk.NewCoins(
+		sdk.NewInt64Coin("ucon", 100),
+		sdk.NewInt64Coin(voucher("channel-1", "uother"), 3),
+		sdk.NewInt64Coin(voucher("channel-2", "ucon"), 5),
+		sdk.NewInt64Coin("stake", 7),
+	), providerKeeper.GetLabelledConsumerRewardsAllocation(ctx, "chain"))
+	require.Equal(t, rewards, providerKeeper.GetConsumerRewardsAllocation(ctx, "chain").Rewards)
+
+	providerKeeper.DeletePreferredRewardDenom(ctx, "chain")
+	_, found = providerKeeper.GetPreferredRewardDenom(ctx, "chain")
+	require.False(t, found)
+}
@@ -2,22 +2,30 @@ package keeper
 
 import (
 	"fmt"
+	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+
 	"github.com/cosmos/interchain-security/x/ccv/provider/types"
 	ccv "github.com/cosmos/interchain-security/x/ccv/types"
 )
 
-// InitGenesis initializes the CCV provider state and binds to PortID.
+// InitGenesis initializes the CCV provider state and binds to the port ID set in the genesis state,
+// or to the default provider port if none is set.
 func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
-	k.SetPort(ctx, ccv.ProviderPortID)
+	portID := genState.PortId
+	if portID == "" {
+		portID = ccv.ProviderPortID
+	}
+	k.SetPort(ctx, portID)
 
 	// Only try to bind to port if it is not already bound, since we may already own
 	// port capability from capability InitGenesis
-	if !k.IsBound(ctx, ccv.ProviderPortID) {
+	if !k.IsBound(ctx, portID) {
 		// CCV module binds to the provider port on InitChain
 		// and claims the returned capability
-		err := k.BindPort(ctx, ccv.ProviderPortID)
+		err := k.BindPort(ctx, portID)
 		if err != nil {
 			// If the binding fails, the chain MUST NOT start
 			panic(fmt.Errorf("could not claim port capability: %v", err))
@@ -27,6 +35,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	k.SetValidatorSetUpdateId(ctx, genState.ValsetUpdateId)
 	for _, v2h := range genState.ValsetUpdateIdToHeight {
 		k.SetValsetUpdateBlockHeight(ctx, v2h.ValsetUpdateId, v2h.Height)
+		if v2h.Timestamp != nil {
+			k.SetValsetUpdateTimestamp(ctx, v2h.ValsetUpdateId, *v2h.Timestamp)
+		}
 	}
 
 	for _, prop := range genState.ConsumerAdditionProposals {
@@ -65,10 +76,129 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 			k.SetChannelToChain(ctx, cs.ChannelId, chainID)
 			k.SetChainToChannel(ctx, chainID, cs.ChannelId)
 			k.SetInitChainHeight(ctx, chainID, cs.InitialHeight)
-			k.SetSlashAcks(ctx, cs.ChainId, cs.SlashDowntimeAck)
+			if cs.SlashAcks != nil {
+				k.setSlashAcks(ctx, chainID, *cs.SlashAcks)
+			} else {
+				k.SetSlashAcks(ctx, chainID, cs.SlashDowntimeAck)
+			}
 		} else {
 			k.AppendPendingVSCPackets(ctx, chainID, cs.PendingValsetChanges...)
 		}
+		k.SetSendSlashConfirmations(ctx, chainID, cs.SendSlashConfirmations)
+		k.SetSlashDoubleSigns(ctx, chainID, cs.SlashDoubleSigns)
+		k.SetPreferredRewardDenom(ctx, chainID, cs.PreferredRewardDenom)
+		k.SetDowntimeJailDuration(ctx, chainID, cs.DowntimeJailDuration)
+		k.SetConsumerChainPaused(ctx, chainID, cs.Paused)
+		k.SetTopN(ctx, chainID, cs.TopN)
+		if !cs.RewardsAllocation.Rewards.IsZero() {
+			k.SetConsumerRewardsAllocation(ctx, chainID, cs.RewardsAllocation)
+		}
+		for _, valAddr := range cs.OptedInValidators {
+			k.SetOptedIn(ctx, chainID, valAddr)
+		}
+		k.SetConsumerValSetUpdateId(ctx, chainID, cs.ConsumerValSetUpdateId)
+		for _, val := range cs.ConsumerValSet {
+			k.SetConsumerValidator(ctx, chainID, val)
+		}
+		for _, vscSendTimestamp := range cs.VscSendTimestamps {
+			k.SetVscSendTimestamp(ctx, chainID, vscSendTimestamp.VscId, vscSendTimestamp.Timestamp)
+		}
+		if cs.ClientInactiveTimestamp != nil {
+			k.SetClientInactiveTimestamp(ctx, chainID, *cs.ClientInactiveTimestamp)
+		}
+		allowlist, err := types.ParseValidatorList(cs.ValidatorAllowlist)
+		if err != nil {
+			panic(fmt.Errorf("invalid validator allowlist for consumer chain %s: %w", chainID, err))
+		}
+		k.SetValidatorAllowlist(ctx, chainID, allowlist)
+		denylist, err := types.ParseValidatorList(cs.ValidatorDenylist)
+		if err != nil {
+			panic(fmt.Errorf("invalid validator denylist for consumer chain %s: %w", chainID, err))
+		}
+		k.SetValidatorDenylist(ctx, chainID, denylist)
+		if cs.ValidatorListsUpdated {
+			k.SetValidatorListsUpdated(ctx, chainID)
+		}
+		if cs.GenesisHashExempt {
+			k.SetGenesisHashExempt(ctx, chainID)
+		}
+		softOptedOut, err := types.ParseValidatorList(cs.SoftOptedOutValidators)
+		if err != nil {
+			panic(fmt.Errorf("invalid soft opted out validators for consumer chain %s: %w", chainID, err))
+		}
+		for _, providerAddr := range softOptedOut {
+			k.SetSoftOptedOut(ctx, chainID, providerAddr)
+		}
+		if cs.ConsumerParameters != nil {
+			k.SetConsumerParameters(ctx, chainID, *cs.ConsumerParameters)
+		}
+		if cs.Metadata != nil {
+			k.SetConsumerMetadata(ctx, chainID, *cs.Metadata)
+		}
+		if cs.RewardsWindow != nil {
+			k.SetConsumerRewardsWindow(ctx, chainID, *cs.RewardsWindow)
+		}
+		for _, record := range cs.LastDowntimeInfractionHeights {
+			k.SetLastDowntimeInfractionHeight(ctx, chainID, *record.ProviderAddr, record.InfractionHeight)
+		}
+		if cs.SlashConfirmationSeq != 0 {
+			k.SetSlashConfirmationSeq(ctx, chainID, cs.SlashConfirmationSeq)
+		}
+		if cs.LastSentSequence != 0 {
+			k.SetLastSentSequence(ctx, chainID, cs.LastSentSequence)
+		}
+		if cs.LastAckedSequence != 0 {
+			k.SetLastAckedSequence(ctx, chainID, cs.LastAckedSequence)
+		}
+		if cs.LastConsumerActivity != nil {
+			k.SetLastConsumerActivity(ctx, chainID, *cs.LastConsumerActivity)
+		}
+		if cs.ClientExpiryWarningTimestamp != nil {
+			k.SetClientExpiryWarningTimestamp(ctx, chainID, *cs.ClientExpiryWarningTimestamp)
+		}
+	}
+
+	// The capabilities of the CCV channels are not part of the provider genesis: they are restored,
+	// together with their owners, by the capability module InitGenesis, which runs before this one.
+	// Make sure that the provider still owns them, since it could otherwise not close
+	// the CCV channels, e.g., when stopping a consumer chain.
+	for _, channelToChain := range k.GetAllChannelToChains(ctx) {
+		capName := host.ChannelCapabilityPath(portID, channelToChain.ChannelId)
+		if _, ok := k.scopedKeeper.GetCapability(ctx, capName); !ok {
+			// If the provider does not own the capability, the chain MUST NOT start
+			panic(fmt.Errorf("could not retrieve capability of CCV channel to consumer chain %s at: %s",
+				channelToChain.ChainId, capName))
+		}
+	}
+
+	for _, item := range genState.InitTimeoutTimestamps {
+		k.SetInitTimeoutTimestamp(ctx, item.ChainId, item.Timestamp)
+	}
+
+	for _, entry := range genState.SlashRetries {
+		k.SetSlashRetry(ctx, entry)
+	}
+
+	for _, entry := range genState.FailedSlashes {
+		k.SetFailedSlash(ctx, entry)
+	}
+
+	for _, channelID := range genState.InvalidatedChannelIds {
+		k.SetInvalidatedChannel(ctx, channelID)
+	}
+
+	for _, record := range genState.ValidatorJailRecords {
+		k.SetValidatorJailRecord(ctx, *record.ProviderAddr, record.VscId)
+	}
+
+	for _, held := range genState.HeldUnbondingOps {
+		for _, ubdOpIndex := range held.UnbondingOpsIndex {
+			k.SetUnbondingOpIndex(ctx, held.ChainId, ubdOpIndex.GetVscId(), ubdOpIndex.GetUnbondingOpIds())
+		}
+	}
+
+	for _, denom := range genState.ConsumerRewardDenoms {
+		k.SetConsumerRewardDenom(ctx, denom)
 	}
 
 	// Import key assignment state
@@ -88,6 +218,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 
 	k.SetParams(ctx, genState.Params)
 	k.InitializeSlashMeter(ctx)
+
+	// the genesis state is imported in the latest format, so no store migration is needed
+	k.SetStoreVersion(ctx, LatestStoreVersion())
 }
 
 // ExportGenesis returns the CCV provider module's exported genesis
@@ -119,10 +252,56 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 			if !found {
 				panic(fmt.Errorf("cannot find init height for consumer chain %s", chain.ChainId))
 			}
-			cs.SlashDowntimeAck = k.GetSlashAcks(ctx, chain.ChainId)
+			if sa := k.getSlashAcks(ctx, chain.ChainId); len(sa.Addresses) > 0 {
+				cs.SlashAcks = &sa
+			}
 		}
 
 		cs.PendingValsetChanges = k.GetPendingVSCPackets(ctx, chain.ChainId)
+		cs.SendSlashConfirmations = k.GetSendSlashConfirmations(ctx, chain.ChainId)
+		cs.SlashDoubleSigns = k.GetSlashDoubleSigns(ctx, chain.ChainId)
+		cs.PreferredRewardDenom, _ = k.GetPreferredRewardDenom(ctx, chain.ChainId)
+		cs.DowntimeJailDuration, _ = k.GetDowntimeJailDuration(ctx, chain.ChainId)
+		cs.Paused = k.IsConsumerChainPaused(ctx, chain.ChainId)
+		cs.TopN = k.GetTopN(ctx, chain.ChainId)
+		cs.RewardsAllocation = k.GetConsumerRewardsAllocation(ctx, chain.ChainId)
+		cs.OptedInValidators = k.GetAllOptedIn(ctx, chain.ChainId)
+		cs.ConsumerValSetUpdateId, _ = k.GetConsumerValSetUpdateId(ctx, chain.ChainId)
+		cs.ConsumerValSet = k.GetConsumerValSet(ctx, chain.ChainId)
+		cs.VscSendTimestamps = k.GetAllVscSendTimestamps(ctx, chain.ChainId)
+		if ts, found := k.GetClientInactiveTimestamp(ctx, chain.ChainId); found {
+			cs.ClientInactiveTimestamp = &ts
+		}
+		for _, providerAddr := range k.GetValidatorAllowlist(ctx, chain.ChainId) {
+			cs.ValidatorAllowlist = append(cs.ValidatorAllowlist, providerAddr.String())
+		}
+		for _, providerAddr := range k.GetValidatorDenylist(ctx, chain.ChainId) {
+			cs.ValidatorDenylist = append(cs.ValidatorDenylist, providerAddr.String())
+		}
+		cs.ValidatorListsUpdated = k.GetValidatorListsUpdated(ctx, chain.ChainId)
+		cs.GenesisHashExempt = k.IsGenesisHashExempt(ctx, chain.ChainId)
+		for _, providerAddr := range k.GetAllSoftOptedOut(ctx, chain.ChainId) {
+			cs.SoftOptedOutValidators = append(cs.SoftOptedOutValidators, providerAddr.String())
+		}
+		if params, found := k.GetConsumerParameters(ctx, chain.ChainId); found {
+			cs.ConsumerParameters = &params
+		}
+		if metadata, found := k.GetConsumerMetadata(ctx, chain.ChainId); found {
+			cs.Metadata = &metadata
+		}
+		if window, found := k.GetConsumerRewardsWindow(ctx, chain.ChainId); found {
+			cs.RewardsWindow = &window
+		}
+		cs.LastDowntimeInfractionHeights = k.GetAllLastDowntimeInfractionHeights(ctx, chain.ChainId)
+		cs.SlashConfirmationSeq, _ = k.GetSlashConfirmationSeq(ctx, chain.ChainId)
+		cs.LastSentSequence, _ = k.GetLastSentSequence(ctx, chain.ChainId)
+		cs.LastAckedSequence, _ = k.GetLastAckedSequence(ctx, chain.ChainId)
+		if activity, found := k.GetLastConsumerActivity(ctx, chain.ChainId); found {
+			cs.LastConsumerActivity = &activity
+		}
+		if ts, found := k.GetClientExpiryWarningTimestamp(ctx, chain.ChainId); found {
+			cs.ClientExpiryWarningTimestamp = &ts
+		}
 		consumerStates = append(consumerStates, cs)
 
 	}
@@ -135,7 +314,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 
 	params := k.GetParams(ctx)
 
-	return types.NewGenesisState(
+	genState := types.NewGenesisState(
 		k.GetValidatorSetUpdateId(ctx),
 		k.GetAllValsetUpdateBlockHeights(ctx),
 		consumerStates,
@@ -148,4 +327,48 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		k.GetAllValidatorsByConsumerAddr(ctx, nil),
 		consumerAddrsToPrune,
 	)
+	genState.InitTimeoutTimestamps = k.GetAllInitTimeoutTimestamps(ctx)
+	genState.SlashRetries = k.GetAllSlashRetries(ctx, nil)
+	genState.FailedSlashes = k.GetAllFailedSlashes(ctx, nil)
+	genState.InvalidatedChannelIds = k.GetAllInvalidatedChannels(ctx)
+	genState.ValidatorJailRecords = k.GetAllValidatorJailRecords(ctx)
+	genState.ConsumerRewardDenoms = k.GetAllConsumerRewardDenoms(ctx)
+	genState.HeldUnbondingOps = k.getAllHeldUnbondingOps(ctx, registeredChains)
+	// the port is only exported if it is not the default provider port
+	if portID := k.GetPort(ctx); portID != ccv.ProviderPortID {
+		genState.PortId = portID
+	}
+
+	return genState
+}
+
+// getAllHeldUnbondingOps returns, in ascending order of chain IDs, the unbonding op indexes of the
+// consumer chains, other than the given registered ones, that unbonding operations still wait on,
+// i.e., of the consumer chains stopped after a packet timeout, see StopTimedOutConsumerChain
+func (k Keeper) getAllHeldUnbondingOps(ctx sdk.Context, registeredChains []types.Chain) (heldUnbondingOps []types.HeldUnbondingOps) {
+	registered := map[string]bool{}
+	for _, chain := range registeredChains {
+		registered[chain.ChainId] = true
+	}
+	held := map[string]bool{}
+	for _, ubdOp := range k.GetAllUnbondingOps(ctx) {
+		for _, chainID := range ubdOp.UnbondingConsumerChains {
+			if !registered[chainID] {
+				held[chainID] = true
+			}
+		}
+	}
+	chainIDs := make([]string, 0, len(held))
+	for chainID := range held {
+		chainIDs = append(chainIDs, chainID)
+	}
+	sort.Strings(chainIDs)
+
+	for _, chainID := range chainIDs {
+		heldUnbondingOps = append(heldUnbondingOps, types.HeldUnbondingOps{
+			ChainId:           chainID,
+			UnbondingOpsIndex: k.GetAllUnbondingOpIndexes(ctx, chainID),
+		})
+	}
+	return heldUnbondingOps
 }
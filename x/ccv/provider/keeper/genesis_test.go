@@ -5,6 +5,8 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 	"github.com/cosmos/interchain-security/testutil/crypto"
 	testkeeper "github.com/cosmos/interchain-security/testutil/keeper"
@@ -15,6 +17,7 @@ import (
 	ccv "github.com/cosmos/interchain-security/x/ccv/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 // TestInitAndExportGenesis tests the export and the initialisation of a provider chain genesis
@@ -49,7 +52,10 @@ func TestInitAndExportGenesis(t *testing.T) {
 					{VscId: vscID, UnbondingOpIds: ubdIndex},
 				},
 				[]ccv.ValidatorSetChangePacketData{},
-				[]string{"slashedValidatorConsAddress"},
+				&providertypes.SlashAcks{
+					Addresses:   []string{"slashedValidatorConsAddress"},
+					Infractions: []stakingtypes.InfractionType{stakingtypes.Downtime},
+				},
 			),
 			providertypes.NewConsumerStates(
 				cChainIDs[1],
@@ -65,6 +71,7 @@ func TestInitAndExportGenesis(t *testing.T) {
 		[]providertypes.UnbondingOp{{
 			Id:                      vscID,
 			UnbondingConsumerChains: []string{cChainIDs[0]},
+			Balance:                 sdk.NewInt(100),
 		}},
 		&ccv.MaturedUnbondingOps{Ids: ubdIndex},
 		[]providertypes.ConsumerAdditionProposal{{
@@ -107,6 +114,9 @@ func TestInitAndExportGenesis(t *testing.T) {
 		mocks.MockScopedKeeper.EXPECT().GetCapability(
 			ctx, host.PortPath(ccv.ProviderPortID),
 		).Return(nil, true).Times(1),
+		mocks.MockScopedKeeper.EXPECT().GetCapability(
+			ctx, host.ChannelCapabilityPath(ccv.ProviderPortID, "channel"),
+		).Return(&capabilitytypes.Capability{}, true).Times(1),
 		mocks.MockStakingKeeper.EXPECT().GetLastTotalPower(
 			ctx).Return(sdk.NewInt(100)).Times(1), // Return total voting power as 100
 	)
@@ -114,6 +124,9 @@ func TestInitAndExportGenesis(t *testing.T) {
 	// init provider chain
 	pk.InitGenesis(ctx, provGenesis)
 
+	// Expect the store to be at the latest store version
+	require.Equal(t, keeper.LatestStoreVersion(), pk.GetStoreVersion(ctx))
+
 	// Expect slash meter to be initialized to it's allowance value
 	// (replenish fraction * mocked value defined above)
 	slashMeter := pk.GetSlashMeter(ctx)
@@ -198,6 +211,325 @@ func assertConsumerChainStates(ctx sdk.Context, t *testing.T, pk keeper.Keeper,
 			require.Equal(t, ubdOpIdx.UnbondingOpIds, ubdIndex)
 		}
 
-		require.Equal(t, cs.SlashDowntimeAck, pk.GetSlashAcks(ctx, chainID))
+		if cs.SlashAcks != nil {
+			require.Equal(t, cs.SlashAcks.Addresses, pk.GetSlashAcks(ctx, chainID))
+			require.Equal(t, cs.SlashAcks.Infractions, pk.GetSlashAckInfractions(ctx, chainID))
+		} else {
+			require.Empty(t, pk.GetSlashAcks(ctx, chainID))
+		}
+	}
+}
+
+// TestExportAndReimportGenesis tests that the provider state exported from a keeper
+// is fully restored when the exported genesis is imported into a fresh keeper
+func TestExportAndReimportGenesis(t *testing.T) {
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	chainIDs := []string{"c0", "c1"}
+	vscID := uint64(5)
+	now := time.Now().UTC()
+
+	valA := crypto.NewCryptoIdentityFromIntSeed(1)
+	valB := crypto.NewCryptoIdentityFromIntSeed(2)
+	valBConsumer := crypto.NewCryptoIdentityFromIntSeed(3)
+	consumerAddrB := valBConsumer.ConsumerConsAddress()
+
+	// set representative state on the provider, with a chain that established the CCV channel
+	// and a chain that is still waiting for it
+	pk.SetValidatorSetUpdateId(ctx, vscID)
+	pk.SetValsetUpdateBlockHeight(ctx, vscID, 10)
+	pk.SetValsetUpdateTimestamp(ctx, vscID, now)
+	pk.SetParams(ctx, providertypes.DefaultParams())
+	pk.SetUnbondingOp(ctx, providertypes.UnbondingOp{
+		Id:                      1,
+		UnbondingConsumerChains: []string{chainIDs[0]},
+		Balance:                 sdk.NewInt(100),
+	})
+	// the unbonding operation 2 is held for a consumer chain stopped after a packet timeout
+	pk.SetUnbondingOp(ctx, providertypes.UnbondingOp{
+		Id:                      2,
+		UnbondingConsumerChains: []string{"stopped"},
+		Balance:                 sdk.NewInt(100),
+	})
+	pk.SetUnbondingOpIndex(ctx, "stopped", vscID, []uint64{2})
+	pk.SetPendingConsumerAdditionProp(ctx, &providertypes.ConsumerAdditionProposal{ChainId: "c2", SpawnTime: now.Add(time.Hour)})
+	pk.SetPendingConsumerRemovalProp(ctx, &providertypes.ConsumerRemovalProposal{ChainId: chainIDs[0], StopTime: now.Add(time.Hour)})
+
+	for _, chainID := range chainIDs {
+		pk.SetConsumerClientId(ctx, chainID, "client-"+chainID)
+		require.NoError(t, pk.SetConsumerGenesis(ctx, chainID, *consumertypes.DefaultGenesisState()))
+	}
+
+	pk.SetChannelToChain(ctx, "channel-0", chainIDs[0])
+	pk.SetChainToChannel(ctx, chainIDs[0], "channel-0")
+	pk.SetInitChainHeight(ctx, chainIDs[0], 3)
+	pk.AppendSlashAck(ctx.WithBlockTime(now), chainIDs[0], consumerAddrB.String(), stakingtypes.DoubleSign)
+	pk.SetUnbondingOpIndex(ctx, chainIDs[0], vscID, []uint64{1})
+	pk.SetSendSlashConfirmations(ctx, chainIDs[0], true)
+	pk.SetSlashDoubleSigns(ctx, chainIDs[0], true)
+	pk.SetConsumerChainPaused(ctx, chainIDs[0], true)
+	pk.SetLastDowntimeInfractionHeight(ctx, chainIDs[0], valA.ProviderConsAddress(), 12)
+	pk.SetPreferredRewardDenom(ctx, chainIDs[0], "uatom")
+	pk.SetDowntimeJailDuration(ctx, chainIDs[0], 24*time.Hour)
+	pk.SetTopN(ctx, chainIDs[0], 2)
+	pk.SetConsumerMetadata(ctx, chainIDs[0], providertypes.ConsumerMetadata{Name: "FooChain"})
+	pk.AddConsumerRewardsAllocation(ctx, chainIDs[0], sdk.NewCoins(sdk.NewInt64Coin("uatom", 10)))
+	pk.SetOptedIn(ctx, chainIDs[0], valA.SDKValOpAddress())
+	pk.SetValidatorAllowlist(ctx, chainIDs[0], []providertypes.ProviderConsAddress{valA.ProviderConsAddress(), valB.ProviderConsAddress()})
+	pk.SetValidatorDenylist(ctx, chainIDs[1], []providertypes.ProviderConsAddress{valA.ProviderConsAddress()})
+	pk.SetValidatorListsUpdated(ctx, chainIDs[1])
+	pk.SetGenesisHashExempt(ctx, chainIDs[1])
+	pk.SetConsumerParameters(ctx, chainIDs[0], providertypes.ConsumerParameters{
+		ConsumerRedistributeFraction: "0.5",
+		SoftOptOutThreshold:          "0.05",
+	})
+	pk.SetValidatorConsumerPubKey(ctx, chainIDs[0], valB.ProviderConsAddress(), valBConsumer.TMProtoCryptoPublicKey())
+	pk.SetValidatorByConsumerAddr(ctx, chainIDs[0], consumerAddrB, valB.ProviderConsAddress())
+	pk.AppendConsumerAddrsToPrune(ctx, chainIDs[0], vscID, consumerAddrB)
+	pk.SetConsumerValSet(ctx, chainIDs[0], vscID, []abci.ValidatorUpdate{
+		{PubKey: valA.TMProtoCryptoPublicKey(), Power: 1},
+		{PubKey: valBConsumer.TMProtoCryptoPublicKey(), Power: 2},
+	})
+	pk.SetVscSendTimestamp(ctx, chainIDs[0], vscID, now)
+	pk.SetClientInactiveTimestamp(ctx, chainIDs[0], now)
+	pk.SetSlashConfirmationSeq(ctx, chainIDs[0], 7)
+	pk.SetLastSentSequence(ctx, chainIDs[0], 9)
+	pk.SetLastAckedSequence(ctx, chainIDs[0], 8)
+	pk.SetLastConsumerActivity(ctx, chainIDs[0], providertypes.ConsumerActivity{Height: 5, Time: now})
+	pk.SetClientExpiryWarningTimestamp(ctx, chainIDs[0], now)
+	pk.SetSlashRetry(ctx, providertypes.SlashRetry{
+		ChainId: chainIDs[0],
+		Data:    *ccv.NewSlashPacketData(abci.Validator{Address: valA.SDKValConsAddress()}, vscID, stakingtypes.Downtime),
+	})
+	pk.SetFailedSlash(ctx, providertypes.SlashRetry{
+		ChainId: chainIDs[0],
+		Data:    *ccv.NewSlashPacketData(abci.Validator{Address: consumerAddrB.ToSdkConsAddr()}, vscID, stakingtypes.Downtime),
+		Retries: 3,
+	})
+
+	pk.AppendPendingVSCPackets(ctx, chainIDs[1], ccv.ValidatorSetChangePacketData{ValsetUpdateId: vscID})
+	pk.SetInitTimeoutTimestamp(ctx, chainIDs[1], uint64(now.UnixNano()))
+	pk.SetInvalidatedChannel(ctx, "channel-1")
+	pk.SetValidatorJailRecord(ctx, valA.ProviderConsAddress(), vscID)
+	pk.SetConsumerRewardDenom(ctx, "ibc/denom")
+
+	exported := pk.ExportGenesis(ctx)
+
+	// the state added to the genesis is exported
+	require.Len(t, exported.ConsumerStates, 2)
+	require.Len(t, exported.ValsetUpdateIdToHeight, 1)
+	require.Equal(t, now, *exported.ValsetUpdateIdToHeight[0].Timestamp)
+	cs := exported.ConsumerStates[0]
+	require.True(t, cs.SendSlashConfirmations)
+	require.True(t, cs.SlashDoubleSigns)
+	require.True(t, cs.Paused)
+	require.Len(t, cs.LastDowntimeInfractionHeights, 1)
+	require.Empty(t, exported.ConsumerStates[1].LastDowntimeInfractionHeights)
+	require.Equal(t, "uatom", cs.PreferredRewardDenom)
+	require.Equal(t, 24*time.Hour, cs.DowntimeJailDuration)
+	require.Equal(t, uint32(2), cs.TopN)
+	require.Equal(t, &providertypes.SlashAcks{
+		Addresses:      []string{consumerAddrB.String()},
+		Infractions:    []stakingtypes.InfractionType{stakingtypes.DoubleSign},
+		BatchStartTime: &now,
+	}, cs.SlashAcks)
+	require.Nil(t, exported.ConsumerStates[1].SlashAcks)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 10)), cs.RewardsAllocation.Rewards)
+	require.NotNil(t, cs.RewardsWindow)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 10)), cs.RewardsWindow.Received)
+	require.Len(t, cs.OptedInValidators, 1)
+	require.Equal(t, vscID, cs.ConsumerValSetUpdateId)
+	require.Len(t, cs.ConsumerValSet, 2)
+	require.Len(t, cs.VscSendTimestamps, 1)
+	require.NotNil(t, cs.ClientInactiveTimestamp)
+	require.Equal(t, uint64(7), cs.SlashConfirmationSeq)
+	require.Zero(t, exported.ConsumerStates[1].SlashConfirmationSeq)
+	require.Equal(t, uint64(9), cs.LastSentSequence)
+	require.Equal(t, uint64(8), cs.LastAckedSequence)
+	require.Zero(t, exported.ConsumerStates[1].LastSentSequence)
+	require.Equal(t, &providertypes.ConsumerActivity{Height: 5, Time: now}, cs.LastConsumerActivity)
+	require.Nil(t, exported.ConsumerStates[1].LastConsumerActivity)
+	require.Equal(t, now, *cs.ClientExpiryWarningTimestamp)
+	require.Nil(t, exported.ConsumerStates[1].ClientExpiryWarningTimestamp)
+	require.False(t, cs.ValidatorListsUpdated)
+	require.True(t, exported.ConsumerStates[1].ValidatorListsUpdated)
+	require.False(t, cs.GenesisHashExempt)
+	require.True(t, exported.ConsumerStates[1].GenesisHashExempt)
+	require.NotNil(t, cs.ConsumerParameters)
+	require.Nil(t, exported.ConsumerStates[1].ConsumerParameters)
+	require.Equal(t, &providertypes.ConsumerMetadata{Name: "FooChain"}, cs.Metadata)
+	require.Nil(t, exported.ConsumerStates[1].Metadata)
+	require.Len(t, exported.InitTimeoutTimestamps, 1)
+	require.Len(t, exported.SlashRetries, 1)
+	require.Len(t, exported.FailedSlashes, 1)
+	require.Equal(t, []string{"channel-1"}, exported.InvalidatedChannelIds)
+	require.Len(t, exported.ValidatorJailRecords, 1)
+	require.Equal(t, []string{"ibc/denom"}, exported.ConsumerRewardDenoms)
+	require.Equal(t, []providertypes.HeldUnbondingOps{{
+		ChainId:           "stopped",
+		UnbondingOpsIndex: []providertypes.VscUnbondingOps{{VscId: vscID, UnbondingOpIds: []uint64{2}}},
+	}}, exported.HeldUnbondingOps)
+
+	// import the exported genesis into a fresh provider keeper
+	freshPk, freshCtx, freshCtrl, freshMocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer freshCtrl.Finish()
+	gomock.InOrder(
+		freshMocks.MockScopedKeeper.EXPECT().GetCapability(
+			freshCtx, host.PortPath(ccv.ProviderPortID),
+		).Return(nil, true).Times(1),
+		freshMocks.MockScopedKeeper.EXPECT().GetCapability(
+			freshCtx, host.ChannelCapabilityPath(ccv.ProviderPortID, "channel-0"),
+		).Return(&capabilitytypes.Capability{}, true).Times(1),
+		freshMocks.MockStakingKeeper.EXPECT().GetLastTotalPower(
+			freshCtx).Return(sdk.NewInt(100)).Times(1),
+	)
+	freshPk.InitGenesis(freshCtx, exported)
+
+	require.Equal(t, exported, freshPk.ExportGenesis(freshCtx))
+	require.Equal(t, pk.GetConsumerValSet(ctx, chainIDs[0]), freshPk.GetConsumerValSet(freshCtx, chainIDs[0]))
+	require.Equal(t, pk.GetAllOptedIn(ctx, chainIDs[0]), freshPk.GetAllOptedIn(freshCtx, chainIDs[0]))
+	require.Equal(t, uint64(1), freshPk.GetPacketSequenceGap(freshCtx, chainIDs[0]))
+	require.Equal(t, pk.GetSlashAckInfractions(ctx, chainIDs[0]), freshPk.GetSlashAckInfractions(freshCtx, chainIDs[0]))
+}
+
+// TestInitGenesisChannelCapabilities tests that importing a provider genesis verifies that
+// the provider owns the capabilities of the established CCV channels, as restored by the
+// capability module, and panics otherwise
+func TestInitGenesisChannelCapabilities(t *testing.T) {
+	genState := providertypes.NewGenesisState(0, nil,
+		[]providertypes.ConsumerState{
+			providertypes.NewConsumerStates("c0", "client-0", "channel-0", 1,
+				*consumertypes.DefaultGenesisState(), nil, nil, nil),
+			providertypes.NewConsumerStates("c1", "client-1", "channel-1", 1,
+				*consumertypes.DefaultGenesisState(), nil, nil, nil),
+			// the CCV channel to c2 is not yet established
+			providertypes.NewConsumerStates("c2", "client-2", "", 0,
+				*consumertypes.DefaultGenesisState(), nil, nil, nil),
+		},
+		nil, nil, nil, nil, providertypes.DefaultParams(), nil, nil, nil,
+	)
+
+	testCases := []struct {
+		name      string
+		ownedCaps map[string]bool
+		expPanic  bool
+	}{
+		{"all channel capabilities owned", map[string]bool{"channel-0": true, "channel-1": true}, false},
+		{"channel capability not owned", map[string]bool{"channel-0": true, "channel-1": false}, true},
+	}
+	for _, tc := range testCases {
+		pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+
+		orderedCalls := []*gomock.Call{
+			mocks.MockScopedKeeper.EXPECT().GetCapability(
+				ctx, host.PortPath(ccv.ProviderPortID),
+			).Return(&capabilitytypes.Capability{}, true).Times(1),
+		}
+		// the channel capabilities are looked up in ascending order of channel IDs
+		for _, channelID := range []string{"channel-0", "channel-1"} {
+			orderedCalls = append(orderedCalls, mocks.MockScopedKeeper.EXPECT().GetCapability(
+				ctx, host.ChannelCapabilityPath(ccv.ProviderPortID, channelID),
+			).Return(&capabilitytypes.Capability{}, tc.ownedCaps[channelID]).Times(1))
+		}
+		if !tc.expPanic {
+			orderedCalls = append(orderedCalls,
+				mocks.MockStakingKeeper.EXPECT().GetLastTotalPower(ctx).Return(sdk.NewInt(100)).Times(1))
+		}
+		gomock.InOrder(orderedCalls...)
+
+		if tc.expPanic {
+			require.Panics(t, func() { pk.InitGenesis(ctx, genState) }, tc.name)
+			ctrl.Finish()
+			continue
+		}
+		pk.InitGenesis(ctx, genState)
+		for i, channelID := range []string{"channel-0", "channel-1"} {
+			chainID, found := pk.GetChannelToChain(ctx, channelID)
+			require.True(t, found, tc.name)
+			require.Equal(t, genState.ConsumerStates[i].ChainId, chainID, tc.name)
+		}
+		ctrl.Finish()
+	}
+}
+
+// TestExportAndReimportKeyAssignments tests that the consumer keys assigned by several validators
+// on multiple consumer chains, as well as their reverse index, are restored when the exported
+// genesis is imported into a fresh keeper
+func TestExportAndReimportKeyAssignments(t *testing.T) {
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	pk.SetParams(ctx, providertypes.DefaultParams())
+
+	chainIDs := []string{"c0", "c1", "c2"}
+	for _, chainID := range chainIDs {
+		pk.SetConsumerClientId(ctx, chainID, "client-"+chainID)
+		require.NoError(t, pk.SetConsumerGenesis(ctx, chainID, *consumertypes.DefaultGenesisState()))
+	}
+
+	type assignment struct {
+		chainID      string
+		providerAddr providertypes.ProviderConsAddress
+		consumerKey  *crypto.CryptoIdentity
+	}
+	var assignments []assignment
+	seed := 100
+	for i := 0; i < 3; i++ {
+		val := crypto.NewCryptoIdentityFromIntSeed(i)
+		// validator i assigns a consumer key on the first i+1 consumer chains
+		for _, chainID := range chainIDs[:i+1] {
+			consumerKey := crypto.NewCryptoIdentityFromIntSeed(seed)
+			seed++
+			pk.SetValidatorConsumerPubKey(ctx, chainID, val.ProviderConsAddress(), consumerKey.TMProtoCryptoPublicKey())
+			pk.SetValidatorByConsumerAddr(ctx, chainID, consumerKey.ConsumerConsAddress(), val.ProviderConsAddress())
+			assignments = append(assignments, assignment{chainID, val.ProviderConsAddress(), consumerKey})
+		}
+	}
+	// the previous consumer key of validator 0 on c0 is still mapped until it is pruned
+	oldConsumerAddr := crypto.NewCryptoIdentityFromIntSeed(seed).ConsumerConsAddress()
+	pk.SetValidatorByConsumerAddr(ctx, chainIDs[0], oldConsumerAddr, assignments[0].providerAddr)
+	pk.AppendConsumerAddrsToPrune(ctx, chainIDs[0], 7, oldConsumerAddr)
+
+	exported := pk.ExportGenesis(ctx)
+	require.Len(t, exported.ValidatorConsumerPubkeys, len(assignments))
+	require.Len(t, exported.ValidatorsByConsumerAddr, len(assignments)+1)
+	require.Len(t, exported.ConsumerAddrsToPrune, 1)
+	require.NoError(t, providertypes.KeyAssignmentValidateBasic(exported.ValidatorConsumerPubkeys,
+		exported.ValidatorsByConsumerAddr, exported.ConsumerAddrsToPrune))
+
+	// import the exported genesis into a fresh provider keeper
+	freshPk, freshCtx, freshCtrl, freshMocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer freshCtrl.Finish()
+	gomock.InOrder(
+		freshMocks.MockScopedKeeper.EXPECT().GetCapability(
+			freshCtx, host.PortPath(ccv.ProviderPortID),
+		).Return(nil, true).Times(1),
+		freshMocks.MockStakingKeeper.EXPECT().GetLastTotalPower(
+			freshCtx).Return(sdk.NewInt(100)).Times(1),
+	)
+	freshPk.InitGenesis(freshCtx, exported)
+
+	for _, a := range assignments {
+		consumerKey, found := freshPk.GetValidatorConsumerPubKey(freshCtx, a.chainID, a.providerAddr)
+		require.True(t, found)
+		require.Equal(t, a.consumerKey.TMProtoCryptoPublicKey(), consumerKey)
+		providerAddr, found := freshPk.GetValidatorByConsumerAddr(freshCtx, a.chainID, a.consumerKey.ConsumerConsAddress())
+		require.True(t, found)
+		require.Equal(t, a.providerAddr, providerAddr)
+		// slash packets for the consumer address target the validator on the provider
+		require.Equal(t, a.providerAddr, freshPk.GetProviderAddrFromConsumerAddr(freshCtx, a.chainID, a.consumerKey.ConsumerConsAddress()))
 	}
+	// a consumer key is only mapped on the chain it was assigned on
+	_, found := freshPk.GetValidatorConsumerPubKey(freshCtx, chainIDs[2], assignments[0].providerAddr)
+	require.False(t, found)
+	providerAddr, found := freshPk.GetValidatorByConsumerAddr(freshCtx, chainIDs[0], oldConsumerAddr)
+	require.True(t, found)
+	require.Equal(t, assignments[0].providerAddr, providerAddr)
+	require.Equal(t, []*providertypes.ConsumerConsAddress{&oldConsumerAddr},
+		freshPk.GetConsumerAddrsToPrune(freshCtx, chainIDs[0], 7).Addresses)
+
+	reexported := freshPk.ExportGenesis(freshCtx)
+	require.Equal(t, exported.ValidatorConsumerPubkeys, reexported.ValidatorConsumerPubkeys)
+	require.Equal(t, exported.ValidatorsByConsumerAddr, reexported.ValidatorsByConsumerAddr)
+	require.Equal(t, exported.ConsumerAddrsToPrune, reexported.ConsumerAddrsToPrune)
 }
@@ -3,9 +3,12 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"sort"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/interchain-security/x/ccv/provider/types"
 	ccvtypes "github.com/cosmos/interchain-security/x/ccv/types"
 	"github.com/cosmos/interchain-security/x/ccv/utils"
@@ -28,10 +31,14 @@ func (k Keeper) QueryConsumerGenesis(c context.Context, req *types.QueryConsumer
 
 	gen, ok := k.GetConsumerGenesis(ctx, req.ChainId)
 	if !ok {
-		return nil, sdkerrors.Wrap(types.ErrUnknownConsumerChainId, req.ChainId)
+		return nil, sdkerrors.Wrapf(types.ErrUnknownConsumerChainId,
+			"no genesis stored for consumer chain %s; the consumer chain was either not created yet or stopped", req.ChainId)
 	}
 
-	return &types.QueryConsumerGenesisResponse{GenesisState: gen}, nil
+	// the init chain height is zero until the CCV channel is established
+	initChainHeight, _ := k.GetInitChainHeight(ctx, req.ChainId)
+
+	return &types.QueryConsumerGenesisResponse{GenesisState: gen, InitChainHeight: initChainHeight}, nil
 }
 
 func (k Keeper) QueryConsumerChains(goCtx context.Context, req *types.QueryConsumerChainsRequest) (*types.QueryConsumerChainsResponse, error) {
@@ -40,16 +47,20 @@ func (k Keeper) QueryConsumerChains(goCtx context.Context, req *types.QueryConsu
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	consumerChains, pageRes, err := k.GetConsumerChainsPaginated(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
 	// convert to array of pointers
 	chains := []*types.Chain{}
-	for _, chain := range k.GetAllConsumerChains(ctx) {
+	for _, chain := range consumerChains {
 		// prevent implicit memory aliasing
 		c := chain
 		chains = append(chains, &c)
 	}
 
-	return &types.QueryConsumerChainsResponse{Chains: chains}, nil
+	return &types.QueryConsumerChainsResponse{Chains: chains, Pagination: pageRes}, nil
 }
 
 func (k Keeper) QueryConsumerChainStarts(goCtx context.Context, req *types.QueryConsumerChainStartProposalsRequest) (*types.QueryConsumerChainStartProposalsResponse, error) {
@@ -215,6 +226,421 @@ func (k Keeper) QueryThrottledConsumerPacketData(goCtx context.Context, req *typ
 	}, nil
 }
 
+func (k Keeper) QueryChainHeldUnbondingValue(goCtx context.Context, req *types.QueryChainHeldUnbondingValueRequest) (*types.QueryChainHeldUnbondingValueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request: chain id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetConsumerClientId(ctx, req.ChainId); !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownConsumerChainId, req.ChainId)
+	}
+
+	return &types.QueryChainHeldUnbondingValueResponse{
+		ChainId: req.ChainId,
+		Value:   k.GetChainHeldUnbondingValue(ctx, req.ChainId),
+	}, nil
+}
+
+func (k Keeper) QueryConsumerUnbondingOps(goCtx context.Context, req *types.QueryConsumerUnbondingOpsRequest) (*types.QueryConsumerUnbondingOpsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request: chain id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetConsumerClientId(ctx, req.ChainId); !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownConsumerChainId, req.ChainId)
+	}
+
+	unbondingOps := k.GetUnbondingOpsFromIndex(ctx, req.ChainId, req.VscId)
+	if unbondingOps == nil {
+		unbondingOps = []types.UnbondingOp{}
+	}
+
+	// the creation height is left as zero if vscID is not mapped to a block height
+	creationHeight, _ := k.GetValsetUpdateBlockHeight(ctx, req.VscId)
+
+	return &types.QueryConsumerUnbondingOpsResponse{
+		ChainId:        req.ChainId,
+		VscId:          req.VscId,
+		CreationHeight: creationHeight,
+		UnbondingOps:   unbondingOps,
+	}, nil
+}
+
+func (k Keeper) QueryValsetUpdateBlockHeight(goCtx context.Context, req *types.QueryValsetUpdateBlockHeightRequest) (*types.QueryValsetUpdateBlockHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	height, found := k.GetValsetUpdateBlockHeight(ctx, req.VscId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no block height found for valset update id %d", req.VscId)
+	}
+
+	return &types.QueryValsetUpdateBlockHeightResponse{
+		VscId:  req.VscId,
+		Height: height,
+	}, nil
+}
+
+func (k Keeper) QueryValsetUpdateHeightRange(goCtx context.Context, req *types.QueryValsetUpdateHeightRangeRequest) (*types.QueryValsetUpdateHeightRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	start, end, found := k.GetValsetUpdateHeightRange(ctx, req.VscId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no block height found for valset update id %d", req.VscId)
+	}
+
+	return &types.QueryValsetUpdateHeightRangeResponse{
+		VscId:       req.VscId,
+		StartHeight: start,
+		EndHeight:   end,
+	}, nil
+}
+
+func (k Keeper) QueryValidatorSetUpdateId(goCtx context.Context, req *types.QueryValidatorSetUpdateIdRequest) (*types.QueryValidatorSetUpdateIdResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	valUpdateID := k.GetValidatorSetUpdateId(ctx)
+	// the height is left as zero if the valset update id is not yet mapped to a block height
+	height, _ := k.GetValsetUpdateBlockHeight(ctx, valUpdateID)
+
+	return &types.QueryValidatorSetUpdateIdResponse{
+		ValsetUpdateId: valUpdateID,
+		Height:         height,
+	}, nil
+}
+
+func (k Keeper) QueryConsumerRewardsAllocation(goCtx context.Context, req *types.QueryConsumerRewardsAllocationRequest) (*types.QueryConsumerRewardsAllocationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request: chain id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetConsumerClientId(ctx, req.ChainId); !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownConsumerChainId, req.ChainId)
+	}
+
+	preferredRewardDenom, _ := k.GetPreferredRewardDenom(ctx, req.ChainId)
+
+	return &types.QueryConsumerRewardsAllocationResponse{
+		ChainId:              req.ChainId,
+		Rewards:              k.GetConsumerRewardsAllocation(ctx, req.ChainId).Rewards,
+		PreferredRewardDenom: preferredRewardDenom,
+		LabelledRewards:      k.GetLabelledConsumerRewardsAllocation(ctx, req.ChainId),
+	}, nil
+}
+
+func (k Keeper) QueryConsumerRewardCompliance(goCtx context.Context, req *types.QueryConsumerRewardComplianceRequest) (*types.QueryConsumerRewardComplianceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request: chain id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetConsumerClientId(ctx, req.ChainId); !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownConsumerChainId, req.ChainId)
+	}
+
+	// the rewards window is left empty if the CCV channel is not yet established
+	window, _ := k.GetConsumerRewardsWindow(ctx, req.ChainId)
+	shortfall := k.GetConsumerRewardsShortfall(ctx, req.ChainId)
+
+	return &types.QueryConsumerRewardComplianceResponse{
+		ChainId:                  req.ChainId,
+		ExpectedRewardsPerWindow: k.GetConsumerChainExpectedRewards(ctx, req.ChainId),
+		RewardsWindow:            window,
+		Shortfall:                shortfall,
+		Compliant:                shortfall.IsZero(),
+	}, nil
+}
+
+func (k Keeper) QueryConsumerPacketStatus(goCtx context.Context, req *types.QueryConsumerPacketStatusRequest) (*types.QueryConsumerPacketStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request: chain id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetConsumerClientId(ctx, req.ChainId); !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownConsumerChainId, req.ChainId)
+	}
+
+	// the sequences are zero if no packet was sent or acknowledged yet
+	lastSent, _ := k.GetLastSentSequence(ctx, req.ChainId)
+	lastAcked, _ := k.GetLastAckedSequence(ctx, req.ChainId)
+
+	return &types.QueryConsumerPacketStatusResponse{
+		ChainId:           req.ChainId,
+		LastSentSequence:  lastSent,
+		LastAckedSequence: lastAcked,
+		Gap:               k.GetPacketSequenceGap(ctx, req.ChainId),
+	}, nil
+}
+
+func (k Keeper) QueryConsumerChainInfo(goCtx context.Context, req *types.QueryConsumerChainInfoRequest) (*types.QueryConsumerChainInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request: chain id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	clientID, clientFound := k.GetConsumerClientId(ctx, req.ChainId)
+	channelID, channelFound := k.GetChainToChannel(ctx, req.ChainId)
+	_, genesisFound := k.GetConsumerGenesis(ctx, req.ChainId)
+	if !clientFound && !channelFound && !genesisFound {
+		return nil, sdkerrors.Wrap(types.ErrUnknownConsumerChainId, req.ChainId)
+	}
+
+	info := &types.QueryConsumerChainInfoResponse{
+		ChainId:             req.ChainId,
+		ClientId:            clientID,
+		ChannelId:           channelID,
+		PendingSlashAcks:    uint64(len(k.GetSlashAcks(ctx, req.ChainId))),
+		PendingUnbondingOps: uint64(k.GetPendingUnbondingOpsCount(ctx, req.ChainId)),
+		HasConsumerGenesis:  genesisFound,
+		Paused:              k.IsConsumerChainPaused(ctx, req.ChainId),
+		TopN:                k.GetTopN(ctx, req.ChainId),
+	}
+	info.DowntimeJailDuration, _ = k.GetDowntimeJailDuration(ctx, req.ChainId)
+	if channelFound {
+		if channel, found := k.channelKeeper.GetChannel(ctx, k.GetPort(ctx), channelID); found {
+			info.ChannelState = channel.State.String()
+		}
+		info.InitChainHeight, _ = k.GetInitChainHeight(ctx, req.ChainId)
+	}
+
+	return info, nil
+}
+
+func (k Keeper) QueryConsumerChannels(goCtx context.Context, req *types.QueryConsumerChannelsRequest) (*types.QueryConsumerChannelsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	channelToChains, pageRes, err := k.GetChannelToChainsPaginated(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	channels := []*types.ConsumerChannel{}
+	for _, channelToChain := range channelToChains {
+		// the state of a channel that is not found is STATE_UNINITIALIZED_UNSPECIFIED
+		channel, _ := k.channelKeeper.GetChannel(ctx, k.GetPort(ctx), channelToChain.ChannelId)
+		channels = append(channels, &types.ConsumerChannel{
+			ChannelId: channelToChain.ChannelId,
+			ChainId:   channelToChain.ChainId,
+			State:     channel.State.String(),
+		})
+	}
+
+	return &types.QueryConsumerChannelsResponse{Channels: channels, Pagination: pageRes}, nil
+}
+
+func (k Keeper) QueryConsumerTotalPower(goCtx context.Context, req *types.QueryConsumerTotalPowerRequest) (*types.QueryConsumerTotalPowerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request: chain id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	valsetUpdateID, found := k.GetConsumerValSetUpdateId(ctx, req.ChainId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no validator set sent to consumer chain %s", req.ChainId)
+	}
+
+	return &types.QueryConsumerTotalPowerResponse{
+		ChainId:            req.ChainId,
+		ValsetUpdateId:     valsetUpdateID,
+		ConsumerTotalPower: k.GetConsumerTotalPower(ctx, req.ChainId),
+		ProviderTotalPower: k.stakingKeeper.GetLastTotalPower(ctx),
+	}, nil
+}
+
+func (k Keeper) QueryConsumerLiveness(goCtx context.Context, req *types.QueryConsumerLivenessRequest) (*types.QueryConsumerLivenessResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request: chain id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	lastActivity, found := k.GetLastConsumerActivity(ctx, req.ChainId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no activity recorded for consumer chain %s", req.ChainId)
+	}
+
+	return &types.QueryConsumerLivenessResponse{
+		ChainId:          req.ChainId,
+		LastActivity:     lastActivity,
+		InactiveDuration: ctx.BlockTime().Sub(lastActivity.Time),
+		Inactive:         k.IsConsumerInactive(ctx, lastActivity),
+	}, nil
+}
+
+func (k Keeper) QueryConsumerMetadata(goCtx context.Context, req *types.QueryConsumerMetadataRequest) (*types.QueryConsumerMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request: chain id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	metadata, found := k.GetConsumerMetadata(ctx, req.ChainId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no metadata found for consumer chain %s", req.ChainId)
+	}
+
+	return &types.QueryConsumerMetadataResponse{
+		ChainId:  req.ChainId,
+		Metadata: metadata,
+	}, nil
+}
+
+func (k Keeper) QueryOptedInValidators(goCtx context.Context, req *types.QueryOptedInValidatorsRequest) (*types.QueryOptedInValidatorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request: chain id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	validatorAddresses := []string{}
+	for _, valAddr := range k.GetConsumerValidators(ctx, req.ChainId) {
+		validatorAddresses = append(validatorAddresses, valAddr.String())
+	}
+
+	return &types.QueryOptedInValidatorsResponse{
+		ChainId:            req.ChainId,
+		ValidatorAddresses: validatorAddresses,
+	}, nil
+}
+
+func (k Keeper) QueryConsumerClientId(goCtx context.Context, req *types.QueryConsumerClientIdRequest) (*types.QueryConsumerClientIdResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request: chain id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	clientID, found := k.GetConsumerClientId(ctx, req.ChainId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no client found for consumer chain %s", req.ChainId)
+	}
+
+	return &types.QueryConsumerClientIdResponse{
+		ChainId:  req.ChainId,
+		ClientId: clientID,
+	}, nil
+}
+
+func (k Keeper) QueryPhaseSummary(goCtx context.Context, req *types.QueryPhaseSummaryRequest) (*types.QueryPhaseSummaryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryPhaseSummaryResponse{PhaseCounts: k.GetConsumerPhaseCounts(ctx)}, nil
+}
+
+func (k Keeper) QueryEffectiveConsumerParams(goCtx context.Context, req *types.QueryEffectiveConsumerParamsRequest) (*types.QueryEffectiveConsumerParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request: chain id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params, found := k.GetEffectiveConsumerParams(ctx, req.ChainId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "unknown consumer chain %s", req.ChainId)
+	}
+
+	return &types.QueryEffectiveConsumerParamsResponse{Params: params}, nil
+}
+
+func (k Keeper) QueryConsumerValidatorSet(goCtx context.Context, req *types.QueryConsumerValidatorSetRequest) (*types.QueryConsumerValidatorSetResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request: chain id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	valsetUpdateID, found := k.GetConsumerValSetUpdateId(ctx, req.ChainId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no validator set sent to consumer chain %s", req.ChainId)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChainIdWithLenKey(types.ConsumerValSetBytePrefix, req.ChainId))
+	var vals []types.ConsumerValidator
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var val types.ConsumerValidator
+		if err := val.Unmarshal(value); err != nil {
+			return err
+		}
+		vals = append(vals, val)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryConsumerValidatorSetResponse{
+		ChainId:        req.ChainId,
+		ValsetUpdateId: valsetUpdateID,
+		Validators:     vals,
+		Pagination:     pageRes,
+	}, nil
+}
+
 // getSlashPacketData fetches a slash packet data from the store using consumerChainId and ibcSeqNum (direct access)
 // If the returned bytes do not unmarshal to SlashPacketData, the data is considered not found.
 func (k Keeper) getSlashPacketData(ctx sdk.Context, consumerChainID string, ibcSeqNum uint64) (ccvtypes.SlashPacketData, bool) {
@@ -238,3 +664,106 @@ func (k Keeper) getSlashPacketData(ctx sdk.Context, consumerChainID string, ibcS
 
 	return packet, true
 }
+
+func (k Keeper) QueryConsumerInitHeight(goCtx context.Context, req *types.QueryConsumerInitHeightRequest) (*types.QueryConsumerInitHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request: chain id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	height, found := k.GetInitChainHeight(ctx, req.ChainId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound,
+			"no init chain height found for consumer chain %s; its CCV channel is not established", req.ChainId)
+	}
+
+	return &types.QueryConsumerInitHeightResponse{
+		ChainId: req.ChainId,
+		Height:  height,
+	}, nil
+}
+
+func (k Keeper) QuerySlashAcks(goCtx context.Context, req *types.QuerySlashAcksRequest) (*types.QuerySlashAcksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request: chain id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QuerySlashAcksResponse{
+		ChainId:   req.ChainId,
+		Addresses: k.GetSlashAcks(ctx, req.ChainId),
+	}, nil
+}
+
+func (k Keeper) QueryAllSlashAcks(goCtx context.Context, req *types.QueryAllSlashAcksRequest) (*types.QueryAllSlashAcksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	slashAcks := []types.QuerySlashAcksResponse{}
+	k.IterateSlashAcks(ctx, func(chainID string, acks []string) bool {
+		slashAcks = append(slashAcks, types.QuerySlashAcksResponse{
+			ChainId:   chainID,
+			Addresses: acks,
+		})
+		return false
+	})
+	// IterateSlashAcks already returns the slash acks in ascending order of chainIDs;
+	// the sorting guarantees the order of the response regardless of the store iteration
+	sort.SliceStable(slashAcks, func(i, j int) bool {
+		return slashAcks[i].ChainId < slashAcks[j].ChainId
+	})
+
+	return &types.QueryAllSlashAcksResponse{SlashAcks: slashAcks}, nil
+}
+
+func (k Keeper) QueryChainsBlockingUnbonding(goCtx context.Context, req *types.QueryChainsBlockingUnbondingRequest) (*types.QueryChainsBlockingUnbondingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	chainIDs := k.GetChainsBlockingUnbonding(ctx, req.UnbondingOpId)
+	if chainIDs == nil {
+		return nil, status.Errorf(codes.NotFound,
+			"no unbonding op found for id %d; it either completed or was not created", req.UnbondingOpId)
+	}
+
+	return &types.QueryChainsBlockingUnbondingResponse{
+		UnbondingOpId: req.UnbondingOpId,
+		ChainIds:      chainIDs,
+	}, nil
+}
+
+func (k Keeper) QueryRegisteredConsumerRewardDenoms(goCtx context.Context, req *types.QueryRegisteredConsumerRewardDenomsRequest) (*types.QueryRegisteredConsumerRewardDenomsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryRegisteredConsumerRewardDenomsResponse{
+		Denoms: k.GetAllConsumerRewardDenoms(ctx),
+	}, nil
+}
+
+func (k Keeper) QueryParams(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
@@ -2,11 +2,13 @@ package keeper
 
 import (
 	"fmt"
+	"strconv"
 
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	providertypes "github.com/cosmos/interchain-security/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/x/ccv/types"
 	"github.com/cosmos/interchain-security/x/ccv/utils"
 )
 
@@ -26,18 +28,29 @@ func (k *Keeper) Hooks() Hooks {
 func (h Hooks) AfterUnbondingInitiated(ctx sdk.Context, ID uint64) error {
 	var consumerChainIDS []string
 
+	maxUnbondingOps := h.k.GetMaxUnbondingOpsPerChain(ctx)
 	for _, chain := range h.k.GetAllConsumerChains(ctx) {
+		if maxUnbondingOps > 0 {
+			// Once a consumer chain reached the cap, new unbonding ops
+			// no longer wait for it, i.e., the chain is degraded
+			if count := h.k.GetPendingUnbondingOpsCount(ctx, chain.ChainId); int64(count) >= maxUnbondingOps {
+				h.k.handleUnbondingOpsCapExceeded(ctx, chain.ChainId, ID, count, maxUnbondingOps)
+				continue
+			}
+		}
 		consumerChainIDS = append(consumerChainIDS, chain.ChainId)
 	}
 
 	if len(consumerChainIDS) == 0 {
 		// Do not put the unbonding op on hold if there are no consumer chains
+		// or if all the consumer chains reached MaxUnbondingOpsPerChain
 		return nil
 	}
 	valsetUpdateID := h.k.GetValidatorSetUpdateId(ctx)
 	unbondingOp := providertypes.UnbondingOp{
 		Id:                      ID,
 		UnbondingConsumerChains: consumerChainIDS,
+		Balance:                 h.k.getUnbondingBalance(ctx, ID),
 	}
 
 	// Add to indexes
@@ -64,6 +77,71 @@ func (h Hooks) AfterUnbondingInitiated(ctx sdk.Context, ID uint64) error {
 	return nil
 }
 
+// handleUnbondingOpsCapExceeded emits an event, logs an error and increments the cap exceeded counter
+// when the unbonding op with the given ID does not wait for a consumer with chainID, since the chain reached
+// MaxUnbondingOpsPerChain. The unbonding op completes without a VSCMaturedPacket from the chain,
+// i.e., the tokens it unbonds may no longer be slashed for infractions committed on the chain.
+func (k Keeper) handleUnbondingOpsCapExceeded(ctx sdk.Context, chainID string, id uint64, count int, max int64) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			ccv.EventTypeUnbondingOpsCapExceeded,
+			sdk.NewAttribute(sdk.AttributeKeyModule, providertypes.ModuleName),
+			sdk.NewAttribute(ccv.AttributeChainID, chainID),
+			sdk.NewAttribute(ccv.AttributeUnbondingOpIDs, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(ccv.AttributePendingUnbondingOps, strconv.Itoa(count)),
+			sdk.NewAttribute(ccv.AttributeMaxUnbondingOpsPerChain, strconv.FormatInt(max, 10)),
+			sdk.NewAttribute(ccv.AttributeValSetUpdateID, strconv.FormatUint(k.GetValidatorSetUpdateId(ctx), 10)),
+		),
+	)
+	incrUnbondingOpsCapExceededCounter(chainID)
+	k.Logger(ctx).Error("unbonding op does not wait for consumer chain that reached the max number of pending unbonding ops;"+
+		" the unbonded tokens may no longer be slashed for infractions on the consumer chain",
+		"chainID", chainID,
+		"opID", id,
+		"pending unbonding ops", count,
+		"max unbonding ops per chain", max,
+		"vscID", k.GetValidatorSetUpdateId(ctx),
+	)
+}
+
+// getUnbondingBalance returns the token balance of the unbonding operation with the given ID,
+// i.e., the balance of the unbonding delegation entry, the initial balance of the
+// redelegation entry, or the tokens of the unbonding validator.
+// If the unbonding operation cannot be found in staking, a zero balance is returned.
+func (k Keeper) getUnbondingBalance(ctx sdk.Context, id uint64) sdk.Int {
+	unbondingType, found := k.stakingKeeper.GetUnbondingType(ctx, id)
+	if !found {
+		k.Logger(ctx).Error("unbonding type not found", "opID", id)
+		return sdk.ZeroInt()
+	}
+
+	switch unbondingType {
+	case stakingtypes.UnbondingType_UnbondingDelegation:
+		if ubd, found := k.stakingKeeper.GetUnbondingDelegationByUnbondingID(ctx, id); found {
+			for _, entry := range ubd.Entries {
+				if entry.UnbondingId == id {
+					return entry.Balance
+				}
+			}
+		}
+	case stakingtypes.UnbondingType_Redelegation:
+		if red, found := k.stakingKeeper.GetRedelegationByUnbondingID(ctx, id); found {
+			for _, entry := range red.Entries {
+				if entry.UnbondingId == id {
+					return entry.InitialBalance
+				}
+			}
+		}
+	case stakingtypes.UnbondingType_ValidatorUnbonding:
+		if val, found := k.stakingKeeper.GetValidatorByUnbondingID(ctx, id); found {
+			return val.GetTokens()
+		}
+	}
+
+	k.Logger(ctx).Error("unbonding entry not found", "opID", id, "type", unbondingType)
+	return sdk.ZeroInt()
+}
+
 // ValidatorConsensusKeyInUse is called when a new validator is created
 // in the x/staking module of cosmos-sdk. In case it panics, the TX aborts
 // and thus, the validator is not created. See AfterValidatorCreated hook.
@@ -82,16 +160,11 @@ func ValidatorConsensusKeyInUse(k *Keeper, ctx sdk.Context, valAddr sdk.ValAddre
 		panic("could not get validator cons addr ")
 	}
 
-	inUse := false
-
-	for _, validatorConsumerAddrs := range k.GetAllValidatorsByConsumerAddr(ctx, nil) {
-		if validatorConsumerAddrs.ConsumerAddr.ToSdkConsAddr().Equals(consensusAddr) {
-			inUse = true
-			break
-		}
-	}
-
-	return inUse
+	// the reverse index of the consumer addresses covers the addresses on all consumer chains,
+	// including the ones waiting to be pruned. Note that the index is only set by SetValidatorByConsumerAddr,
+	// so it is backfilled for the key assignments made before it existed by the store migration,
+	// see migrateParamsAndIndexes
+	return len(k.GetValidatorsByConsumerAddrOnAllChains(ctx, providertypes.NewConsumerConsAddress(consensusAddr))) > 0
 }
 
 func (h Hooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) {
@@ -101,6 +174,12 @@ func (h Hooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) {
 	}
 }
 
+// AfterValidatorRemoved removes the key assignment state of a validator that is removed from
+// the staking module, i.e., that fully unbonded, on every consumer chain, so that the assigned
+// consumer keys do not linger and can be assigned again. The consumer addresses of previously assigned
+// keys are kept until they are pruned, since they are still part of the ConsumerAddrsToPrune lists.
+// The validator is also removed from the validators that opted in to validate consumer chains,
+// and its jail record and last downtime infraction heights are deleted.
 func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, valConsAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
 	for _, validatorConsumerPubKey := range h.k.GetAllValidatorConsumerPubKeys(ctx, nil) {
 		if validatorConsumerPubKey.ProviderAddr.ToSdkConsAddr().Equals(valConsAddr) {
@@ -112,8 +191,15 @@ func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, valConsAddr sdk.ConsAddres
 			consumerAddr := providertypes.NewConsumerConsAddress(consumerAddrTmp)
 			h.k.DeleteValidatorByConsumerAddr(ctx, validatorConsumerPubKey.ChainId, consumerAddr)
 			h.k.DeleteValidatorConsumerPubKey(ctx, validatorConsumerPubKey.ChainId, *validatorConsumerPubKey.ProviderAddr)
+			h.k.DeleteKeyAssignmentReplacement(ctx, validatorConsumerPubKey.ChainId, *validatorConsumerPubKey.ProviderAddr)
 		}
 	}
+
+	for _, chain := range h.k.GetAllConsumerChains(ctx) {
+		h.k.DeleteLastDowntimeInfractionHeight(ctx, chain.ChainId, providertypes.NewProviderConsAddress(valConsAddr))
+	}
+	h.k.RemoveValidatorFromAllConsumers(ctx, valAddr)
+	h.k.DeleteValidatorJailRecord(ctx, providertypes.NewProviderConsAddress(valConsAddr))
 }
 
 func (h Hooks) BeforeDelegationCreated(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
@@ -132,3 +218,27 @@ func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _
 }
 func (h Hooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) {
 }
+
+//
+// Provider hooks, notifying the modules that observe the lifecycle of the consumer chains
+//
+
+var _ providertypes.ProviderHooks = Keeper{}
+
+func (k Keeper) AfterConsumerChainAdded(ctx sdk.Context, chainID string) {
+	if k.hooks != nil {
+		k.hooks.AfterConsumerChainAdded(ctx, chainID)
+	}
+}
+
+func (k Keeper) AfterChannelValidating(ctx sdk.Context, chainID, channelID string) {
+	if k.hooks != nil {
+		k.hooks.AfterChannelValidating(ctx, chainID, channelID)
+	}
+}
+
+func (k Keeper) AfterConsumerChainRemoved(ctx sdk.Context, chainID string) {
+	if k.hooks != nil {
+		k.hooks.AfterConsumerChainRemoved(ctx, chainID)
+	}
+}
@@ -4,10 +4,14 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	cryptotestutil "github.com/cosmos/interchain-security/testutil/crypto"
 	testkeeper "github.com/cosmos/interchain-security/testutil/keeper"
 	providerkeeper "github.com/cosmos/interchain-security/x/ccv/provider/keeper"
+	providertypes "github.com/cosmos/interchain-security/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/x/ccv/types"
 	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestValidatorConsensusKeyInUse(t *testing.T) {
@@ -75,3 +79,160 @@ func TestValidatorConsensusKeyInUse(t *testing.T) {
 		})
 	}
 }
+
+// TestAfterValidatorRemoved tests that the key assignments of a removed validator are deleted
+// on every consumer chain, and that its consumer key can then be assigned by another validator
+func TestAfterValidatorRemoved(t *testing.T) {
+	k, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	removedValidator := cryptotestutil.NewCryptoIdentityFromIntSeed(0)
+	otherValidator := cryptotestutil.NewCryptoIdentityFromIntSeed(1)
+	consumerKey := cryptotestutil.NewCryptoIdentityFromIntSeed(2)
+	chainIDs := []string{"chainid0", "chainid1"}
+
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx,
+		consumerKey.SDKValConsAddress(),
+	).Return(stakingtypes.Validator{}, false).AnyTimes()
+
+	// the validator assigns the same consumer key on both consumer chains, and opts in to validate them
+	for _, chainID := range chainIDs {
+		require.NoError(t, k.AssignConsumerKey(ctx, chainID,
+			removedValidator.SDKStakingValidator(), consumerKey.TMProtoCryptoPublicKey()))
+		k.SetOptedIn(ctx, chainID, removedValidator.SDKValOpAddress())
+	}
+	// the consumer key cannot be assigned by another validator while in use
+	require.Error(t, k.AssignConsumerKey(ctx, chainIDs[0],
+		otherValidator.SDKStakingValidator(), consumerKey.TMProtoCryptoPublicKey()))
+
+	k.Hooks().AfterValidatorRemoved(ctx, removedValidator.SDKValConsAddress(), removedValidator.SDKValOpAddress())
+
+	for _, chainID := range chainIDs {
+		_, found := k.GetValidatorConsumerPubKey(ctx, chainID, removedValidator.ProviderConsAddress())
+		require.False(t, found)
+		_, found = k.GetValidatorByConsumerAddr(ctx, chainID, consumerKey.ConsumerConsAddress())
+		require.False(t, found)
+		require.False(t, k.IsOptedIn(ctx, chainID, removedValidator.SDKValOpAddress()))
+	}
+	require.Empty(t, k.GetValidatorsByConsumerAddrOnAllChains(ctx, consumerKey.ConsumerConsAddress()))
+
+	// the consumer key can now be assigned by another validator
+	require.NoError(t, k.AssignConsumerKey(ctx, chainIDs[0],
+		otherValidator.SDKStakingValidator(), consumerKey.TMProtoCryptoPublicKey()))
+	providerAddr, found := k.GetValidatorByConsumerAddr(ctx, chainIDs[0], consumerKey.ConsumerConsAddress())
+	require.True(t, found)
+	require.Equal(t, otherValidator.ProviderConsAddress(), providerAddr)
+}
+
+// recordingProviderHooks is a test double recording the calls to the provider hooks
+type recordingProviderHooks struct {
+	calls *[]string
+}
+
+func (h recordingProviderHooks) AfterConsumerChainAdded(_ sdk.Context, chainID string) {
+	*h.calls = append(*h.calls, "added "+chainID)
+}
+
+func (h recordingProviderHooks) AfterChannelValidating(_ sdk.Context, chainID, channelID string) {
+	*h.calls = append(*h.calls, "validating "+chainID+" "+channelID)
+}
+
+func (h recordingProviderHooks) AfterConsumerChainRemoved(_ sdk.Context, chainID string) {
+	*h.calls = append(*h.calls, "removed "+chainID)
+}
+
+// TestProviderHooks tests that all the registered provider hooks are notified
+// when a consumer chain is added, its CCV channel is established, and it is stopped
+func TestProviderHooks(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	var callsA, callsB []string
+	providerKeeper.SetHooks(providertypes.NewMultiProviderHooks(
+		recordingProviderHooks{&callsA},
+		recordingProviderHooks{&callsB},
+	))
+	require.Panics(t, func() { providerKeeper.SetHooks(recordingProviderHooks{&callsA}) })
+
+	// the consumer chain is created and its CCV channel is established
+	testkeeper.SetupForStoppingConsumerChain(t, ctx, &providerKeeper, mocks)
+	expectedCalls := []string{"added chainID", "validating chainID channelID"}
+	require.Equal(t, expectedCalls, callsA)
+	require.Equal(t, expectedCalls, callsB)
+
+	require.NoError(t, providerKeeper.StopConsumerChain(ctx, "chainID", true))
+	expectedCalls = append(expectedCalls, "removed chainID")
+	require.Equal(t, expectedCalls, callsA)
+	require.Equal(t, expectedCalls, callsB)
+
+	// stopping an unknown consumer chain does not notify the hooks
+	require.Error(t, providerKeeper.StopConsumerChain(ctx, "chainID", true))
+	require.Equal(t, expectedCalls, callsA)
+}
+
+// TestAfterUnbondingInitiatedMaxUnbondingOps tests that new unbonding ops do not wait
+// for the consumer chains that reached MaxUnbondingOpsPerChain
+func TestAfterUnbondingInitiatedMaxUnbondingOps(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	params := providertypes.DefaultParams()
+	params.MaxUnbondingOpsPerChain = 2
+	providerKeeper.SetParams(ctx, params)
+	providerKeeper.SetValidatorSetUpdateId(ctx, 1)
+
+	// chain-1 is one unbonding op below the cap, chain-2 is at the cap
+	providerKeeper.SetConsumerClientId(ctx, "chain-1", "client-1")
+	providerKeeper.SetConsumerClientId(ctx, "chain-2", "client-2")
+	providerKeeper.SetUnbondingOpIndex(ctx, "chain-1", 1, []uint64{1})
+	providerKeeper.SetUnbondingOpIndex(ctx, "chain-2", 1, []uint64{1, 2})
+
+	expectUnbondingOnHold := func(id uint64) {
+		gomock.InOrder(
+			mocks.MockStakingKeeper.EXPECT().GetUnbondingType(gomock.Any(), id).Return(stakingtypes.UnbondingType_UnbondingDelegation, false),
+			mocks.MockStakingKeeper.EXPECT().PutUnbondingOnHold(gomock.Any(), id).Return(nil),
+		)
+	}
+	cappedChains := func(ctx sdk.Context) (chainIDs []string) {
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != ccv.EventTypeUnbondingOpsCapExceeded {
+				continue
+			}
+			for _, attr := range event.Attributes {
+				if string(attr.Key) == ccv.AttributeChainID {
+					chainIDs = append(chainIDs, string(attr.Value))
+				}
+			}
+		}
+		return chainIDs
+	}
+
+	// the unbonding op only waits for chain-1
+	expectUnbondingOnHold(3)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, providerKeeper.Hooks().AfterUnbondingInitiated(ctx, 3))
+	op, found := providerKeeper.GetUnbondingOp(ctx, 3)
+	require.True(t, found)
+	require.Equal(t, []string{"chain-1"}, op.UnbondingConsumerChains)
+	require.Equal(t, 2, providerKeeper.GetPendingUnbondingOpsCount(ctx, "chain-1"))
+	require.Equal(t, 2, providerKeeper.GetPendingUnbondingOpsCount(ctx, "chain-2"))
+	require.Equal(t, []string{"chain-2"}, cappedChains(ctx))
+
+	// both chains are at the cap, thus the unbonding op is not put on hold
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, providerKeeper.Hooks().AfterUnbondingInitiated(ctx, 4))
+	_, found = providerKeeper.GetUnbondingOp(ctx, 4)
+	require.False(t, found)
+	require.Equal(t, []string{"chain-1", "chain-2"}, cappedChains(ctx))
+
+	// the cap is disabled once set to zero
+	providerKeeper.SetMaxUnbondingOpsPerChain(ctx, 0)
+	expectUnbondingOnHold(5)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, providerKeeper.Hooks().AfterUnbondingInitiated(ctx, 5))
+	op, found = providerKeeper.GetUnbondingOp(ctx, 5)
+	require.True(t, found)
+	require.Equal(t, []string{"chain-1", "chain-2"}, op.UnbondingConsumerChains)
+	require.Empty(t, cappedChains(ctx))
+}
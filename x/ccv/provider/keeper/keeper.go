@@ -1,23 +1,27 @@
 package keeper
 
 import (
+	"bytes"
 	"encoding/binary"
 	"fmt"
+	"math"
 	"reflect"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	conntypes "github.com/cosmos/ibc-go/v4/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
 	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
-	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
-	ibctmtypes "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
 
 	consumertypes "github.com/cosmos/interchain-security/x/ccv/consumer/types"
 	"github.com/cosmos/interchain-security/x/ccv/provider/types"
@@ -36,11 +40,13 @@ type Keeper struct {
 	portKeeper       ccv.PortKeeper
 	connectionKeeper ccv.ConnectionKeeper
 	accountKeeper    ccv.AccountKeeper
+	bankKeeper       ccv.BankKeeper
 	clientKeeper     ccv.ClientKeeper
 	stakingKeeper    ccv.StakingKeeper
 	slashingKeeper   ccv.SlashingKeeper
 	evidenceKeeper   ccv.EvidenceKeeper
 	feeCollectorName string
+	hooks            types.ProviderHooks
 }
 
 // NewKeeper creates a new provider Keeper instance
@@ -49,7 +55,7 @@ func NewKeeper(
 	channelKeeper ccv.ChannelKeeper, portKeeper ccv.PortKeeper,
 	connectionKeeper ccv.ConnectionKeeper, clientKeeper ccv.ClientKeeper,
 	stakingKeeper ccv.StakingKeeper, slashingKeeper ccv.SlashingKeeper,
-	accountKeeper ccv.AccountKeeper, evidenceKeeper ccv.EvidenceKeeper,
+	accountKeeper ccv.AccountKeeper, bankKeeper ccv.BankKeeper, evidenceKeeper ccv.EvidenceKeeper,
 	feeCollectorName string,
 ) Keeper {
 	// set KeyTable if it has not already been set
@@ -66,6 +72,7 @@ func NewKeeper(
 		portKeeper:       portKeeper,
 		connectionKeeper: connectionKeeper,
 		accountKeeper:    accountKeeper,
+		bankKeeper:       bankKeeper,
 		clientKeeper:     clientKeeper,
 		stakingKeeper:    stakingKeeper,
 		slashingKeeper:   slashingKeeper,
@@ -82,10 +89,12 @@ func NewKeeper(
 func (k Keeper) mustValidateFields() {
 
 	// Ensures no fields are missed in this validation
-	if reflect.ValueOf(k).NumField() != 13 {
-		panic("number of fields in provider keeper is not 13")
+	if reflect.ValueOf(k).NumField() != 15 {
+		panic("number of fields in provider keeper is not 15")
 	}
 
+	// Note 14 fields will be validated, hooks are explicitly set after the constructor
+
 	if reflect.ValueOf(k.cdc).IsZero() { // 1
 		panic("cdc is zero-valued or nil")
 	}
@@ -110,19 +119,22 @@ func (k Keeper) mustValidateFields() {
 	if reflect.ValueOf(k.accountKeeper).IsZero() { // 8
 		panic("accountKeeper is zero-valued or nil")
 	}
-	if reflect.ValueOf(k.clientKeeper).IsZero() { // 9
+	if reflect.ValueOf(k.bankKeeper).IsZero() { // 9
+		panic("bankKeeper is zero-valued or nil")
+	}
+	if reflect.ValueOf(k.clientKeeper).IsZero() { // 10
 		panic("clientKeeper is zero-valued or nil")
 	}
-	if reflect.ValueOf(k.stakingKeeper).IsZero() { // 10
+	if reflect.ValueOf(k.stakingKeeper).IsZero() { // 11
 		panic("stakingKeeper is zero-valued or nil")
 	}
-	if reflect.ValueOf(k.slashingKeeper).IsZero() { // 11
+	if reflect.ValueOf(k.slashingKeeper).IsZero() { // 12
 		panic("slashingKeeper is zero-valued or nil")
 	}
-	if reflect.ValueOf(k.evidenceKeeper).IsZero() { // 12
+	if reflect.ValueOf(k.evidenceKeeper).IsZero() { // 13
 		panic("evidenceKeeper is zero-valued or nil")
 	}
-	if reflect.ValueOf(k.feeCollectorName).IsZero() { // 13
+	if reflect.ValueOf(k.feeCollectorName).IsZero() { // 14
 		panic("feeCollectorName is zero-valued or nil")
 	}
 }
@@ -132,6 +144,20 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+host.ModuleName+"-"+types.ModuleName)
 }
 
+// SetHooks sets the hooks notified of the lifecycle of the consumer chains.
+// Multiple hooks can be combined with types.NewMultiProviderHooks.
+func (k *Keeper) SetHooks(ph types.ProviderHooks) *Keeper {
+	if k.hooks != nil {
+		// This should never happen as SetHooks is expected
+		// to be called only once in app.go
+		panic("cannot set provider hooks twice")
+	}
+
+	k.hooks = ph
+
+	return k
+}
+
 // IsBound checks if the CCV module is already bound to the desired port
 func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
 	_, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(portID))
@@ -215,6 +241,89 @@ func (k Keeper) GetAllConsumerChains(ctx sdk.Context) (chains []types.Chain) {
 	return chains
 }
 
+// GetConsumerChainsPaginated returns the registered consumer chains in the page requested by pageReq,
+// in ascending order of chainIDs, together with the page response to request the next page.
+func (k Keeper) GetConsumerChainsPaginated(ctx sdk.Context, pageReq *query.PageRequest) ([]types.Chain, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.ChainToClientBytePrefix})
+	chains := []types.Chain{}
+	pageRes, err := query.Paginate(store, pageReq, func(key []byte, value []byte) error {
+		chains = append(chains, types.Chain{
+			ChainId:  string(key),
+			ClientId: string(value),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return chains, pageRes, nil
+}
+
+// GetConsumerPhase returns the phase of the lifecycle the consumer chain with the given chain ID is in.
+// Note that a chain with a pending consumer removal proposal is considered to be stopping,
+// regardless of whether its CCV channel is established.
+func (k Keeper) GetConsumerPhase(ctx sdk.Context, chainID string) types.ConsumerPhase {
+	if _, found := k.GetConsumerClientId(ctx, chainID); !found {
+		for _, prop := range k.GetAllPendingConsumerAdditionProps(ctx) {
+			if prop.ChainId == chainID {
+				return types.ConsumerPhasePending
+			}
+		}
+		return types.ConsumerPhaseUnspecified
+	}
+	for _, prop := range k.GetAllPendingConsumerRemovalProps(ctx) {
+		if prop.ChainId == chainID {
+			return types.ConsumerPhaseStopping
+		}
+	}
+	if _, found := k.GetChainToChannel(ctx, chainID); found {
+		return types.ConsumerPhaseLaunched
+	}
+	return types.ConsumerPhaseInitialized
+}
+
+// GetAllConsumerPhases returns the lifecycle phase of every consumer chain known to the provider,
+// i.e., of every consumer chain that has either a pending consumer addition proposal or a consumer client
+func (k Keeper) GetAllConsumerPhases(ctx sdk.Context) map[string]types.ConsumerPhase {
+	phases := map[string]types.ConsumerPhase{}
+	for _, prop := range k.GetAllPendingConsumerAdditionProps(ctx) {
+		phases[prop.ChainId] = types.ConsumerPhasePending
+	}
+	for _, chain := range k.GetAllConsumerChains(ctx) {
+		if _, found := k.GetChainToChannel(ctx, chain.ChainId); found {
+			phases[chain.ChainId] = types.ConsumerPhaseLaunched
+		} else {
+			phases[chain.ChainId] = types.ConsumerPhaseInitialized
+		}
+	}
+	for _, prop := range k.GetAllPendingConsumerRemovalProps(ctx) {
+		if _, ok := phases[prop.ChainId]; ok {
+			phases[prop.ChainId] = types.ConsumerPhaseStopping
+		}
+	}
+	return phases
+}
+
+// GetConsumerPhaseCounts returns the number of consumer chains in every phase of the lifecycle,
+// ordered by phase. Phases without any consumer chain are included with a zero count.
+func (k Keeper) GetConsumerPhaseCounts(ctx sdk.Context) []types.ConsumerPhaseCount {
+	counts := map[types.ConsumerPhase]uint64{}
+	for _, phase := range k.GetAllConsumerPhases(ctx) {
+		counts[phase]++
+	}
+
+	phaseCounts := []types.ConsumerPhaseCount{}
+	for _, phase := range []types.ConsumerPhase{
+		types.ConsumerPhasePending,
+		types.ConsumerPhaseInitialized,
+		types.ConsumerPhaseLaunched,
+		types.ConsumerPhaseStopping,
+	} {
+		phaseCounts = append(phaseCounts, types.ConsumerPhaseCount{Phase: phase, Count: counts[phase]})
+	}
+	return phaseCounts
+}
+
 // SetChannelToChain sets the mapping from the CCV channel ID to the consumer chainID.
 func (k Keeper) SetChannelToChain(ctx sdk.Context, channelID, chainID string) {
 	store := ctx.KVStore(k.storeKey)
@@ -263,6 +372,54 @@ func (k Keeper) GetAllChannelToChains(ctx sdk.Context) (channels []types.Channel
 	return channels
 }
 
+// GetChannelToChainsPaginated returns the mappings from CCV channel IDs to consumer chain IDs in the page
+// requested by pageReq, in ascending order of channelIDs, together with the page response to request the next page.
+func (k Keeper) GetChannelToChainsPaginated(ctx sdk.Context, pageReq *query.PageRequest) ([]types.ChannelToChain, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.ChannelToChainBytePrefix})
+	channels := []types.ChannelToChain{}
+	pageRes, err := query.Paginate(store, pageReq, func(key []byte, value []byte) error {
+		channels = append(channels, types.ChannelToChain{
+			ChannelId: string(key),
+			ChainId:   string(value),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return channels, pageRes, nil
+}
+
+// SetInvalidatedChannel records that the CCV channel with the given channel ID was invalidated.
+// An invalidated channel cannot be used again as the CCV channel of a consumer chain.
+func (k Keeper) SetInvalidatedChannel(ctx sdk.Context, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.InvalidatedChannelKey(channelID), []byte{})
+}
+
+// IsChannelInvalidated returns whether the CCV channel with the given channel ID was invalidated
+func (k Keeper) IsChannelInvalidated(ctx sdk.Context, channelID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.InvalidatedChannelKey(channelID))
+}
+
+// GetAllInvalidatedChannels returns the IDs of all the invalidated CCV channels.
+//
+// Note that the invalidated channels are stored under keys with the following format:
+// InvalidatedChannelBytePrefix | channelID
+// Thus, the returned array is in ascending order of channelIDs.
+func (k Keeper) GetAllInvalidatedChannels(ctx sdk.Context) (channelIDs []string) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{types.InvalidatedChannelBytePrefix})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		channelIDs = append(channelIDs, string(iterator.Key()[1:]))
+	}
+
+	return channelIDs
+}
+
 func (k Keeper) SetConsumerGenesis(ctx sdk.Context, chainID string, gen consumertypes.GenesisState) error {
 	store := ctx.KVStore(k.storeKey)
 	bz, err := gen.Marshal()
@@ -296,31 +453,89 @@ func (k Keeper) DeleteConsumerGenesis(ctx sdk.Context, chainID string) {
 }
 
 // VerifyConsumerChain verifies that the chain trying to connect on the channel handshake
-// is the expected consumer chain.
-func (k Keeper) VerifyConsumerChain(ctx sdk.Context, channelID string, connectionHops []string) error {
+// is the expected consumer chain, and that it booted from the consumer genesis generated
+// by the provider, i.e., that genesisHash is the hash of that consumer genesis.
+// An empty genesisHash, i.e., a plain version proposed by the consumer chain, is only accepted
+// for the consumer chains exempted from sending it, see SetGenesisHashExempt.
+func (k Keeper) VerifyConsumerChain(ctx sdk.Context, channelID string, connectionHops []string, genesisHash []byte) error {
+	// Verify that the channel was not invalidated and is not already the CCV channel of a consumer chain
+	if k.IsChannelInvalidated(ctx, channelID) {
+		return sdkerrors.Wrapf(ccv.ErrInvalidatedChannel, "CCV channel with ID: %s cannot be used again", channelID)
+	}
+	if chainID, found := k.GetChannelToChain(ctx, channelID); found {
+		return sdkerrors.Wrapf(ccv.ErrValidatingChannel, "channel with ID: %s is already the CCV channel of consumer chain %s", channelID, chainID)
+	}
 	if len(connectionHops) != 1 {
 		return sdkerrors.Wrap(channeltypes.ErrTooManyConnectionHops, "must have direct connection to provider chain")
 	}
 	connectionID := connectionHops[0]
-	clientID, tmClient, err := k.getUnderlyingClient(ctx, connectionID)
+	clientID, clientState, err := k.getUnderlyingClient(ctx, connectionID)
 	if err != nil {
 		return err
 	}
-	ccvClientId, found := k.GetConsumerClientId(ctx, tmClient.ChainId)
+	chainID := clientState.GetChainID()
+	ccvClientId, found := k.GetConsumerClientId(ctx, chainID)
 	if !found {
-		return sdkerrors.Wrapf(ccv.ErrClientNotFound, "cannot find client for consumer chain %s", tmClient.ChainId)
+		return sdkerrors.Wrapf(ccv.ErrClientNotFound, "cannot find client for consumer chain %s", chainID)
 	}
 	if ccvClientId != clientID {
 		return sdkerrors.Wrapf(ccv.ErrInvalidConsumerClient, "CCV channel must be built on top of CCV client. expected %s, got %s", ccvClientId, clientID)
 	}
 
 	// Verify that there isn't already a CCV channel for the consumer chain
-	if prevChannel, ok := k.GetChainToChannel(ctx, tmClient.ChainId); ok {
-		return sdkerrors.Wrapf(ccv.ErrDuplicateChannel, "CCV channel with ID: %s already created for consumer chain %s", prevChannel, tmClient.ChainId)
+	if prevChannel, ok := k.GetChainToChannel(ctx, chainID); ok {
+		return sdkerrors.Wrapf(ccv.ErrDuplicateChannel, "CCV channel with ID: %s already created for consumer chain %s", prevChannel, chainID)
+	}
+
+	// Verify that the consumer chain booted from the consumer genesis generated by the provider
+	if len(genesisHash) == 0 {
+		if !k.IsGenesisHashExempt(ctx, chainID) {
+			return sdkerrors.Wrapf(ccv.ErrInvalidGenesisHash,
+				"consumer chain %s did not send the hash of its genesis on the CCV channel handshake", chainID)
+		}
+		k.Logger(ctx).Info("consumer chain exempted from sending the hash of its genesis on the CCV channel handshake",
+			"chainID", chainID,
+			"channelID", channelID,
+		)
+		return nil
+	}
+	consumerGen, found := k.GetConsumerGenesis(ctx, chainID)
+	if !found {
+		return sdkerrors.Wrapf(ccv.ErrInvalidConsumerState, "cannot find consumer genesis for consumer chain %s", chainID)
+	}
+	expectedHash, err := consumerGen.Hash()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(expectedHash, genesisHash) {
+		return sdkerrors.Wrapf(ccv.ErrInvalidGenesisHash, "consumer chain %s booted from a consumer genesis with hash %X, expected %X",
+			chainID, genesisHash, expectedHash)
 	}
 	return nil
 }
 
+// SetGenesisHashExempt records that the consumer chain with the given chain ID may open its
+// CCV channel without sending the hash of its genesis, i.e., with a plain version. Only the
+// consumer chains added before the genesis hash was verified are exempted, see migrateParamsAndIndexes.
+func (k Keeper) SetGenesisHashExempt(ctx sdk.Context, chainID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GenesisHashExemptKey(chainID), []byte{})
+}
+
+// IsGenesisHashExempt returns whether the consumer chain with the given chain ID
+// may open its CCV channel without sending the hash of its genesis
+func (k Keeper) IsGenesisHashExempt(ctx sdk.Context, chainID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GenesisHashExemptKey(chainID))
+}
+
+// DeleteGenesisHashExempt removes the exemption of the consumer chain
+// with the given chain ID from sending the hash of its genesis
+func (k Keeper) DeleteGenesisHashExempt(ctx sdk.Context, chainID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GenesisHashExemptKey(chainID))
+}
+
 // SetConsumerChain ensures that the consumer chain has not already been
 // set by a different channel, and then sets the consumer chain mappings
 // in keeper, and set the channel status to validating.
@@ -329,20 +544,37 @@ func (k Keeper) VerifyConsumerChain(ctx sdk.Context, channelID string, connectio
 //
 // SetConsumerChain is called by OnChanOpenConfirm.
 func (k Keeper) SetConsumerChain(ctx sdk.Context, channelID string) error {
-	channel, ok := k.channelKeeper.GetChannel(ctx, ccv.ProviderPortID, channelID)
+	// Verify that the channel was not invalidated, so that it can never transition back to validating
+	if k.IsChannelInvalidated(ctx, channelID) {
+		return sdkerrors.Wrapf(ccv.ErrInvalidatedChannel, "CCV channel with ID: %s cannot be used again", channelID)
+	}
+	channel, ok := k.channelKeeper.GetChannel(ctx, k.GetPort(ctx), channelID)
 	if !ok {
 		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "channel not found for channel ID: %s", channelID)
 	}
+	// Verify that the channel is opened with the consumer CCV module, as checked during the handshake
+	if channel.Counterparty.PortId != ccv.ConsumerPortID {
+		return sdkerrors.Wrapf(porttypes.ErrInvalidPort,
+			"invalid counterparty port: %s, expected %s", channel.Counterparty.PortId, ccv.ConsumerPortID)
+	}
 	if len(channel.ConnectionHops) != 1 {
 		return sdkerrors.Wrap(channeltypes.ErrTooManyConnectionHops, "must have direct connection to consumer chain")
 	}
 	connectionID := channel.ConnectionHops[0]
-	clientID, tmClient, err := k.getUnderlyingClient(ctx, connectionID)
+	clientID, clientState, err := k.getUnderlyingClient(ctx, connectionID)
 	if err != nil {
 		return err
 	}
+	// Verify that the channel is still built on top of the CCV client, as checked during the handshake
+	chainID := clientState.GetChainID()
+	ccvClientId, found := k.GetConsumerClientId(ctx, chainID)
+	if !found {
+		return sdkerrors.Wrapf(ccv.ErrClientNotFound, "cannot find client for consumer chain %s", chainID)
+	}
+	if ccvClientId != clientID {
+		return sdkerrors.Wrapf(ccv.ErrInvalidConsumerClient, "CCV channel must be built on top of CCV client. expected %s, got %s", ccvClientId, clientID)
+	}
 	// Verify that there isn't already a CCV channel for the consumer chain
-	chainID := tmClient.ChainId
 	if prevChannelID, ok := k.GetChainToChannel(ctx, chainID); ok {
 		return sdkerrors.Wrapf(ccv.ErrDuplicateChannel, "CCV channel with ID: %s already created for consumer chain %s", prevChannelID, chainID)
 	}
@@ -353,6 +585,10 @@ func (k Keeper) SetConsumerChain(ctx sdk.Context, channelID string) error {
 	k.SetChannelToChain(ctx, channelID, chainID)
 	// - set current block height for the consumer chain initialization
 	k.SetInitChainHeight(ctx, chainID, uint64(ctx.BlockHeight()))
+	// - the completion of the handshake is the first activity of the consumer chain
+	k.recordConsumerActivity(ctx, chainID)
+	// start the first rewards window of the consumer chain
+	k.SetConsumerRewardsWindow(ctx, chainID, types.ConsumerRewardsWindow{StartTime: ctx.BlockTime()})
 	// - remove init timeout timestamp
 	k.DeleteInitTimeoutTimestamp(ctx, chainID)
 
@@ -367,6 +603,8 @@ func (k Keeper) SetConsumerChain(ctx sdk.Context, channelID string) error {
 			sdk.NewAttribute(conntypes.AttributeKeyConnectionID, connectionID),
 		),
 	)
+
+	k.AfterChannelValidating(ctx, chainID, channelID)
 	return nil
 }
 
@@ -448,7 +686,7 @@ func (k Keeper) RemoveConsumerFromUnbondingOp(ctx sdk.Context, id uint64, chainI
 	// Get the unbonding op from store
 	unbondingOp, found := k.GetUnbondingOp(ctx, id)
 	if !found {
-		panic(fmt.Errorf("internal state corrupted; could not find UnbondingOp with ID %d", id))
+		panic(sdkerrors.Wrapf(types.ErrUnknownUnbondingOp, "internal state corrupted; could not find UnbondingOp with ID %d", id))
 	}
 
 	// Remove consumer chain ID from unbonding op
@@ -470,6 +708,16 @@ func (k Keeper) RemoveConsumerFromUnbondingOp(ctx sdk.Context, id uint64, chainI
 	return
 }
 
+// GetChainsBlockingUnbonding returns the IDs of the consumer chains the unbonding op with 'id' is still waiting on.
+// It returns nil if the unbonding op is not found, e.g., since it already completed.
+func (k Keeper) GetChainsBlockingUnbonding(ctx sdk.Context, id uint64) []string {
+	unbondingOp, found := k.GetUnbondingOp(ctx, id)
+	if !found {
+		return nil
+	}
+	return unbondingOp.UnbondingConsumerChains
+}
+
 func removeStringFromSlice(slice []string, x string) (newSlice []string, numRemoved int) {
 	for _, y := range slice {
 		if x != y {
@@ -480,11 +728,23 @@ func removeStringFromSlice(slice []string, x string) (newSlice []string, numRemo
 	return newSlice, len(slice) - len(newSlice)
 }
 
+func containsString(slice []string, x string) bool {
+	for _, y := range slice {
+		if x == y {
+			return true
+		}
+	}
+	return false
+}
+
 // SetUnbondingOpIndex sets the IDs of unbonding operations that are waiting for
 // a VSCMaturedPacket with vscID from a consumer with chainID
 func (k Keeper) SetUnbondingOpIndex(ctx sdk.Context, chainID string, vscID uint64, IDs []uint64) {
 	store := ctx.KVStore(k.storeKey)
 
+	prevIDs, _ := k.GetUnbondingOpIndex(ctx, chainID, vscID)
+	k.setPendingUnbondingOpsCount(ctx, chainID, k.GetPendingUnbondingOpsCount(ctx, chainID)-len(prevIDs)+len(IDs))
+
 	vscUnbondingOps := types.VscUnbondingOps{
 		VscId:          vscID,
 		UnbondingOpIds: IDs,
@@ -497,6 +757,7 @@ func (k Keeper) SetUnbondingOpIndex(ctx sdk.Context, chainID string, vscID uint6
 	}
 
 	store.Set(types.UnbondingOpIndexKey(chainID, vscID), bz)
+	k.updatePendingUnbondingOpsGauge(ctx, chainID)
 }
 
 // GetAllUnbondingOpIndexes gets all unbonding indexes for a given chain id,
@@ -528,6 +789,31 @@ func (k Keeper) GetAllUnbondingOpIndexes(ctx sdk.Context, chainID string) (index
 	return indexes
 }
 
+// GetPendingUnbondingOpsCount returns the number of unbonding operations
+// that are waiting for VSCMaturedPackets from a consumer with chainID.
+//
+// Note that the count is kept up to date by SetUnbondingOpIndex and DeleteUnbondingOpIndex,
+// so that it can be read without iterating over the unbonding op indexes of the chain.
+func (k Keeper) GetPendingUnbondingOpsCount(ctx sdk.Context, chainID string) int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PendingUnbondingOpsCountKey(chainID))
+	if bz == nil {
+		return 0
+	}
+	return int(sdk.BigEndianToUint64(bz))
+}
+
+// setPendingUnbondingOpsCount sets the number of unbonding operations
+// that are waiting for VSCMaturedPackets from a consumer with chainID
+func (k Keeper) setPendingUnbondingOpsCount(ctx sdk.Context, chainID string, count int) {
+	store := ctx.KVStore(k.storeKey)
+	if count <= 0 {
+		store.Delete(types.PendingUnbondingOpsCountKey(chainID))
+		return
+	}
+	store.Set(types.PendingUnbondingOpsCountKey(chainID), sdk.Uint64ToBigEndian(uint64(count)))
+}
+
 // GetUnbondingOpIndex gets the IDs of unbonding operations that are waiting for
 // a VSCMaturedPacket with vscID from a consumer with chainID
 func (k Keeper) GetUnbondingOpIndex(ctx sdk.Context, chainID string, vscID uint64) ([]uint64, bool) {
@@ -552,7 +838,10 @@ func (k Keeper) GetUnbondingOpIndex(ctx sdk.Context, chainID string, vscID uint6
 // a VSCMaturedPacket with vscID from a consumer with chainID
 func (k Keeper) DeleteUnbondingOpIndex(ctx sdk.Context, chainID string, vscID uint64) {
 	store := ctx.KVStore(k.storeKey)
+	prevIDs, _ := k.GetUnbondingOpIndex(ctx, chainID, vscID)
+	k.setPendingUnbondingOpsCount(ctx, chainID, k.GetPendingUnbondingOpsCount(ctx, chainID)-len(prevIDs))
 	store.Delete(types.UnbondingOpIndexKey(chainID, vscID))
+	k.updatePendingUnbondingOpsGauge(ctx, chainID)
 }
 
 // GetUnbondingOpsFromIndex gets the unbonding ops waiting for a given chainID and vscID
@@ -567,7 +856,8 @@ func (k Keeper) GetUnbondingOpsFromIndex(ctx sdk.Context, chainID string, valset
 			// An error here would indicate something is very wrong.
 			// Every UnbondingOpIndex is assumed to have the corresponding UnbondingOps set in store.
 			// This is done in AfterUnbondingInitiated and InitGenesis.
-			panic("did not find UnbondingOp according to index- index was probably not correctly updated")
+			panic(sdkerrors.Wrapf(types.ErrUnknownUnbondingOp,
+				"did not find UnbondingOp with ID %d according to index, index was probably not correctly updated", id))
 		}
 		entries = append(entries, entry)
 	}
@@ -575,6 +865,26 @@ func (k Keeper) GetUnbondingOpsFromIndex(ctx sdk.Context, chainID string, valset
 	return entries
 }
 
+// GetChainHeldUnbondingValue returns the sum of the balances of all unbonding operations
+// that are waiting for VSCMaturedPackets from a consumer with chainID
+func (k Keeper) GetChainHeldUnbondingValue(ctx sdk.Context, chainID string) sdk.Int {
+	total := sdk.ZeroInt()
+	for _, index := range k.GetAllUnbondingOpIndexes(ctx, chainID) {
+		for _, id := range index.UnbondingOpIds {
+			unbondingOp, found := k.GetUnbondingOp(ctx, id)
+			if !found {
+				// An error here would indicate something is very wrong,
+				// every UnbondingOpIndex is assumed to have the corresponding UnbondingOps set in store.
+				panic(sdkerrors.Wrapf(types.ErrUnknownUnbondingOp, "internal state corrupted; could not find UnbondingOp with ID %d", id))
+			}
+			if !unbondingOp.Balance.IsNil() {
+				total = total.Add(unbondingOp.Balance)
+			}
+		}
+	}
+	return total
+}
+
 // GetMaturedUnbondingOps returns the list of matured unbonding operation ids
 func (k Keeper) GetMaturedUnbondingOps(ctx sdk.Context) (ids []uint64) {
 	store := ctx.KVStore(k.storeKey)
@@ -625,8 +935,12 @@ func (k Keeper) ConsumeMaturedUnbondingOps(ctx sdk.Context) []uint64 {
 }
 
 // Retrieves the underlying client state corresponding to a connection ID.
+// The client must be of one of the types allowed by the AllowedConsumerClientTypes param.
+// Note that this check does not decide which clients the CCV channels are built on:
+// the provider creates the clients to the consumer chains itself (see CreateConsumerClient),
+// and these are always Tendermint clients, which can therefore never be disallowed.
 func (k Keeper) getUnderlyingClient(ctx sdk.Context, connectionID string) (
-	clientID string, tmClient *ibctmtypes.ClientState, err error,
+	clientID string, clientState types.ConsumerClientState, err error,
 ) {
 	conn, ok := k.connectionKeeper.GetConnection(ctx, connectionID)
 	if !ok {
@@ -634,34 +948,72 @@ func (k Keeper) getUnderlyingClient(ctx sdk.Context, connectionID string) (
 			"connection not found for connection ID: %s", connectionID)
 	}
 	clientID = conn.ClientId
-	clientState, ok := k.clientKeeper.GetClientState(ctx, clientID)
+	cs, ok := k.clientKeeper.GetClientState(ctx, clientID)
 	if !ok {
 		return "", nil, sdkerrors.Wrapf(clienttypes.ErrClientNotFound,
 			"client not found for client ID: %s", conn.ClientId)
 	}
-	tmClient, ok = clientState.(*ibctmtypes.ClientState)
+	allowedClientTypes := k.GetAllowedConsumerClientTypes(ctx)
+	allowed := false
+	for _, clientType := range allowedClientTypes {
+		if cs.ClientType() == clientType {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return "", nil, sdkerrors.Wrapf(clienttypes.ErrInvalidClientType,
+			"invalid client type. expected one of %v, got %s", allowedClientTypes, cs.ClientType())
+	}
+	clientState, ok = cs.(types.ConsumerClientState)
 	if !ok {
 		return "", nil, sdkerrors.Wrapf(clienttypes.ErrInvalidClientType,
-			"invalid client type. expected %s, got %s", ibcexported.Tendermint, clientState.ClientType())
+			"client type %s does not expose the chain ID of the consumer chain", cs.ClientType())
 	}
-	return clientID, tmClient, nil
+	return clientID, clientState, nil
 }
 
 // chanCloseInit defines a wrapper function for the channel Keeper's function
 func (k Keeper) chanCloseInit(ctx sdk.Context, channelID string) error {
-	capName := host.ChannelCapabilityPath(ccv.ProviderPortID, channelID)
+	portID := k.GetPort(ctx)
+	capName := host.ChannelCapabilityPath(portID, channelID)
 	chanCap, ok := k.scopedKeeper.GetCapability(ctx, capName)
 	if !ok {
 		return sdkerrors.Wrapf(channeltypes.ErrChannelCapabilityNotFound, "could not retrieve channel capability at: %s", capName)
 	}
-	return k.channelKeeper.ChanCloseInit(ctx, ccv.ProviderPortID, channelID, chanCap)
+	return k.channelKeeper.ChanCloseInit(ctx, portID, channelID, chanCap)
 }
 
+// IncrementValidatorSetUpdateId increments the validator set update ID.
+//
+// Note that the validator set update ID never wraps around: wrapping around to a used ID
+// would map the new validator set updates to the unbonding operations and block heights
+// of old ones, i.e., the unbonding operations would mature incorrectly. Since saturating
+// would equally reuse an ID, the provider panics once the ID reaches the maximum uint64,
+// halting the chain instead of corrupting its state.
 func (k Keeper) IncrementValidatorSetUpdateId(ctx sdk.Context) {
 	validatorSetUpdateId := k.GetValidatorSetUpdateId(ctx)
+	if validatorSetUpdateId == math.MaxUint64 {
+		panic(fmt.Errorf("validator set update ID overflow: the ID %d cannot be incremented", validatorSetUpdateId))
+	}
 	k.SetValidatorSetUpdateId(ctx, validatorSetUpdateId+1)
 }
 
+// CommitValidatorSetUpdate increments the validator set update ID and maps the new ID to a block height.
+// It must be called at the end of the block in which the validator updates of the current ID were collected.
+//
+// Note that the new ID is mapped to the height of the block after the next one,
+// i.e., the height that EndBlockCIS of the next block would map it to. This ensures that
+// every validator set update ID is mapped to a block height as soon as it is produced.
+func (k Keeper) CommitValidatorSetUpdate(ctx sdk.Context) {
+	k.IncrementValidatorSetUpdateId(ctx)
+	valUpdateID := k.GetValidatorSetUpdateId(ctx)
+	blockHeight := uint64(ctx.BlockHeight()) + 2
+	k.SetValsetUpdateBlockHeight(ctx, valUpdateID, blockHeight)
+	k.SetValsetUpdateTimestamp(ctx, valUpdateID, ctx.BlockTime())
+	k.Logger(ctx).Debug("vscID was mapped to block height", "vscID", valUpdateID, "height", blockHeight)
+}
+
 func (k Keeper) SetValidatorSetUpdateId(ctx sdk.Context, valUpdateID uint64) {
 	store := ctx.KVStore(k.storeKey)
 
@@ -704,6 +1056,61 @@ func (k Keeper) GetValsetUpdateBlockHeight(ctx sdk.Context, valsetUpdateId uint6
 	return binary.BigEndian.Uint64(bz), true
 }
 
+// SetValsetUpdateTimestamp sets the block time at which the given valset update id was mapped to a block height
+func (k Keeper) SetValsetUpdateTimestamp(ctx sdk.Context, valsetUpdateId uint64, timestamp time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ValsetUpdateTimestampKey(valsetUpdateId), sdk.FormatTimeBytes(timestamp))
+}
+
+// GetValsetUpdateTimestamp returns the block time at which the given valset update id was mapped to a block height
+func (k Keeper) GetValsetUpdateTimestamp(ctx sdk.Context, valsetUpdateId uint64) (time.Time, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ValsetUpdateTimestampKey(valsetUpdateId))
+	if bz == nil {
+		return time.Time{}, false
+	}
+	ts, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the timestamp is assumed to be correctly serialized in SetValsetUpdateTimestamp.
+		panic(fmt.Errorf("failed to parse valset update timestamp: %w", err))
+	}
+	return ts, true
+}
+
+// GetValsetUpdateHeightRange returns the range of provider block heights in which the validator set
+// of the given valset update id was effective, i.e., from the block height mapped to the valset update id
+// (inclusive) to the block height mapped to the next mapped valset update id (exclusive).
+// Note that valset update ids are not necessarily mapped to block heights consecutively.
+// For the newest mapped valset update id, the range is open-ended and the returned end height is zero.
+// The returned bool is false if the valset update id is not mapped to a block height.
+func (k Keeper) GetValsetUpdateHeightRange(ctx sdk.Context, valsetUpdateId uint64) (start, end uint64, found bool) {
+	start, found = k.GetValsetUpdateBlockHeight(ctx, valsetUpdateId)
+	if !found {
+		return 0, 0, false
+	}
+	// the end height is left as zero if no later valset update id is mapped to a block height
+	if nextValsetUpdateId, found := k.getNextMappedValsetUpdateId(ctx, valsetUpdateId); found {
+		end, _ = k.GetValsetUpdateBlockHeight(ctx, nextValsetUpdateId)
+	}
+	return start, end, true
+}
+
+// getNextMappedValsetUpdateId returns the smallest valset update ID greater than
+// the given one that is mapped to a block height, if any
+func (k Keeper) getNextMappedValsetUpdateId(ctx sdk.Context, valsetUpdateId uint64) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(
+		types.ValsetUpdateBlockHeightKey(valsetUpdateId+1),
+		[]byte{types.ValsetUpdateBlockHeightBytePrefix + 1},
+	)
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return 0, false
+	}
+	return binary.BigEndian.Uint64(iterator.Key()[1:]), true
+}
+
 // GetAllValsetUpdateBlockHeights gets all the block heights for all valset updates
 //
 // Note that the mapping from vscIDs to block heights is stored under keys with the following format:
@@ -718,58 +1125,231 @@ func (k Keeper) GetAllValsetUpdateBlockHeights(ctx sdk.Context) (valsetUpdateBlo
 		valsetUpdateId := binary.BigEndian.Uint64(iterator.Key()[1:])
 		height := binary.BigEndian.Uint64(iterator.Value())
 
-		valsetUpdateBlockHeights = append(valsetUpdateBlockHeights, types.ValsetUpdateIdToHeight{
+		v2h := types.ValsetUpdateIdToHeight{
 			ValsetUpdateId: valsetUpdateId,
 			Height:         height,
-		})
+		}
+		if ts, found := k.GetValsetUpdateTimestamp(ctx, valsetUpdateId); found {
+			v2h.Timestamp = &ts
+		}
+		valsetUpdateBlockHeights = append(valsetUpdateBlockHeights, v2h)
 	}
 
 	return valsetUpdateBlockHeights
 }
 
-// DeleteValsetUpdateBlockHeight deletes the block height value for a given vaset update id
+// DeleteValsetUpdateBlockHeight deletes the block height value, and the time at which
+// it was set, for a given vaset update id
 func (k Keeper) DeleteValsetUpdateBlockHeight(ctx sdk.Context, valsetUpdateId uint64) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.ValsetUpdateBlockHeightKey(valsetUpdateId))
+	store.Delete(types.ValsetUpdateTimestampKey(valsetUpdateId))
+}
+
+// PruneValsetUpdateBlockHeights deletes the block heights of the valset update IDs older than
+// the HistoricalValsetEntries most recent ones, once the validator set of the valset update ID
+// was replaced at least an unbonding period ago, i.e., once the next valset update ID was mapped
+// to a block height at least an unbonding period ago. Consumer chains cannot send slash packets
+// for infractions older than the unbonding period, hence such block heights are no longer needed.
+//
+// The block height of an old valset update ID is kept as long as it is referenced, i.e.,
+// as long as an unbonding operation waiting on a consumer chain references it (an UnbondingOpIndex
+// exists for that valset update ID), or as long as a throttled slash packet references it.
+// The block heights of valset update IDs for which the time at which the next valset update ID
+// was mapped is unknown are kept.
+//
+// Since the valset update IDs are mapped to block heights in increasing order of block time,
+// the check stops at the first valset update ID that was not replaced an unbonding period ago.
+// At most MaxValsetUpdateIdsCheckedPerBlock valset update IDs are checked per block: the check
+// resumes from the prune cursor in the next block, and restarts from the oldest valset update ID
+// once it stopped, so that the block heights that were still referenced are checked again.
+// Note that the prune cursor is not exported, i.e., the check restarts after a genesis import.
+func (k Keeper) PruneValsetUpdateBlockHeights(ctx sdk.Context) {
+	latestID := k.GetValidatorSetUpdateId(ctx)
+	historicalEntries := uint64(k.GetHistoricalValsetEntries(ctx))
+	if latestID <= historicalEntries {
+		return
+	}
+	// the block heights of the valset update IDs in [1, pruneUpToID] are candidates for pruning
+	pruneUpToID := latestID - historicalEntries
+
+	cursor := k.getValsetUpdatePruneCursor(ctx)
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(
+		types.ValsetUpdateBlockHeightKey(cursor),
+		types.ValsetUpdateBlockHeightKey(pruneUpToID+1),
+	)
+	var candidates []uint64
+	for ; iterator.Valid() && len(candidates) < types.MaxValsetUpdateIdsCheckedPerBlock; iterator.Next() {
+		candidates = append(candidates, binary.BigEndian.Uint64(iterator.Key()[1:]))
+	}
+	iterator.Close()
+
+	// the check resumes after the last candidate if the candidates were capped,
+	// and restarts from the oldest valset update ID otherwise
+	nextCursor := uint64(0)
+	if len(candidates) == types.MaxValsetUpdateIdsCheckedPerBlock {
+		nextCursor = candidates[len(candidates)-1] + 1
+	}
+
+	cutoff := ctx.BlockTime().Add(-k.stakingKeeper.UnbondingTime(ctx))
+	var chains []types.Chain
+	// the valset update IDs referenced by throttled slash packets,
+	// loaded once a candidate was replaced an unbonding period ago
+	var throttledIDs map[uint64]bool
+	for _, vscID := range candidates {
+		if !k.valsetReplacedBefore(ctx, vscID, cutoff) {
+			// neither were the next valset update IDs
+			nextCursor = 0
+			break
+		}
+		if throttledIDs == nil {
+			chains = k.GetAllConsumerChains(ctx)
+			throttledIDs = map[uint64]bool{}
+			for _, chain := range chains {
+				slashData, _, _, _ := k.GetAllThrottledPacketData(ctx, chain.ChainId)
+				for _, data := range slashData {
+					throttledIDs[data.ValsetUpdateId] = true
+				}
+			}
+		}
+		if throttledIDs[vscID] {
+			continue
+		}
+		referenced := false
+		for _, chain := range chains {
+			if _, found := k.GetUnbondingOpIndex(ctx, chain.ChainId, vscID); found {
+				referenced = true
+				break
+			}
+		}
+		if !referenced {
+			k.DeleteValsetUpdateBlockHeight(ctx, vscID)
+		}
+	}
+
+	if nextCursor != cursor {
+		k.setValsetUpdatePruneCursor(ctx, nextCursor)
+	}
 }
 
-// SetSlashAcks sets the slash acks under the given chain ID
+// setValsetUpdatePruneCursor sets the valset update ID from which the block heights
+// of the valset update IDs are checked for pruning, see PruneValsetUpdateBlockHeights
+func (k Keeper) setValsetUpdatePruneCursor(ctx sdk.Context, valsetUpdateId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if valsetUpdateId == 0 {
+		store.Delete(types.ValsetUpdatePruneCursorKey())
+		return
+	}
+	store.Set(types.ValsetUpdatePruneCursorKey(), sdk.Uint64ToBigEndian(valsetUpdateId))
+}
+
+// getValsetUpdatePruneCursor returns the valset update ID from which the block heights
+// of the valset update IDs are checked for pruning, zero if they are checked from the oldest one
+func (k Keeper) getValsetUpdatePruneCursor(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ValsetUpdatePruneCursorKey())
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// valsetReplacedBefore returns whether the next valset update ID mapped to a block height
+// after the given one was mapped no later than the given time
+func (k Keeper) valsetReplacedBefore(ctx sdk.Context, valsetUpdateId uint64, cutoff time.Time) bool {
+	nextValsetUpdateId, found := k.getNextMappedValsetUpdateId(ctx, valsetUpdateId)
+	if !found {
+		return false
+	}
+	ts, found := k.GetValsetUpdateTimestamp(ctx, nextValsetUpdateId)
+	return found && !ts.After(cutoff)
+}
+
+// SetSlashAcks sets the slash acks under the given chain ID.
+// Note that the slash acks are set for downtime infractions.
 //
 // TODO: SlashAcks should be persisted as a list of ConsumerConsAddr types, not strings.
 // See https://github.com/cosmos/interchain-security/issues/728
 func (k Keeper) SetSlashAcks(ctx sdk.Context, chainID string, acks []string) {
+	k.setSlashAcks(ctx, chainID, types.SlashAcks{
+		Addresses: acks,
+	})
+}
+
+// setSlashAcks stores the given slash acks under the given chain ID
+func (k Keeper) setSlashAcks(ctx sdk.Context, chainID string, sa types.SlashAcks) {
 	store := ctx.KVStore(k.storeKey)
 
-	sa := types.SlashAcks{
-		Addresses: acks,
-	}
 	bz, err := sa.Marshal()
 	if err != nil {
 		// An error here would indicate something is very wrong,
-		// sa is instantiated in this method and should be able to be marshaled.
+		// sa is instantiated by the provider and should be able to be marshaled.
 		panic(fmt.Errorf("failed to marshal SlashAcks: %w", err))
 	}
 	store.Set(types.SlashAcksKey(chainID), bz)
+	updatePendingSlashAcksGauge(chainID, len(sa.Addresses))
 }
 
-// GetSlashAcks returns the slash acks stored under the given chain ID
-//
-// TODO: SlashAcks should be persisted as a list of ConsumerConsAddr types, not strings.
-// See https://github.com/cosmos/interchain-security/issues/728
-func (k Keeper) GetSlashAcks(ctx sdk.Context, chainID string) []string {
+// getSlashAcks returns the slash acks stored under the given chain ID
+func (k Keeper) getSlashAcks(ctx sdk.Context, chainID string) (acks types.SlashAcks) {
 	store := ctx.KVStore(k.storeKey)
 	bz := store.Get(types.SlashAcksKey(chainID))
 	if bz == nil {
-		return nil
+		return acks
 	}
-	var acks types.SlashAcks
 	if err := acks.Unmarshal(bz); err != nil {
 		// An error here would indicate something is very wrong,
-		// the SlashAcks are assumed to be correctly serialized in SetSlashAcks.
+		// the SlashAcks are assumed to be correctly serialized in setSlashAcks.
 		panic(fmt.Errorf("failed to unmarshal SlashAcks: %w", err))
 	}
+	return acks
+}
+
+// GetSlashAcks returns the slash acks stored under the given chain ID
+//
+// TODO: SlashAcks should be persisted as a list of ConsumerConsAddr types, not strings.
+// See https://github.com/cosmos/interchain-security/issues/728
+func (k Keeper) GetSlashAcks(ctx sdk.Context, chainID string) []string {
+	return k.getSlashAcks(ctx, chainID).Addresses
+}
+
+// IterateSlashAcks iterates over the slash acks of all the registered consumer chains,
+// in ascending order of chainIDs, skipping the chains without any slash ack.
+// The iteration stops if the callback returns true.
+//
+// The order is deterministic, i.e., it does not depend on the order in which the consumer chains
+// were registered or the slash acks were appended: the chainIDs are compared byte-wise (e.g., "c10"
+// comes before "c2"), as they are stored under the ChainToClientBytePrefix, and the slash acks
+// of a consumer chain are in the order they were appended.
+//
+// Note that the slash acks are not iterated over using the SlashAcksBytePrefix,
+// since the slash log of the validators is stored under the same prefix.
+func (k Keeper) IterateSlashAcks(ctx sdk.Context, cb func(chainID string, acks []string) (stop bool)) {
+	for _, chain := range k.GetAllConsumerChains(ctx) {
+		acks := k.GetSlashAcks(ctx, chain.ChainId)
+		if len(acks) == 0 {
+			continue
+		}
+		if cb(chain.ChainId, acks) {
+			return
+		}
+	}
+}
 
-	return acks.GetAddresses()
+// GetSlashAckInfractions returns the infraction types of the slash acks stored
+// under the given chain ID, in the same order as the slash acks returned by GetSlashAcks
+func (k Keeper) GetSlashAckInfractions(ctx sdk.Context, chainID string) []stakingtypes.InfractionType {
+	acks := k.getSlashAcks(ctx, chainID)
+	if len(acks.Addresses) == 0 {
+		return nil
+	}
+	infractions := make([]stakingtypes.InfractionType, len(acks.Addresses))
+	for i := range infractions {
+		infractions[i] = stakingtypes.Downtime
+	}
+	copy(infractions, acks.Infractions)
+	return infractions
 }
 
 // ConsumeSlashAcks empties and returns the slash acks for a given chain ID
@@ -780,6 +1360,7 @@ func (k Keeper) ConsumeSlashAcks(ctx sdk.Context, chainID string) (acks []string
 	}
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.SlashAcksKey(chainID))
+	updatePendingSlashAcksGauge(chainID, 0)
 	return
 }
 
@@ -787,15 +1368,354 @@ func (k Keeper) ConsumeSlashAcks(ctx sdk.Context, chainID string) (acks []string
 func (k Keeper) DeleteSlashAcks(ctx sdk.Context, chainID string) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.SlashAcksKey(chainID))
+	updatePendingSlashAcksGauge(chainID, 0)
 }
 
-// AppendSlashAck appends the given slash ack to the given chain ID slash acks in store
+// AppendSlashAck appends the given slash ack, together with its infraction type,
+// to the given chain ID slash acks in store. The block time of the first slash ack
+// is recorded as the start of the batch of slash acks, see SendPendingSlashAcks.
+//
+// A slash ack already pending with the same infraction type is not appended again,
+// since the consumer chain handles it only once. If the consumer chain already has
+// MaxSlashAcksPerChain pending slash acks, the slash ack is still appended, since the
+// consumer chain only clears the outstanding downtime of a validator once it receives
+// its slash ack, but the exceeded cap is reported.
 func (k Keeper) AppendSlashAck(ctx sdk.Context, chainID,
 	ack string, // TODO: consumer cons addr should be accepted here, see https://github.com/cosmos/interchain-security/issues/728
+	infraction stakingtypes.InfractionType,
 ) {
 	acks := k.GetSlashAcks(ctx, chainID)
-	acks = append(acks, ack)
-	k.SetSlashAcks(ctx, chainID, acks)
+	infractions := k.GetSlashAckInfractions(ctx, chainID)
+	for i := range acks {
+		if acks[i] == ack && infractions[i] == infraction {
+			return
+		}
+	}
+	if len(acks) >= types.MaxSlashAcksPerChain {
+		k.handleSlashAcksCapExceeded(ctx, chainID, ack, infraction)
+	}
+	// the batch of slash acks starts with its first slash ack
+	batchStartTime := k.getSlashAcks(ctx, chainID).BatchStartTime
+	if len(acks) == 0 {
+		blockTime := ctx.BlockTime()
+		batchStartTime = &blockTime
+	}
+	k.setSlashAcks(ctx, chainID, types.SlashAcks{
+		Addresses:      append(acks, ack),
+		Infractions:    append(infractions, infraction),
+		BatchStartTime: batchStartTime,
+	})
+	incrSlashAcksCounter(chainID)
+}
+
+// handleSlashAcksCapExceeded emits an event and increments the cap exceeded counter when the
+// given slash ack is appended, although the consumer with chainID reached MaxSlashAcksPerChain
+func (k Keeper) handleSlashAcksCapExceeded(ctx sdk.Context, chainID, ack string, infraction stakingtypes.InfractionType) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			ccv.EventTypeSlashAcksCapExceeded,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(ccv.AttributeChainID, chainID),
+			sdk.NewAttribute(ccv.AttributeValidatorConsumerAddress, ack),
+			sdk.NewAttribute(ccv.AttributeInfractionType, infraction.String()),
+		),
+	)
+	incrSlashAcksCapExceededCounter(chainID)
+	k.Logger(ctx).Error("consumer chain exceeded the max number of pending slash acks",
+		"chainID", chainID,
+		"ack", ack,
+		"infraction", infraction.String(),
+	)
+}
+
+// SetSendSlashConfirmations sets whether the consumer chain with the given chain ID
+// expects a confirmation packet after each of its slash requests is handled
+func (k Keeper) SetSendSlashConfirmations(ctx sdk.Context, chainID string, enabled bool) {
+	store := ctx.KVStore(k.storeKey)
+	if !enabled {
+		store.Delete(types.SendSlashConfirmationsKey(chainID))
+		return
+	}
+	store.Set(types.SendSlashConfirmationsKey(chainID), []byte{})
+}
+
+// GetSendSlashConfirmations returns whether the consumer chain with the given chain ID
+// expects a confirmation packet after each of its slash requests is handled
+func (k Keeper) GetSendSlashConfirmations(ctx sdk.Context, chainID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.SendSlashConfirmationsKey(chainID))
+}
+
+// SetSlashDoubleSigns sets whether the provider applies the double-sign
+// slash packets received from the consumer chain with the given chain ID
+func (k Keeper) SetSlashDoubleSigns(ctx sdk.Context, chainID string, enabled bool) {
+	store := ctx.KVStore(k.storeKey)
+	if !enabled {
+		store.Delete(types.SlashDoubleSignsKey(chainID))
+		return
+	}
+	store.Set(types.SlashDoubleSignsKey(chainID), []byte{})
+}
+
+// GetSlashDoubleSigns returns whether the provider applies the double-sign
+// slash packets received from the consumer chain with the given chain ID
+func (k Keeper) GetSlashDoubleSigns(ctx sdk.Context, chainID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.SlashDoubleSignsKey(chainID))
+}
+
+// SetConsumerChainPaused sets whether the consumer chain with the given chain ID is paused,
+// i.e., whether the validator set updates sent to it are withheld
+func (k Keeper) SetConsumerChainPaused(ctx sdk.Context, chainID string, paused bool) {
+	store := ctx.KVStore(k.storeKey)
+	if !paused {
+		store.Delete(types.ConsumerPausedKey(chainID))
+		return
+	}
+	store.Set(types.ConsumerPausedKey(chainID), []byte{})
+}
+
+// IsConsumerChainPaused returns whether the consumer chain with the given chain ID is paused
+func (k Keeper) IsConsumerChainPaused(ctx sdk.Context, chainID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.ConsumerPausedKey(chainID))
+}
+
+// PauseConsumerChain pauses the validating consumer chain with the given chain ID, i.e.,
+// the validator set updates are queued, but they are not sent to the consumer chain
+// until it is resumed. The CCV channel stays open and the unbonding operations
+// keep waiting on the consumer chain.
+func (k Keeper) PauseConsumerChain(ctx sdk.Context, chainID string) error {
+	if _, found := k.GetChainToChannel(ctx, chainID); !found {
+		return sdkerrors.Wrapf(types.ErrInvalidConsumerPauseChange,
+			"cannot pause consumer chain %s: the consumer chain is not validating", chainID)
+	}
+	if k.IsConsumerChainPaused(ctx, chainID) {
+		return sdkerrors.Wrapf(types.ErrInvalidConsumerPauseChange,
+			"cannot pause consumer chain %s: the consumer chain is already paused", chainID)
+	}
+	k.SetConsumerChainPaused(ctx, chainID, true)
+	return nil
+}
+
+// ResumeConsumerChain resumes the paused consumer chain with the given chain ID, i.e.,
+// the validator set updates queued while it was paused are sent in the next EndBlock
+func (k Keeper) ResumeConsumerChain(ctx sdk.Context, chainID string) error {
+	if !k.IsConsumerChainPaused(ctx, chainID) {
+		return sdkerrors.Wrapf(types.ErrInvalidConsumerPauseChange,
+			"cannot resume consumer chain %s: the consumer chain is not paused", chainID)
+	}
+	k.SetConsumerChainPaused(ctx, chainID, false)
+	return nil
+}
+
+// SetDowntimeJailDuration sets the duration for which the validators are jailed
+// for a downtime infraction on the consumer chain with the given chain ID.
+// A zero duration deletes the override, so that the slashing module default applies.
+func (k Keeper) SetDowntimeJailDuration(ctx sdk.Context, chainID string, duration time.Duration) {
+	store := ctx.KVStore(k.storeKey)
+	if duration == 0 {
+		store.Delete(types.DowntimeJailDurationKey(chainID))
+		return
+	}
+	store.Set(types.DowntimeJailDurationKey(chainID), sdk.Uint64ToBigEndian(uint64(duration)))
+}
+
+// GetDowntimeJailDuration returns the duration for which the validators are jailed
+// for a downtime infraction on the consumer chain with the given chain ID,
+// and false if the slashing module default applies
+func (k Keeper) GetDowntimeJailDuration(ctx sdk.Context, chainID string) (time.Duration, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.DowntimeJailDurationKey(chainID))
+	if bz == nil {
+		return 0, false
+	}
+	return time.Duration(sdk.BigEndianToUint64(bz)), true
+}
+
+// SetTopN sets the number of provider validators with the largest powers that validate
+// the consumer chain with the given chain ID. Zero deletes the setting, so that all the validators validate it.
+func (k Keeper) SetTopN(ctx sdk.Context, chainID string, n uint32) {
+	store := ctx.KVStore(k.storeKey)
+	if n == 0 {
+		store.Delete(types.TopNKey(chainID))
+		return
+	}
+	store.Set(types.TopNKey(chainID), sdk.Uint64ToBigEndian(uint64(n)))
+}
+
+// GetTopN returns the number of provider validators with the largest powers that validate
+// the consumer chain with the given chain ID, or zero if all the validators validate it
+func (k Keeper) GetTopN(ctx sdk.Context, chainID string) uint32 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TopNKey(chainID))
+	if bz == nil {
+		return 0
+	}
+	return uint32(sdk.BigEndianToUint64(bz))
+}
+
+// SetSlashConfirmationSeq sets the sequence number of the last slash confirmation packet
+// sent to the consumer chain with the given chain ID
+func (k Keeper) SetSlashConfirmationSeq(ctx sdk.Context, chainID string, seq uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.SlashConfirmationSeqKey(chainID), sdk.Uint64ToBigEndian(seq))
+}
+
+// GetSlashConfirmationSeq returns the sequence number of the last slash confirmation packet
+// sent to the consumer chain with the given chain ID
+func (k Keeper) GetSlashConfirmationSeq(ctx sdk.Context, chainID string) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.SlashConfirmationSeqKey(chainID))
+	if bz == nil {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// DeleteSlashConfirmationSeq deletes the sequence number of the last slash confirmation packet
+// sent to the consumer chain with the given chain ID
+func (k Keeper) DeleteSlashConfirmationSeq(ctx sdk.Context, chainID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.SlashConfirmationSeqKey(chainID))
+}
+
+// SetLastSentSequence sets the sequence number of the last packet
+// sent to the consumer chain with the given chain ID
+func (k Keeper) SetLastSentSequence(ctx sdk.Context, chainID string, seq uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastSentSequenceKey(chainID), sdk.Uint64ToBigEndian(seq))
+}
+
+// GetLastSentSequence returns the sequence number of the last packet
+// sent to the consumer chain with the given chain ID
+func (k Keeper) GetLastSentSequence(ctx sdk.Context, chainID string) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastSentSequenceKey(chainID))
+	if bz == nil {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// DeleteLastSentSequence deletes the sequence number of the last packet
+// sent to the consumer chain with the given chain ID
+func (k Keeper) DeleteLastSentSequence(ctx sdk.Context, chainID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.LastSentSequenceKey(chainID))
+}
+
+// SetLastAckedSequence sets the sequence number of the last acknowledged packet
+// sent to the consumer chain with the given chain ID
+func (k Keeper) SetLastAckedSequence(ctx sdk.Context, chainID string, seq uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastAckedSequenceKey(chainID), sdk.Uint64ToBigEndian(seq))
+}
+
+// GetLastAckedSequence returns the sequence number of the last acknowledged packet
+// sent to the consumer chain with the given chain ID
+func (k Keeper) GetLastAckedSequence(ctx sdk.Context, chainID string) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastAckedSequenceKey(chainID))
+	if bz == nil {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// DeleteLastAckedSequence deletes the sequence number of the last acknowledged packet
+// sent to the consumer chain with the given chain ID
+func (k Keeper) DeleteLastAckedSequence(ctx sdk.Context, chainID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.LastAckedSequenceKey(chainID))
+}
+
+// GetPacketSequenceGap returns the number of packets sent to the consumer chain
+// with the given chain ID that are not acknowledged yet.
+// Since CCV channels are ordered, the packets are acknowledged in the order they were sent.
+func (k Keeper) GetPacketSequenceGap(ctx sdk.Context, chainID string) uint64 {
+	lastSent, _ := k.GetLastSentSequence(ctx, chainID)
+	lastAcked, _ := k.GetLastAckedSequence(ctx, chainID)
+	if lastAcked >= lastSent {
+		return 0
+	}
+	return lastSent - lastAcked
+}
+
+// SetLastConsumerActivity sets the provider block at which the provider
+// last heard from the consumer chain with the given chain ID
+func (k Keeper) SetLastConsumerActivity(ctx sdk.Context, chainID string, activity types.ConsumerActivity) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := activity.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// ConsumerActivity is instantiated only by the provider.
+		panic(fmt.Errorf("failed to marshal consumer activity: %w", err))
+	}
+	store.Set(types.LastConsumerActivityKey(chainID), bz)
+}
+
+// GetLastConsumerActivity returns the provider block at which the provider
+// last heard from the consumer chain with the given chain ID
+func (k Keeper) GetLastConsumerActivity(ctx sdk.Context, chainID string) (types.ConsumerActivity, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastConsumerActivityKey(chainID))
+	if bz == nil {
+		return types.ConsumerActivity{}, false
+	}
+
+	var activity types.ConsumerActivity
+	if err := activity.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the ConsumerActivity is assumed to be correctly serialized in SetLastConsumerActivity.
+		panic(fmt.Errorf("failed to unmarshal consumer activity: %w", err))
+	}
+	return activity, true
+}
+
+// DeleteLastConsumerActivity deletes the provider block at which the provider
+// last heard from the consumer chain with the given chain ID
+func (k Keeper) DeleteLastConsumerActivity(ctx sdk.Context, chainID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.LastConsumerActivityKey(chainID))
+}
+
+// recordConsumerActivity records that the provider heard from the consumer chain
+// with the given chain ID in the current block
+func (k Keeper) recordConsumerActivity(ctx sdk.Context, chainID string) {
+	k.SetLastConsumerActivity(ctx, chainID, types.ConsumerActivity{
+		Height: ctx.BlockHeight(),
+		Time:   ctx.BlockTime(),
+	})
+}
+
+// IsConsumerInactive returns whether a consumer chain, last heard from in the given block,
+// is inactive, i.e., was not heard from within the ConsumerLivenessWindow param.
+// Consumer chains are never inactive if the param is zero.
+func (k Keeper) IsConsumerInactive(ctx sdk.Context, lastActivity types.ConsumerActivity) bool {
+	window := k.GetConsumerLivenessWindow(ctx)
+	return window != 0 && !ctx.BlockTime().Before(lastActivity.Time.Add(window))
+}
+
+// ReportInactiveConsumers logs and increments the inactive consumer counter for every consumer chain
+// with an established CCV channel that was not heard from within the ConsumerLivenessWindow param
+func (k Keeper) ReportInactiveConsumers(ctx sdk.Context) {
+	if k.GetConsumerLivenessWindow(ctx) == 0 {
+		return
+	}
+	for _, chain := range k.GetAllConsumerChains(ctx) {
+		// the activity of a consumer chain is recorded once its CCV channel is established
+		lastActivity, found := k.GetLastConsumerActivity(ctx, chain.ChainId)
+		if !found || !k.IsConsumerInactive(ctx, lastActivity) {
+			continue
+		}
+		incrConsumerInactiveCounter(chain.ChainId)
+		k.Logger(ctx).Error("consumer chain was not heard from within the liveness window",
+			"chainID", chain.ChainId,
+			"last activity height", lastActivity.Height,
+			"inactive duration", ctx.BlockTime().Sub(lastActivity.Time).String(),
+		)
+	}
 }
 
 // SetInitChainHeight sets the provider block height when the given consumer chain was initiated
@@ -835,7 +1755,7 @@ func (k Keeper) GetPendingVSCPackets(ctx sdk.Context, chainID string) []ccv.Vali
 	}
 	if err := packets.Unmarshal(bz); err != nil {
 		// An error here would indicate something is very wrong,
-		// the PendingVSCPackets are assumed to be correctly serialized in AppendPendingVSCPackets.
+		// the PendingVSCPackets are assumed to be correctly serialized in SetPendingVSCPackets.
 		panic(fmt.Errorf("cannot unmarshal pending validator set changes: %w", err))
 	}
 	return packets.GetList()
@@ -844,8 +1764,11 @@ func (k Keeper) GetPendingVSCPackets(ctx sdk.Context, chainID string) []ccv.Vali
 // AppendPendingVSCPackets adds the given ValidatorSetChange packet to the list
 // of pending ValidatorSetChange packets stored under chain ID
 func (k Keeper) AppendPendingVSCPackets(ctx sdk.Context, chainID string, newPackets ...ccv.ValidatorSetChangePacketData) {
-	pds := append(k.GetPendingVSCPackets(ctx, chainID), newPackets...)
+	k.SetPendingVSCPackets(ctx, chainID, append(k.GetPendingVSCPackets(ctx, chainID), newPackets...))
+}
 
+// SetPendingVSCPackets replaces the list of pending ValidatorSetChange packets stored under chain ID
+func (k Keeper) SetPendingVSCPackets(ctx sdk.Context, chainID string, pds []ccv.ValidatorSetChangePacketData) {
 	store := ctx.KVStore(k.storeKey)
 	packets := ccv.ValidatorSetChangePackets{List: pds}
 	buf, err := packets.Marshal()
@@ -949,6 +1872,37 @@ func (k Keeper) SetVscSendTimestamp(
 	store.Set(types.VscSendingTimestampKey(chainID, vscID), timeBz)
 }
 
+// SetClientInactiveTimestamp sets the time at which the provider first observed
+// that the client to the consumer chain with the given chain ID is expired or frozen
+func (k Keeper) SetClientInactiveTimestamp(ctx sdk.Context, chainID string, timestamp time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ClientInactiveTimestampKey(chainID), sdk.FormatTimeBytes(timestamp))
+}
+
+// GetClientInactiveTimestamp returns the time at which the provider first observed
+// that the client to the consumer chain with the given chain ID is expired or frozen
+func (k Keeper) GetClientInactiveTimestamp(ctx sdk.Context, chainID string) (time.Time, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ClientInactiveTimestampKey(chainID))
+	if bz == nil {
+		return time.Time{}, false
+	}
+	ts, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the timestamp is assumed to be correctly serialized in SetClientInactiveTimestamp.
+		panic(fmt.Errorf("failed to parse client inactive timestamp: %w", err))
+	}
+	return ts, true
+}
+
+// DeleteClientInactiveTimestamp removes the time at which the provider first observed
+// that the client to the consumer chain with the given chain ID is expired or frozen
+func (k Keeper) DeleteClientInactiveTimestamp(ctx sdk.Context, chainID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ClientInactiveTimestampKey(chainID))
+}
+
 // GetVscSendTimestamp returns a VSC send timestamp by chainID and vscID
 //
 // Note: This method is used only for testing.
@@ -1053,6 +2007,135 @@ func (k Keeper) GetFirstVscSendTimestamp(ctx sdk.Context, chainID string) (vscSe
 	return types.VscSendTimestamp{}, false
 }
 
+// GetUnackedValsetUpdateIds returns, in ascending order, the vscIDs in the range [from, to]
+// of all VSCPackets that were sent to the chain with ID chainID,
+// but for which no VSCMaturedPacket was received yet
+//
+// Note that a VSC send timestamp is set when a VSCPacket is sent to a consumer chain
+// and it is removed once the corresponding VSCMaturedPacket is handled.
+func (k Keeper) GetUnackedValsetUpdateIds(ctx sdk.Context, chainID string, from, to uint64) (vscIDs []uint64) {
+	if from > to {
+		return vscIDs
+	}
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ChainIdWithLenKey(types.VscSendTimestampBytePrefix, chainID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		_, vscID, err := types.ParseVscSendingTimestampKey(iterator.Key())
+		if err != nil {
+			// An error here would indicate something is very wrong,
+			// the store key is assumed to be correctly serialized in SetVscSendTimestamp.
+			panic(fmt.Errorf("failed to parse VscSendTimestampKey: %w", err))
+		}
+		if vscID < from {
+			continue
+		}
+		if vscID > to {
+			// the iteration is in ascending order of vscIDs
+			break
+		}
+		vscIDs = append(vscIDs, vscID)
+	}
+
+	return vscIDs
+}
+
+// SetValidatorJailRecord records the valset update ID at which the validator
+// with the given provider address was jailed for a downtime infraction on a consumer chain
+func (k Keeper) SetValidatorJailRecord(ctx sdk.Context, providerAddr types.ProviderConsAddress, vscID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, vscID)
+	store.Set(types.ValidatorJailRecordKey(providerAddr), bz)
+}
+
+// GetValidatorJailRecord returns the valset update ID at which the validator
+// with the given provider address was last jailed for a downtime infraction on a consumer chain
+func (k Keeper) GetValidatorJailRecord(ctx sdk.Context, providerAddr types.ProviderConsAddress) (vscID uint64, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ValidatorJailRecordKey(providerAddr))
+	if bz == nil {
+		return 0, false
+	}
+	return binary.BigEndian.Uint64(bz), true
+}
+
+// DeleteValidatorJailRecord deletes the jail record of the validator with the given provider address
+func (k Keeper) DeleteValidatorJailRecord(ctx sdk.Context, providerAddr types.ProviderConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ValidatorJailRecordKey(providerAddr))
+}
+
+// GetAllValidatorJailRecords returns the jail records of all the validators
+func (k Keeper) GetAllValidatorJailRecords(ctx sdk.Context) (records []types.ValidatorJailRecord) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{types.ValidatorJailRecordBytePrefix})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		providerAddr := types.NewProviderConsAddress(iterator.Key()[1:])
+		records = append(records, types.ValidatorJailRecord{
+			ProviderAddr: &providerAddr,
+			VscId:        binary.BigEndian.Uint64(iterator.Value()),
+		})
+	}
+	return records
+}
+
+// SetLastDowntimeInfractionHeight records the provider block height of the last downtime infraction
+// of the validator with the given provider address on the given consumer chain that was applied
+func (k Keeper) SetLastDowntimeInfractionHeight(ctx sdk.Context, chainID string, providerAddr types.ProviderConsAddress, height uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, height)
+	store.Set(types.LastDowntimeInfractionHeightKey(chainID, providerAddr), bz)
+}
+
+// GetLastDowntimeInfractionHeight returns the provider block height of the last downtime infraction
+// of the validator with the given provider address on the given consumer chain that was applied
+func (k Keeper) GetLastDowntimeInfractionHeight(ctx sdk.Context, chainID string, providerAddr types.ProviderConsAddress) (height uint64, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastDowntimeInfractionHeightKey(chainID, providerAddr))
+	if bz == nil {
+		return 0, false
+	}
+	return binary.BigEndian.Uint64(bz), true
+}
+
+// DeleteLastDowntimeInfractionHeight deletes the last downtime infraction height
+// of the validator with the given provider address on the given consumer chain
+func (k Keeper) DeleteLastDowntimeInfractionHeight(ctx sdk.Context, chainID string, providerAddr types.ProviderConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.LastDowntimeInfractionHeightKey(chainID, providerAddr))
+}
+
+// GetAllLastDowntimeInfractionHeights returns the last downtime infraction heights
+// of all the validators on the given consumer chain
+func (k Keeper) GetAllLastDowntimeInfractionHeights(ctx sdk.Context, chainID string) (heights []types.ValidatorInfractionHeight) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.ChainIdWithLenKey(types.LastDowntimeInfractionHeightBytePrefix, chainID)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		providerAddr := types.NewProviderConsAddress(iterator.Key()[len(prefix):])
+		heights = append(heights, types.ValidatorInfractionHeight{
+			ProviderAddr:     &providerAddr,
+			InfractionHeight: binary.BigEndian.Uint64(iterator.Value()),
+		})
+	}
+	return heights
+}
+
+// DeleteAllLastDowntimeInfractionHeights deletes the last downtime infraction heights
+// of all the validators on the given consumer chain
+func (k Keeper) DeleteAllLastDowntimeInfractionHeights(ctx sdk.Context, chainID string) {
+	for _, record := range k.GetAllLastDowntimeInfractionHeights(ctx, chainID) {
+		k.DeleteLastDowntimeInfractionHeight(ctx, chainID, *record.ProviderAddr)
+	}
+}
+
 // SetSlashLog updates validator's slash log for a consumer chain
 // If an entry exists for a given validator address, at least one
 // double signing slash packet was received by the provider from at least one consumer chain
@@ -2,21 +2,34 @@ package keeper_test
 
 import (
 	"fmt"
+	"math"
 	"sort"
 	"testing"
 	"time"
 
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	conntypes "github.com/cosmos/ibc-go/v4/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+	solomachinetypes "github.com/cosmos/ibc-go/v4/modules/light-clients/06-solomachine/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
 
 	ibcsimapp "github.com/cosmos/interchain-security/legacy_ibc_testing/simapp"
 
 	cryptotestutil "github.com/cosmos/interchain-security/testutil/crypto"
 	testkeeper "github.com/cosmos/interchain-security/testutil/keeper"
+	consumertypes "github.com/cosmos/interchain-security/x/ccv/consumer/types"
 	"github.com/cosmos/interchain-security/x/ccv/provider/types"
 	ccv "github.com/cosmos/interchain-security/x/ccv/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmprotocrypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -87,6 +100,196 @@ func TestGetAllValsetUpdateBlockHeights(t *testing.T) {
 	require.Equal(t, expectedGetAllOrder, result)
 }
 
+// TestGetValsetUpdateHeightRange tests that the height range of a valset update id
+// is derived from the block heights mapped to it and to the next mapped valset update id
+func TestGetValsetUpdateHeightRange(t *testing.T) {
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// valset update ids 1, 2, 3, and 5 are mapped to sequential block heights
+	heights := map[uint64]uint64{1: 10, 2: 15, 3: 21, 5: 30}
+	for vscID, height := range heights {
+		pk.SetValsetUpdateBlockHeight(ctx, vscID, height)
+	}
+
+	testCases := []struct {
+		vscID         uint64
+		expectedStart uint64
+		expectedEnd   uint64
+		expectedFound bool
+	}{
+		{vscID: 0, expectedFound: false},
+		{vscID: 1, expectedStart: 10, expectedEnd: 15, expectedFound: true},
+		{vscID: 2, expectedStart: 15, expectedEnd: 21, expectedFound: true},
+		// the range ends at the next mapped valset update id
+		{vscID: 3, expectedStart: 21, expectedEnd: 30, expectedFound: true},
+		{vscID: 4, expectedFound: false},
+		// the range of the newest valset update id is open-ended
+		{vscID: 5, expectedStart: 30, expectedEnd: 0, expectedFound: true},
+		{vscID: 6, expectedFound: false},
+	}
+	for _, tc := range testCases {
+		start, end, found := pk.GetValsetUpdateHeightRange(ctx, tc.vscID)
+		require.Equal(t, tc.expectedFound, found, "vscID %d", tc.vscID)
+		require.Equal(t, tc.expectedStart, start, "vscID %d", tc.vscID)
+		require.Equal(t, tc.expectedEnd, end, "vscID %d", tc.vscID)
+
+		res, err := pk.QueryValsetUpdateHeightRange(sdk.WrapSDKContext(ctx), &types.QueryValsetUpdateHeightRangeRequest{VscId: tc.vscID})
+		if !tc.expectedFound {
+			require.Error(t, err, "vscID %d", tc.vscID)
+			continue
+		}
+		require.NoError(t, err, "vscID %d", tc.vscID)
+		require.Equal(t, &types.QueryValsetUpdateHeightRangeResponse{
+			VscId:       tc.vscID,
+			StartHeight: tc.expectedStart,
+			EndHeight:   tc.expectedEnd,
+		}, res)
+	}
+
+	// once the next valset update id is mapped to a block height, the range is closed
+	pk.SetValsetUpdateBlockHeight(ctx, 6, 40)
+	start, end, found := pk.GetValsetUpdateHeightRange(ctx, 5)
+	require.True(t, found)
+	require.Equal(t, uint64(30), start)
+	require.Equal(t, uint64(40), end)
+}
+
+// TestPruneValsetUpdateBlockHeights tests that the block heights of old valset update IDs are pruned
+// an unbonding period after they were replaced, unless an unbonding operation or a throttled slash
+// packet still references them
+func TestPruneValsetUpdateBlockHeights(t *testing.T) {
+	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	params := types.DefaultParams()
+	params.HistoricalValsetEntries = 2
+	pk.SetParams(ctx, params)
+
+	unbondingPeriod := 24 * time.Hour
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(unbondingPeriod).AnyTimes()
+
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	pk.SetConsumerClientId(ctx, "chain", "client")
+	for vscID := uint64(1); vscID <= 6; vscID++ {
+		pk.SetValsetUpdateBlockHeight(ctx, vscID, 10*vscID)
+		pk.SetValsetUpdateTimestamp(ctx, vscID, start.Add(time.Duration(vscID)*time.Hour))
+	}
+	// the unbonding operation 7 waits on the consumer chain for the valset update ID 1
+	pk.SetUnbondingOpIndex(ctx, "chain", 1, []uint64{7})
+	// a throttled slash packet references the valset update ID 2
+	require.NoError(t, pk.QueueThrottledSlashPacketData(ctx, "chain", 1, ccv.SlashPacketData{ValsetUpdateId: 2}))
+	pk.SetValidatorSetUpdateId(ctx, 6)
+
+	// no pruning before an unbonding period elapsed since the valset update IDs were replaced
+	ctx = ctx.WithBlockTime(start.Add(unbondingPeriod))
+	pk.PruneValsetUpdateBlockHeights(ctx)
+	require.Len(t, pk.GetAllValsetUpdateBlockHeights(ctx), 6)
+
+	// the valset update ID 3 was replaced by the valset update ID 4 an unbonding period ago;
+	// the block heights of the valset update IDs 1 and 2 are retained, since they are still referenced
+	ctx = ctx.WithBlockTime(start.Add(unbondingPeriod + 4*time.Hour))
+	pk.PruneValsetUpdateBlockHeights(ctx)
+	require.Equal(t, []uint64{1, 2, 4, 5, 6}, getValsetUpdateIDs(pk.GetAllValsetUpdateBlockHeights(ctx)))
+
+	// the block heights of the HistoricalValsetEntries most recent valset update IDs are retained
+	ctx = ctx.WithBlockTime(start.Add(10 * unbondingPeriod))
+	pk.PruneValsetUpdateBlockHeights(ctx)
+	require.Equal(t, []uint64{1, 2, 5, 6}, getValsetUpdateIDs(pk.GetAllValsetUpdateBlockHeights(ctx)))
+
+	// the block heights are pruned once they are no longer referenced
+	pk.DeleteUnbondingOpIndex(ctx, "chain", 1)
+	pk.DeleteThrottledPacketDataForConsumer(ctx, "chain")
+	pk.PruneValsetUpdateBlockHeights(ctx)
+	require.Equal(t, []uint64{5, 6}, getValsetUpdateIDs(pk.GetAllValsetUpdateBlockHeights(ctx)))
+
+	// the block height of a valset update ID is retained if the time at which it was replaced is unknown
+	pk.SetValsetUpdateBlockHeight(ctx, 7, 70)
+	pk.SetValsetUpdateBlockHeight(ctx, 8, 80)
+	pk.SetValsetUpdateBlockHeight(ctx, 9, 90)
+	pk.SetValidatorSetUpdateId(ctx, 9)
+	pk.PruneValsetUpdateBlockHeights(ctx)
+	require.Equal(t, []uint64{6, 7, 8, 9}, getValsetUpdateIDs(pk.GetAllValsetUpdateBlockHeights(ctx)))
+}
+
+// TestPruneValsetUpdateBlockHeightsCapped tests that at most MaxValsetUpdateIdsCheckedPerBlock
+// valset update IDs are checked for pruning per block, and that the check resumes from the prune
+// cursor in the next block and restarts from the oldest valset update ID once it stopped
+func TestPruneValsetUpdateBlockHeightsCapped(t *testing.T) {
+	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	params := types.DefaultParams()
+	params.HistoricalValsetEntries = 2
+	pk.SetParams(ctx, params)
+
+	unbondingPeriod := 24 * time.Hour
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(unbondingPeriod).AnyTimes()
+
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	pk.SetConsumerClientId(ctx, "chain", "client")
+	numIDs := uint64(2*types.MaxValsetUpdateIdsCheckedPerBlock + 50)
+	for vscID := uint64(1); vscID <= numIDs; vscID++ {
+		pk.SetValsetUpdateBlockHeight(ctx, vscID, 10*vscID)
+		pk.SetValsetUpdateTimestamp(ctx, vscID, start.Add(time.Duration(vscID)*time.Minute))
+	}
+	// the unbonding operation 7 waits on the consumer chain for the valset update ID 1
+	pk.SetUnbondingOpIndex(ctx, "chain", 1, []uint64{7})
+	pk.SetValidatorSetUpdateId(ctx, numIDs)
+	ctx = ctx.WithBlockTime(start.Add(10 * unbondingPeriod))
+
+	// the first MaxValsetUpdateIdsCheckedPerBlock valset update IDs are checked,
+	// the block height of the valset update ID 1 is retained since it is still referenced
+	pk.PruneValsetUpdateBlockHeights(ctx)
+	v2hs := pk.GetAllValsetUpdateBlockHeights(ctx)
+	require.Len(t, v2hs, int(numIDs)-types.MaxValsetUpdateIdsCheckedPerBlock+1)
+	require.Equal(t, uint64(1), v2hs[0].ValsetUpdateId)
+	require.Equal(t, uint64(types.MaxValsetUpdateIdsCheckedPerBlock+1), v2hs[1].ValsetUpdateId)
+
+	// the check resumes from the next valset update ID
+	pk.PruneValsetUpdateBlockHeights(ctx)
+	v2hs = pk.GetAllValsetUpdateBlockHeights(ctx)
+	require.Len(t, v2hs, int(numIDs)-2*types.MaxValsetUpdateIdsCheckedPerBlock+1)
+	require.Equal(t, uint64(2*types.MaxValsetUpdateIdsCheckedPerBlock+1), v2hs[1].ValsetUpdateId)
+
+	// the remaining candidates are pruned, except the HistoricalValsetEntries most recent valset update IDs
+	pk.PruneValsetUpdateBlockHeights(ctx)
+	require.Equal(t, []uint64{1, numIDs - 1, numIDs}, getValsetUpdateIDs(pk.GetAllValsetUpdateBlockHeights(ctx)))
+
+	// the check restarted from the oldest valset update ID, which is pruned once no longer referenced
+	pk.DeleteUnbondingOpIndex(ctx, "chain", 1)
+	pk.PruneValsetUpdateBlockHeights(ctx)
+	require.Equal(t, []uint64{numIDs - 1, numIDs}, getValsetUpdateIDs(pk.GetAllValsetUpdateBlockHeights(ctx)))
+}
+
+func getValsetUpdateIDs(v2hs []types.ValsetUpdateIdToHeight) []uint64 {
+	ids := []uint64{}
+	for _, v2h := range v2hs {
+		ids = append(ids, v2h.ValsetUpdateId)
+	}
+	return ids
+}
+
+// TestIncrementValidatorSetUpdateIdOverflow tests that the validator set update ID
+// is incremented up to the maximum uint64, and that it never wraps around
+func TestIncrementValidatorSetUpdateIdOverflow(t *testing.T) {
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	pk.SetValidatorSetUpdateId(ctx, math.MaxUint64-2)
+	pk.IncrementValidatorSetUpdateId(ctx)
+	require.Equal(t, uint64(math.MaxUint64-1), pk.GetValidatorSetUpdateId(ctx))
+	pk.IncrementValidatorSetUpdateId(ctx)
+	require.Equal(t, uint64(math.MaxUint64), pk.GetValidatorSetUpdateId(ctx))
+
+	// incrementing the maximum ID panics and leaves it unchanged
+	require.Panics(t, func() { pk.IncrementValidatorSetUpdateId(ctx) })
+	require.Equal(t, uint64(math.MaxUint64), pk.GetValidatorSetUpdateId(ctx))
+	// the same applies to the increment path of EndBlock
+	require.Panics(t, func() { pk.CommitValidatorSetUpdate(ctx) })
+	require.Equal(t, uint64(math.MaxUint64), pk.GetValidatorSetUpdateId(ctx))
+}
+
 // TestSlashAcks tests the getter, setter, iteration, and deletion methods for stored slash acknowledgements
 func TestSlashAcks(t *testing.T) {
 	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
@@ -124,6 +327,86 @@ func TestSlashAcks(t *testing.T) {
 	}
 }
 
+// TestIterateSlashAcks tests that the slash acks of the registered consumer chains are iterated over
+// in ascending order of chainIDs, skipping the chains without slash acks and the slash log of the validators
+func TestIterateSlashAcks(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	iterate := func() (chainIDs []string) {
+		providerKeeper.IterateSlashAcks(ctx, func(chainID string, acks []string) bool {
+			chainIDs = append(chainIDs, chainID)
+			return false
+		})
+		return chainIDs
+	}
+
+	// no slash acks
+	require.Empty(t, iterate())
+
+	for _, chainID := range []string{"c3", "c1", "c2"} {
+		providerKeeper.SetConsumerClientId(ctx, chainID, "client-"+chainID)
+	}
+	providerKeeper.SetSlashAcks(ctx, "c3", []string{"alice"})
+	providerKeeper.SetSlashAcks(ctx, "c1", []string{"bob", "charlie"})
+	providerKeeper.SetSlashLog(ctx, cryptotestutil.NewCryptoIdentityFromIntSeed(1).ProviderConsAddress())
+	require.Equal(t, []string{"c1", "c3"}, iterate())
+
+	// the iteration stops once the callback returns true
+	var chainIDs []string
+	providerKeeper.IterateSlashAcks(ctx, func(chainID string, acks []string) bool {
+		chainIDs = append(chainIDs, chainID)
+		return true
+	})
+	require.Equal(t, []string{"c1"}, chainIDs)
+}
+
+// TestSlashAcksOrdering tests that the slash acks of multiple consumer chains are iterated over and queried
+// in the same order, regardless of the order in which the chains were registered and the slash acks appended
+func TestSlashAcksOrdering(t *testing.T) {
+	acksPerChain := map[string][]string{
+		"c2":  {"alice"},
+		"c10": {"bob", "charlie"},
+		"c1":  {"dave"},
+		"b":   {"eve", "frank", "grace"},
+	}
+	expChainIDs := []string{"b", "c1", "c10", "c2"}
+
+	for _, registrationOrder := range [][]string{
+		{"c2", "c10", "c1", "b"},
+		{"b", "c1", "c10", "c2"},
+		{"c10", "b", "c2", "c1"},
+	} {
+		providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+
+		for _, chainID := range registrationOrder {
+			providerKeeper.SetConsumerClientId(ctx, chainID, "client-"+chainID)
+			for _, ack := range acksPerChain[chainID] {
+				providerKeeper.AppendSlashAck(ctx, chainID, ack, stakingtypes.Downtime)
+			}
+		}
+
+		var chainIDs []string
+		providerKeeper.IterateSlashAcks(ctx, func(chainID string, acks []string) bool {
+			chainIDs = append(chainIDs, chainID)
+			// the slash acks of a chain are in the order they were appended
+			require.Equal(t, acksPerChain[chainID], acks)
+			return false
+		})
+		require.Equal(t, expChainIDs, chainIDs, registrationOrder)
+
+		res, err := providerKeeper.QueryAllSlashAcks(sdk.WrapSDKContext(ctx), &types.QueryAllSlashAcksRequest{})
+		require.NoError(t, err)
+		require.Len(t, res.SlashAcks, len(expChainIDs))
+		for i, chainID := range expChainIDs {
+			require.Equal(t, chainID, res.SlashAcks[i].ChainId, registrationOrder)
+			require.Equal(t, acksPerChain[chainID], res.SlashAcks[i].Addresses, registrationOrder)
+		}
+
+		ctrl.Finish()
+	}
+}
+
 // TestAppendSlashAck tests the append method for stored slash acknowledgements
 func TestAppendSlashAck(t *testing.T) {
 	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
@@ -133,15 +416,73 @@ func TestAppendSlashAck(t *testing.T) {
 	chains := []string{"c1", "c2"}
 	providerKeeper.SetSlashAcks(ctx, chains[0], p)
 
-	providerKeeper.AppendSlashAck(ctx, chains[0], p[0])
+	providerKeeper.AppendSlashAck(ctx, chains[0], "dave", stakingtypes.Downtime)
 	acks := providerKeeper.GetSlashAcks(ctx, chains[0])
 	require.NotNil(t, acks)
 	require.Len(t, acks, len(p)+1)
 
-	providerKeeper.AppendSlashAck(ctx, chains[1], p[0])
+	providerKeeper.AppendSlashAck(ctx, chains[1], p[0], stakingtypes.Downtime)
 	acks = providerKeeper.GetSlashAcks(ctx, chains[1])
 	require.NotNil(t, acks)
 	require.Len(t, acks, 1)
+
+	// the infraction types are recorded together with the slash acks,
+	// where the slash acks set without infraction types are for downtime
+	providerKeeper.AppendSlashAck(ctx, chains[0], p[1], stakingtypes.DoubleSign)
+	require.Equal(t, append(p, "dave", p[1]), providerKeeper.GetSlashAcks(ctx, chains[0]))
+	require.Equal(t, []stakingtypes.InfractionType{
+		stakingtypes.Downtime, stakingtypes.Downtime, stakingtypes.Downtime,
+		stakingtypes.Downtime, stakingtypes.DoubleSign,
+	}, providerKeeper.GetSlashAckInfractions(ctx, chains[0]))
+	require.Equal(t, []stakingtypes.InfractionType{stakingtypes.Downtime},
+		providerKeeper.GetSlashAckInfractions(ctx, chains[1]))
+	require.Nil(t, providerKeeper.GetSlashAckInfractions(ctx, "unknown"))
+}
+
+// TestAppendSlashAckDuplicates tests that a slash ack already pending
+// for the same infraction type is not appended again
+func TestAppendSlashAckDuplicates(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	for i := 0; i < 3; i++ {
+		providerKeeper.AppendSlashAck(ctx, "chain", "alice", stakingtypes.Downtime)
+		providerKeeper.AppendSlashAck(ctx, "chain", "bob", stakingtypes.Downtime)
+	}
+	// a slash ack for another infraction type is appended
+	providerKeeper.AppendSlashAck(ctx, "chain", "alice", stakingtypes.DoubleSign)
+	providerKeeper.AppendSlashAck(ctx, "chain", "alice", stakingtypes.DoubleSign)
+	require.Equal(t, []string{"alice", "bob", "alice"}, providerKeeper.GetSlashAcks(ctx, "chain"))
+	require.Equal(t, []stakingtypes.InfractionType{stakingtypes.Downtime, stakingtypes.Downtime, stakingtypes.DoubleSign},
+		providerKeeper.GetSlashAckInfractions(ctx, "chain"))
+
+	// once the slash acks are consumed, the same slash ack is appended again
+	require.Len(t, providerKeeper.ConsumeSlashAcks(ctx, "chain"), 3)
+	providerKeeper.AppendSlashAck(ctx, "chain", "alice", stakingtypes.Downtime)
+	require.Equal(t, []string{"alice"}, providerKeeper.GetSlashAcks(ctx, "chain"))
+}
+
+// TestAppendSlashAckCap tests that the slash acks are still appended, emitting an event,
+// once a consumer chain has MaxSlashAcksPerChain pending slash acks
+func TestAppendSlashAckCap(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	acks := make([]string, types.MaxSlashAcksPerChain)
+	for i := range acks {
+		acks[i] = fmt.Sprintf("validator-%d", i)
+	}
+	providerKeeper.SetSlashAcks(ctx, "chain", acks)
+
+	providerKeeper.AppendSlashAck(ctx, "chain", "alice", stakingtypes.Downtime)
+	require.Equal(t, append(acks, "alice"), providerKeeper.GetSlashAcks(ctx, "chain"))
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, ccv.EventTypeSlashAcksCapExceeded, events[0].Type)
+
+	// the slash acks of other consumer chains are appended
+	providerKeeper.AppendSlashAck(ctx, "chain-2", "alice", stakingtypes.Downtime)
+	require.Equal(t, []string{"alice"}, providerKeeper.GetSlashAcks(ctx, "chain-2"))
 }
 
 // TestPendingVSCs tests the getter, appending, and deletion methods for stored pending VSCs
@@ -260,6 +601,34 @@ func TestGetAllUnbondingOpIndexes(t *testing.T) {
 	require.Equal(t, result, expectedGetAllOrder)
 }
 
+// TestPendingUnbondingOpsCount tests that the number of pending unbonding ops of a consumer chain
+// is kept up to date when its unbonding op indexes are set, overwritten and deleted
+func TestPendingUnbondingOpsCount(t *testing.T) {
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	require.Equal(t, 0, pk.GetPendingUnbondingOpsCount(ctx, "chain-1"))
+
+	pk.SetUnbondingOpIndex(ctx, "chain-1", 1, []uint64{1, 2, 3})
+	pk.SetUnbondingOpIndex(ctx, "chain-1", 2, []uint64{4})
+	pk.SetUnbondingOpIndex(ctx, "chain-2", 1, []uint64{1, 2, 3})
+	require.Equal(t, 4, pk.GetPendingUnbondingOpsCount(ctx, "chain-1"))
+	require.Equal(t, 3, pk.GetPendingUnbondingOpsCount(ctx, "chain-2"))
+
+	// overwriting an index replaces its unbonding ops in the count
+	pk.SetUnbondingOpIndex(ctx, "chain-1", 1, []uint64{1, 2, 3, 5, 6})
+	require.Equal(t, 6, pk.GetPendingUnbondingOpsCount(ctx, "chain-1"))
+
+	pk.DeleteUnbondingOpIndex(ctx, "chain-1", 1)
+	require.Equal(t, 1, pk.GetPendingUnbondingOpsCount(ctx, "chain-1"))
+	// deleting an index that does not exist leaves the count unchanged
+	pk.DeleteUnbondingOpIndex(ctx, "chain-1", 1)
+	require.Equal(t, 1, pk.GetPendingUnbondingOpsCount(ctx, "chain-1"))
+	pk.DeleteUnbondingOpIndex(ctx, "chain-1", 2)
+	require.Equal(t, 0, pk.GetPendingUnbondingOpsCount(ctx, "chain-1"))
+	require.Equal(t, 3, pk.GetPendingUnbondingOpsCount(ctx, "chain-2"))
+}
+
 func TestMaturedUnbondingOps(t *testing.T) {
 
 	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
@@ -390,6 +759,47 @@ func TestVscSendTimestamp(t *testing.T) {
 	require.Empty(t, providerKeeper.GetAllVscSendTimestamps(ctx, chainID))
 }
 
+// TestGetUnackedValsetUpdateIds tests that GetUnackedValsetUpdateIds returns
+// the vscIDs within a range that were sent to a chain, but not acked yet
+func TestGetUnackedValsetUpdateIds(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	chainID := "chain"
+	require.Empty(t, providerKeeper.GetUnackedValsetUpdateIds(ctx, chainID, 0, 10))
+
+	// send VSCPackets with vscIDs 1 to 6 to chain and vscID 3 to another chain
+	now := time.Now().UTC()
+	for vscID := uint64(1); vscID <= 6; vscID++ {
+		providerKeeper.SetVscSendTimestamp(ctx, chainID, vscID, now)
+	}
+	providerKeeper.SetVscSendTimestamp(ctx, "chain1", 3, now)
+
+	// chain acks vscIDs 2 and 5
+	providerKeeper.HandleVSCMaturedPacket(ctx, chainID, ccv.VSCMaturedPacketData{ValsetUpdateId: 2})
+	providerKeeper.HandleVSCMaturedPacket(ctx, chainID, ccv.VSCMaturedPacketData{ValsetUpdateId: 5})
+
+	testCases := []struct {
+		from     uint64
+		to       uint64
+		expected []uint64
+	}{
+		{from: 0, to: 10, expected: []uint64{1, 3, 4, 6}},
+		{from: 2, to: 5, expected: []uint64{3, 4}},
+		{from: 3, to: 3, expected: []uint64{3}},
+		{from: 5, to: 5, expected: nil},
+		{from: 7, to: 10, expected: nil},
+		{from: 5, to: 2, expected: nil},
+	}
+	for _, tc := range testCases {
+		require.Equal(t, tc.expected, providerKeeper.GetUnackedValsetUpdateIds(ctx, chainID, tc.from, tc.to),
+			"from %d to %d", tc.from, tc.to)
+	}
+
+	// acks of one chain do not affect another chain
+	require.Equal(t, []uint64{3}, providerKeeper.GetUnackedValsetUpdateIds(ctx, "chain1", 0, 10))
+}
+
 // TestGetAllConsumerChains tests GetAllConsumerChains behaviour correctness
 func TestGetAllConsumerChains(t *testing.T) {
 	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
@@ -412,6 +822,71 @@ func TestGetAllConsumerChains(t *testing.T) {
 	require.Equal(t, expectedGetAllOrder, result)
 }
 
+// TestGetConsumerChainsPaginated tests that GetConsumerChainsPaginated returns
+// the consumer chains in pages, in the same order as GetAllConsumerChains
+func TestGetConsumerChainsPaginated(t *testing.T) {
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// no consumer chains
+	chains, pageRes, err := pk.GetConsumerChainsPaginated(ctx, &query.PageRequest{Limit: 10, CountTotal: true})
+	require.NoError(t, err)
+	require.Empty(t, chains)
+	require.Nil(t, pageRes.NextKey)
+	require.Zero(t, pageRes.Total)
+
+	numChains := 25
+	for i := 0; i < numChains; i++ {
+		pk.SetConsumerClientId(ctx, fmt.Sprintf("chain-%02d", i), fmt.Sprintf("client-%d", i))
+	}
+	allChains := pk.GetAllConsumerChains(ctx)
+	require.Len(t, allChains, numChains)
+
+	// iterate through the pages using the next keys
+	pageReq := &query.PageRequest{Limit: 10, CountTotal: true}
+	gotChains := []types.Chain{}
+	expectedPageSizes := []int{10, 10, 5}
+	for i, pageSize := range expectedPageSizes {
+		chains, pageRes, err := pk.GetConsumerChainsPaginated(ctx, pageReq)
+		require.NoError(t, err)
+		require.Len(t, chains, pageSize)
+		require.Equal(t, allChains[len(gotChains):len(gotChains)+pageSize], chains)
+		gotChains = append(gotChains, chains...)
+		if i == len(expectedPageSizes)-1 {
+			// there are no chains after the last page
+			require.Nil(t, pageRes.NextKey)
+		} else {
+			require.NotNil(t, pageRes.NextKey)
+		}
+		if i == 0 {
+			// the total is only counted for the first page
+			require.Equal(t, uint64(numChains), pageRes.Total)
+		}
+		pageReq = &query.PageRequest{Key: pageRes.NextKey, Limit: 10}
+	}
+	require.Equal(t, allChains, gotChains)
+
+	// a page ending exactly at the last chain
+	chains, pageRes, err = pk.GetConsumerChainsPaginated(ctx, &query.PageRequest{Offset: 20, Limit: 5})
+	require.NoError(t, err)
+	require.Equal(t, allChains[20:], chains)
+	require.Nil(t, pageRes.NextKey)
+
+	// an offset past the last chain returns an empty page
+	chains, _, err = pk.GetConsumerChainsPaginated(ctx, &query.PageRequest{Offset: 30, Limit: 5})
+	require.NoError(t, err)
+	require.Empty(t, chains)
+
+	// no page request returns all chains
+	chains, _, err = pk.GetConsumerChainsPaginated(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, allChains, chains)
+
+	// key and offset cannot both be set
+	_, _, err = pk.GetConsumerChainsPaginated(ctx, &query.PageRequest{Key: []byte("chain-05"), Offset: 1})
+	require.Error(t, err)
+}
+
 // TestGetAllChannelToChains tests GetAllChannelToChains behaviour correctness
 func TestGetAllChannelToChains(t *testing.T) {
 	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
@@ -434,6 +909,44 @@ func TestGetAllChannelToChains(t *testing.T) {
 	require.Equal(t, expectedGetAllOrder, result)
 }
 
+// TestGetChannelToChainsPaginated tests that GetChannelToChainsPaginated returns
+// the channel to chain mappings in pages, in the same order as GetAllChannelToChains
+func TestGetChannelToChainsPaginated(t *testing.T) {
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// no channels
+	channels, pageRes, err := pk.GetChannelToChainsPaginated(ctx, &query.PageRequest{Limit: 2})
+	require.NoError(t, err)
+	require.Empty(t, channels)
+	require.Nil(t, pageRes.NextKey)
+
+	for i := 0; i < 5; i++ {
+		pk.SetChannelToChain(ctx, fmt.Sprintf("channel-%d", i), fmt.Sprintf("chain-%d", i))
+	}
+	allChannels := pk.GetAllChannelToChains(ctx)
+
+	// iterate through the pages using the next keys
+	pageReq := &query.PageRequest{Limit: 2, CountTotal: true}
+	gotChannels := []types.ChannelToChain{}
+	for {
+		channels, pageRes, err := pk.GetChannelToChainsPaginated(ctx, pageReq)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(channels), 2)
+		gotChannels = append(gotChannels, channels...)
+		if pageRes.NextKey == nil {
+			break
+		}
+		pageReq = &query.PageRequest{Key: pageRes.NextKey, Limit: 2}
+	}
+	require.Equal(t, allChannels, gotChannels)
+
+	// no page request returns all channels
+	channels, _, err = pk.GetChannelToChainsPaginated(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, allChannels, channels)
+}
+
 // TestGetAllUnbondingOps tests GetAllUnbondingOps behaviour correctness
 func TestGetAllUnbondingOps(t *testing.T) {
 	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
@@ -443,18 +956,22 @@ func TestGetAllUnbondingOps(t *testing.T) {
 		{
 			Id:                      2,
 			UnbondingConsumerChains: []string{"chain-2", "chain-1"},
+			Balance:                 sdk.NewInt(20),
 		},
 		{
 			Id:                      1,
 			UnbondingConsumerChains: []string{"chain-1", "chain-2"},
+			Balance:                 sdk.NewInt(10),
 		},
 		{
 			Id:                      4,
 			UnbondingConsumerChains: []string{"chain-2"},
+			Balance:                 sdk.NewInt(40),
 		},
 		{
 			Id:                      3,
 			UnbondingConsumerChains: []string{"chain-3", "chain-1", "chain-2"},
+			Balance:                 sdk.NewInt(30),
 		},
 	}
 	expectedGetAllOrder := ops
@@ -473,7 +990,38 @@ func TestGetAllUnbondingOps(t *testing.T) {
 	require.Equal(t, expectedGetAllOrder, result)
 }
 
-// TestRemoveConsumerFromUnbondingOp tests RemoveConsumerFromUnbondingOp behaviour correctness
+// TestGetChainHeldUnbondingValue tests that GetChainHeldUnbondingValue returns
+// the sum of the balances of the unbonding ops held by a given consumer chain
+func TestGetChainHeldUnbondingValue(t *testing.T) {
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	require.Equal(t, sdk.ZeroInt(), pk.GetChainHeldUnbondingValue(ctx, "chain-1"))
+
+	ops := []types.UnbondingOp{
+		{Id: 1, UnbondingConsumerChains: []string{"chain-1", "chain-2"}, Balance: sdk.NewInt(100)},
+		{Id: 2, UnbondingConsumerChains: []string{"chain-1"}, Balance: sdk.NewInt(20)},
+		{Id: 3, UnbondingConsumerChains: []string{"chain-1", "chain-2"}, Balance: sdk.NewInt(3)},
+	}
+	for _, op := range ops {
+		pk.SetUnbondingOp(ctx, op)
+	}
+	pk.SetUnbondingOpIndex(ctx, "chain-1", 1, []uint64{1, 2})
+	pk.SetUnbondingOpIndex(ctx, "chain-1", 2, []uint64{3})
+	pk.SetUnbondingOpIndex(ctx, "chain-2", 1, []uint64{1})
+	pk.SetUnbondingOpIndex(ctx, "chain-2", 2, []uint64{3})
+
+	require.Equal(t, sdk.NewInt(123), pk.GetChainHeldUnbondingValue(ctx, "chain-1"))
+	require.Equal(t, sdk.NewInt(103), pk.GetChainHeldUnbondingValue(ctx, "chain-2"))
+	require.Equal(t, sdk.ZeroInt(), pk.GetChainHeldUnbondingValue(ctx, "chain-3"))
+
+	// the value decreases once the unbonding ops are no longer held by the chain
+	pk.DeleteUnbondingOpIndex(ctx, "chain-1", 1)
+	require.Equal(t, sdk.NewInt(3), pk.GetChainHeldUnbondingValue(ctx, "chain-1"))
+	require.Equal(t, sdk.NewInt(103), pk.GetChainHeldUnbondingValue(ctx, "chain-2"))
+}
+
+// TestRemoveConsumerFromUnbondingOp tests RemoveConsumerFromUnbondingOp and GetChainsBlockingUnbonding behaviour correctness
 func TestRemoveConsumerFromUnbondingOp(t *testing.T) {
 	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
 	defer ctrl.Finish()
@@ -492,6 +1040,7 @@ func TestRemoveConsumerFromUnbondingOp(t *testing.T) {
 	require.True(t, found)
 	expectedChainIDs := []string{"chain-3", "chain-2"}
 	require.Equal(t, expectedChainIDs, unbondingOp.UnbondingConsumerChains)
+	require.Equal(t, expectedChainIDs, pk.GetChainsBlockingUnbonding(ctx, expectedID))
 
 	canComplete = pk.RemoveConsumerFromUnbondingOp(ctx, expectedID, "chain-2")
 	require.False(t, canComplete)
@@ -512,6 +1061,8 @@ func TestRemoveConsumerFromUnbondingOp(t *testing.T) {
 	unbondingOp, found = pk.GetUnbondingOp(ctx, expectedID)
 	require.False(t, found)
 	require.Empty(t, unbondingOp.UnbondingConsumerChains)
+	// no chain blocks a completed unbonding op
+	require.Nil(t, pk.GetChainsBlockingUnbonding(ctx, expectedID))
 
 	// check that it panics when calling with wrong chain IDs
 	require.Panics(t, func() {
@@ -520,6 +1071,53 @@ func TestRemoveConsumerFromUnbondingOp(t *testing.T) {
 	})
 }
 
+// TestUnknownUnbondingOp tests that the methods expecting an unbonding op to be stored
+// panic with ErrUnknownUnbondingOp if it is not, since the provider state is corrupted
+func TestUnknownUnbondingOp(t *testing.T) {
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// the unbonding op index of chain-1 references an unbonding op that is not stored
+	pk.SetUnbondingOpIndex(ctx, "chain-1", 1, []uint64{7})
+
+	for name, f := range map[string]func(){
+		"RemoveConsumerFromUnbondingOp": func() { pk.RemoveConsumerFromUnbondingOp(ctx, 7, "chain-1") },
+		"GetUnbondingOpsFromIndex":      func() { pk.GetUnbondingOpsFromIndex(ctx, "chain-1", 1) },
+		"GetChainHeldUnbondingValue":    func() { pk.GetChainHeldUnbondingValue(ctx, "chain-1") },
+	} {
+		requirePanicsWithErrorIs(t, types.ErrUnknownUnbondingOp, f, name)
+	}
+}
+
+// requirePanicsWithErrorIs asserts that f panics with an error wrapping target
+func requirePanicsWithErrorIs(t *testing.T, target error, f func(), msgAndArgs ...interface{}) {
+	t.Helper()
+	defer func() {
+		r := recover()
+		require.NotNil(t, r, msgAndArgs...)
+		err, ok := r.(error)
+		require.True(t, ok, msgAndArgs...)
+		require.ErrorIs(t, err, target, msgAndArgs...)
+	}()
+	f()
+}
+
+// TestGetConsumerGenesisCorrupted tests that a consumer genesis that cannot be unmarshaled
+// is never returned as found, i.e., GetConsumerGenesis panics instead
+func TestGetConsumerGenesisCorrupted(t *testing.T) {
+	keeperParams := testkeeper.NewInMemKeeperParams(t)
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, keeperParams)
+	defer ctrl.Finish()
+
+	_, found := pk.GetConsumerGenesis(ctx, "chain")
+	require.False(t, found)
+
+	ctx.KVStore(keeperParams.StoreKey).Set(types.ConsumerGenesisKey("chain"), []byte{0xff, 0xff})
+	require.Panics(t, func() {
+		pk.GetConsumerGenesis(ctx, "chain")
+	})
+}
+
 // TestSetSlashLog tests slash log getter and setter methods
 func TestSetSlashLog(t *testing.T) {
 	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
@@ -532,3 +1130,307 @@ func TestSetSlashLog(t *testing.T) {
 	require.True(t, providerKeeper.GetSlashLog(ctx, addrWithDoubleSigns))
 	require.False(t, providerKeeper.GetSlashLog(ctx, addrWithoutDoubleSigns))
 }
+
+// TestGetConsumerPhaseCounts tests that the consumer chains are counted
+// in the phase of the lifecycle they are in
+func TestGetConsumerPhaseCounts(t *testing.T) {
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	now := time.Now().UTC()
+
+	// no consumer chains
+	require.Equal(t, []types.ConsumerPhaseCount{
+		{Phase: types.ConsumerPhasePending, Count: 0},
+		{Phase: types.ConsumerPhaseInitialized, Count: 0},
+		{Phase: types.ConsumerPhaseLaunched, Count: 0},
+		{Phase: types.ConsumerPhaseStopping, Count: 0},
+	}, pk.GetConsumerPhaseCounts(ctx))
+
+	// pending chains
+	for _, chainID := range []string{"pending-1", "pending-2"} {
+		pk.SetPendingConsumerAdditionProp(ctx, &types.ConsumerAdditionProposal{ChainId: chainID, SpawnTime: now})
+	}
+	// initialized chains
+	for _, chainID := range []string{"initialized-1", "initialized-2", "initialized-3"} {
+		pk.SetConsumerClientId(ctx, chainID, "client-"+chainID)
+	}
+	// launched chains
+	for _, chainID := range []string{"launched-1", "launched-2", "launched-3", "launched-4"} {
+		pk.SetConsumerClientId(ctx, chainID, "client-"+chainID)
+		pk.SetChainToChannel(ctx, chainID, "channel-"+chainID)
+	}
+	// stopping chains, i.e., a launched chain and an initialized chain with pending removal proposals
+	pk.SetConsumerClientId(ctx, "stopping-1", "client-stopping-1")
+	pk.SetChainToChannel(ctx, "stopping-1", "channel-stopping-1")
+	pk.SetPendingConsumerRemovalProp(ctx, &types.ConsumerRemovalProposal{ChainId: "stopping-1", StopTime: now})
+	pk.SetConsumerClientId(ctx, "stopping-2", "client-stopping-2")
+	pk.SetPendingConsumerRemovalProp(ctx, &types.ConsumerRemovalProposal{ChainId: "stopping-2", StopTime: now})
+
+	require.Equal(t, []types.ConsumerPhaseCount{
+		{Phase: types.ConsumerPhasePending, Count: 2},
+		{Phase: types.ConsumerPhaseInitialized, Count: 3},
+		{Phase: types.ConsumerPhaseLaunched, Count: 4},
+		{Phase: types.ConsumerPhaseStopping, Count: 2},
+	}, pk.GetConsumerPhaseCounts(ctx))
+
+	for chainID, phase := range pk.GetAllConsumerPhases(ctx) {
+		require.Equal(t, phase, pk.GetConsumerPhase(ctx, chainID))
+	}
+	require.Equal(t, types.ConsumerPhaseStopping, pk.GetConsumerPhase(ctx, "stopping-2"))
+	require.Equal(t, types.ConsumerPhaseUnspecified, pk.GetConsumerPhase(ctx, "unknown"))
+}
+
+// TestInvalidatedChannel tests that the CCV channel of a stopped consumer chain
+// is invalidated and can never be used again as a CCV channel
+func TestInvalidatedChannel(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, types.DefaultParams())
+
+	// the consumer chain is created and its CCV channel is established
+	testkeeper.SetupForStoppingConsumerChain(t, ctx, &providerKeeper, mocks)
+	require.False(t, providerKeeper.IsChannelInvalidated(ctx, "channelID"))
+	require.Empty(t, providerKeeper.GetAllInvalidatedChannels(ctx))
+
+	// the channel is validating, so it cannot be used by another handshake
+	err := providerKeeper.VerifyConsumerChain(ctx, "channelID", []string{"connectionID"}, nil)
+	require.ErrorIs(t, err, ccv.ErrValidatingChannel)
+
+	// the consumer chain is stopped, which invalidates the channel
+	require.NoError(t, providerKeeper.StopConsumerChain(ctx, "chainID", true))
+	require.True(t, providerKeeper.IsChannelInvalidated(ctx, "channelID"))
+	require.Equal(t, []string{"channelID"}, providerKeeper.GetAllInvalidatedChannels(ctx))
+
+	// reopening the invalidated channel fails at every step of the handshake
+	err = providerKeeper.VerifyConsumerChain(ctx, "channelID", []string{"connectionID"}, nil)
+	require.ErrorIs(t, err, ccv.ErrInvalidatedChannel)
+	err = providerKeeper.SetConsumerChain(ctx, "channelID")
+	require.ErrorIs(t, err, ccv.ErrInvalidatedChannel)
+	_, found := providerKeeper.GetChannelToChain(ctx, "channelID")
+	require.False(t, found)
+	_, found = providerKeeper.GetChainToChannel(ctx, "chainID")
+	require.False(t, found)
+}
+
+// TestDuplicateChannel tests that a duplicate CCV channel for a consumer chain
+// leaves the channel mappings of the established CCV channel unchanged
+func TestDuplicateChannel(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, types.DefaultParams())
+
+	// the CCV channel of the consumer chain is established
+	providerKeeper.SetConsumerClientId(ctx, "chainID", "clientID")
+	providerKeeper.SetChainToChannel(ctx, "chainID", "channelID")
+	providerKeeper.SetChannelToChain(ctx, "channelID", "chainID")
+
+	// a second channel to the same consumer chain is rejected
+	gomock.InOrder(testkeeper.GetMocksForSetConsumerChain(ctx, &mocks, "chainID")...)
+	err := providerKeeper.SetConsumerChain(ctx, "duplicateChannelID")
+	require.ErrorIs(t, err, ccv.ErrDuplicateChannel)
+
+	// the consumer chain has exactly one CCV channel
+	require.Equal(t, []types.ChannelToChain{{ChannelId: "channelID", ChainId: "chainID"}},
+		providerKeeper.GetAllChannelToChains(ctx))
+	channelID, found := providerKeeper.GetChainToChannel(ctx, "chainID")
+	require.True(t, found)
+	require.Equal(t, "channelID", channelID)
+	_, found = providerKeeper.GetChannelToChain(ctx, "duplicateChannelID")
+	require.False(t, found)
+	require.False(t, providerKeeper.IsChannelInvalidated(ctx, "channelID"))
+}
+
+// TestSetConsumerChainClientChanged tests that the CCV channel of a consumer chain is not established
+// if the client of the consumer chain changed after the channel handshake was verified
+func TestSetConsumerChainClientChanged(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, types.DefaultParams())
+
+	// the channel handshake is verified against the client of the consumer chain
+	providerKeeper.SetConsumerClientId(ctx, "chainID", "clientID")
+	consumerGen := *consumertypes.DefaultGenesisState()
+	require.NoError(t, providerKeeper.SetConsumerGenesis(ctx, "chainID", consumerGen))
+	genesisHash, err := consumerGen.Hash()
+	require.NoError(t, err)
+	gomock.InOrder(
+		mocks.MockConnectionKeeper.EXPECT().GetConnection(ctx, "connectionID").Return(
+			conntypes.ConnectionEnd{ClientId: "clientID"}, true,
+		).Times(1),
+		mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "clientID").Return(
+			&ibctmtypes.ClientState{ChainId: "chainID"}, true,
+		).Times(1),
+	)
+	require.NoError(t, providerKeeper.VerifyConsumerChain(ctx, "channelID", []string{"connectionID"}, genesisHash))
+
+	// the client of the consumer chain changes before the channel is opened
+	providerKeeper.SetConsumerClientId(ctx, "chainID", "anotherClientID")
+	gomock.InOrder(testkeeper.GetMocksForSetConsumerChain(ctx, &mocks, "chainID")...)
+	err = providerKeeper.SetConsumerChain(ctx, "channelID")
+	require.ErrorIs(t, err, ccv.ErrInvalidConsumerClient)
+
+	_, found := providerKeeper.GetChainToChannel(ctx, "chainID")
+	require.False(t, found)
+	_, found = providerKeeper.GetChannelToChain(ctx, "channelID")
+	require.False(t, found)
+}
+
+// TestSetConsumerChainWrongCounterpartyPort tests that the CCV channel of a consumer chain is not
+// established if the counterparty port of the channel is not the consumer CCV port
+func TestSetConsumerChainWrongCounterpartyPort(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerKeeper.SetConsumerClientId(ctx, "chainID", "clientID")
+	gomock.InOrder(
+		mocks.MockChannelKeeper.EXPECT().GetChannel(ctx, ccv.ProviderPortID, "channelID").Return(
+			channeltypes.Channel{
+				State:          channeltypes.OPEN,
+				Counterparty:   channeltypes.NewCounterparty("transfer", "consumerChannelID"),
+				ConnectionHops: []string{"connectionID"},
+			},
+			true,
+		).Times(1),
+	)
+	err := providerKeeper.SetConsumerChain(ctx, "channelID")
+	require.ErrorIs(t, err, porttypes.ErrInvalidPort)
+
+	_, found := providerKeeper.GetChainToChannel(ctx, "chainID")
+	require.False(t, found)
+	_, found = providerKeeper.GetChannelToChain(ctx, "channelID")
+	require.False(t, found)
+	_, found = providerKeeper.GetInitChainHeight(ctx, "chainID")
+	require.False(t, found)
+}
+
+// mockClientState is the state of a non-Tendermint light client of a consumer chain
+type mockClientState struct {
+	*ibctmtypes.ClientState
+}
+
+func (mockClientState) ClientType() string { return "99-mock" }
+
+// TestVerifyConsumerChainClientTypes tests that the CCV channels can only be built
+// on top of light clients of the types allowed by the AllowedConsumerClientTypes param
+func TestVerifyConsumerChainClientTypes(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, types.DefaultParams())
+
+	providerKeeper.SetConsumerClientId(ctx, "chainID", "clientID")
+	consumerGen := *consumertypes.DefaultGenesisState()
+	require.NoError(t, providerKeeper.SetConsumerGenesis(ctx, "chainID", consumerGen))
+	genesisHash, err := consumerGen.Hash()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name               string
+		allowedClientTypes []string
+		clientState        ibcexported.ClientState
+		expErr             error
+	}{
+		{
+			name:               "Tendermint client allowed by default",
+			allowedClientTypes: types.DefaultAllowedConsumerClientTypes,
+			clientState:        &ibctmtypes.ClientState{ChainId: "chainID"},
+		},
+		{
+			name:               "non-Tendermint client not allowed by default",
+			allowedClientTypes: types.DefaultAllowedConsumerClientTypes,
+			clientState:        mockClientState{&ibctmtypes.ClientState{ChainId: "chainID"}},
+			expErr:             clienttypes.ErrInvalidClientType,
+		},
+		{
+			name:               "non-Tendermint client allowed",
+			allowedClientTypes: []string{ibcexported.Tendermint, "99-mock"},
+			clientState:        mockClientState{&ibctmtypes.ClientState{ChainId: "chainID"}},
+		},
+		{
+			name:               "allowed client without chain ID",
+			allowedClientTypes: []string{ibcexported.Tendermint, ibcexported.Solomachine},
+			clientState:        &solomachinetypes.ClientState{},
+			expErr:             clienttypes.ErrInvalidClientType,
+		},
+	}
+
+	for _, tc := range testCases {
+		providerKeeper.SetAllowedConsumerClientTypes(ctx, tc.allowedClientTypes)
+		gomock.InOrder(
+			mocks.MockConnectionKeeper.EXPECT().GetConnection(ctx, "connectionID").Return(
+				conntypes.ConnectionEnd{ClientId: "clientID"}, true,
+			).Times(1),
+			mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "clientID").Return(
+				tc.clientState, true,
+			).Times(1),
+		)
+		err := providerKeeper.VerifyConsumerChain(ctx, "channelID", []string{"connectionID"}, genesisHash)
+		if tc.expErr != nil {
+			require.ErrorIs(t, err, tc.expErr, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+// TestValidatorJailRecord tests the getter, setter and deletion methods for the jail records of validators
+func TestValidatorJailRecord(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerAddrA := cryptotestutil.NewCryptoIdentityFromIntSeed(1).ProviderConsAddress()
+	providerAddrB := cryptotestutil.NewCryptoIdentityFromIntSeed(2).ProviderConsAddress()
+
+	_, found := providerKeeper.GetValidatorJailRecord(ctx, providerAddrA)
+	require.False(t, found)
+	require.Empty(t, providerKeeper.GetAllValidatorJailRecords(ctx))
+
+	providerKeeper.SetValidatorJailRecord(ctx, providerAddrA, 3)
+	providerKeeper.SetValidatorJailRecord(ctx, providerAddrB, 5)
+	// a later jailing replaces the record
+	providerKeeper.SetValidatorJailRecord(ctx, providerAddrA, 7)
+	vscID, found := providerKeeper.GetValidatorJailRecord(ctx, providerAddrA)
+	require.True(t, found)
+	require.Equal(t, uint64(7), vscID)
+	require.Len(t, providerKeeper.GetAllValidatorJailRecords(ctx), 2)
+
+	providerKeeper.DeleteValidatorJailRecord(ctx, providerAddrA)
+	_, found = providerKeeper.GetValidatorJailRecord(ctx, providerAddrA)
+	require.False(t, found)
+	require.Equal(t, []types.ValidatorJailRecord{{ProviderAddr: &providerAddrB, VscId: 5}},
+		providerKeeper.GetAllValidatorJailRecords(ctx))
+}
+
+// TestLastDowntimeInfractionHeight tests the getter, setter and deletion methods
+// for the last downtime infraction heights of validators on consumer chains
+func TestLastDowntimeInfractionHeight(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerAddrA := cryptotestutil.NewCryptoIdentityFromIntSeed(1).ProviderConsAddress()
+	providerAddrB := cryptotestutil.NewCryptoIdentityFromIntSeed(2).ProviderConsAddress()
+
+	_, found := providerKeeper.GetLastDowntimeInfractionHeight(ctx, "chain-1", providerAddrA)
+	require.False(t, found)
+	require.Empty(t, providerKeeper.GetAllLastDowntimeInfractionHeights(ctx, "chain-1"))
+
+	providerKeeper.SetLastDowntimeInfractionHeight(ctx, "chain-1", providerAddrA, 3)
+	providerKeeper.SetLastDowntimeInfractionHeight(ctx, "chain-1", providerAddrB, 5)
+	providerKeeper.SetLastDowntimeInfractionHeight(ctx, "chain-2", providerAddrA, 4)
+	// a later infraction replaces the height
+	providerKeeper.SetLastDowntimeInfractionHeight(ctx, "chain-1", providerAddrA, 7)
+	height, found := providerKeeper.GetLastDowntimeInfractionHeight(ctx, "chain-1", providerAddrA)
+	require.True(t, found)
+	require.Equal(t, uint64(7), height)
+	require.Len(t, providerKeeper.GetAllLastDowntimeInfractionHeights(ctx, "chain-1"), 2)
+
+	providerKeeper.DeleteLastDowntimeInfractionHeight(ctx, "chain-1", providerAddrA)
+	require.Equal(t, []types.ValidatorInfractionHeight{{ProviderAddr: &providerAddrB, InfractionHeight: 5}},
+		providerKeeper.GetAllLastDowntimeInfractionHeights(ctx, "chain-1"))
+
+	// the heights of the other consumer chains are left untouched
+	providerKeeper.DeleteAllLastDowntimeInfractionHeights(ctx, "chain-1")
+	require.Empty(t, providerKeeper.GetAllLastDowntimeInfractionHeights(ctx, "chain-1"))
+	require.Equal(t, []types.ValidatorInfractionHeight{{ProviderAddr: &providerAddrA, InfractionHeight: 4}},
+		providerKeeper.GetAllLastDowntimeInfractionHeights(ctx, "chain-2"))
+}
@@ -141,6 +141,42 @@ func (k Keeper) SetValidatorByConsumerAddr(
 		panic(fmt.Sprintf("failed to marshal provider address: %v", err))
 	}
 	store.Set(types.ValidatorsByConsumerAddrKey(chainID, consumerAddr), bz)
+	// update the reverse index used to find the chains the consumer address is assigned on
+	store.Set(types.ConsumerAddrChainKey(consumerAddr, chainID), bz)
+}
+
+// GetValidatorsByConsumerAddrOnAllChains returns the mappings from the given consensus address
+// to consensus addresses on the provider chain, on every consumer chain the address is assigned on.
+//
+// Note that the mappings are stored under keys with the following format:
+// ConsumerAddrChainsBytePrefix | len(consumerAddr) | consumerAddr | chainID
+// Thus, the returned array is in ascending order of chain IDs.
+func (k Keeper) GetValidatorsByConsumerAddrOnAllChains(
+	ctx sdk.Context,
+	consumerAddr types.ConsumerConsAddress,
+) (validatorConsumerAddrs []types.ValidatorByConsumerAddr) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.ConsumerAddrChainsPrefix(consumerAddr)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		chainID := string(iterator.Key()[len(prefix):])
+		var providerAddr types.ProviderConsAddress
+		err := providerAddr.Unmarshal(iterator.Value())
+		if err != nil {
+			// An error here would indicate something is very wrong,
+			// the provider address is assumed to be correctly serialized in SetValidatorByConsumerAddr.
+			panic(fmt.Sprintf("failed to unmarshal provider address: %v", err))
+		}
+		addr := consumerAddr
+		validatorConsumerAddrs = append(validatorConsumerAddrs, types.ValidatorByConsumerAddr{
+			ConsumerAddr: &addr,
+			ProviderAddr: &providerAddr,
+			ChainId:      chainID,
+		})
+	}
+
+	return validatorConsumerAddrs
 }
 
 // GetValidatorsByConsumerAddrs gets all the mappings from consensus addresses
@@ -196,6 +232,7 @@ func (k Keeper) GetAllValidatorsByConsumerAddr(ctx sdk.Context, chainID *string)
 func (k Keeper) DeleteValidatorByConsumerAddr(ctx sdk.Context, chainID string, consumerAddr types.ConsumerConsAddress) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.ValidatorsByConsumerAddrKey(chainID, consumerAddr))
+	store.Delete(types.ConsumerAddrChainKey(consumerAddr, chainID))
 }
 
 // GetKeyAssignmentReplacement returns the previous assigned consumer key and the current power
@@ -424,6 +461,17 @@ func (k Keeper) AssignConsumerKey(
 		)
 	}
 
+	for _, validatorConsumerAddr := range k.GetValidatorsByConsumerAddrOnAllChains(ctx, consumerAddr) {
+		// the same validator may use the consumer key on several consumer chains,
+		// but different validators may not share the consumer key across consumer chains
+		if !validatorConsumerAddr.ProviderAddr.ToSdkConsAddr().Equals(providerAddr.ToSdkConsAddr()) {
+			return sdkerrors.Wrapf(
+				types.ErrConsumerKeyInUse, "a different validator has assigned the consumer key on consumer chain %s",
+				validatorConsumerAddr.ChainId,
+			)
+		}
+	}
+
 	// check whether the consumer chain is already registered,
 	// i.e., a client to the consumer was already created
 	if _, consumerRegistered := k.GetConsumerClientId(ctx, chainID); consumerRegistered {
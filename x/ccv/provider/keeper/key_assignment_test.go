@@ -114,11 +114,43 @@ func TestValidatorByConsumerAddrCRUD(t *testing.T) {
 	require.NotEmpty(t, providerAddrResult, "provider address is empty")
 	require.Equal(t, providerAddr, providerAddrResult)
 
+	// the reverse index is updated
+	require.Equal(t, []types.ValidatorByConsumerAddr{
+		{ChainId: chainID, ConsumerAddr: &consumerAddr, ProviderAddr: &providerAddr},
+	}, keeper.GetValidatorsByConsumerAddrOnAllChains(ctx, consumerAddr))
+
 	keeper.DeleteValidatorByConsumerAddr(ctx, chainID, consumerAddr)
 	providerAddrResult, found = keeper.GetValidatorByConsumerAddr(ctx, chainID, consumerAddr)
 	require.False(t, found, "provider address was found")
 	require.Empty(t, providerAddrResult, "provider address not empty")
 	require.NotEqual(t, providerAddr, providerAddrResult)
+	require.Empty(t, keeper.GetValidatorsByConsumerAddrOnAllChains(ctx, consumerAddr))
+}
+
+// TestGetProviderAddrFromConsumerAddr tests that consumer addresses are translated back
+// to the provider addresses of both validators with and without an assigned consumer key
+func TestGetProviderAddrFromConsumerAddr(t *testing.T) {
+	chainID := "consumer"
+	assignedProviderAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(1).ProviderConsAddress()
+	assignedConsumerAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(2).ConsumerConsAddress()
+	defaultIdentity := cryptotestutil.NewCryptoIdentityFromIntSeed(3)
+
+	keeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	keeper.SetValidatorByConsumerAddr(ctx, chainID, assignedConsumerAddr, assignedProviderAddr)
+
+	// a validator with an assigned key is found through the reverse index
+	require.Equal(t, assignedProviderAddr,
+		keeper.GetProviderAddrFromConsumerAddr(ctx, chainID, assignedConsumerAddr))
+
+	// the key assignment of a chain does not apply to other chains
+	require.Equal(t, types.NewProviderConsAddress(assignedConsumerAddr.ToSdkConsAddr()),
+		keeper.GetProviderAddrFromConsumerAddr(ctx, "other-consumer", assignedConsumerAddr))
+
+	// a validator using its default key has the same address on both chains
+	require.Equal(t, defaultIdentity.ProviderConsAddress(),
+		keeper.GetProviderAddrFromConsumerAddr(ctx, chainID, defaultIdentity.ConsumerConsAddress()))
 }
 
 func TestGetAllValidatorsByConsumerAddr(t *testing.T) {
@@ -382,6 +414,8 @@ func TestAssignConsensusKeyForConsumerChain(t *testing.T) {
 			5. Consumer not registered: Assign PK0->CK0, PK0->CK1 and retrieve PK0->CK1
 			6. Consumer not registered: Assign PK0->CK0, PK1->CK0 and error
 			7. Consumer not registered: Assign PK1->PK0 and error
+			8. Consumers not registered: Assign PK0->CK0, PK1->CK0 on another consumer and error,
+			   then PK0->CK0 on another consumer and retrieve PK0->CK0 on both consumers
 		*/
 		{
 			name: "0",
@@ -591,6 +625,37 @@ func TestAssignConsensusKeyForConsumerChain(t *testing.T) {
 				require.Error(t, err)
 			},
 		},
+		{
+			name: "8",
+			mockSetup: func(ctx sdk.Context, k providerkeeper.Keeper, mocks testkeeper.MockedKeepers) {
+				mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx,
+					consumerIdentities[0].SDKValConsAddress(),
+				).Return(stakingtypes.Validator{}, false).Times(3)
+			},
+			doActions: func(ctx sdk.Context, k providerkeeper.Keeper) {
+				err := k.AssignConsumerKey(ctx, chainID,
+					providerIdentities[0].SDKStakingValidator(),
+					consumerIdentities[0].TMProtoCryptoPublicKey(),
+				)
+				require.NoError(t, err)
+				err = k.AssignConsumerKey(ctx, "otherChainID",
+					providerIdentities[1].SDKStakingValidator(),
+					consumerIdentities[0].TMProtoCryptoPublicKey(),
+				)
+				require.ErrorIs(t, err, types.ErrConsumerKeyInUse)
+				err = k.AssignConsumerKey(ctx, "otherChainID",
+					providerIdentities[0].SDKStakingValidator(),
+					consumerIdentities[0].TMProtoCryptoPublicKey(),
+				)
+				require.NoError(t, err)
+				validatorConsumerAddrs := k.GetValidatorsByConsumerAddrOnAllChains(ctx, consumerIdentities[0].ConsumerConsAddress())
+				require.Len(t, validatorConsumerAddrs, 2)
+				for i, expectedChainID := range []string{chainID, "otherChainID"} {
+					require.Equal(t, expectedChainID, validatorConsumerAddrs[i].ChainId)
+					require.Equal(t, providerIdentities[0].ProviderConsAddress(), *validatorConsumerAddrs[i].ProviderAddr)
+				}
+			},
+		},
 	}
 
 	for _, tc := range testCases {
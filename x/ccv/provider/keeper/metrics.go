@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"time"
+
+	metrics "github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/interchain-security/x/ccv/provider/types"
+)
+
+// updatePendingUnbondingOpsGauge sets the pending unbonding ops gauge to the number of
+// unbonding operations that are waiting for VSCMaturedPackets from a consumer with chainID.
+//
+// Note that the store reads are done with an infinite gas meter, since
+// emitting telemetry must not change the gas consumed by a tx.
+func (k Keeper) updatePendingUnbondingOpsGauge(ctx sdk.Context, chainID string) {
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	setChainGauge(types.MetricKeyPendingUnbondingOps, chainID, k.GetPendingUnbondingOpsCount(ctx, chainID))
+}
+
+// incrUnbondingOpsCapExceededCounter increments the counter of unbonding operations
+// that do not wait for a consumer with chainID, since the chain reached MaxUnbondingOpsPerChain
+func incrUnbondingOpsCapExceededCounter(chainID string) {
+	incrChainCounter(types.MetricKeyUnbondingOpsCapExceeded, chainID)
+}
+
+// incrSlashAcksCapExceededCounter increments the counter of slash acks appended
+// while a consumer with chainID had MaxSlashAcksPerChain pending slash acks
+func incrSlashAcksCapExceededCounter(chainID string) {
+	incrChainCounter(types.MetricKeySlashAcksCapExceeded, chainID)
+}
+
+// incrConsumerInactiveCounter increments the counter of blocks at the end of which
+// a consumer with chainID was not heard from within the ConsumerLivenessWindow param
+func incrConsumerInactiveCounter(chainID string) {
+	incrChainCounter(types.MetricKeyConsumerInactive, chainID)
+}
+
+// updateClientTimeToExpiryGauge sets the gauge of the time left before the client
+// to a consumer with chainID expires
+func updateClientTimeToExpiryGauge(chainID string, timeToExpiry time.Duration) {
+	setChainGauge(types.MetricKeyClientTimeToExpiry, chainID, int(timeToExpiry.Seconds()))
+}
+
+// incrClientExpiringCounter increments the counter of blocks at the end of which the client
+// to a consumer with chainID is about to expire, i.e., within the ClientExpiryWarningFraction param
+func incrClientExpiringCounter(chainID string) {
+	incrChainCounter(types.MetricKeyClientExpiring, chainID)
+}
+
+// updatePendingSlashAcksGauge sets the pending slash acks gauge for a consumer with chainID
+func updatePendingSlashAcksGauge(chainID string, count int) {
+	setChainGauge(types.MetricKeyPendingSlashAcks, chainID, count)
+}
+
+// updateConsumerChainsGauge sets the consumer chains gauge to the number of registered consumer chains
+func updateConsumerChainsGauge(count int) {
+	telemetry.SetGauge(float32(count), types.MetricKeyConsumerChains...)
+}
+
+// incrSlashAcksCounter increments the slash acks counter for a consumer with chainID
+func incrSlashAcksCounter(chainID string) {
+	incrChainCounter(types.MetricKeySlashAcks, chainID)
+}
+
+// incrVSCPacketsSentCounter increments the VSC packets sent counter for a consumer with chainID
+func incrVSCPacketsSentCounter(chainID string) {
+	incrChainCounter(types.MetricKeyVSCPacketsSent, chainID)
+}
+
+// incrVSCPacketSendFailuresCounter increments the counter of VSC packets
+// that could not be sent to a consumer with chainID
+func incrVSCPacketSendFailuresCounter(chainID string) {
+	incrChainCounter(types.MetricKeyVSCPacketSendFailures, chainID)
+}
+
+// updatePacketSequenceGauge sets the packet sequence gap gauge for a consumer with chainID,
+// and increments the gap exceeded counter if the gap is above PacketSequenceGapThreshold
+func (k Keeper) updatePacketSequenceGauge(ctx sdk.Context, chainID string) {
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	gap := k.GetPacketSequenceGap(ctx, chainID)
+	setChainGauge(types.MetricKeyPacketSequenceGap, chainID, int(gap))
+	if gap > types.PacketSequenceGapThreshold {
+		incrChainCounter(types.MetricKeyPacketSequenceGapExceeded, chainID)
+		k.Logger(ctx).Error("packets sent to consumer chain are not acknowledged",
+			"chainID", chainID,
+			"unacknowledged packets", gap,
+		)
+	}
+}
+
+// setChainGauge sets a gauge labeled with the given consumer chain ID
+func setChainGauge(keys []string, chainID string, val int) {
+	telemetry.SetGaugeWithLabels(
+		keys,
+		float32(val),
+		[]metrics.Label{telemetry.NewLabel(types.MetricLabelChainID, chainID)},
+	)
+}
+
+// incrChainCounter increments a counter labeled with the given consumer chain ID
+func incrChainCounter(keys []string, chainID string) {
+	telemetry.IncrCounterWithLabels(
+		keys,
+		1,
+		[]metrics.Label{telemetry.NewLabel(types.MetricLabelChainID, chainID)},
+	)
+}
@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	metrics "github.com/armon/go-metrics"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	testkeeper "github.com/cosmos/interchain-security/testutil/keeper"
+	"github.com/cosmos/interchain-security/x/ccv/provider/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// TestPendingCountGauges tests that the pending unbonding ops and slash acks gauges
+// reflect the current counts stored for each consumer chain
+func TestPendingCountGauges(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	sink := metrics.NewInmemSink(time.Hour, time.Hour)
+	cfg := metrics.DefaultConfig("")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	_, err := metrics.NewGlobal(cfg, sink)
+	require.NoError(t, err)
+
+	gauge := func(keys []string, chainID string) float32 {
+		intervals := sink.Data()
+		require.NotEmpty(t, intervals)
+		name := strings.Join(keys, ".") + ";" + types.MetricLabelChainID + "=" + chainID
+		g, found := intervals[len(intervals)-1].Gauges[name]
+		require.True(t, found, "gauge %s not found", name)
+		return g.Value
+	}
+
+	// unbonding op indexes
+	providerKeeper.SetUnbondingOpIndex(ctx, "chain-1", 1, []uint64{1, 2, 3})
+	require.Equal(t, float32(3), gauge(types.MetricKeyPendingUnbondingOps, "chain-1"))
+	providerKeeper.SetUnbondingOpIndex(ctx, "chain-1", 2, []uint64{4, 5})
+	require.Equal(t, float32(5), gauge(types.MetricKeyPendingUnbondingOps, "chain-1"))
+	providerKeeper.SetUnbondingOpIndex(ctx, "chain-2", 2, []uint64{4})
+	require.Equal(t, float32(1), gauge(types.MetricKeyPendingUnbondingOps, "chain-2"))
+	require.Equal(t, float32(5), gauge(types.MetricKeyPendingUnbondingOps, "chain-1"))
+	providerKeeper.DeleteUnbondingOpIndex(ctx, "chain-1", 1)
+	require.Equal(t, float32(2), gauge(types.MetricKeyPendingUnbondingOps, "chain-1"))
+	providerKeeper.DeleteUnbondingOpIndex(ctx, "chain-1", 2)
+	require.Equal(t, float32(0), gauge(types.MetricKeyPendingUnbondingOps, "chain-1"))
+
+	// slash acks
+	providerKeeper.SetSlashAcks(ctx, "chain-1", []string{"alice", "bob"})
+	require.Equal(t, float32(2), gauge(types.MetricKeyPendingSlashAcks, "chain-1"))
+	providerKeeper.AppendSlashAck(ctx, "chain-1", "charlie", stakingtypes.Downtime)
+	require.Equal(t, float32(3), gauge(types.MetricKeyPendingSlashAcks, "chain-1"))
+	providerKeeper.AppendSlashAck(ctx, "chain-2", "alice", stakingtypes.Downtime)
+	require.Equal(t, float32(1), gauge(types.MetricKeyPendingSlashAcks, "chain-2"))
+	providerKeeper.ConsumeSlashAcks(ctx, "chain-1")
+	require.Equal(t, float32(0), gauge(types.MetricKeyPendingSlashAcks, "chain-1"))
+	providerKeeper.DeleteSlashAcks(ctx, "chain-2")
+	require.Equal(t, float32(0), gauge(types.MetricKeyPendingSlashAcks, "chain-2"))
+}
+
+// TestPacketFlowMetrics tests that the consumer chains gauge and the slash acks counter
+// are updated by the provider EndBlock and when slash acks are queued
+func TestPacketFlowMetrics(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, types.DefaultParams())
+
+	sink := metrics.NewInmemSink(time.Hour, time.Hour)
+	cfg := metrics.DefaultConfig("")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	_, err := metrics.NewGlobal(cfg, sink)
+	require.NoError(t, err)
+
+	lastInterval := func() *metrics.IntervalMetrics {
+		intervals := sink.Data()
+		require.NotEmpty(t, intervals)
+		return intervals[len(intervals)-1]
+	}
+
+	// consumer chains
+	providerKeeper.SetConsumerClientId(ctx, "chain-1", "client-1")
+	providerKeeper.SetConsumerClientId(ctx, "chain-2", "client-2")
+	mocks.MockStakingKeeper.EXPECT().GetValidatorUpdates(ctx).Return([]abci.ValidatorUpdate{}).Times(1)
+	providerKeeper.QueueVSCPackets(ctx)
+	g, found := lastInterval().Gauges[strings.Join(types.MetricKeyConsumerChains, ".")]
+	require.True(t, found)
+	require.Equal(t, float32(2), g.Value)
+
+	// slash acks
+	providerKeeper.AppendSlashAck(ctx, "chain-1", "alice", stakingtypes.Downtime)
+	providerKeeper.AppendSlashAck(ctx, "chain-1", "bob", stakingtypes.DoubleSign)
+	providerKeeper.ConsumeSlashAcks(ctx, "chain-1")
+	providerKeeper.AppendSlashAck(ctx, "chain-1", "charlie", stakingtypes.Downtime)
+	name := strings.Join(types.MetricKeySlashAcks, ".") + ";" + types.MetricLabelChainID + "=chain-1"
+	c, found := lastInterval().Counters[name]
+	require.True(t, found)
+	require.Equal(t, 3, c.Count)
+}
@@ -0,0 +1,265 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/interchain-security/x/ccv/provider/types"
+)
+
+// Migration migrates the provider store from one store version to the next
+type Migration func(ctx sdk.Context, k Keeper) error
+
+// storeMigrations are the migrations of the provider store, in the order they are applied,
+// i.e., storeMigrations[i] migrates the store from version i to version i+1.
+// New migrations must be appended, so that the version of existing stores remains valid.
+var storeMigrations = []Migration{
+	migrateSlashAcksToProto,
+	migrateParamsAndIndexes,
+}
+
+// LatestStoreVersion returns the version of the provider store once all the migrations are applied
+func LatestStoreVersion() uint64 {
+	return uint64(len(storeMigrations))
+}
+
+// SetStoreVersion sets the version of the provider store
+func (k Keeper) SetStoreVersion(ctx sdk.Context, version uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.StoreVersionKey(), sdk.Uint64ToBigEndian(version))
+}
+
+// GetStoreVersion returns the version of the provider store.
+// A store without version was never migrated, i.e., its version is zero.
+func (k Keeper) GetStoreVersion(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.StoreVersionKey())
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// MigrateStore applies, in order, the migrations registered after the stored version of the
+// provider store, and bumps the store version after each of them. It is a no-op for a store
+// that is already at the latest version.
+func (k Keeper) MigrateStore(ctx sdk.Context) error {
+	version := k.GetStoreVersion(ctx)
+	if version > LatestStoreVersion() {
+		return sdkerrors.Wrapf(types.ErrInvalidStoreVersion,
+			"provider store version %d is newer than the latest store version %d", version, LatestStoreVersion())
+	}
+	for ; version < LatestStoreVersion(); version++ {
+		if err := storeMigrations[version](ctx, k); err != nil {
+			return fmt.Errorf("failed to migrate provider store from version %d: %w", version, err)
+		}
+		k.SetStoreVersion(ctx, version+1)
+		k.Logger(ctx).Info("provider store migrated", "version", version+1)
+	}
+	return nil
+}
+
+// migrateSlashAcksToProto migrates the slash acks of the consumer chains from their legacy format,
+// i.e., a JSON encoded list of addresses, to the SlashAcks proto type.
+// Slash acks already stored as SlashAcks are left unchanged.
+//
+// Note that the slash acks are not iterated over using the SlashAcksBytePrefix,
+// since the slash log of the validators is stored under the same prefix.
+func migrateSlashAcksToProto(ctx sdk.Context, k Keeper) error {
+	store := ctx.KVStore(k.storeKey)
+	for _, chain := range k.GetAllConsumerChains(ctx) {
+		bz := store.Get(types.SlashAcksKey(chain.ChainId))
+		if bz == nil {
+			continue
+		}
+		var acks []string
+		if err := json.Unmarshal(bz, &acks); err != nil {
+			// the slash acks are not in the legacy format
+			var sa types.SlashAcks
+			if err := sa.Unmarshal(bz); err != nil {
+				return fmt.Errorf("cannot decode the slash acks of chain %s: %w", chain.ChainId, err)
+			}
+			continue
+		}
+		k.SetSlashAcks(ctx, chain.ChainId, acks)
+	}
+	return nil
+}
+
+// migrateParamsAndIndexes initializes the state introduced after the first store version:
+//   - every provider param that is not set, e.g., the params added after the store was created,
+//     is set to its default value, since GetParams panics on params that are not set;
+//   - the index of the consumer chains every consumer address is assigned on is backfilled
+//     from the key assignments, since ValidatorConsensusKeyInUse relies on it;
+//   - the denoms of the rewards held in the consumer rewards pool or allocated to a consumer
+//     chain are registered as consumer reward denoms, since such denoms were accepted before
+//     the consumer reward denoms were registered through governance;
+//   - the redistribute fraction of the rewards allocated to every consumer chain is set to be distributed
+//     in the next block, as if the rewards were received at the migration, since the fraction was applied
+//     to the rewards allocation in every block before it was applied once to the received rewards;
+//   - the block time of the migration is recorded for every valset update ID mapped
+//     to a block height without block time, so that its block height can be pruned;
+//   - the number of pending unbonding operations of every consumer chain, including the
+//     stopped chains whose unbonding operations are held, is backfilled from the unbonding
+//     op indexes, since the MaxUnbondingOpsPerChain cap relies on it;
+//   - the last validator set sent to every consumer chain is seeded, see seedConsumerValSets;
+//   - the consumer chains whose CCV channel is not established are exempted from sending the hash
+//     of their genesis on the handshake, since they were started from a consumer genesis without it.
+func migrateParamsAndIndexes(ctx sdk.Context, k Keeper) error {
+	defaultParams := types.DefaultParams()
+	for _, pair := range defaultParams.ParamSetPairs() {
+		if !k.paramSpace.Has(ctx, pair.Key) {
+			k.paramSpace.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+
+	for _, mapping := range k.GetAllValidatorsByConsumerAddr(ctx, nil) {
+		// setting the mapping again sets the ConsumerAddrChains index
+		k.SetValidatorByConsumerAddr(ctx, mapping.ChainId, *mapping.ConsumerAddr, *mapping.ProviderAddr)
+	}
+
+	poolAddr := k.accountKeeper.GetModuleAccount(ctx, types.ConsumerRewardsPool).GetAddress()
+	for _, coin := range k.bankKeeper.GetAllBalances(ctx, poolAddr) {
+		k.SetConsumerRewardDenom(ctx, coin.Denom)
+	}
+	for _, chain := range k.GetAllConsumerChains(ctx) {
+		pool := k.GetConsumerRewardsAllocation(ctx, chain.ChainId)
+		if pool.Rewards.IsZero() {
+			continue
+		}
+		for _, coin := range pool.Rewards {
+			k.SetConsumerRewardDenom(ctx, coin.Denom)
+		}
+		pool.ToDistribute = k.redistributedRewards(ctx, chain.ChainId, pool.Rewards)
+		k.SetConsumerRewardsAllocation(ctx, chain.ChainId, pool)
+	}
+
+	for _, v2h := range k.GetAllValsetUpdateBlockHeights(ctx) {
+		if v2h.Timestamp == nil {
+			k.SetValsetUpdateTimestamp(ctx, v2h.ValsetUpdateId, ctx.BlockTime())
+		}
+	}
+
+	for _, chain := range k.GetAllConsumerChains(ctx) {
+		if _, found := k.GetChainToChannel(ctx, chain.ChainId); !found {
+			k.SetGenesisHashExempt(ctx, chain.ChainId)
+		}
+	}
+
+	if err := backfillPendingUnbondingOpsCounts(ctx, k); err != nil {
+		return err
+	}
+	return seedConsumerValSets(ctx, k)
+}
+
+// seedConsumerValSets sets the last validator set sent to the consumer chains started before it was
+// stored, since FilterValidatorUpdates computes the updates that filter the validator set of a consumer
+// chain from it. These consumer chains are validated by all the bonded validators, thus their validator
+// set is seeded from the last validator powers with the assigned consumer keys applied; the keys whose
+// assignment was not sent yet are replaced by the previous consumer keys, which are the ones known by the
+// consumer chain. The pending VSC packets are then replayed, so that the seeded set contains the keys
+// they add or remove. Note that the replayed packets are applied again once sent, which leaves the set unchanged.
+func seedConsumerValSets(ctx sdk.Context, k Keeper) error {
+	var chainIDs []string
+	for _, chain := range k.GetAllConsumerChains(ctx) {
+		if _, found := k.GetConsumerValSetUpdateId(ctx, chain.ChainId); !found {
+			chainIDs = append(chainIDs, chain.ChainId)
+		}
+	}
+	if len(chainIDs) == 0 {
+		return nil
+	}
+
+	var lastPowers []abci.ValidatorUpdate
+	var providerAddrs []types.ProviderConsAddress
+	var err error
+	k.stakingKeeper.IterateLastValidatorPowers(ctx, func(valAddr sdk.ValAddress, power int64) (stop bool) {
+		val, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+		if !found {
+			err = fmt.Errorf("validator %s with last power not found", valAddr)
+			return true
+		}
+		consAddr, e := val.GetConsAddr()
+		if e != nil {
+			err = fmt.Errorf("invalid consensus address of validator %s: %w", valAddr, e)
+			return true
+		}
+		pubKey, e := val.TmConsPublicKey()
+		if e != nil {
+			err = fmt.Errorf("invalid consensus public key of validator %s: %w", valAddr, e)
+			return true
+		}
+		lastPowers = append(lastPowers, abci.ValidatorUpdate{PubKey: pubKey, Power: power})
+		providerAddrs = append(providerAddrs, types.NewProviderConsAddress(consAddr))
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	// the seeded set is the one sent with the last committed valset update ID
+	valsetUpdateID := k.GetValidatorSetUpdateId(ctx)
+	if valsetUpdateID > 0 {
+		valsetUpdateID--
+	}
+	for _, chainID := range chainIDs {
+		updates := make([]abci.ValidatorUpdate, 0, len(lastPowers))
+		for i, update := range lastPowers {
+			if prevConsumerKey, _, found := k.GetKeyAssignmentReplacement(ctx, chainID, providerAddrs[i]); found {
+				update.PubKey = prevConsumerKey
+			} else if consumerKey, found := k.GetValidatorConsumerPubKey(ctx, chainID, providerAddrs[i]); found {
+				update.PubKey = consumerKey
+			}
+			updates = append(updates, update)
+		}
+		k.SetConsumerValSet(ctx, chainID, valsetUpdateID, updates)
+		for _, packet := range k.GetPendingVSCPackets(ctx, chainID) {
+			k.ApplyConsumerValSetUpdates(ctx, chainID, packet.ValsetUpdateId, packet.ValidatorUpdates)
+		}
+	}
+	return nil
+}
+
+// backfillPendingUnbondingOpsCounts sets the number of pending unbonding operations
+// of every consumer chain that has unbonding op indexes, in ascending order of chain IDs
+func backfillPendingUnbondingOpsCounts(ctx sdk.Context, k Keeper) error {
+	counts, err := countUnbondingOpIndexes(ctx, k)
+	if err != nil {
+		return err
+	}
+	chainIDs := make([]string, 0, len(counts))
+	for chainID := range counts {
+		chainIDs = append(chainIDs, chainID)
+	}
+	sort.Strings(chainIDs)
+	for _, chainID := range chainIDs {
+		k.setPendingUnbondingOpsCount(ctx, chainID, counts[chainID])
+	}
+	return nil
+}
+
+// countUnbondingOpIndexes returns, for every consumer chain, the number
+// of unbonding operation IDs stored in its unbonding op indexes
+func countUnbondingOpIndexes(ctx sdk.Context, k Keeper) (map[string]int, error) {
+	counts := map[string]int{}
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{types.UnbondingOpIndexBytePrefix})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		chainID, _, err := types.ParseUnbondingOpIndexKey(iterator.Key())
+		if err != nil {
+			return nil, err
+		}
+		var vscUnbondingOps types.VscUnbondingOps
+		if err := vscUnbondingOps.Unmarshal(iterator.Value()); err != nil {
+			return nil, fmt.Errorf("cannot decode the unbonding op index of chain %s: %w", chainID, err)
+		}
+		counts[chainID] += len(vscUnbondingOps.UnbondingOpIds)
+	}
+	return counts, nil
+}
@@ -0,0 +1,241 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	cryptotestutil "github.com/cosmos/interchain-security/testutil/crypto"
+	testkeeper "github.com/cosmos/interchain-security/testutil/keeper"
+	providerkeeper "github.com/cosmos/interchain-security/x/ccv/provider/keeper"
+	providertypes "github.com/cosmos/interchain-security/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/x/ccv/types"
+)
+
+// TestStoreVersion tests the getter and setter of the provider store version
+func TestStoreVersion(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// a store without version was never migrated
+	require.Equal(t, uint64(0), providerKeeper.GetStoreVersion(ctx))
+
+	providerKeeper.SetStoreVersion(ctx, 3)
+	require.Equal(t, uint64(3), providerKeeper.GetStoreVersion(ctx))
+}
+
+// TestMigrateStore tests that the store migrations convert the slash acks from their
+// legacy JSON format to the SlashAcks proto type and bump the store version
+func TestMigrateStore(t *testing.T) {
+	keeperParams := testkeeper.NewInMemKeeperParams(t)
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, keeperParams)
+	defer ctrl.Finish()
+	expectRewardsPoolBalances(mocks, sdk.NewCoins())
+	expectLastValidatorPowers(mocks, nil, nil)
+
+	// the slash acks of chain-1 and chain-2 are stored in the legacy format,
+	// while the slash acks of chain-3 are already stored as SlashAcks
+	store := ctx.KVStore(keeperParams.StoreKey)
+	legacyAcks := map[string][]string{
+		"chain-1": {"ack-1", "ack-2"},
+		"chain-2": {"ack-3"},
+	}
+	for chainID, acks := range legacyAcks {
+		providerKeeper.SetConsumerClientId(ctx, chainID, "client-"+chainID)
+		bz, err := json.Marshal(acks)
+		require.NoError(t, err)
+		store.Set(providertypes.SlashAcksKey(chainID), bz)
+	}
+	providerKeeper.SetConsumerClientId(ctx, "chain-3", "client-chain-3")
+	providerKeeper.AppendSlashAck(ctx, "chain-3", "ack-4", stakingtypes.DoubleSign)
+	// chain-4 has no slash acks
+	providerKeeper.SetConsumerClientId(ctx, "chain-4", "client-chain-4")
+
+	require.NoError(t, providerKeeper.MigrateStore(ctx))
+	require.Equal(t, providerkeeper.LatestStoreVersion(), providerKeeper.GetStoreVersion(ctx))
+
+	for chainID, acks := range legacyAcks {
+		require.Equal(t, acks, providerKeeper.GetSlashAcks(ctx, chainID))
+		// the migrated slash acks are stored as SlashAcks
+		var sa providertypes.SlashAcks
+		require.NoError(t, sa.Unmarshal(store.Get(providertypes.SlashAcksKey(chainID))))
+		require.Equal(t, acks, sa.Addresses)
+	}
+	require.Equal(t, []string{"ack-4"}, providerKeeper.GetSlashAcks(ctx, "chain-3"))
+	require.Equal(t, []stakingtypes.InfractionType{stakingtypes.DoubleSign},
+		providerKeeper.GetSlashAckInfractions(ctx, "chain-3"))
+	require.Empty(t, providerKeeper.GetSlashAcks(ctx, "chain-4"))
+
+	// migrating a store at the latest version is a no-op
+	require.NoError(t, providerKeeper.MigrateStore(ctx))
+	require.Equal(t, providerkeeper.LatestStoreVersion(), providerKeeper.GetStoreVersion(ctx))
+	require.Equal(t, []string{"ack-1", "ack-2"}, providerKeeper.GetSlashAcks(ctx, "chain-1"))
+
+	// a store version newer than the latest one cannot be migrated
+	providerKeeper.SetStoreVersion(ctx, providerkeeper.LatestStoreVersion()+1)
+	require.ErrorIs(t, providerKeeper.MigrateStore(ctx), providertypes.ErrInvalidStoreVersion)
+}
+
+// TestMigrateStoreInvalidSlashAcks tests that the store migration fails
+// for slash acks that are neither in the legacy nor in the proto format
+func TestMigrateStoreInvalidSlashAcks(t *testing.T) {
+	keeperParams := testkeeper.NewInMemKeeperParams(t)
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, keeperParams)
+	defer ctrl.Finish()
+
+	providerKeeper.SetConsumerClientId(ctx, "chain", "client")
+	ctx.KVStore(keeperParams.StoreKey).Set(providertypes.SlashAcksKey("chain"), []byte{0xff})
+
+	require.Error(t, providerKeeper.MigrateStore(ctx))
+	// the store version is not bumped
+	require.Equal(t, uint64(0), providerKeeper.GetStoreVersion(ctx))
+}
+
+// TestMigrateStoreParamsAndIndexes tests that the store migration from version 1 sets the params that
+// are not set to their default values, backfills the index of the consumer chains every consumer address
+// is assigned on, registers the denoms of the existing consumer rewards and records the block time
+// for the valset update IDs mapped without it. It also tests that the last validator set sent
+// to the consumer chains is seeded, so that it can be filtered after the upgrade, and that the consumer
+// chains whose CCV channel is not established are exempted from sending the hash of their genesis
+func TestMigrateStoreParamsAndIndexes(t *testing.T) {
+	keeperParams := testkeeper.NewInMemKeeperParams(t)
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, keeperParams)
+	defer ctrl.Finish()
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+	providerKeeper.SetStoreVersion(ctx, 1)
+
+	// only a param of the first store version is set
+	keeperParams.ParamsSubspace.Set(ctx, providertypes.KeyMaxThrottledPackets, int64(7))
+
+	// a consumer chain whose CCV channel is established
+	providerKeeper.SetConsumerClientId(ctx, "chain-with-channel", "client-with-channel")
+	providerKeeper.SetChainToChannel(ctx, "chain-with-channel", "channel")
+
+	// a key assignment without ConsumerAddrChains entry
+	providerKeeper.SetConsumerClientId(ctx, "chain", "client")
+	consumerIdentity := cryptotestutil.NewCryptoIdentityFromIntSeed(1)
+	consumerAddr := consumerIdentity.ConsumerConsAddress()
+	providerAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(2).ProviderConsAddress()
+	store := ctx.KVStore(keeperParams.StoreKey)
+	bz, err := providerAddr.Marshal()
+	require.NoError(t, err)
+	store.Set(providertypes.ValidatorsByConsumerAddrKey("chain", consumerAddr), bz)
+	require.Empty(t, providerKeeper.GetValidatorsByConsumerAddrOnAllChains(ctx, consumerAddr))
+
+	// consumer rewards held in the pool and allocated to the consumer chain
+	expectRewardsPoolBalances(mocks, sdk.NewCoins(sdk.NewInt64Coin("ibc/pooled", 10)))
+	providerKeeper.SetConsumerRewardsAllocation(ctx, "chain", providertypes.ConsumerRewardsAllocation{
+		Rewards: sdk.NewCoins(sdk.NewInt64Coin("ibc/allocated", 5)),
+	})
+
+	// a valset update ID mapped to a block height without block time
+	providerKeeper.SetValsetUpdateBlockHeight(ctx, 3, 30)
+
+	// unbonding op indexes without pending unbonding ops count, including
+	// the ones of a stopped consumer chain whose unbonding ops are held
+	for i, chainID := range []string{"chain", "chain", "stopped-chain"} {
+		bz, err := (&providertypes.VscUnbondingOps{VscId: uint64(i), UnbondingOpIds: []uint64{1, 2}}).Marshal()
+		require.NoError(t, err)
+		store.Set(providertypes.UnbondingOpIndexKey(chainID, uint64(i)), bz)
+	}
+	require.Equal(t, 0, providerKeeper.GetPendingUnbondingOpsCount(ctx, "chain"))
+
+	// the consumer chain has no last validator set sent to it, while validator A has an assigned
+	// consumer key and the power of validator B is updated by a pending VSC packet
+	valA := cryptotestutil.NewCryptoIdentityFromIntSeed(3)
+	valAConsumer := cryptotestutil.NewCryptoIdentityFromIntSeed(4)
+	valB := cryptotestutil.NewCryptoIdentityFromIntSeed(5)
+	vals := []*cryptotestutil.CryptoIdentity{valA, valB}
+	lastPowers := []int64{1, 2}
+	providerKeeper.SetValidatorConsumerPubKey(ctx, "chain", valA.ProviderConsAddress(), valAConsumer.TMProtoCryptoPublicKey())
+	providerKeeper.SetValidatorByConsumerAddr(ctx, "chain", valAConsumer.ConsumerConsAddress(), valA.ProviderConsAddress())
+	providerKeeper.SetValidatorSetUpdateId(ctx, 7)
+	providerKeeper.AppendPendingVSCPackets(ctx, "chain", ccv.NewValidatorSetChangePacketData(
+		[]abci.ValidatorUpdate{{PubKey: valB.TMProtoCryptoPublicKey(), Power: 2}}, 6, nil))
+	expectLastValidatorPowers(mocks, vals, lastPowers)
+
+	require.NoError(t, providerKeeper.MigrateStore(ctx))
+	require.Equal(t, providerkeeper.LatestStoreVersion(), providerKeeper.GetStoreVersion(ctx))
+
+	// the params that were not set have their default values
+	expectedParams := providertypes.DefaultParams()
+	expectedParams.MaxThrottledPackets = 7
+	require.Equal(t, expectedParams, providerKeeper.GetParams(ctx))
+
+	require.Equal(t, []providertypes.ValidatorByConsumerAddr{
+		{ConsumerAddr: &consumerAddr, ProviderAddr: &providerAddr, ChainId: "chain"},
+	}, providerKeeper.GetValidatorsByConsumerAddrOnAllChains(ctx, consumerAddr))
+	// a validator cannot be created with the assigned consumer key
+	mocks.MockStakingKeeper.EXPECT().GetValidator(ctx, consumerIdentity.SDKValOpAddress()).Return(
+		consumerIdentity.SDKStakingValidator(), true).Times(1)
+	require.True(t, providerkeeper.ValidatorConsensusKeyInUse(&providerKeeper, ctx, consumerIdentity.SDKValOpAddress()))
+
+	require.Equal(t, []string{"ibc/allocated", "ibc/pooled"}, providerKeeper.GetAllConsumerRewardDenoms(ctx))
+	// the redistribute fraction of the allocated rewards is to be distributed, i.e., all of them by default
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ibc/allocated", 5)),
+		providerKeeper.GetConsumerRewardsAllocation(ctx, "chain").ToDistribute)
+
+	ts, found := providerKeeper.GetValsetUpdateTimestamp(ctx, 3)
+	require.True(t, found)
+	require.Equal(t, now, ts)
+
+	require.Equal(t, 4, providerKeeper.GetPendingUnbondingOpsCount(ctx, "chain"))
+	require.Equal(t, 2, providerKeeper.GetPendingUnbondingOpsCount(ctx, "stopped-chain"))
+
+	// only the consumer chain whose CCV channel is not established is exempted from sending its genesis hash
+	require.True(t, providerKeeper.IsGenesisHashExempt(ctx, "chain"))
+	require.False(t, providerKeeper.IsGenesisHashExempt(ctx, "chain-with-channel"))
+
+	// the last validator set sent to the consumer chain is seeded with the consumer keys
+	// applied and the pending VSC packet replayed
+	valSetUpdateID, found := providerKeeper.GetConsumerValSetUpdateId(ctx, "chain")
+	require.True(t, found)
+	require.Equal(t, uint64(6), valSetUpdateID)
+	valAConsumerKey := valAConsumer.TMProtoCryptoPublicKey()
+	providerAddrA, providerAddrB := valA.ProviderConsAddress(), valB.ProviderConsAddress()
+	require.ElementsMatch(t, []providertypes.ConsumerValidator{
+		{ProviderAddr: &providerAddrA, ConsumerKey: &valAConsumerKey, Power: 1},
+		{ProviderAddr: &providerAddrB, Power: 2},
+	}, providerKeeper.GetConsumerValSet(ctx, "chain"))
+
+	// thus, a validator denylisted after the upgrade is removed from the consumer validator set
+	providerKeeper.SetValidatorDenylist(ctx, "chain", []providertypes.ProviderConsAddress{providerAddrB})
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, valB.SDKValConsAddress()).Return(
+		valB.SDKStakingValidator(), true).Times(1)
+	expectLastValidatorPowers(mocks, vals, lastPowers)
+	require.Equal(t, []abci.ValidatorUpdate{{PubKey: valB.TMProtoCryptoPublicKey(), Power: 0}},
+		providerKeeper.FilterValidatorUpdates(ctx, "chain"))
+}
+
+// expectLastValidatorPowers sets the expected calls iterating over the given validators with the given last powers
+func expectLastValidatorPowers(mocks testkeeper.MockedKeepers, vals []*cryptotestutil.CryptoIdentity, powers []int64) {
+	mocks.MockStakingKeeper.EXPECT().IterateLastValidatorPowers(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, cb func(sdk.ValAddress, int64) bool) {
+			for i, val := range vals {
+				if cb(val.SDKValOpAddress(), powers[i]) {
+					return
+				}
+			}
+		}).Times(1)
+	for _, val := range vals {
+		mocks.MockStakingKeeper.EXPECT().GetValidator(gomock.Any(), val.SDKValOpAddress()).Return(
+			val.SDKStakingValidator(), true).Times(1)
+	}
+}
+
+// expectRewardsPoolBalances sets the expected calls returning the balances of the consumer rewards pool
+func expectRewardsPoolBalances(mocks testkeeper.MockedKeepers, balances sdk.Coins) {
+	moduleAcct := authtypes.NewEmptyModuleAccount(providertypes.ConsumerRewardsPool)
+	gomock.InOrder(
+		mocks.MockAccountKeeper.EXPECT().GetModuleAccount(gomock.Any(), providertypes.ConsumerRewardsPool).Return(moduleAcct).Times(1),
+		mocks.MockBankKeeper.EXPECT().GetAllBalances(gomock.Any(), moduleAcct.GetAddress()).Return(balances).Times(1),
+	)
+}
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
+
+	"github.com/cosmos/interchain-security/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/x/ccv/types"
+)
+
+// HandleConsumerMisbehaviour verifies the given light client attack on a consumer chain, i.e., two
+// conflicting headers, against the client of the consumer chain. If the misbehaviour is valid,
+// the client is frozen and the consumer chain is stopped, which sets the CCV channel to INVALID.
+//
+// Note that misbehaviour can only be submitted for consumer chains with an established CCV channel.
+func (k Keeper) HandleConsumerMisbehaviour(ctx sdk.Context, misbehaviour ibctmtypes.Misbehaviour) error {
+	chainID := misbehaviour.Header1.Header.ChainID
+
+	// the misbehaviour must be for a known consumer chain
+	if _, found := k.GetConsumerClientId(ctx, chainID); !found {
+		return sdkerrors.Wrapf(types.ErrUnknownConsumerChainId, "misbehaviour for unknown consumer chain: %s", chainID)
+	}
+	channelID, found := k.GetChainToChannel(ctx, chainID)
+	if !found {
+		return sdkerrors.Wrapf(ccv.ErrInvalidConsumerState, "CCV channel not established for consumer chain: %s", chainID)
+	}
+
+	// locate the client underlying the CCV channel
+	channel, found := k.channelKeeper.GetChannel(ctx, k.GetPort(ctx), channelID)
+	if !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "CCV channel not found: %s", channelID)
+	}
+	clientID, clientState, err := k.getUnderlyingClient(ctx, channel.ConnectionHops[0])
+	if err != nil {
+		return err
+	}
+	if clientID != misbehaviour.ClientId {
+		return sdkerrors.Wrapf(types.ErrInvalidConsumerMisbehaviour,
+			"misbehaviour client ID %s does not match the client ID %s of consumer chain %s",
+			misbehaviour.ClientId, clientID, chainID)
+	}
+	if clientState.GetChainID() != chainID {
+		return sdkerrors.Wrapf(types.ErrInvalidConsumerMisbehaviour,
+			"misbehaviour chain ID %s does not match the chain ID %s of client %s",
+			chainID, clientState.GetChainID(), clientID)
+	}
+
+	// verify the misbehaviour and freeze the client
+	if err := k.clientKeeper.CheckMisbehaviourAndUpdateState(ctx, &misbehaviour); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidConsumerMisbehaviour, err.Error())
+	}
+
+	// stop the consumer chain and close the CCV channel
+	if err := k.StopConsumerChain(ctx, chainID, true); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info("consumer chain stopped due to misbehaviour",
+		"chainID", chainID,
+		"clientID", clientID,
+	)
+
+	return nil
+}
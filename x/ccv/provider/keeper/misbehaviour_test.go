@@ -0,0 +1,140 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	conntypes "github.com/cosmos/ibc-go/v4/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
+	"github.com/golang/mock/gomock"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	testkeeper "github.com/cosmos/interchain-security/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/x/ccv/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestHandleConsumerMisbehaviour tests that a light client attack on a consumer chain
+// is verified against the client of the consumer chain and, if valid, stops the consumer chain
+func TestHandleConsumerMisbehaviour(t *testing.T) {
+	chainID := "chainID"
+	misbehaviour := func(clientID, chainID string) ibctmtypes.Misbehaviour {
+		header := &ibctmtypes.Header{SignedHeader: &tmproto.SignedHeader{Header: &tmproto.Header{ChainID: chainID}}}
+		return ibctmtypes.Misbehaviour{ClientId: clientID, Header1: header, Header2: header}
+	}
+	mocksForUnderlyingClient := func(mocks testkeeper.MockedKeepers, clientChainID string) []*gomock.Call {
+		return []*gomock.Call{
+			mocks.MockChannelKeeper.EXPECT().GetChannel(gomock.Any(), ccv.ProviderPortID, "channelID").Return(
+				channeltypes.Channel{State: channeltypes.OPEN, ConnectionHops: []string{"connectionID"}}, true,
+			).Times(1),
+			mocks.MockConnectionKeeper.EXPECT().GetConnection(gomock.Any(), "connectionID").Return(
+				conntypes.ConnectionEnd{ClientId: "clientID"}, true,
+			).Times(1),
+			mocks.MockClientKeeper.EXPECT().GetClientState(gomock.Any(), "clientID").Return(
+				&ibctmtypes.ClientState{ChainId: clientChainID}, true,
+			).Times(1),
+		}
+	}
+
+	testCases := []struct {
+		name         string
+		misbehaviour ibctmtypes.Misbehaviour
+		// whether the CCV channel is established
+		channel         bool
+		setupMocks      func(sdk.Context, testkeeper.MockedKeepers)
+		expErr          error
+		expChainStopped bool
+	}{
+		{
+			"unknown consumer chain",
+			misbehaviour("clientID", "unknown"),
+			true,
+			func(sdk.Context, testkeeper.MockedKeepers) {},
+			providertypes.ErrUnknownConsumerChainId,
+			false,
+		},
+		{
+			"CCV channel not established",
+			misbehaviour("clientID", chainID),
+			false,
+			func(sdk.Context, testkeeper.MockedKeepers) {},
+			ccv.ErrInvalidConsumerState,
+			false,
+		},
+		{
+			"client ID does not match the consumer client",
+			misbehaviour("otherClientID", chainID),
+			true,
+			func(ctx sdk.Context, mocks testkeeper.MockedKeepers) {
+				gomock.InOrder(mocksForUnderlyingClient(mocks, chainID)...)
+			},
+			providertypes.ErrInvalidConsumerMisbehaviour,
+			false,
+		},
+		{
+			"chain ID does not match the consumer client",
+			misbehaviour("clientID", chainID),
+			true,
+			func(ctx sdk.Context, mocks testkeeper.MockedKeepers) {
+				gomock.InOrder(mocksForUnderlyingClient(mocks, "otherChainID")...)
+			},
+			providertypes.ErrInvalidConsumerMisbehaviour,
+			false,
+		},
+		{
+			"invalid misbehaviour",
+			misbehaviour("clientID", chainID),
+			true,
+			func(ctx sdk.Context, mocks testkeeper.MockedKeepers) {
+				gomock.InOrder(append(mocksForUnderlyingClient(mocks, chainID),
+					mocks.MockClientKeeper.EXPECT().CheckMisbehaviourAndUpdateState(gomock.Any(), gomock.Any()).Return(
+						errors.New("invalid misbehaviour"),
+					).Times(1),
+				)...)
+			},
+			providertypes.ErrInvalidConsumerMisbehaviour,
+			false,
+		},
+		{
+			"valid misbehaviour, the consumer chain is stopped",
+			misbehaviour("clientID", chainID),
+			true,
+			func(ctx sdk.Context, mocks testkeeper.MockedKeepers) {
+				calls := append(mocksForUnderlyingClient(mocks, chainID),
+					mocks.MockClientKeeper.EXPECT().CheckMisbehaviourAndUpdateState(gomock.Any(), gomock.Any()).Return(nil).Times(1),
+				)
+				gomock.InOrder(append(calls, testkeeper.GetMocksForStopConsumerChain(ctx, &mocks)...)...)
+			},
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+		providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+		providerKeeper.SetConsumerClientId(ctx, chainID, "clientID")
+		if tc.channel {
+			providerKeeper.SetChainToChannel(ctx, chainID, "channelID")
+			providerKeeper.SetChannelToChain(ctx, "channelID", chainID)
+		}
+		tc.setupMocks(ctx, mocks)
+
+		err := providerKeeper.HandleConsumerMisbehaviour(ctx, tc.misbehaviour)
+		if tc.expErr != nil {
+			require.ErrorIs(t, err, tc.expErr, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+
+		_, found := providerKeeper.GetConsumerClientId(ctx, chainID)
+		require.Equal(t, !tc.expChainStopped, found, tc.name)
+
+		ctrl.Finish()
+	}
+}
@@ -25,7 +25,8 @@ func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
 
 var _ types.MsgServer = msgServer{}
 
-// CreateValidator defines a method for creating a new validator
+// AssignConsumerKey defines a method to assign a consensus key on a consumer chain
+// for a given validator on the provider
 func (k msgServer) AssignConsumerKey(goCtx context.Context, msg *types.MsgAssignConsumerKey) (*types.MsgAssignConsumerKeyResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -80,6 +81,7 @@ func (k msgServer) AssignConsumerKey(goCtx context.Context, msg *types.MsgAssign
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			ccvtypes.EventTypeAssignConsumerKey,
+			sdk.NewAttribute(ccvtypes.AttributeChainID, msg.ChainId),
 			sdk.NewAttribute(ccvtypes.AttributeProviderValidatorAddress, msg.ProviderAddr),
 			sdk.NewAttribute(ccvtypes.AttributeConsumerConsensusPubKey, consumerSDKPublicKey.String()),
 		),
@@ -87,3 +89,23 @@ func (k msgServer) AssignConsumerKey(goCtx context.Context, msg *types.MsgAssign
 
 	return &types.MsgAssignConsumerKeyResponse{}, nil
 }
+
+// SubmitConsumerMisbehaviour defines a method to report a light client attack on a consumer chain
+func (k msgServer) SubmitConsumerMisbehaviour(goCtx context.Context, msg *types.MsgSubmitConsumerMisbehaviour) (*types.MsgSubmitConsumerMisbehaviourResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.HandleConsumerMisbehaviour(ctx, *msg.Misbehaviour); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			ccvtypes.EventTypeConsumerMisbehaviour,
+			sdk.NewAttribute(ccvtypes.AttributeChainID, msg.Misbehaviour.Header1.Header.ChainID),
+			sdk.NewAttribute(ccvtypes.AttributeClientID, msg.Misbehaviour.ClientId),
+			sdk.NewAttribute(ccvtypes.AttributeSubmitterAddress, msg.Submitter),
+		),
+	})
+
+	return &types.MsgSubmitConsumerMisbehaviourResponse{}, nil
+}
@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/interchain-security/x/ccv/provider/types"
+)
+
+// SetOptedIn registers the validator with the given operator address
+// to validate the consumer chain with the given chain ID
+func (k Keeper) SetOptedIn(ctx sdk.Context, chainID string, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.OptedInKey(chainID, valAddr), []byte{})
+}
+
+// IsOptedIn returns whether the validator with the given operator address
+// is registered to validate the consumer chain with the given chain ID
+func (k Keeper) IsOptedIn(ctx sdk.Context, chainID string, valAddr sdk.ValAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.OptedInKey(chainID, valAddr))
+}
+
+// DeleteOptedIn removes the validator with the given operator address
+// from the validators registered to validate the consumer chain with the given chain ID
+func (k Keeper) DeleteOptedIn(ctx sdk.Context, chainID string, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.OptedInKey(chainID, valAddr))
+}
+
+// GetAllOptedIn returns the operator addresses of all the validators
+// registered to validate the consumer chain with the given chain ID
+func (k Keeper) GetAllOptedIn(ctx sdk.Context, chainID string) (valAddrs []sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.ChainIdWithLenKey(types.OptedInBytePrefix, chainID)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		valAddrs = append(valAddrs, sdk.ValAddress(iterator.Key()[len(prefix):]))
+	}
+
+	return valAddrs
+}
+
+// GetConsumerValidators returns the operator addresses of the validators opted in to validate
+// the consumer chain with the given chain ID, in ascending order of addresses.
+//
+// Note that the opted in validators are stored under the chain ID, i.e., the store is a per-chain index
+// that is updated whenever a validator opts in or out, so the validators of a consumer chain are
+// read without iterating over the validators of the other consumer chains.
+func (k Keeper) GetConsumerValidators(ctx sdk.Context, chainID string) []sdk.ValAddress {
+	valAddrs := k.GetAllOptedIn(ctx, chainID)
+	if valAddrs == nil {
+		return []sdk.ValAddress{}
+	}
+	return valAddrs
+}
+
+// DeleteAllOptedIn removes all the validators registered
+// to validate the consumer chain with the given chain ID
+func (k Keeper) DeleteAllOptedIn(ctx sdk.Context, chainID string) {
+	for _, valAddr := range k.GetAllOptedIn(ctx, chainID) {
+		k.DeleteOptedIn(ctx, chainID, valAddr)
+	}
+}
+
+// RemoveValidatorFromAllConsumers removes the validator with the given operator address
+// from the validators registered to validate every consumer chain.
+// It returns the chain IDs of the consumer chains the validator was removed from.
+func (k Keeper) RemoveValidatorFromAllConsumers(ctx sdk.Context, valAddr sdk.ValAddress) (chainIDs []string) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{types.OptedInBytePrefix})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		chainID, addr, err := types.ParseOptedInKey(iterator.Key())
+		if err != nil {
+			// An error here would indicate something is very wrong,
+			// the key is assumed to be correctly serialized in SetOptedIn.
+			panic(fmt.Errorf("failed to parse opted in key: %w", err))
+		}
+		if addr.Equals(valAddr) {
+			chainIDs = append(chainIDs, chainID)
+		}
+	}
+
+	for _, chainID := range chainIDs {
+		k.DeleteOptedIn(ctx, chainID, valAddr)
+	}
+
+	if len(chainIDs) > 0 {
+		k.Logger(ctx).Info("validator removed from all consumer chains",
+			"validator", valAddr.String(),
+			"chainIDs", strings.Join(chainIDs, ", "),
+		)
+	}
+
+	return chainIDs
+}
+
+// SetConsumerValidators registers all the given validators to validate the consumer chain
+// with the given chain ID. Every validator must exist in the staking module, otherwise
+// an error listing the unknown validators is returned and none of the validators is registered.
+func (k Keeper) SetConsumerValidators(ctx sdk.Context, chainID string, vals []sdk.ValAddress) error {
+	var unknown []string
+	for _, valAddr := range vals {
+		if _, found := k.stakingKeeper.GetValidator(ctx, valAddr); !found {
+			unknown = append(unknown, valAddr.String())
+		}
+	}
+	if len(unknown) > 0 {
+		return sdkerrors.Wrapf(types.ErrUnknownValidator,
+			"cannot register validators for consumer chain %s: %s", chainID, strings.Join(unknown, ", "))
+	}
+
+	for _, valAddr := range vals {
+		k.SetOptedIn(ctx, chainID, valAddr)
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/cosmos/interchain-security/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/x/ccv/provider/types"
+)
+
+// TestOptedIn tests the getter, setter and deletion methods
+// for the validators registered to validate a consumer chain
+func TestOptedIn(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	valA := sdk.ValAddress([]byte("valA"))
+	valB := sdk.ValAddress([]byte("valB"))
+
+	require.False(t, providerKeeper.IsOptedIn(ctx, "chain", valA))
+	require.Empty(t, providerKeeper.GetAllOptedIn(ctx, "chain"))
+
+	providerKeeper.SetOptedIn(ctx, "chain", valA)
+	providerKeeper.SetOptedIn(ctx, "chain", valB)
+	providerKeeper.SetOptedIn(ctx, "chain1", valA)
+
+	require.True(t, providerKeeper.IsOptedIn(ctx, "chain", valA))
+	require.ElementsMatch(t, []sdk.ValAddress{valA, valB}, providerKeeper.GetAllOptedIn(ctx, "chain"))
+
+	providerKeeper.DeleteOptedIn(ctx, "chain", valA)
+	require.False(t, providerKeeper.IsOptedIn(ctx, "chain", valA))
+	require.Equal(t, []sdk.ValAddress{valB}, providerKeeper.GetAllOptedIn(ctx, "chain"))
+
+	providerKeeper.DeleteAllOptedIn(ctx, "chain")
+	require.Empty(t, providerKeeper.GetAllOptedIn(ctx, "chain"))
+	// the validators of other consumer chains are not affected
+	require.Equal(t, []sdk.ValAddress{valA}, providerKeeper.GetAllOptedIn(ctx, "chain1"))
+}
+
+// TestSetConsumerValidators tests that the given validators are registered
+// to validate a consumer chain only if all of them exist in the staking module
+func TestSetConsumerValidators(t *testing.T) {
+	valA := sdk.ValAddress([]byte("valA"))
+	valB := sdk.ValAddress([]byte("valB"))
+	valC := sdk.ValAddress([]byte("valC"))
+
+	testCases := []struct {
+		name        string
+		vals        []sdk.ValAddress
+		unknownVals []sdk.ValAddress
+		expError    bool
+	}{
+		{
+			name: "all validators exist",
+			vals: []sdk.ValAddress{valA, valB},
+		},
+		{
+			name:        "some validators do not exist",
+			vals:        []sdk.ValAddress{valA, valB, valC},
+			unknownVals: []sdk.ValAddress{valB, valC},
+			expError:    true,
+		},
+		{
+			name:        "no validator exists",
+			vals:        []sdk.ValAddress{valA},
+			unknownVals: []sdk.ValAddress{valA},
+			expError:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+
+		for _, val := range tc.vals {
+			found := true
+			for _, unknownVal := range tc.unknownVals {
+				if val.Equals(unknownVal) {
+					found = false
+				}
+			}
+			mocks.MockStakingKeeper.EXPECT().GetValidator(ctx, val).Return(stakingtypes.Validator{}, found).Times(1)
+		}
+
+		err := providerKeeper.SetConsumerValidators(ctx, "chain", tc.vals)
+		if tc.expError {
+			require.ErrorIs(t, err, providertypes.ErrUnknownValidator, tc.name)
+			for _, unknownVal := range tc.unknownVals {
+				require.Contains(t, err.Error(), unknownVal.String(), tc.name)
+			}
+			// no validator is registered
+			require.Empty(t, providerKeeper.GetAllOptedIn(ctx, "chain"), tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+			require.ElementsMatch(t, tc.vals, providerKeeper.GetAllOptedIn(ctx, "chain"), tc.name)
+		}
+
+		ctrl.Finish()
+	}
+}
+
+// TestRemoveValidatorFromAllConsumers tests that a validator is removed from the validators
+// registered to validate every consumer chain, and that the affected chains are returned
+func TestRemoveValidatorFromAllConsumers(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	valA := sdk.ValAddress([]byte("valA"))
+	valB := sdk.ValAddress([]byte("valB"))
+
+	// validator A is not registered for any consumer chain
+	require.Empty(t, providerKeeper.RemoveValidatorFromAllConsumers(ctx, valA))
+
+	providerKeeper.SetOptedIn(ctx, "chain", valA)
+	providerKeeper.SetOptedIn(ctx, "chain", valB)
+	providerKeeper.SetOptedIn(ctx, "chain1", valA)
+	providerKeeper.SetOptedIn(ctx, "chain22", valA)
+	providerKeeper.SetOptedIn(ctx, "chain3", valB)
+
+	chainIDs := providerKeeper.RemoveValidatorFromAllConsumers(ctx, valA)
+	require.ElementsMatch(t, []string{"chain", "chain1", "chain22"}, chainIDs)
+	for _, chainID := range []string{"chain", "chain1", "chain22", "chain3"} {
+		require.False(t, providerKeeper.IsOptedIn(ctx, chainID, valA))
+	}
+
+	// validator B is not affected
+	require.Equal(t, []sdk.ValAddress{valB}, providerKeeper.GetAllOptedIn(ctx, "chain"))
+	require.Equal(t, []sdk.ValAddress{valB}, providerKeeper.GetAllOptedIn(ctx, "chain3"))
+
+	// removing validator A again does not affect any consumer chain
+	require.Empty(t, providerKeeper.RemoveValidatorFromAllConsumers(ctx, valA))
+}
+
+// TestGetConsumerValidators tests that the validators opted in to validate a consumer chain
+// are listed, and that opting validators in and out updates the list
+func TestGetConsumerValidators(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	valA := sdk.ValAddress([]byte("valA"))
+	valB := sdk.ValAddress([]byte("valB"))
+	valC := sdk.ValAddress([]byte("valC"))
+
+	// no validator is opted in
+	require.Equal(t, []sdk.ValAddress{}, providerKeeper.GetConsumerValidators(ctx, "chain"))
+
+	// the validators are listed in ascending order of addresses
+	providerKeeper.SetOptedIn(ctx, "chain", valC)
+	providerKeeper.SetOptedIn(ctx, "chain", valA)
+	providerKeeper.SetOptedIn(ctx, "chain1", valB)
+	require.Equal(t, []sdk.ValAddress{valA, valC}, providerKeeper.GetConsumerValidators(ctx, "chain"))
+	require.Equal(t, []sdk.ValAddress{valB}, providerKeeper.GetConsumerValidators(ctx, "chain1"))
+
+	// opting in twice does not duplicate the validator
+	providerKeeper.SetOptedIn(ctx, "chain", valA)
+	require.Equal(t, []sdk.ValAddress{valA, valC}, providerKeeper.GetConsumerValidators(ctx, "chain"))
+
+	// opting out removes the validator from the chain only
+	providerKeeper.DeleteOptedIn(ctx, "chain", valC)
+	require.Equal(t, []sdk.ValAddress{valA}, providerKeeper.GetConsumerValidators(ctx, "chain"))
+	providerKeeper.SetOptedIn(ctx, "chain1", valA)
+	providerKeeper.RemoveValidatorFromAllConsumers(ctx, valA)
+	require.Equal(t, []sdk.ValAddress{}, providerKeeper.GetConsumerValidators(ctx, "chain"))
+	require.Equal(t, []sdk.ValAddress{valB}, providerKeeper.GetConsumerValidators(ctx, "chain1"))
+
+	// the opted in validators are exposed through the query
+	res, err := providerKeeper.QueryOptedInValidators(sdk.WrapSDKContext(ctx),
+		&providertypes.QueryOptedInValidatorsRequest{ChainId: "chain1"})
+	require.NoError(t, err)
+	require.Equal(t, []string{valB.String()}, res.ValidatorAddresses)
+	_, err = providerKeeper.QueryOptedInValidators(sdk.WrapSDKContext(ctx),
+		&providertypes.QueryOptedInValidatorsRequest{})
+	require.Error(t, err)
+}
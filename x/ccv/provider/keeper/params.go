@@ -7,6 +7,7 @@ import (
 
 	ibctmtypes "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
 
+	consumertypes "github.com/cosmos/interchain-security/x/ccv/consumer/types"
 	"github.com/cosmos/interchain-security/x/ccv/provider/types"
 	ccvtypes "github.com/cosmos/interchain-security/x/ccv/types"
 )
@@ -77,6 +78,166 @@ func (k Keeper) GetMaxThrottledPackets(ctx sdk.Context) int64 {
 	return p
 }
 
+// GetConsumerRedistributeFraction returns the string fraction of the rewards allocation
+// of a consumer chain that is distributed to the fee collector every block
+func (k Keeper) GetConsumerRedistributeFraction(ctx sdk.Context) string {
+	var f string
+	k.paramSpace.Get(ctx, types.KeyConsumerRedistributeFraction, &f)
+	return f
+}
+
+// GetMaxSlashRetries returns the maximum number of times the provider retries
+// to apply a slash packet whose validator is not found
+func (k Keeper) GetMaxSlashRetries(ctx sdk.Context) int64 {
+	var r int64
+	k.paramSpace.Get(ctx, types.KeyMaxSlashRetries, &r)
+	return r
+}
+
+// GetClientExpirationGracePeriod returns the period during which the provider waits
+// for the expired or frozen client of a consumer chain to be recovered
+func (k Keeper) GetClientExpirationGracePeriod(ctx sdk.Context) time.Duration {
+	var p time.Duration
+	k.paramSpace.Get(ctx, types.KeyClientExpirationGracePeriod, &p)
+	return p
+}
+
+// GetHistoricalValsetEntries returns the number of most recent valset update IDs
+// whose block heights are kept by the provider
+func (k Keeper) GetHistoricalValsetEntries(ctx sdk.Context) int64 {
+	var n int64
+	k.paramSpace.Get(ctx, types.KeyHistoricalValsetEntries, &n)
+	return n
+}
+
+// GetSoftOptOutThreshold returns the fraction of the total voting power, held by the validators
+// with the smallest powers, whose validators are opted out of validating the consumer chains
+func (k Keeper) GetSoftOptOutThreshold(ctx sdk.Context) string {
+	var f string
+	k.paramSpace.Get(ctx, types.KeySoftOptOutThreshold, &f)
+	return f
+}
+
+// GetConsumerRewardsWindowPeriod returns the period over which the rewards received
+// from a consumer chain are compared to its expected rewards
+func (k Keeper) GetConsumerRewardsWindowPeriod(ctx sdk.Context) time.Duration {
+	var p time.Duration
+	k.paramSpace.Get(ctx, types.KeyConsumerRewardsWindowPeriod, &p)
+	return p
+}
+
+// GetSlashFractionDoubleSign returns the fraction of the stake of a validator
+// that is slashed for a double-sign infraction reported by a consumer chain
+func (k Keeper) GetSlashFractionDoubleSign(ctx sdk.Context) string {
+	var f string
+	k.paramSpace.Get(ctx, types.KeySlashFractionDoubleSign, &f)
+	return f
+}
+
+// SetSlashFractionDoubleSign sets the fraction of the stake of a validator
+// that is slashed for a double-sign infraction reported by a consumer chain
+func (k Keeper) SetSlashFractionDoubleSign(ctx sdk.Context, fraction string) {
+	k.paramSpace.Set(ctx, types.KeySlashFractionDoubleSign, fraction)
+}
+
+// GetSlashFractionDowntime returns the fraction of the stake of a validator
+// that is slashed for a downtime infraction reported by a consumer chain
+func (k Keeper) GetSlashFractionDowntime(ctx sdk.Context) string {
+	var f string
+	k.paramSpace.Get(ctx, types.KeySlashFractionDowntime, &f)
+	return f
+}
+
+// SetSlashFractionDowntime sets the fraction of the stake of a validator
+// that is slashed for a downtime infraction reported by a consumer chain
+func (k Keeper) SetSlashFractionDowntime(ctx sdk.Context, fraction string) {
+	k.paramSpace.Set(ctx, types.KeySlashFractionDowntime, fraction)
+}
+
+// GetMaxUnbondingOpsPerChain returns the maximum number of unbonding operations
+// that can wait for VSCMaturedPackets from a single consumer chain
+func (k Keeper) GetMaxUnbondingOpsPerChain(ctx sdk.Context) int64 {
+	var max int64
+	k.paramSpace.Get(ctx, types.KeyMaxUnbondingOpsPerChain, &max)
+	return max
+}
+
+// SetMaxUnbondingOpsPerChain sets the maximum number of unbonding operations
+// that can wait for VSCMaturedPackets from a single consumer chain
+func (k Keeper) SetMaxUnbondingOpsPerChain(ctx sdk.Context, max int64) {
+	k.paramSpace.Set(ctx, types.KeyMaxUnbondingOpsPerChain, max)
+}
+
+// GetLogValsetUpdateDiffs returns whether the validator power changes
+// of the VSC packets sent to the consumer chains are logged
+func (k Keeper) GetLogValsetUpdateDiffs(ctx sdk.Context) bool {
+	var enabled bool
+	k.paramSpace.Get(ctx, types.KeyLogValsetUpdateDiffs, &enabled)
+	return enabled
+}
+
+// SetLogValsetUpdateDiffs sets whether the validator power changes
+// of the VSC packets sent to the consumer chains are logged
+func (k Keeper) SetLogValsetUpdateDiffs(ctx sdk.Context, enabled bool) {
+	k.paramSpace.Set(ctx, types.KeyLogValsetUpdateDiffs, enabled)
+}
+
+// GetSlashAckBatchPeriod returns the period during which
+// the slash acks of a consumer chain are batched
+func (k Keeper) GetSlashAckBatchPeriod(ctx sdk.Context) time.Duration {
+	var p time.Duration
+	k.paramSpace.Get(ctx, types.KeySlashAckBatchPeriod, &p)
+	return p
+}
+
+// SetSlashAckBatchPeriod sets the period during which
+// the slash acks of a consumer chain are batched
+func (k Keeper) SetSlashAckBatchPeriod(ctx sdk.Context, period time.Duration) {
+	k.paramSpace.Set(ctx, types.KeySlashAckBatchPeriod, period)
+}
+
+// GetConsumerLivenessWindow returns the period after which
+// a consumer chain that was not heard from is reported as inactive
+func (k Keeper) GetConsumerLivenessWindow(ctx sdk.Context) time.Duration {
+	var p time.Duration
+	k.paramSpace.Get(ctx, types.KeyConsumerLivenessWindow, &p)
+	return p
+}
+
+// SetConsumerLivenessWindow sets the period after which
+// a consumer chain that was not heard from is reported as inactive
+func (k Keeper) SetConsumerLivenessWindow(ctx sdk.Context, window time.Duration) {
+	k.paramSpace.Set(ctx, types.KeyConsumerLivenessWindow, window)
+}
+
+// GetAllowedConsumerClientTypes returns the types of the light clients
+// that the CCV channels to the consumer chains can be built on
+func (k Keeper) GetAllowedConsumerClientTypes(ctx sdk.Context) []string {
+	var clientTypes []string
+	k.paramSpace.Get(ctx, types.KeyAllowedConsumerClientTypes, &clientTypes)
+	return clientTypes
+}
+
+// SetAllowedConsumerClientTypes sets the types of the light clients
+// that the CCV channels to the consumer chains can be built on
+func (k Keeper) SetAllowedConsumerClientTypes(ctx sdk.Context, clientTypes []string) {
+	k.paramSpace.Set(ctx, types.KeyAllowedConsumerClientTypes, clientTypes)
+}
+
+// GetClientExpiryWarningFraction returns the fraction of the trusting period of a consumer client
+// below which its time to expiry is reported
+func (k Keeper) GetClientExpiryWarningFraction(ctx sdk.Context) string {
+	var f string
+	k.paramSpace.Get(ctx, types.KeyClientExpiryWarningFraction, &f)
+	return f
+}
+
+// SetClientExpiryWarningFraction sets the fraction of the trusting period of a consumer client
+// below which its time to expiry is reported
+func (k Keeper) SetClientExpiryWarningFraction(ctx sdk.Context, fraction string) {
+	k.paramSpace.Set(ctx, types.KeyClientExpiryWarningFraction, fraction)
+}
+
 // GetParams returns the paramset for the provider module
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return types.NewParams(
@@ -88,9 +249,91 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.GetSlashMeterReplenishPeriod(ctx),
 		k.GetSlashMeterReplenishFraction(ctx),
 		k.GetMaxThrottledPackets(ctx),
+		k.GetConsumerRedistributeFraction(ctx),
+		k.GetMaxSlashRetries(ctx),
+		k.GetClientExpirationGracePeriod(ctx),
+		k.GetHistoricalValsetEntries(ctx),
+		k.GetSoftOptOutThreshold(ctx),
+		k.GetConsumerRewardsWindowPeriod(ctx),
+		k.GetSlashFractionDoubleSign(ctx),
+		k.GetSlashFractionDowntime(ctx),
+		k.GetMaxUnbondingOpsPerChain(ctx),
+		k.GetLogValsetUpdateDiffs(ctx),
+		k.GetSlashAckBatchPeriod(ctx),
+		k.GetConsumerLivenessWindow(ctx),
+		k.GetAllowedConsumerClientTypes(ctx),
+		k.GetClientExpiryWarningFraction(ctx),
 	)
 }
 
+// GetEffectiveConsumerParams returns the parameters that apply to the consumer chain with the given chain ID.
+// The values are taken from the consumer genesis if the consumer client was created,
+// or from the pending consumer addition proposal otherwise. Every value that is not set
+// for the consumer chain is replaced by its default value.
+// It returns false if the provider does not know of the consumer chain.
+func (k Keeper) GetEffectiveConsumerParams(ctx sdk.Context, chainID string) (types.EffectiveConsumerParams, bool) {
+	params := types.EffectiveConsumerParams{
+		ChainId:                           chainID,
+		UnbondingPeriod:                   consumertypes.DefaultConsumerUnbondingPeriod,
+		CcvTimeoutPeriod:                  ccvtypes.DefaultCCVTimeoutPeriod,
+		TransferTimeoutPeriod:             consumertypes.DefaultTransferTimeoutPeriod,
+		ConsumerRedistributionFraction:    consumertypes.DefaultConsumerRedistributeFrac,
+		BlocksPerDistributionTransmission: consumertypes.DefaultBlocksPerDistributionTransmission,
+		HistoricalEntries:                 consumertypes.DefaultHistoricalEntries,
+	}
+
+	var set consumertypes.Params
+	if gen, found := k.GetConsumerGenesis(ctx, chainID); found {
+		set = gen.Params
+		params.SendSlashConfirmations = k.GetSendSlashConfirmations(ctx, chainID)
+		params.PreferredRewardDenom, _ = k.GetPreferredRewardDenom(ctx, chainID)
+		params.SlashDoubleSigns = k.GetSlashDoubleSigns(ctx, chainID)
+	} else {
+		found := false
+		for _, prop := range k.GetAllPendingConsumerAdditionProps(ctx) {
+			if prop.ChainId != chainID {
+				continue
+			}
+			set = consumertypes.Params{
+				UnbondingPeriod:                   prop.UnbondingPeriod,
+				CcvTimeoutPeriod:                  prop.CcvTimeoutPeriod,
+				TransferTimeoutPeriod:             prop.TransferTimeoutPeriod,
+				ConsumerRedistributionFraction:    prop.ConsumerRedistributionFraction,
+				BlocksPerDistributionTransmission: prop.BlocksPerDistributionTransmission,
+				HistoricalEntries:                 prop.HistoricalEntries,
+			}
+			params.SendSlashConfirmations = prop.SendSlashConfirmations
+			params.PreferredRewardDenom = prop.PreferredRewardDenom
+			params.SlashDoubleSigns = prop.SlashDoubleSigns
+			found = true
+		}
+		if !found {
+			return params, false
+		}
+	}
+
+	if set.UnbondingPeriod != 0 {
+		params.UnbondingPeriod = set.UnbondingPeriod
+	}
+	if set.CcvTimeoutPeriod != 0 {
+		params.CcvTimeoutPeriod = set.CcvTimeoutPeriod
+	}
+	if set.TransferTimeoutPeriod != 0 {
+		params.TransferTimeoutPeriod = set.TransferTimeoutPeriod
+	}
+	if set.ConsumerRedistributionFraction != "" {
+		params.ConsumerRedistributionFraction = set.ConsumerRedistributionFraction
+	}
+	if set.BlocksPerDistributionTransmission != 0 {
+		params.BlocksPerDistributionTransmission = set.BlocksPerDistributionTransmission
+	}
+	if set.HistoricalEntries != 0 {
+		params.HistoricalEntries = set.HistoricalEntries
+	}
+
+	return params, true
+}
+
 // SetParams sets the params for the provider module
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSpace.SetParamSet(ctx, &params)
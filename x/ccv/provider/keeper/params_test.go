@@ -8,7 +8,9 @@ import (
 	commitmenttypes "github.com/cosmos/ibc-go/v4/modules/core/23-commitment/types"
 	ibctmtypes "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
 	testkeeper "github.com/cosmos/interchain-security/testutil/keeper"
+	consumertypes "github.com/cosmos/interchain-security/x/ccv/consumer/types"
 	providertypes "github.com/cosmos/interchain-security/x/ccv/provider/types"
+	ccvtypes "github.com/cosmos/interchain-security/x/ccv/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -45,8 +47,76 @@ func TestParams(t *testing.T) {
 		time.Hour,
 		"0.4",
 		100,
+		"0.5",
+		5,
+		2*time.Hour,
+		500,
+		"0.05",
+		24*time.Hour,
+		"0.1",
+		"0.01",
+		1000,
+		true,
+		time.Minute,
+		2*time.Hour,
+		[]string{"07-tendermint", "99-mock"},
+		"0.25",
 	)
 	providerKeeper.SetParams(ctx, newParams)
 	params = providerKeeper.GetParams(ctx)
 	require.Equal(t, newParams, params)
 }
+
+// TestGetEffectiveConsumerParams tests that the parameters that apply to a consumer chain
+// are the values set for the chain, or the default values if none were set
+func TestGetEffectiveConsumerParams(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// unknown consumer chain
+	_, found := providerKeeper.GetEffectiveConsumerParams(ctx, "unknown")
+	require.False(t, found)
+
+	// consumer chain with a pending consumer addition proposal that sets some of the values
+	providerKeeper.SetPendingConsumerAdditionProp(ctx, &providertypes.ConsumerAdditionProposal{
+		ChainId:                        "pending",
+		SpawnTime:                      time.Now().UTC(),
+		UnbondingPeriod:                10 * time.Hour,
+		ConsumerRedistributionFraction: "0.5",
+		SendSlashConfirmations:         true,
+	})
+	params, found := providerKeeper.GetEffectiveConsumerParams(ctx, "pending")
+	require.True(t, found)
+	require.Equal(t, providertypes.EffectiveConsumerParams{
+		ChainId:                           "pending",
+		UnbondingPeriod:                   10 * time.Hour,
+		CcvTimeoutPeriod:                  ccvtypes.DefaultCCVTimeoutPeriod,
+		TransferTimeoutPeriod:             consumertypes.DefaultTransferTimeoutPeriod,
+		ConsumerRedistributionFraction:    "0.5",
+		BlocksPerDistributionTransmission: consumertypes.DefaultBlocksPerDistributionTransmission,
+		HistoricalEntries:                 consumertypes.DefaultHistoricalEntries,
+		SendSlashConfirmations:            true,
+	}, params)
+
+	// consumer chain whose consumer client was created
+	gen := consumertypes.GenesisState{}
+	gen.Params.CcvTimeoutPeriod = 2 * time.Hour
+	gen.Params.TransferTimeoutPeriod = 3 * time.Hour
+	gen.Params.HistoricalEntries = 50
+	require.NoError(t, providerKeeper.SetConsumerGenesis(ctx, "launched", gen))
+	providerKeeper.SetPreferredRewardDenom(ctx, "launched", "ufoo")
+	providerKeeper.SetSlashDoubleSigns(ctx, "launched", true)
+	params, found = providerKeeper.GetEffectiveConsumerParams(ctx, "launched")
+	require.True(t, found)
+	require.Equal(t, providertypes.EffectiveConsumerParams{
+		ChainId:                           "launched",
+		UnbondingPeriod:                   consumertypes.DefaultConsumerUnbondingPeriod,
+		CcvTimeoutPeriod:                  2 * time.Hour,
+		TransferTimeoutPeriod:             3 * time.Hour,
+		ConsumerRedistributionFraction:    consumertypes.DefaultConsumerRedistributeFrac,
+		BlocksPerDistributionTransmission: consumertypes.DefaultBlocksPerDistributionTransmission,
+		HistoricalEntries:                 50,
+		PreferredRewardDenom:              "ufoo",
+		SlashDoubleSigns:                  true,
+	}, params)
+}
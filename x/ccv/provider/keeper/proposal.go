@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
@@ -29,6 +30,17 @@ import (
 // Spec tag: [CCV-PCF-HCAPROP.1]
 func (k Keeper) HandleConsumerAdditionProposal(ctx sdk.Context, p *types.ConsumerAdditionProposal) error {
 
+	// verify that the proposal does not add the provider chain as a consumer of itself
+	if p.ChainId == ctx.ChainID() {
+		return sdkerrors.Wrapf(types.ErrConsumerIsProviderChainId,
+			"cannot add the provider chain %s as a consumer chain", p.ChainId)
+	}
+
+	// verify that the chain ID is not used by another consumer chain or pending proposal
+	if err := k.ValidateConsumerChainIdUnique(ctx, p.ChainId); err != nil {
+		return err
+	}
+
 	// verify the consumer addition proposal execution
 	// in cached context and discard the cached writes
 	if _, _, err := k.CreateConsumerClientInCachedCtx(ctx, *p); err != nil {
@@ -37,6 +49,16 @@ func (k Keeper) HandleConsumerAdditionProposal(ctx sdk.Context, p *types.Consume
 
 	k.SetPendingConsumerAdditionProp(ctx, p)
 
+	// Note that a proposal whose spawn time has already elapsed
+	// is executed in the BeginBlockInit of the next block.
+	if !ctx.BlockTime().Before(p.SpawnTime) {
+		k.Logger(ctx).Info("spawn time of consumer addition proposal already elapsed, consumer chain will be spawned in the next block",
+			"chainID", p.ChainId,
+			"spawn time", p.SpawnTime.UTC(),
+			"block time", ctx.BlockTime().UTC(),
+		)
+	}
+
 	k.Logger(ctx).Info("consumer addition proposal enqueued",
 		"chainID", p.ChainId,
 		"title", p.Title,
@@ -46,6 +68,50 @@ func (k Keeper) HandleConsumerAdditionProposal(ctx sdk.Context, p *types.Consume
 	return nil
 }
 
+// ValidateConsumerChainIdUnique returns an error if the given chain ID is already used
+// by a registered consumer chain, i.e., if a client, a genesis or a CCV channel is stored
+// for the chain ID, by a pending consumer addition proposal, or by a consumer chain stopped
+// after a packet timeout whose unbonding operations are still held.
+// Note that the state of a consumer chain is removed once the chain is stopped,
+// thus the chain ID of a stopped consumer chain can be used again once no unbonding
+// operation is held for it anymore, see HandleForceCompleteUnbondingProposal.
+func (k Keeper) ValidateConsumerChainIdUnique(ctx sdk.Context, chainID string) error {
+	if clientID, found := k.GetConsumerClientId(ctx, chainID); found {
+		return sdkerrors.Wrapf(ccv.ErrDuplicateConsumerChain,
+			"consumer chain %s is already registered with client %s", chainID, clientID)
+	}
+	if _, found := k.GetConsumerGenesis(ctx, chainID); found {
+		return sdkerrors.Wrapf(ccv.ErrDuplicateConsumerChain,
+			"a consumer genesis is already stored for consumer chain %s", chainID)
+	}
+	if channelID, found := k.GetChainToChannel(ctx, chainID); found {
+		return sdkerrors.Wrapf(ccv.ErrDuplicateConsumerChain,
+			"consumer chain %s is already mapped to channel %s", chainID, channelID)
+	}
+	if err := k.validateNoHeldUnbondingOps(ctx, chainID); err != nil {
+		return err
+	}
+	for _, prop := range k.GetAllPendingConsumerAdditionProps(ctx) {
+		if prop.ChainId == chainID {
+			return sdkerrors.Wrapf(ccv.ErrDuplicateConsumerChain,
+				"a pending consumer addition proposal for consumer chain %s with spawn time %s already exists",
+				chainID, prop.SpawnTime.UTC())
+		}
+	}
+	return nil
+}
+
+// validateNoHeldUnbondingOps returns an error if unbonding operations are held for a consumer chain
+// with the given chain ID that was stopped after a packet timeout. A new consumer chain with the same
+// chain ID would otherwise take over the held unbonding operations and release them.
+func (k Keeper) validateNoHeldUnbondingOps(ctx sdk.Context, chainID string) error {
+	if indexes := k.GetAllUnbondingOpIndexes(ctx, chainID); len(indexes) != 0 {
+		return sdkerrors.Wrapf(ccv.ErrDuplicateConsumerChain,
+			"unbonding operations are still held for stopped consumer chain %s", chainID)
+	}
+	return nil
+}
+
 // CreateConsumerClient will create the CCV client for the given consumer chain. The CCV channel must be built
 // on top of the CCV client to ensure connection with the right consumer chain.
 //
@@ -59,6 +125,11 @@ func (k Keeper) CreateConsumerClient(ctx sdk.Context, prop *types.ConsumerAdditi
 		return sdkerrors.Wrap(ccv.ErrDuplicateConsumerChain,
 			fmt.Sprintf("cannot create client for existent consumer chain: %s", chainID))
 	}
+	// check that no unbonding operations are held for a consumer chain with the same chain ID,
+	// e.g., if the chain timed out after the consumer addition proposal was handled
+	if err := k.validateNoHeldUnbondingOps(ctx, chainID); err != nil {
+		return err
+	}
 
 	// Consumers start out with the unbonding period from the consumer addition prop
 	consumerUnbondingPeriod := prop.UnbondingPeriod
@@ -74,6 +145,26 @@ func (k Keeper) CreateConsumerClient(ctx sdk.Context, prop *types.ConsumerAdditi
 	}
 	clientState.TrustingPeriod = trustPeriod
 	clientState.UnbondingPeriod = consumerUnbondingPeriod
+	// reject unsafe trust parameters before anything is stored for the consumer chain
+	if err := types.ValidateConsumerClientState(clientState); err != nil {
+		return err
+	}
+
+	// the validator lists and the top N are set before the genesis is made, since they filter the initial validator set
+	allowlist, err := types.ParseValidatorList(prop.ValidatorAllowlist)
+	if err != nil {
+		return err
+	}
+	denylist, err := types.ParseValidatorList(prop.ValidatorDenylist)
+	if err != nil {
+		return err
+	}
+	k.SetValidatorAllowlist(ctx, chainID, allowlist)
+	k.SetValidatorDenylist(ctx, chainID, denylist)
+	k.SetTopN(ctx, chainID, prop.TopN)
+	if prop.Metadata != nil {
+		k.SetConsumerMetadata(ctx, chainID, *prop.Metadata)
+	}
 
 	consumerGen, validatorSetHash, err := k.MakeConsumerGenesis(ctx, prop)
 	if err != nil {
@@ -83,6 +174,8 @@ func (k Keeper) CreateConsumerClient(ctx sdk.Context, prop *types.ConsumerAdditi
 	if err != nil {
 		return err
 	}
+	// the initial validator set is the first validator set sent to the consumer chain
+	k.SetConsumerValSet(ctx, chainID, 0, consumerGen.InitialValSet)
 
 	// Create consensus state
 	consensusState := ibctmtypes.NewConsensusState(
@@ -101,6 +194,11 @@ func (k Keeper) CreateConsumerClient(ctx sdk.Context, prop *types.ConsumerAdditi
 	ts := ctx.BlockTime().Add(k.GetParams(ctx).InitTimeoutPeriod)
 	k.SetInitTimeoutTimestamp(ctx, chainID, uint64(ts.UnixNano()))
 
+	k.SetSendSlashConfirmations(ctx, chainID, prop.SendSlashConfirmations)
+	k.SetSlashDoubleSigns(ctx, chainID, prop.SlashDoubleSigns)
+	k.SetPreferredRewardDenom(ctx, chainID, prop.PreferredRewardDenom)
+	k.SetDowntimeJailDuration(ctx, chainID, prop.DowntimeJailDuration)
+
 	k.Logger(ctx).Info("consumer chain registered (client created)",
 		"chainID", chainID,
 		"clientID", clientID,
@@ -119,6 +217,8 @@ func (k Keeper) CreateConsumerClient(ctx sdk.Context, prop *types.ConsumerAdditi
 		),
 	)
 
+	k.AfterConsumerChainAdded(ctx, chainID)
+
 	return nil
 }
 
@@ -153,38 +253,88 @@ func (k Keeper) HandleConsumerRemovalProposal(ctx sdk.Context, p *types.Consumer
 // See: https://github.com/cosmos/ibc/blob/main/spec/app/ics-028-cross-chain-validation/methods.md#ccv-pcf-stcc1
 // Spec tag: [CCV-PCF-STCC.1]
 func (k Keeper) StopConsumerChain(ctx sdk.Context, chainID string, closeChan bool) (err error) {
+	return k.stopConsumerChain(ctx, chainID, closeChan, true)
+}
+
+// StopTimedOutConsumerChain cleans up the states for the given consumer chain ID, like StopConsumerChain,
+// but keeps the unbonding operations waiting on the consumer chain, together with its unbonding op indexes.
+// Since the consumer chain can no longer mature them, they are held until a ForceCompleteUnbondingProposal
+// releases them, which gives governance the time to act on the infractions the consumer chain could
+// not report anymore.
+func (k Keeper) StopTimedOutConsumerChain(ctx sdk.Context, chainID string, closeChan bool) error {
+	return k.stopConsumerChain(ctx, chainID, closeChan, false)
+}
+
+// stopConsumerChain cleans up the states for the given consumer chain ID and,
+// if releaseUnbondings is true, completes the outstanding unbonding operations on the consumer chain
+func (k Keeper) stopConsumerChain(ctx sdk.Context, chainID string, closeChan, releaseUnbondings bool) error {
 	// check that a client for chainID exists
 	if _, found := k.GetConsumerClientId(ctx, chainID); !found {
 		return sdkerrors.Wrap(ccv.ErrConsumerChainNotFound,
 			fmt.Sprintf("cannot stop non-existent consumer chain: %s", chainID))
 	}
 
-	// clean up states
+	// close the CCV channel before its mappings are deleted
+	if channelID, found := k.GetChainToChannel(ctx, chainID); found && closeChan {
+		// Close the channel for the given channel ID on the condition
+		// that the channel exists and isn't already in the CLOSED state
+		channel, found := k.channelKeeper.GetChannel(ctx, k.GetPort(ctx), channelID)
+		if found && channel.State != channeltypes.CLOSED {
+			err := k.chanCloseInit(ctx, channelID)
+			if err != nil {
+				k.Logger(ctx).Error("channel to consumer chain could not be closed",
+					"chainID", chainID,
+					"channelID", channelID,
+					"error", err.Error(),
+				)
+			}
+		}
+	}
+
+	k.deleteConsumerChainState(ctx, chainID, releaseUnbondings)
+
+	k.Logger(ctx).Info("consumer chain removed from provider", "chainID", chainID, "unbondings released", releaseUnbondings)
+
+	k.AfterConsumerChainRemoved(ctx, chainID)
+
+	return nil
+}
+
+// DeleteConsumerChainState deletes all the state the provider stores for the consumer chain
+// with the given chain ID, i.e., its client, genesis, channel mappings, key assignments,
+// slash and VSC packet state, rewards state and parameters. The CCV channel of the chain,
+// if any, is marked as invalidated, so that it cannot be used again as a CCV channel.
+// The rewards still allocated to the chain are distributed before its allocation is deleted.
+//
+// The consumer chain is removed from all the unbonding operations waiting on it.
+// The unbonding operations that are not waiting on any other consumer chain are deleted
+// and matured, while the ones still waiting on other consumer chains are kept.
+//
+// Note that this method does not close the CCV channel of the consumer chain.
+func (k Keeper) DeleteConsumerChainState(ctx sdk.Context, chainID string) {
+	k.deleteConsumerChainState(ctx, chainID, true)
+}
+
+// deleteConsumerChainState deletes all the state the provider stores for the consumer chain,
+// see DeleteConsumerChainState. If releaseUnbondings is false, the unbonding operations waiting
+// on the consumer chain and its unbonding op indexes are kept.
+func (k Keeper) deleteConsumerChainState(ctx sdk.Context, chainID string, releaseUnbondings bool) {
 	k.DeleteConsumerClientId(ctx, chainID)
 	k.DeleteConsumerGenesis(ctx, chainID)
+	k.DeleteConsumerValSet(ctx, chainID)
+	k.DeleteClientInactiveTimestamp(ctx, chainID)
+	k.DeleteClientExpiryWarningTimestamp(ctx, chainID)
 	k.DeleteInitTimeoutTimestamp(ctx, chainID)
+	k.DeleteGenesisHashExempt(ctx, chainID)
 	// Note: this call panics if the key assignment state is invalid
 	k.DeleteKeyAssignments(ctx, chainID)
 
-	// close channel and delete the mappings between chain ID and channel ID
+	// delete the mappings between chain ID and channel ID
 	if channelID, found := k.GetChainToChannel(ctx, chainID); found {
-		if closeChan {
-			// Close the channel for the given channel ID on the condition
-			// that the channel exists and isn't already in the CLOSED state
-			channel, found := k.channelKeeper.GetChannel(ctx, ccv.ProviderPortID, channelID)
-			if found && channel.State != channeltypes.CLOSED {
-				err := k.chanCloseInit(ctx, channelID)
-				if err != nil {
-					k.Logger(ctx).Error("channel to consumer chain could not be closed",
-						"chainID", chainID,
-						"channelID", channelID,
-						"error", err.Error(),
-					)
-				}
-			}
-		}
 		k.DeleteChainToChannel(ctx, chainID)
 		k.DeleteChannelToChain(ctx, channelID)
+		// the channel can never be used again as a CCV channel
+		k.SetInvalidatedChannel(ctx, channelID)
 
 		// delete VSC send timestamps
 		k.DeleteVscSendTimestampsForConsumer(ctx, chainID)
@@ -193,25 +343,32 @@ func (k Keeper) StopConsumerChain(ctx sdk.Context, chainID string, closeChan boo
 	k.DeleteInitChainHeight(ctx, chainID)
 	k.DeleteSlashAcks(ctx, chainID)
 	k.DeletePendingVSCPackets(ctx, chainID)
-
-	// release unbonding operations
-	for _, unbondingOpsIndex := range k.GetAllUnbondingOpIndexes(ctx, chainID) {
-		// iterate over the unbonding operations for the current VSC ID
-		var maturedIds []uint64
-		for _, id := range unbondingOpsIndex.UnbondingOpIds {
-			// Remove consumer chain ID from unbonding op record.
-			// Note that RemoveConsumerFromUnbondingOp cannot panic here
-			// as it is expected that for all UnbondingOpIds in every
-			// VscUnbondingOps returned by GetAllUnbondingOpIndexes
-			// there is an unbonding op in store that can be retrieved
-			// via via GetUnbondingOp.
-			if k.RemoveConsumerFromUnbondingOp(ctx, id, chainID) {
-				// Store id of matured unbonding op for later completion of unbonding in staking module
-				maturedIds = append(maturedIds, id)
-			}
-		}
-		k.AppendMaturedUnbondingOps(ctx, maturedIds)
-		k.DeleteUnbondingOpIndex(ctx, chainID, unbondingOpsIndex.VscId)
+	k.SetSendSlashConfirmations(ctx, chainID, false)
+	k.SetSlashDoubleSigns(ctx, chainID, false)
+	k.SetDowntimeJailDuration(ctx, chainID, 0)
+	k.SetTopN(ctx, chainID, 0)
+	k.SetConsumerChainPaused(ctx, chainID, false)
+	k.DeleteSlashConfirmationSeq(ctx, chainID)
+	k.DeleteLastSentSequence(ctx, chainID)
+	k.DeleteLastAckedSequence(ctx, chainID)
+	k.DeleteLastConsumerActivity(ctx, chainID)
+
+	// distribute the rewards of the consumer chain that are still held in the consumer rewards pool
+	k.distributeConsumerRewards(ctx, chainID, k.GetConsumerRewardsAllocation(ctx, chainID).Rewards)
+	k.DeleteConsumerRewardsAllocation(ctx, chainID)
+	k.DeleteConsumerRewardsWindow(ctx, chainID)
+	k.DeletePreferredRewardDenom(ctx, chainID)
+	k.DeleteAllOptedIn(ctx, chainID)
+	k.DeleteAllSlashRetries(ctx, chainID)
+	k.DeleteAllFailedSlashes(ctx, chainID)
+	k.DeleteValidatorLists(ctx, chainID)
+	k.DeleteAllSoftOptedOut(ctx, chainID)
+	k.DeleteAllLastDowntimeInfractionHeights(ctx, chainID)
+	k.DeleteConsumerParameters(ctx, chainID)
+	k.DeleteConsumerMetadata(ctx, chainID)
+
+	if releaseUnbondings {
+		k.releaseUnbondingOps(ctx, chainID)
 	}
 
 	// Remove any existing throttling related entries from the global queue,
@@ -226,12 +383,35 @@ func (k Keeper) StopConsumerChain(ctx sdk.Context, chainID string, closeChan boo
 
 	// Remove all throttled slash packets and vsc matured packets queued for this consumer.
 	// Note: queued VSC matured packets can be safely removed from the per-chain queue,
-	// since all unbonding operations for this consumer are release above.
+	// since all unbonding operations for this consumer are either released above,
+	// or held until a ForceCompleteUnbondingProposal releases them.
 	k.DeleteThrottledPacketDataForConsumer(ctx, chainID)
+}
 
-	k.Logger(ctx).Info("consumer chain removed from provider", "chainID", chainID)
-
-	return nil
+// releaseUnbondingOps removes the consumer chain with the given chain ID from all the unbonding
+// operations waiting on it and deletes its unbonding op indexes. The unbonding operations that
+// are not waiting on any other consumer chain are matured and thus complete in the next EndBlock.
+// It returns the IDs of the unbonding operations the consumer chain was removed from
+// and the IDs of the matured ones.
+//
+// Note that the unbonding operations are iterated over, rather than the unbonding op indexes
+// of the consumer chain, so that no unbonding operation stays stuck on the consumer chain.
+func (k Keeper) releaseUnbondingOps(ctx sdk.Context, chainID string) (removedIds, maturedIds []uint64) {
+	for _, unbondingOp := range k.GetAllUnbondingOps(ctx) {
+		if !containsString(unbondingOp.UnbondingConsumerChains, chainID) {
+			continue
+		}
+		removedIds = append(removedIds, unbondingOp.Id)
+		if k.RemoveConsumerFromUnbondingOp(ctx, unbondingOp.Id, chainID) {
+			// Store id of matured unbonding op for later completion of unbonding in staking module
+			maturedIds = append(maturedIds, unbondingOp.Id)
+		}
+	}
+	k.AppendMaturedUnbondingOps(ctx, maturedIds)
+	for _, unbondingOpsIndex := range k.GetAllUnbondingOpIndexes(ctx, chainID) {
+		k.DeleteUnbondingOpIndex(ctx, chainID, unbondingOpsIndex.VscId)
+	}
+	return removedIds, maturedIds
 }
 
 // MakeConsumerGenesis constructs the consumer CCV module part of the genesis state.
@@ -241,6 +421,14 @@ func (k Keeper) MakeConsumerGenesis(
 ) (gen consumertypes.GenesisState, nextValidatorsHash []byte, err error) {
 	chainID := prop.ChainId
 	providerUnbondingPeriod := k.stakingKeeper.UnbondingTime(ctx)
+	// the unbonding operations complete only once every consumer chain matured them,
+	// thus a consumer unbonding period longer than the one of the provider would delay
+	// the unbonding operations beyond the provider unbonding period
+	if prop.UnbondingPeriod > providerUnbondingPeriod {
+		return gen, nil, sdkerrors.Wrapf(types.ErrInvalidConsumerAdditionProposal,
+			"consumer unbonding period %s cannot be longer than the provider unbonding period %s",
+			prop.UnbondingPeriod, providerUnbondingPeriod)
+	}
 	height := clienttypes.GetSelfHeight(ctx)
 
 	clientState := k.GetTemplateClient(ctx)
@@ -269,6 +457,7 @@ func (k Keeper) MakeConsumerGenesis(
 	})
 
 	initialUpdates := []abci.ValidatorUpdate{}
+	var providerAddrs []types.ProviderConsAddress
 	for _, p := range lastPowers {
 		addr, err := sdk.ValAddressFromBech32(p.Address)
 		if err != nil {
@@ -280,6 +469,15 @@ func (k Keeper) MakeConsumerGenesis(
 			return gen, nil, sdkerrors.Wrapf(stakingtypes.ErrNoValidatorFound, "error getting validator from LastValidatorPowers: %s", err)
 		}
 
+		consAddr, err := val.GetConsAddr()
+		if err != nil {
+			return gen, nil, err
+		}
+		// skip the validators filtered out by the validator lists of the consumer chain
+		if !k.IsValidatorAllowed(ctx, chainID, types.NewProviderConsAddress(consAddr)) {
+			continue
+		}
+
 		tmProtoPk, err := val.TmConsPublicKey()
 		if err != nil {
 			return gen, nil, err
@@ -289,6 +487,14 @@ func (k Keeper) MakeConsumerGenesis(
 			PubKey: tmProtoPk,
 			Power:  p.Power,
 		})
+		providerAddrs = append(providerAddrs, types.NewProviderConsAddress(consAddr))
+	}
+	// exclude the validators opted out of validating the consumer chain
+	initialUpdates = k.applySoftOptOut(ctx, chainID, providerAddrs, initialUpdates)
+
+	if len(initialUpdates) == 0 && len(lastPowers) != 0 {
+		return gen, nil, sdkerrors.Wrapf(types.ErrInvalidConsumerAdditionProposal,
+			"none of the bonded validators is allowed to validate consumer chain %s", chainID)
 	}
 
 	// Apply key assignments to the initial valset.
@@ -297,7 +503,8 @@ func (k Keeper) MakeConsumerGenesis(
 	// Get a hash of the consumer validator set from the update with applied consumer assigned keys
 	updatesAsValSet, err := tmtypes.PB2TM.ValidatorUpdates(initialUpdatesWithConsumerKeys)
 	if err != nil {
-		return gen, nil, fmt.Errorf("unable to create validator set from updates computed from key assignment in MakeConsumerGenesis: %s", err)
+		return gen, nil, sdkerrors.Wrapf(types.ErrInvalidConsumerGenesis,
+			"unable to create validator set from updates computed from key assignment: %s", err)
 	}
 	hash := tmtypes.NewValidatorSet(updatesAsValSet).Hash()
 
@@ -319,6 +526,11 @@ func (k Keeper) MakeConsumerGenesis(
 		initialUpdatesWithConsumerKeys,
 		consumerGenesisParams,
 	)
+	// the consumer chain expects the port the provider is bound to as the counterparty port,
+	// which is only set in the consumer genesis if it is not the default provider port
+	if portID := k.GetPort(ctx); portID != ccv.ProviderPortID {
+		gen.ProviderPortId = portID
+	}
 	return gen, hash, nil
 }
 
@@ -607,9 +819,278 @@ func (k Keeper) StopConsumerChainInCachedCtx(ctx sdk.Context, p types.ConsumerRe
 func (k Keeper) HandleEquivocationProposal(ctx sdk.Context, p *types.EquivocationProposal) error {
 	for _, ev := range p.Equivocations {
 		if !k.GetSlashLog(ctx, types.NewProviderConsAddress(ev.GetConsensusAddress())) {
-			return fmt.Errorf("no equivocation record found for validator %s", ev.GetConsensusAddress().String())
+			return sdkerrors.Wrapf(types.ErrNoEquivocationRecord, "%s", ev.GetConsensusAddress().String())
 		}
 		k.evidenceKeeper.HandleEquivocationEvidence(ctx, ev)
 	}
 	return nil
 }
+
+// HandleConsumerValidatorListsProposal handles a consumer validator lists proposal.
+// The validator allowlist and denylist of the consumer chain are replaced by the lists in the proposal,
+// and the validator set of the consumer chain is reconciled with the new lists in the next VSC packet.
+func (k Keeper) HandleConsumerValidatorListsProposal(ctx sdk.Context, p *types.ConsumerValidatorListsProposal) error {
+	if _, found := k.GetConsumerClientId(ctx, p.ChainId); !found {
+		return sdkerrors.Wrapf(types.ErrUnknownConsumerChainId, "cannot update the validator lists of unknown consumer chain %s", p.ChainId)
+	}
+
+	allowlist, err := types.ParseValidatorList(p.ValidatorAllowlist)
+	if err != nil {
+		return err
+	}
+	denylist, err := types.ParseValidatorList(p.ValidatorDenylist)
+	if err != nil {
+		return err
+	}
+
+	k.SetValidatorAllowlist(ctx, p.ChainId, allowlist)
+	k.SetValidatorDenylist(ctx, p.ChainId, denylist)
+	k.SetValidatorListsUpdated(ctx, p.ChainId)
+
+	k.Logger(ctx).Info("consumer chain validator lists updated",
+		"chainID", p.ChainId,
+		"len allowlist", len(allowlist),
+		"len denylist", len(denylist),
+	)
+	return nil
+}
+
+// HandleConsumerParametersUpdateProposal handles a consumer parameters update proposal.
+// The parameters set in the proposal replace the ones of the running consumer chain, while
+// the parameters left empty keep their current values. The soft opt out threshold is applied
+// from the next VSC packet.
+func (k Keeper) HandleConsumerParametersUpdateProposal(ctx sdk.Context, p *types.ConsumerParametersUpdateProposal) error {
+	if err := p.ConsumerParameters().Validate(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidConsumerParametersUpdateProp, err.Error())
+	}
+	return k.updateConsumerParameters(ctx, p.ChainId, p.ConsumerParameters())
+}
+
+// HandleChangeConsumerRewardFractionProposal handles a change consumer reward fraction proposal.
+// Only the consumer redistribute fraction of the running consumer chain is replaced, the other
+// consumer parameters keep their current values. The new fraction is applied from the next
+// rewards distribution, see BeginBlockRD.
+func (k Keeper) HandleChangeConsumerRewardFractionProposal(ctx sdk.Context, p *types.ChangeConsumerRewardFractionProposal) error {
+	if err := ccv.ValidateStringFraction(p.ConsumerRedistributeFraction); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidChangeRewardFractionProp, "consumer redistribute fraction is invalid: %s", err)
+	}
+	return k.updateConsumerParameters(ctx, p.ChainId, types.ConsumerParameters{
+		ConsumerRedistributeFraction: p.ConsumerRedistributeFraction,
+	})
+}
+
+// updateConsumerParameters updates the parameters of the running consumer chain with the given chain ID
+// with the non-empty fields of the given parameters. The empty fields of the stored parameters keep
+// defaulting to the provider params.
+func (k Keeper) updateConsumerParameters(ctx sdk.Context, chainID string, update types.ConsumerParameters) error {
+	if _, found := k.GetChainToChannel(ctx, chainID); !found {
+		return sdkerrors.Wrapf(types.ErrUnknownConsumerChainId, "cannot update the parameters of unknown consumer chain %s", chainID)
+	}
+
+	prevRedistributeFraction := k.GetConsumerChainRedistributeFraction(ctx, chainID)
+	prevSoftOptOutThreshold := k.GetConsumerChainSoftOptOutThreshold(ctx, chainID)
+
+	params, _ := k.GetConsumerParameters(ctx, chainID)
+	if update.ConsumerRedistributeFraction != "" {
+		params.ConsumerRedistributeFraction = update.ConsumerRedistributeFraction
+	}
+	if update.SoftOptOutThreshold != "" {
+		params.SoftOptOutThreshold = update.SoftOptOutThreshold
+	}
+	if !update.ExpectedRewardsPerWindow.Empty() {
+		params.ExpectedRewardsPerWindow = update.ExpectedRewardsPerWindow
+	}
+	k.SetConsumerParameters(ctx, chainID, params)
+
+	redistributeFraction := k.GetConsumerChainRedistributeFraction(ctx, chainID)
+	softOptOutThreshold := k.GetConsumerChainSoftOptOutThreshold(ctx, chainID)
+	if softOptOutThreshold != prevSoftOptOutThreshold {
+		// the validators opted out of validating the consumer chain are recomputed in the next block
+		k.SetValidatorListsUpdated(ctx, chainID)
+	}
+	expectedRewards := k.GetConsumerChainExpectedRewards(ctx, chainID)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			ccv.EventTypeUpdateConsumerParameters,
+			sdk.NewAttribute(ccv.AttributeChainID, chainID),
+			sdk.NewAttribute(ccv.AttributePrevConsumerRedistributeFraction, prevRedistributeFraction),
+			sdk.NewAttribute(ccv.AttributeConsumerRedistributeFraction, redistributeFraction),
+			sdk.NewAttribute(ccv.AttributePrevSoftOptOutThreshold, prevSoftOptOutThreshold),
+			sdk.NewAttribute(ccv.AttributeSoftOptOutThreshold, softOptOutThreshold),
+			sdk.NewAttribute(ccv.AttributeExpectedRewardsPerWindow, expectedRewards.String()),
+		),
+	)
+
+	k.Logger(ctx).Info("consumer chain parameters updated",
+		"chainID", chainID,
+		"consumer redistribute fraction", redistributeFraction,
+		"soft opt out threshold", softOptOutThreshold,
+		"expected rewards per window", expectedRewards.String(),
+	)
+	return nil
+}
+
+// HandleConsumerMetadataUpdateProposal handles a consumer metadata update proposal.
+// The metadata of the consumer chain is replaced by the one in the proposal.
+func (k Keeper) HandleConsumerMetadataUpdateProposal(ctx sdk.Context, p *types.ConsumerMetadataUpdateProposal) error {
+	if _, found := k.GetConsumerClientId(ctx, p.ChainId); !found {
+		return sdkerrors.Wrapf(types.ErrUnknownConsumerChainId, "cannot update the metadata of unknown consumer chain %s", p.ChainId)
+	}
+
+	if err := p.Metadata.Validate(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidConsumerMetadataUpdateProp, err.Error())
+	}
+
+	k.SetConsumerMetadata(ctx, p.ChainId, p.Metadata)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			ccv.EventTypeUpdateConsumerMetadata,
+			sdk.NewAttribute(ccv.AttributeChainID, p.ChainId),
+		),
+	)
+
+	k.Logger(ctx).Info("consumer chain metadata updated", "chainID", p.ChainId, "name", p.Metadata.Name)
+	return nil
+}
+
+// HandleForceCompleteUnbondingProposal handles a force complete unbonding proposal.
+// The consumer chain is removed from all the unbonding operations waiting on it and its
+// unbonding op indexes are deleted, see releaseUnbondingOps. The unbonding operations
+// that are not waiting on any other consumer chain complete in the next EndBlock.
+//
+// Note that the rest of the state of the consumer chain is kept, as the consumer chain keeps running.
+// The proposal also releases the unbonding operations held for a consumer chain that was stopped
+// after a packet timeout, see StopTimedOutConsumerChain.
+func (k Keeper) HandleForceCompleteUnbondingProposal(ctx sdk.Context, p *types.ForceCompleteUnbondingProposal) error {
+	removedIds, maturedIds := k.releaseUnbondingOps(ctx, p.ChainId)
+	if len(removedIds) == 0 {
+		return sdkerrors.Wrapf(types.ErrInvalidForceCompleteUnbondingProp,
+			"no unbonding operation is waiting on consumer chain %s", p.ChainId)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			ccv.EventTypeForceCompleteUnbonding,
+			sdk.NewAttribute(ccv.AttributeChainID, p.ChainId),
+			sdk.NewAttribute(ccv.AttributeUnbondingOpIDs, fmt.Sprint(removedIds)),
+			sdk.NewAttribute(ccv.AttributeCompletedUnbondingOpIDs, fmt.Sprint(maturedIds)),
+		),
+	)
+
+	k.Logger(ctx).Info("unbonding operations no longer wait on consumer chain",
+		"chainID", p.ChainId,
+		"unbonding ops", len(removedIds),
+		"matured unbonding ops", len(maturedIds),
+	)
+	return nil
+}
+
+// HandleConsumerAdditionCancellationProposal handles a consumer addition cancellation proposal.
+// The pending consumer addition proposal of the consumer chain is deleted, together with any
+// consumer genesis stored for the chain, so that the consumer chain is not spawned.
+//
+// Note that a consumer chain that was already spawned, i.e., whose consumer client was created,
+// cannot be cancelled; it must be stopped through a consumer removal proposal instead.
+func (k Keeper) HandleConsumerAdditionCancellationProposal(ctx sdk.Context, p *types.ConsumerAdditionCancellationProposal) error {
+	if _, found := k.GetConsumerClientId(ctx, p.ChainId); found {
+		return sdkerrors.Wrapf(types.ErrInvalidAdditionCancellationProp,
+			"consumer chain %s was already spawned", p.ChainId)
+	}
+	if channelID, found := k.GetChainToChannel(ctx, p.ChainId); found {
+		return sdkerrors.Wrapf(types.ErrInvalidAdditionCancellationProp,
+			"consumer chain %s is already mapped to channel %s", p.ChainId, channelID)
+	}
+
+	var cancelled []types.ConsumerAdditionProposal
+	for _, prop := range k.GetAllPendingConsumerAdditionProps(ctx) {
+		if prop.ChainId == p.ChainId {
+			cancelled = append(cancelled, prop)
+		}
+	}
+	if len(cancelled) == 0 {
+		return sdkerrors.Wrapf(types.ErrInvalidAdditionCancellationProp,
+			"no pending consumer addition proposal for consumer chain %s", p.ChainId)
+	}
+	k.DeletePendingConsumerAdditionProps(ctx, cancelled...)
+	k.DeleteConsumerGenesis(ctx, p.ChainId)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			ccv.EventTypeCancelConsumerAddition,
+			sdk.NewAttribute(ccv.AttributeChainID, p.ChainId),
+		),
+	)
+
+	k.Logger(ctx).Info("consumer addition proposal cancelled",
+		"chainID", p.ChainId,
+		"spawn time", cancelled[0].SpawnTime.UTC(),
+	)
+	return nil
+}
+
+// HandleChangeRewardDenomsProposal handles a change reward denoms proposal.
+// The denoms to add are registered as consumer reward denoms and the denoms to remove are unregistered.
+// The proposal fails if a denom to add is already registered or if a denom to remove is not registered.
+//
+// Note that unregistering a denom does not affect the rewards of this denom
+// that were already added to the rewards allocation of the consumer chains.
+func (k Keeper) HandleChangeRewardDenomsProposal(ctx sdk.Context, p *types.ChangeRewardDenomsProposal) error {
+	for _, denom := range p.DenomsToAdd {
+		if k.IsConsumerRewardDenomRegistered(ctx, denom) {
+			return sdkerrors.Wrapf(types.ErrInvalidChangeRewardDenomsProp,
+				"denom %s is already registered as a consumer reward denom", denom)
+		}
+	}
+	for _, denom := range p.DenomsToRemove {
+		if !k.IsConsumerRewardDenomRegistered(ctx, denom) {
+			return sdkerrors.Wrapf(types.ErrInvalidChangeRewardDenomsProp,
+				"denom %s is not registered as a consumer reward denom", denom)
+		}
+	}
+
+	for _, denom := range p.DenomsToAdd {
+		k.SetConsumerRewardDenom(ctx, denom)
+	}
+	for _, denom := range p.DenomsToRemove {
+		k.DeleteConsumerRewardDenom(ctx, denom)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			ccv.EventTypeChangeRewardDenoms,
+			sdk.NewAttribute(ccv.AttributeDenomsToAdd, strings.Join(p.DenomsToAdd, ",")),
+			sdk.NewAttribute(ccv.AttributeDenomsToRemove, strings.Join(p.DenomsToRemove, ",")),
+		),
+	)
+
+	k.Logger(ctx).Info("consumer reward denoms changed",
+		"added", strings.Join(p.DenomsToAdd, ","),
+		"removed", strings.Join(p.DenomsToRemove, ","),
+	)
+	return nil
+}
+
+// HandleConsumerPauseProposal handles a consumer pause proposal.
+// The consumer chain is either paused or resumed, see PauseConsumerChain and ResumeConsumerChain.
+func (k Keeper) HandleConsumerPauseProposal(ctx sdk.Context, p *types.ConsumerPauseProposal) error {
+	eventType := ccv.EventTypeConsumerResumed
+	if p.Pause {
+		if err := k.PauseConsumerChain(ctx, p.ChainId); err != nil {
+			return err
+		}
+		eventType = ccv.EventTypeConsumerPaused
+	} else if err := k.ResumeConsumerChain(ctx, p.ChainId); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(ccv.AttributeChainID, p.ChainId),
+		),
+	)
+
+	k.Logger(ctx).Info("consumer chain pause changed", "chainID", p.ChainId, "paused", p.Pause)
+	return nil
+}
@@ -9,7 +9,9 @@ import (
 
 	_go "github.com/confio/ics23/go"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	ibctmtypes "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
 	"github.com/golang/mock/gomock"
@@ -70,6 +72,48 @@ func TestHandleConsumerAdditionProposal(t *testing.T) {
 			blockTime:     now,
 			expAppendProp: true,
 		},
+		{
+			description: "expect to append valid proposal with spawn time in the past",
+			malleate:    func(ctx sdk.Context, k providerkeeper.Keeper, chainID string) {},
+			prop: providertypes.NewConsumerAdditionProposal(
+				"title",
+				"description",
+				"chainID",
+				clienttypes.NewHeight(2, 3),
+				[]byte("gen_hash"),
+				[]byte("bin_hash"),
+				now.Add(-time.Hour), // Spawn time
+				"0.75",
+				10,
+				10000,
+				100000000000,
+				100000000000,
+				100000000000,
+			).(*providertypes.ConsumerAdditionProposal),
+			blockTime:     now,
+			expAppendProp: true,
+		},
+		{
+			description: "expect to append valid proposal with spawn time in the future",
+			malleate:    func(ctx sdk.Context, k providerkeeper.Keeper, chainID string) {},
+			prop: providertypes.NewConsumerAdditionProposal(
+				"title",
+				"description",
+				"chainID",
+				clienttypes.NewHeight(2, 3),
+				[]byte("gen_hash"),
+				[]byte("bin_hash"),
+				now.Add(time.Hour), // Spawn time
+				"0.75",
+				10,
+				10000,
+				100000000000,
+				100000000000,
+				100000000000,
+			).(*providertypes.ConsumerAdditionProposal),
+			blockTime:     now,
+			expAppendProp: true,
+		},
 		{
 			description: "expect to not append invalid proposal using an already existing chain id",
 			malleate: func(ctx sdk.Context, k providerkeeper.Keeper, chainID string) {
@@ -94,6 +138,77 @@ func TestHandleConsumerAdditionProposal(t *testing.T) {
 			blockTime:     now,
 			expAppendProp: false,
 		},
+		{
+			description: "expect to not append invalid proposal using the chain id of a pending proposal",
+			malleate: func(ctx sdk.Context, k providerkeeper.Keeper, chainID string) {
+				k.SetPendingConsumerAdditionProp(ctx, &providertypes.ConsumerAdditionProposal{
+					ChainId: chainID, SpawnTime: now.Add(time.Hour),
+				})
+			},
+			prop: providertypes.NewConsumerAdditionProposal(
+				"title",
+				"description",
+				"chainID",
+				clienttypes.NewHeight(2, 3),
+				[]byte("gen_hash"),
+				[]byte("bin_hash"),
+				now,
+				"0.75",
+				10,
+				10000,
+				100000000000,
+				100000000000,
+				100000000000,
+			).(*providertypes.ConsumerAdditionProposal),
+			blockTime:     now,
+			expAppendProp: false,
+		},
+		{
+			description: "expect to not append invalid proposal using the chain id of a stored consumer genesis",
+			malleate: func(ctx sdk.Context, k providerkeeper.Keeper, chainID string) {
+				require.NoError(t, k.SetConsumerGenesis(ctx, chainID, *consumertypes.DefaultGenesisState()))
+			},
+			prop: providertypes.NewConsumerAdditionProposal(
+				"title",
+				"description",
+				"chainID",
+				clienttypes.NewHeight(2, 3),
+				[]byte("gen_hash"),
+				[]byte("bin_hash"),
+				now,
+				"0.75",
+				10,
+				10000,
+				100000000000,
+				100000000000,
+				100000000000,
+			).(*providertypes.ConsumerAdditionProposal),
+			blockTime:     now,
+			expAppendProp: false,
+		},
+		{
+			description: "expect to not append invalid proposal using the chain id of a CCV channel",
+			malleate: func(ctx sdk.Context, k providerkeeper.Keeper, chainID string) {
+				k.SetChainToChannel(ctx, chainID, "channelID")
+			},
+			prop: providertypes.NewConsumerAdditionProposal(
+				"title",
+				"description",
+				"chainID",
+				clienttypes.NewHeight(2, 3),
+				[]byte("gen_hash"),
+				[]byte("bin_hash"),
+				now,
+				"0.75",
+				10,
+				10000,
+				100000000000,
+				100000000000,
+				100000000000,
+			).(*providertypes.ConsumerAdditionProposal),
+			blockTime:     now,
+			expAppendProp: false,
+		},
 	}
 
 	for _, tc := range tests {
@@ -120,6 +235,13 @@ func TestHandleConsumerAdditionProposal(t *testing.T) {
 			gotProposal, found := providerKeeper.GetPendingConsumerAdditionProp(ctx, tc.prop.SpawnTime, tc.prop.ChainId)
 			require.True(t, found)
 			require.Equal(t, *tc.prop, gotProposal)
+			// check that the prop is executed in the next block iff its spawn time has elapsed
+			propsToExecute := providerKeeper.GetConsumerAdditionPropsToExecute(ctx)
+			if tc.prop.SpawnTime.After(tc.blockTime) {
+				require.Empty(t, propsToExecute)
+			} else {
+				require.Equal(t, []providertypes.ConsumerAdditionProposal{*tc.prop}, propsToExecute)
+			}
 		} else {
 			require.Error(t, err)
 			// check that prop wasn't added to the stored pending props
@@ -131,6 +253,85 @@ func TestHandleConsumerAdditionProposal(t *testing.T) {
 	}
 }
 
+// TestHandleConsumerAdditionProposalProviderChainId tests that a consumer addition proposal
+// with the chain ID of the provider chain is rejected before any client is created
+func TestHandleConsumerAdditionProposalProviderChainId(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+	ctx = ctx.WithChainID("provider")
+
+	// no client keeper call is expected, i.e., no client is created
+	prop := testkeeper.GetTestConsumerAdditionProp()
+	prop.ChainId = "provider"
+	err := providerKeeper.HandleConsumerAdditionProposal(ctx, prop)
+	require.ErrorIs(t, err, providertypes.ErrConsumerIsProviderChainId)
+
+	require.Empty(t, providerKeeper.GetAllPendingConsumerAdditionProps(ctx))
+	_, found := providerKeeper.GetConsumerClientId(ctx, "provider")
+	require.False(t, found)
+}
+
+// TestHandleConsumerAdditionProposalAfterStop tests that the chain ID of a consumer chain
+// can be proposed again once the consumer chain is stopped and its state is cleaned
+func TestHandleConsumerAdditionProposalAfterStop(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	// the consumer chain is created and its CCV channel is established
+	testkeeper.SetupForStoppingConsumerChain(t, ctx, &providerKeeper, mocks)
+	prop := testkeeper.GetTestConsumerAdditionProp()
+	require.ErrorIs(t, providerKeeper.HandleConsumerAdditionProposal(ctx, prop), ccvtypes.ErrDuplicateConsumerChain)
+
+	// the consumer chain is stopped
+	require.NoError(t, providerKeeper.StopConsumerChain(ctx, "chainID", true))
+	testProviderStateIsCleaned(t, ctx, providerKeeper, "chainID", "channelID")
+
+	// the chain ID can be proposed again
+	gomock.InOrder(testkeeper.GetMocksForCreateConsumerClient(ctx, &mocks, "chainID", clienttypes.NewHeight(4, 5))...)
+	require.NoError(t, providerKeeper.HandleConsumerAdditionProposal(ctx, prop))
+	_, found := providerKeeper.GetPendingConsumerAdditionProp(ctx, prop.SpawnTime, "chainID")
+	require.True(t, found)
+
+	// but not while the proposal is pending
+	require.ErrorIs(t, providerKeeper.HandleConsumerAdditionProposal(ctx, prop), ccvtypes.ErrDuplicateConsumerChain)
+}
+
+// TestHandleConsumerAdditionProposalAfterTimeout tests that the chain ID of a consumer chain stopped
+// after a packet timeout cannot be proposed again while unbonding operations are held for it
+func TestHandleConsumerAdditionProposalAfterTimeout(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	// the consumer chain is created, its CCV channel is established
+	// and an unbonding operation waits on it
+	testkeeper.SetupForStoppingConsumerChain(t, ctx, &providerKeeper, mocks)
+	providerKeeper.SetUnbondingOp(ctx, providertypes.UnbondingOp{Id: 1, UnbondingConsumerChains: []string{"chainID"}})
+	providerKeeper.SetUnbondingOpIndex(ctx, "chainID", 1, []uint64{1})
+
+	// the consumer chain times out, thus its unbonding operation is held
+	require.NoError(t, providerKeeper.StopTimedOutConsumerChain(ctx, "chainID", true))
+	_, found := providerKeeper.GetConsumerClientId(ctx, "chainID")
+	require.False(t, found)
+
+	// the chain ID cannot be proposed again, nor can a client be created for it
+	prop := testkeeper.GetTestConsumerAdditionProp()
+	require.ErrorIs(t, providerKeeper.HandleConsumerAdditionProposal(ctx, prop), ccvtypes.ErrDuplicateConsumerChain)
+	require.Empty(t, providerKeeper.GetAllPendingConsumerAdditionProps(ctx))
+	require.ErrorIs(t, providerKeeper.CreateConsumerClient(ctx, prop), ccvtypes.ErrDuplicateConsumerChain)
+	require.Equal(t, []string{"chainID"}, providerKeeper.GetChainsBlockingUnbonding(ctx, 1))
+
+	// once governance releases the held unbonding operation, the chain ID can be proposed again
+	require.NoError(t, providerKeeper.HandleForceCompleteUnbondingProposal(ctx,
+		&providertypes.ForceCompleteUnbondingProposal{ChainId: "chainID"}))
+	gomock.InOrder(testkeeper.GetMocksForCreateConsumerClient(ctx, &mocks, "chainID", clienttypes.NewHeight(4, 5))...)
+	require.NoError(t, providerKeeper.HandleConsumerAdditionProposal(ctx, prop))
+	_, found = providerKeeper.GetPendingConsumerAdditionProp(ctx, prop.SpawnTime, "chainID")
+	require.True(t, found)
+}
+
 // Tests the CreateConsumerClient method against the spec,
 // with more granularity than what's covered in TestHandleCreateConsumerChainProposal.
 // See: https://github.com/cosmos/ibc/blob/main/spec/app/ics-028-cross-chain-validation/methods.md#ccv-pcf-crclient1
@@ -214,6 +415,107 @@ func testCreatedConsumerClient(t *testing.T,
 	require.True(t, ok)
 }
 
+// TestCreateConsumerClientUnbondingPeriod tests that a consumer chain keeps the unbonding period
+// of its consumer addition proposal, as long as it is not longer than the provider unbonding period
+func TestCreateConsumerClientUnbondingPeriod(t *testing.T) {
+	consumerUnbondingPeriod := 20 * 24 * time.Hour
+
+	testCases := []struct {
+		name                    string
+		providerUnbondingPeriod time.Duration
+		expErr                  bool
+	}{
+		{"consumer unbonding period shorter than the provider one", 21 * 24 * time.Hour, false},
+		{"consumer unbonding period equal to the provider one", consumerUnbondingPeriod, false},
+		{"consumer unbonding period longer than the provider one", 10 * 24 * time.Hour, true},
+	}
+
+	for _, tc := range testCases {
+		providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+		providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+		prop := testkeeper.GetTestConsumerAdditionProp()
+		prop.UnbondingPeriod = consumerUnbondingPeriod
+
+		if tc.expErr {
+			mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(tc.providerUnbondingPeriod).Times(1)
+		} else {
+			gomock.InOrder(
+				append(testkeeper.GetMocksForMakeConsumerGenesis(ctx, &mocks, tc.providerUnbondingPeriod),
+					mocks.MockClientKeeper.EXPECT().CreateClient(gomock.Any(), gomock.Any(), gomock.Any()).Return("clientID", nil).Times(1),
+				)...,
+			)
+		}
+
+		err := providerKeeper.CreateConsumerClient(ctx, prop)
+		if tc.expErr {
+			require.ErrorIs(t, err, providertypes.ErrInvalidConsumerAdditionProposal, tc.name)
+			_, found := providerKeeper.GetConsumerClientId(ctx, "chainID")
+			require.False(t, found, tc.name)
+			_, found = providerKeeper.GetConsumerGenesis(ctx, "chainID")
+			require.False(t, found, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+			gen, found := providerKeeper.GetConsumerGenesis(ctx, "chainID")
+			require.True(t, found, tc.name)
+			// the consumer chain uses its own unbonding period,
+			// while its client to the provider uses the provider unbonding period
+			require.Equal(t, consumerUnbondingPeriod, gen.Params.UnbondingPeriod, tc.name)
+			require.Equal(t, tc.providerUnbondingPeriod, gen.ProviderClientState.UnbondingPeriod, tc.name)
+			params, found := providerKeeper.GetEffectiveConsumerParams(ctx, "chainID")
+			require.True(t, found, tc.name)
+			require.Equal(t, consumerUnbondingPeriod, params.UnbondingPeriod, tc.name)
+		}
+
+		ctrl.Finish()
+	}
+}
+
+// TestCreateConsumerClientTrustParams tests that a consumer client is not created
+// if its trusting period is not strictly shorter than its unbonding period
+func TestCreateConsumerClientTrustParams(t *testing.T) {
+	testCases := []struct {
+		name                   string
+		trustingPeriodFraction string
+		expErr                 bool
+	}{
+		{"trusting period shorter than the unbonding period", "0.66", false},
+		{"zero trusting period", "0", true},
+		{"trusting period equal to the unbonding period", "1", true},
+	}
+
+	for _, tc := range testCases {
+		providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+		params := providertypes.DefaultParams()
+		params.TrustingPeriodFraction = tc.trustingPeriodFraction
+		providerKeeper.SetParams(ctx, params)
+
+		if tc.expErr {
+			// the client state is rejected before the consumer genesis is made
+			mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Times(0)
+			mocks.MockClientKeeper.EXPECT().CreateClient(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		} else {
+			gomock.InOrder(
+				testkeeper.GetMocksForCreateConsumerClient(ctx, &mocks, "chainID", clienttypes.NewHeight(4, 5))...,
+			)
+		}
+
+		err := providerKeeper.CreateConsumerClient(ctx, testkeeper.GetTestConsumerAdditionProp())
+		if tc.expErr {
+			require.ErrorIs(t, err, providertypes.ErrInvalidConsumerClientState, tc.name)
+			_, found := providerKeeper.GetConsumerClientId(ctx, "chainID")
+			require.False(t, found, tc.name)
+			_, found = providerKeeper.GetConsumerGenesis(ctx, "chainID")
+			require.False(t, found, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+			testCreatedConsumerClient(t, ctx, providerKeeper, "chainID", "clientID")
+		}
+
+		ctrl.Finish()
+	}
+}
+
 // TestPendingConsumerAdditionPropDeletion tests the getting/setting
 // and deletion keeper methods for pending consumer addition props
 func TestPendingConsumerAdditionPropDeletion(t *testing.T) {
@@ -504,6 +806,27 @@ func TestStopConsumerChain(t *testing.T) {
 				if err != nil {
 					t.Fatal(err)
 				}
+
+				providerKeeper.SetSoftOptedOut(ctx, "chainID", cryptoutil.NewCryptoIdentityFromIntSeed(91).ProviderConsAddress())
+				providerKeeper.SetConsumerParameters(ctx, "chainID", providertypes.ConsumerParameters{
+					ConsumerRedistributeFraction: "0.5",
+					SoftOptOutThreshold:          "0.05",
+				})
+				providerKeeper.SetConsumerMetadata(ctx, "chainID", providertypes.ConsumerMetadata{Name: "FooChain"})
+				providerKeeper.SetGenesisHashExempt(ctx, "chainID")
+			},
+			expErr: false,
+		},
+		{
+			description: "valid stop of consumer chain, remaining rewards are distributed",
+			setup: func(ctx sdk.Context, providerKeeper *providerkeeper.Keeper, mocks testkeeper.MockedKeepers) {
+
+				testkeeper.SetupForStoppingConsumerChain(t, ctx, providerKeeper, mocks)
+
+				rewards := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+				providerKeeper.AddConsumerRewardsAllocation(ctx, "chainID", rewards)
+				mocks.MockBankKeeper.EXPECT().SendCoinsFromModuleToModule(ctx,
+					providertypes.ConsumerRewardsPool, authtypes.FeeCollectorName, rewards).Return(nil).Times(1)
 			},
 			expErr: false,
 		},
@@ -573,6 +896,100 @@ func testProviderStateIsCleaned(t *testing.T, ctx sdk.Context, providerKeeper pr
 	slashPacketData, vscMaturedPacketData, _, _ := providerKeeper.GetAllThrottledPacketData(ctx, expectedChainID)
 	require.Empty(t, slashPacketData)
 	require.Empty(t, vscMaturedPacketData)
+
+	require.True(t, providerKeeper.GetConsumerRewardsAllocation(ctx, expectedChainID).Rewards.IsZero())
+	_, found = providerKeeper.GetPreferredRewardDenom(ctx, expectedChainID)
+	require.False(t, found)
+
+	require.Empty(t, providerKeeper.GetAllOptedIn(ctx, expectedChainID))
+	require.False(t, providerKeeper.GetSlashDoubleSigns(ctx, expectedChainID))
+	require.False(t, providerKeeper.IsConsumerChainPaused(ctx, expectedChainID))
+	_, found = providerKeeper.GetDowntimeJailDuration(ctx, expectedChainID)
+	require.False(t, found)
+	require.Zero(t, providerKeeper.GetTopN(ctx, expectedChainID))
+	require.Empty(t, providerKeeper.GetAllSlashRetries(ctx, &expectedChainID))
+	require.Empty(t, providerKeeper.GetAllFailedSlashes(ctx, &expectedChainID))
+	require.Empty(t, providerKeeper.GetConsumerValSet(ctx, expectedChainID))
+	_, found = providerKeeper.GetConsumerValSetUpdateId(ctx, expectedChainID)
+	require.False(t, found)
+	_, found = providerKeeper.GetClientInactiveTimestamp(ctx, expectedChainID)
+	require.False(t, found)
+	require.False(t, providerKeeper.HasValidatorLists(ctx, expectedChainID))
+	require.False(t, providerKeeper.GetValidatorListsUpdated(ctx, expectedChainID))
+	require.False(t, providerKeeper.IsGenesisHashExempt(ctx, expectedChainID))
+	require.Empty(t, providerKeeper.GetAllSoftOptedOut(ctx, expectedChainID))
+	require.Empty(t, providerKeeper.GetAllLastDowntimeInfractionHeights(ctx, expectedChainID))
+	_, found = providerKeeper.GetConsumerParameters(ctx, expectedChainID)
+	require.False(t, found)
+	_, found = providerKeeper.GetConsumerMetadata(ctx, expectedChainID)
+	require.False(t, found)
+	_, found = providerKeeper.GetConsumerRewardsWindow(ctx, expectedChainID)
+	require.False(t, found)
+	_, found = providerKeeper.GetLastSentSequence(ctx, expectedChainID)
+	require.False(t, found)
+	_, found = providerKeeper.GetLastAckedSequence(ctx, expectedChainID)
+	require.False(t, found)
+	_, found = providerKeeper.GetLastConsumerActivity(ctx, expectedChainID)
+	require.False(t, found)
+	_, found = providerKeeper.GetClientExpiryWarningTimestamp(ctx, expectedChainID)
+	require.False(t, found)
+}
+
+// TestDeleteConsumerChainState tests that all the state of a consumer chain is deleted,
+// except for the unbonding operations that are still waiting on other consumer chains
+func TestDeleteConsumerChainState(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerKeeper.SetConsumerClientId(ctx, "chainID", "clientID")
+	providerKeeper.SetChainToChannel(ctx, "chainID", "channelID")
+	providerKeeper.SetChannelToChain(ctx, "channelID", "chainID")
+	providerKeeper.SetInitChainHeight(ctx, "chainID", 10)
+	providerKeeper.AppendSlashAck(ctx, "chainID", "ack", stakingtypes.Downtime)
+	providerKeeper.SetInitTimeoutTimestamp(ctx, "chainID", 100)
+	providerKeeper.SetSlashDoubleSigns(ctx, "chainID", true)
+	providerKeeper.SetConsumerChainPaused(ctx, "chainID", true)
+	providerKeeper.SetPreferredRewardDenom(ctx, "chainID", "uatom")
+	providerKeeper.SetLastDowntimeInfractionHeight(ctx, "chainID",
+		cryptoutil.NewCryptoIdentityFromIntSeed(1).ProviderConsAddress(), 10)
+	providerKeeper.SetLastConsumerActivity(ctx, "chainID", providertypes.ConsumerActivity{Height: 10})
+	providerKeeper.SetClientExpiryWarningTimestamp(ctx, "chainID", ctx.BlockTime())
+	providerKeeper.SetTopN(ctx, "chainID", 2)
+	require.NoError(t, providerKeeper.SetConsumerGenesis(ctx, "chainID", *consumertypes.DefaultGenesisState()))
+	// the other consumer chain is left untouched
+	providerKeeper.SetConsumerClientId(ctx, "chain-2", "clientID-2")
+	providerKeeper.SetChainToChannel(ctx, "chain-2", "channelID-2")
+
+	// unbonding op 1 waits only on chainID, while unbonding op 2 also waits on chain-2
+	providerKeeper.SetUnbondingOp(ctx, providertypes.UnbondingOp{Id: 1, UnbondingConsumerChains: []string{"chainID"}})
+	providerKeeper.SetUnbondingOp(ctx, providertypes.UnbondingOp{Id: 2, UnbondingConsumerChains: []string{"chainID", "chain-2"}})
+	providerKeeper.SetUnbondingOpIndex(ctx, "chainID", 1, []uint64{1, 2})
+	providerKeeper.SetUnbondingOpIndex(ctx, "chain-2", 1, []uint64{2})
+
+	providerKeeper.DeleteConsumerChainState(ctx, "chainID")
+
+	testProviderStateIsCleaned(t, ctx, providerKeeper, "chainID", "channelID")
+	_, found := providerKeeper.GetConsumerGenesis(ctx, "chainID")
+	require.False(t, found)
+	require.True(t, providerKeeper.IsChannelInvalidated(ctx, "channelID"))
+
+	// the unbonding op waiting only on chainID is deleted and matured
+	_, found = providerKeeper.GetUnbondingOp(ctx, 1)
+	require.False(t, found)
+	require.Equal(t, []uint64{1}, providerKeeper.GetMaturedUnbondingOps(ctx))
+	require.Empty(t, providerKeeper.GetAllUnbondingOpIndexes(ctx, "chainID"))
+	// the unbonding op also waiting on chain-2 is kept, together with the index of chain-2
+	op, found := providerKeeper.GetUnbondingOp(ctx, 2)
+	require.True(t, found)
+	require.Equal(t, []string{"chain-2"}, op.UnbondingConsumerChains)
+	ids, found := providerKeeper.GetUnbondingOpIndex(ctx, "chain-2", 1)
+	require.True(t, found)
+	require.Equal(t, []uint64{2}, ids)
+
+	_, found = providerKeeper.GetConsumerClientId(ctx, "chain-2")
+	require.True(t, found)
+	_, found = providerKeeper.GetChainToChannel(ctx, "chain-2")
+	require.True(t, found)
 }
 
 // TestPendingConsumerRemovalPropDeletion tests the getting/setting
@@ -781,13 +1198,27 @@ func TestMakeConsumerGenesis(t *testing.T) {
 		},
 		// Note these are unused provider parameters for this test, and not actually asserted against
 		// They must be populated with reasonable values to satisfy SetParams though.
-		TrustingPeriodFraction:      providertypes.DefaultTrustingPeriodFraction,
-		CcvTimeoutPeriod:            ccvtypes.DefaultCCVTimeoutPeriod,
-		InitTimeoutPeriod:           providertypes.DefaultInitTimeoutPeriod,
-		VscTimeoutPeriod:            providertypes.DefaultVscTimeoutPeriod,
-		SlashMeterReplenishPeriod:   providertypes.DefaultSlashMeterReplenishPeriod,
-		SlashMeterReplenishFraction: providertypes.DefaultSlashMeterReplenishFraction,
-		MaxThrottledPackets:         providertypes.DefaultMaxThrottledPackets,
+		TrustingPeriodFraction:       providertypes.DefaultTrustingPeriodFraction,
+		CcvTimeoutPeriod:             ccvtypes.DefaultCCVTimeoutPeriod,
+		InitTimeoutPeriod:            providertypes.DefaultInitTimeoutPeriod,
+		VscTimeoutPeriod:             providertypes.DefaultVscTimeoutPeriod,
+		SlashMeterReplenishPeriod:    providertypes.DefaultSlashMeterReplenishPeriod,
+		SlashMeterReplenishFraction:  providertypes.DefaultSlashMeterReplenishFraction,
+		MaxThrottledPackets:          providertypes.DefaultMaxThrottledPackets,
+		ConsumerRedistributeFraction: providertypes.DefaultConsumerRedistributeFraction,
+		MaxSlashRetries:              providertypes.DefaultMaxSlashRetries,
+		ClientExpirationGracePeriod:  providertypes.DefaultClientExpirationGracePeriod,
+		HistoricalValsetEntries:      providertypes.DefaultHistoricalValsetEntries,
+		SoftOptOutThreshold:          providertypes.DefaultSoftOptOutThreshold,
+		ConsumerRewardsWindowPeriod:  providertypes.DefaultConsumerRewardsWindowPeriod,
+		SlashFractionDoubleSign:      providertypes.DefaultSlashFractionDoubleSign,
+		SlashFractionDowntime:        providertypes.DefaultSlashFractionDowntime,
+		MaxUnbondingOpsPerChain:      providertypes.DefaultMaxUnbondingOpsPerChain,
+		LogValsetUpdateDiffs:         providertypes.DefaultLogValsetUpdateDiffs,
+		SlashAckBatchPeriod:          providertypes.DefaultSlashAckBatchPeriod,
+		ConsumerLivenessWindow:       providertypes.DefaultConsumerLivenessWindow,
+		AllowedConsumerClientTypes:   providertypes.DefaultAllowedConsumerClientTypes,
+		ClientExpiryWarningFraction:  providertypes.DefaultClientExpiryWarningFraction,
 	}
 	providerKeeper.SetParams(ctx, moduleParams)
 	defer ctrl.Finish()
@@ -917,6 +1348,44 @@ func TestBeginBlockInit(t *testing.T) {
 	require.False(t, found)
 }
 
+// TestBeginBlockInitSameSpawnTime tests that consumer addition proposals of distinct chains
+// with the same spawn time are all stored and executed in the same block, in order of chainIDs
+func TestBeginBlockInitSameSpawnTime(t *testing.T) {
+	now := time.Now().UTC()
+	spawnTime := now.Add(-time.Hour)
+
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+	defer ctrl.Finish()
+	ctx = ctx.WithBlockTime(now)
+
+	chainIDs := []string{"chain-b", "chain-a"}
+	for _, chainID := range chainIDs {
+		prop := testkeeper.GetTestConsumerAdditionProp()
+		prop.ChainId = chainID
+		prop.SpawnTime = spawnTime
+		providerKeeper.SetPendingConsumerAdditionProp(ctx, prop)
+	}
+	// both proposals are pending, ordered by chainID
+	props := providerKeeper.GetAllPendingConsumerAdditionProps(ctx)
+	require.Len(t, props, 2)
+	require.Equal(t, "chain-a", props[0].ChainId)
+	require.Equal(t, "chain-b", props[1].ChainId)
+
+	gomock.InOrder(
+		append(testkeeper.GetMocksForCreateConsumerClient(ctx, &mocks, "chain-a", clienttypes.NewHeight(4, 5)),
+			testkeeper.GetMocksForCreateConsumerClient(ctx, &mocks, "chain-b", clienttypes.NewHeight(4, 5))...)...,
+	)
+
+	providerKeeper.BeginBlockInit(ctx)
+
+	require.Empty(t, providerKeeper.GetAllPendingConsumerAdditionProps(ctx))
+	for _, chainID := range chainIDs {
+		_, found := providerKeeper.GetConsumerClientId(ctx, chainID)
+		require.True(t, found, "consumer client of %s not created", chainID)
+	}
+}
+
 // TestBeginBlockCCR tests BeginBlockCCR against the spec.
 //
 // See: https://github.com/cosmos/ibc/blob/main/spec/app/ics-028-cross-chain-validation/methods.md#ccv-pcf-bblock-ccr1
@@ -1054,10 +1523,168 @@ func TestHandleEquivocationProposal(t *testing.T) {
 		err := keeper.HandleEquivocationProposal(ctx, prop)
 
 		if tc.expectErr {
-			require.Error(t, err)
+			require.ErrorIs(t, err, providertypes.ErrNoEquivocationRecord)
 		} else {
 			require.NoError(t, err)
 		}
 		ctrl.Finish()
 	}
 }
+
+// TestHandleForceCompleteUnbondingProposal tests that a force complete unbonding proposal
+// removes the consumer chain from the unbonding operations waiting on it, and matures
+// the unbonding operations that are not waiting on any other consumer chain
+func TestHandleForceCompleteUnbondingProposal(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	prop := providertypes.NewForceCompleteUnbondingProposal("title", "desc", "dead-chain").(*providertypes.ForceCompleteUnbondingProposal)
+
+	// no unbonding operation is waiting on the consumer chain
+	err := providerKeeper.HandleForceCompleteUnbondingProposal(ctx, prop)
+	require.ErrorIs(t, err, providertypes.ErrInvalidForceCompleteUnbondingProp)
+
+	// unbonding op 1 only waits on the dead chain, unbonding op 2 also waits on a live chain,
+	// and unbonding op 3 does not wait on the dead chain
+	unbondingOps := []providertypes.UnbondingOp{
+		{Id: 1, UnbondingConsumerChains: []string{"dead-chain"}},
+		{Id: 2, UnbondingConsumerChains: []string{"dead-chain", "live-chain"}},
+		{Id: 3, UnbondingConsumerChains: []string{"live-chain"}},
+	}
+	for _, op := range unbondingOps {
+		providerKeeper.SetUnbondingOp(ctx, op)
+	}
+	providerKeeper.SetUnbondingOpIndex(ctx, "dead-chain", 1, []uint64{1})
+	providerKeeper.SetUnbondingOpIndex(ctx, "dead-chain", 2, []uint64{2})
+	providerKeeper.SetUnbondingOpIndex(ctx, "live-chain", 2, []uint64{2, 3})
+
+	err = providerKeeper.HandleForceCompleteUnbondingProposal(ctx, prop)
+	require.NoError(t, err)
+
+	// unbonding op 1 no longer waits on any consumer chain, so it is matured
+	_, found := providerKeeper.GetUnbondingOp(ctx, 1)
+	require.False(t, found)
+	require.Equal(t, []uint64{1}, providerKeeper.GetMaturedUnbondingOps(ctx))
+
+	// unbonding op 2 still waits on the live chain, and unbonding op 3 is unchanged
+	require.Equal(t, []string{"live-chain"}, providerKeeper.GetChainsBlockingUnbonding(ctx, 2))
+	require.Equal(t, []string{"live-chain"}, providerKeeper.GetChainsBlockingUnbonding(ctx, 3))
+
+	// the unbonding op indexes of the dead chain are deleted
+	require.Empty(t, providerKeeper.GetAllUnbondingOpIndexes(ctx, "dead-chain"))
+	ids, found := providerKeeper.GetUnbondingOpIndex(ctx, "live-chain", 2)
+	require.True(t, found)
+	require.Equal(t, []uint64{2, 3}, ids)
+
+	// a second proposal for the same consumer chain has no unbonding operation to release
+	err = providerKeeper.HandleForceCompleteUnbondingProposal(ctx, prop)
+	require.ErrorIs(t, err, providertypes.ErrInvalidForceCompleteUnbondingProp)
+	require.Equal(t, []uint64{1}, providerKeeper.GetMaturedUnbondingOps(ctx))
+}
+
+// TestHandleConsumerAdditionCancellationProposal tests that a consumer addition cancellation proposal
+// removes the pending consumer addition proposal of a consumer chain that was not spawned yet,
+// and that it is rejected for a consumer chain that was already spawned
+func TestHandleConsumerAdditionCancellationProposal(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now)
+
+	pending := testkeeper.GetTestConsumerAdditionProp()
+	pending.ChainId = "pending-chain"
+	pending.SpawnTime = now.Add(time.Hour)
+	providerKeeper.SetPendingConsumerAdditionProp(ctx, pending)
+	other := testkeeper.GetTestConsumerAdditionProp()
+	other.ChainId = "other-chain"
+	other.SpawnTime = now.Add(time.Hour)
+	providerKeeper.SetPendingConsumerAdditionProp(ctx, other)
+	require.NoError(t, providerKeeper.SetConsumerGenesis(ctx, "pending-chain", *consumertypes.DefaultGenesisState()))
+
+	// the pending consumer addition proposal and the consumer genesis are deleted
+	prop := providertypes.NewConsumerAdditionCancellationProposal("title", "desc", "pending-chain").(*providertypes.ConsumerAdditionCancellationProposal)
+	require.NoError(t, providerKeeper.HandleConsumerAdditionCancellationProposal(ctx, prop))
+	_, found := providerKeeper.GetPendingConsumerAdditionProp(ctx, pending.SpawnTime, "pending-chain")
+	require.False(t, found)
+	_, found = providerKeeper.GetConsumerGenesis(ctx, "pending-chain")
+	require.False(t, found)
+	// the other pending consumer addition proposal is unchanged
+	require.Equal(t, []providertypes.ConsumerAdditionProposal{*other}, providerKeeper.GetAllPendingConsumerAdditionProps(ctx))
+
+	// no pending consumer addition proposal is left for the cancelled chain
+	err := providerKeeper.HandleConsumerAdditionCancellationProposal(ctx, prop)
+	require.ErrorIs(t, err, providertypes.ErrInvalidAdditionCancellationProp)
+
+	// a consumer chain that was already spawned cannot be cancelled
+	providerKeeper.SetConsumerClientId(ctx, "other-chain", "clientID")
+	prop = providertypes.NewConsumerAdditionCancellationProposal("title", "desc", "other-chain").(*providertypes.ConsumerAdditionCancellationProposal)
+	err = providerKeeper.HandleConsumerAdditionCancellationProposal(ctx, prop)
+	require.ErrorIs(t, err, providertypes.ErrInvalidAdditionCancellationProp)
+	providerKeeper.DeleteConsumerClientId(ctx, "other-chain")
+	providerKeeper.SetChainToChannel(ctx, "other-chain", "channelID")
+	err = providerKeeper.HandleConsumerAdditionCancellationProposal(ctx, prop)
+	require.ErrorIs(t, err, providertypes.ErrInvalidAdditionCancellationProp)
+	require.Len(t, providerKeeper.GetAllPendingConsumerAdditionProps(ctx), 1)
+}
+
+// TestHandleChangeRewardDenomsProposal tests that a change reward denoms proposal
+// registers and unregisters the consumer reward denoms
+func TestHandleChangeRewardDenomsProposal(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	prop := providertypes.NewChangeRewardDenomsProposal("title", "desc", []string{"ibc/denom2", "ibc/denom1"}, nil).(*providertypes.ChangeRewardDenomsProposal)
+	require.NoError(t, providerKeeper.HandleChangeRewardDenomsProposal(ctx, prop))
+	require.Equal(t, []string{"ibc/denom1", "ibc/denom2"}, providerKeeper.GetAllConsumerRewardDenoms(ctx))
+
+	// a registered denom cannot be registered again
+	err := providerKeeper.HandleChangeRewardDenomsProposal(ctx, prop)
+	require.ErrorIs(t, err, providertypes.ErrInvalidChangeRewardDenomsProp)
+
+	// an unregistered denom cannot be unregistered; the proposal is not partially applied
+	prop = providertypes.NewChangeRewardDenomsProposal("title", "desc", []string{"ibc/denom3"}, []string{"ibc/denom4"}).(*providertypes.ChangeRewardDenomsProposal)
+	err = providerKeeper.HandleChangeRewardDenomsProposal(ctx, prop)
+	require.ErrorIs(t, err, providertypes.ErrInvalidChangeRewardDenomsProp)
+	require.False(t, providerKeeper.IsConsumerRewardDenomRegistered(ctx, "ibc/denom3"))
+
+	prop = providertypes.NewChangeRewardDenomsProposal("title", "desc", []string{"ibc/denom3"}, []string{"ibc/denom1"}).(*providertypes.ChangeRewardDenomsProposal)
+	require.NoError(t, providerKeeper.HandleChangeRewardDenomsProposal(ctx, prop))
+	require.Equal(t, []string{"ibc/denom2", "ibc/denom3"}, providerKeeper.GetAllConsumerRewardDenoms(ctx))
+}
+
+// TestHandleConsumerPauseProposal tests that only validating consumer chains can be paused
+// and that only paused consumer chains can be resumed
+func TestHandleConsumerPauseProposal(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	pause := providertypes.NewConsumerPauseProposal("title", "desc", "chainID", true).(*providertypes.ConsumerPauseProposal)
+	resume := providertypes.NewConsumerPauseProposal("title", "desc", "chainID", false).(*providertypes.ConsumerPauseProposal)
+
+	// a consumer chain without CCV channel cannot be paused
+	err := providerKeeper.HandleConsumerPauseProposal(ctx, pause)
+	require.ErrorIs(t, err, providertypes.ErrInvalidConsumerPauseChange)
+	require.False(t, providerKeeper.IsConsumerChainPaused(ctx, "chainID"))
+
+	providerKeeper.SetConsumerClientId(ctx, "chainID", "clientID")
+	providerKeeper.SetChainToChannel(ctx, "chainID", "channelID")
+
+	// a consumer chain that is not paused cannot be resumed
+	err = providerKeeper.HandleConsumerPauseProposal(ctx, resume)
+	require.ErrorIs(t, err, providertypes.ErrInvalidConsumerPauseChange)
+
+	require.NoError(t, providerKeeper.HandleConsumerPauseProposal(ctx, pause))
+	require.True(t, providerKeeper.IsConsumerChainPaused(ctx, "chainID"))
+	// the CCV channel stays open
+	channelID, found := providerKeeper.GetChainToChannel(ctx, "chainID")
+	require.True(t, found)
+	require.Equal(t, "channelID", channelID)
+
+	// a paused consumer chain cannot be paused again
+	err = providerKeeper.HandleConsumerPauseProposal(ctx, pause)
+	require.ErrorIs(t, err, providertypes.ErrInvalidConsumerPauseChange)
+
+	require.NoError(t, providerKeeper.HandleConsumerPauseProposal(ctx, resume))
+	require.False(t, providerKeeper.IsConsumerChainPaused(ctx, "chainID"))
+}
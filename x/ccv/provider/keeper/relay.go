@@ -3,9 +3,11 @@ package keeper
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
@@ -30,8 +32,10 @@ func (k Keeper) OnRecvVSCMaturedPacket(
 		k.Logger(ctx).Error("VSCMaturedPacket received on unknown channel",
 			"channelID", packet.DestinationChannel,
 		)
-		panic(fmt.Errorf("VSCMaturedPacket received on unknown channel %s", packet.DestinationChannel))
+		panic(sdkerrors.Wrapf(providertypes.ErrUnknownConsumerChannelId,
+			"VSCMaturedPacket received on unknown channel %s", packet.DestinationChannel))
 	}
+	k.recordConsumerActivity(ctx, chainID)
 
 	if err := k.QueueThrottledVSCMaturedPacketData(ctx, chainID, packet.Sequence, data); err != nil {
 		return channeltypes.NewErrorAcknowledgement(fmt.Errorf(
@@ -116,6 +120,12 @@ func (k Keeper) completeMaturedUnbondingOps(ctx sdk.Context) {
 
 // OnAcknowledgementPacket handles acknowledgments for sent VSC packets
 func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, ack channeltypes.Acknowledgement) error {
+	if chainID, ok := k.GetChannelToChain(ctx, packet.SourceChannel); ok {
+		// record the acknowledged packet, to detect packets relayed with delay
+		k.SetLastAckedSequence(ctx, chainID, packet.Sequence)
+		k.updatePacketSequenceGauge(ctx, chainID)
+		k.recordConsumerActivity(ctx, chainID)
+	}
 	if err := ack.GetError(); err != "" {
 		// The VSC packet data could not be successfully decoded.
 		// This should never happen.
@@ -134,7 +144,12 @@ func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Pac
 }
 
 // OnTimeoutPacket aborts the transaction if no chain exists for the destination channel,
-// otherwise it stops the chain
+// otherwise it stops the chain and invalidates its CCV channel.
+//
+// Note that CCV channels are ORDERED, thus core IBC closes the channel on timeout.
+// The unbonding operations waiting on the consumer chain are not released: they are held,
+// together with the unbonding op indexes of the consumer chain, until a ForceCompleteUnbondingProposal
+// releases them, see StopTimedOutConsumerChain.
 func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
 	chainID, found := k.GetChannelToChain(ctx, packet.SourceChannel)
 	if !found {
@@ -146,8 +161,8 @@ func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) err
 		)
 	}
 	k.Logger(ctx).Info("packet timeout, removing the consumer:", "chainID", chainID)
-	// stop consumer chain and release unbondings
-	return k.StopConsumerChain(ctx, chainID, false)
+	// stop consumer chain and hold unbondings
+	return k.StopTimedOutConsumerChain(ctx, chainID, false)
 }
 
 // EndBlockVSU contains the EndBlock logic needed for
@@ -163,6 +178,19 @@ func (k Keeper) EndBlockVSU(ctx sdk.Context) {
 	// if the CCV channel is not established for a consumer chain,
 	// the updates will remain queued until the channel is established
 	k.SendVSCPackets(ctx)
+
+	// send the slash acks that were not included in any VSC packet,
+	// i.e., of the consumer chains without changes in this block
+	k.SendPendingSlashAcks(ctx)
+
+	// prune the block heights of old valset update IDs
+	k.PruneValsetUpdateBlockHeights(ctx)
+
+	// report the consumer chains that were not heard from within the liveness window
+	k.ReportInactiveConsumers(ctx)
+
+	// report the clients to consumer chains that are about to expire
+	k.ReportExpiringConsumerClients(ctx)
 }
 
 // SendVSCPackets iterates over all registered consumers and sends pending
@@ -178,17 +206,37 @@ func (k Keeper) SendVSCPackets(ctx sdk.Context) {
 	}
 }
 
-// SendVSCPacketsToChain sends all queued VSC packets to the specified chain
+// SendVSCPacketsToChain sends all queued VSC packets to the specified chain.
+// If a packet cannot be sent, e.g., since the CCV channel is temporarily unavailable,
+// the packet and the ones queued after it remain queued and are sent in a later block,
+// so that the VSC packets are always sent in order and none of them is dropped.
 func (k Keeper) SendVSCPacketsToChain(ctx sdk.Context, chainID, channelID string) {
+	if k.IsConsumerChainPaused(ctx, chainID) {
+		// leave the packet data stored to be sent once the consumer chain is resumed
+		return
+	}
+	if !k.checkConsumerClientActive(ctx, chainID) {
+		// leave the packet data stored to be sent once the client is recovered
+		return
+	}
+
 	pendingPackets := k.GetPendingVSCPackets(ctx, chainID)
-	for _, data := range pendingPackets {
+	logDiffs := len(pendingPackets) != 0 && k.GetLogValsetUpdateDiffs(ctx)
+	for i, data := range pendingPackets {
+		// set the VSC send timestamp for this packet when it is first attempted to be sent,
+		// so that the VSC timeout also applies to the packets that cannot be sent, e.g., since
+		// the CCV channel is unusable; note that a retry does not reset the timestamp
+		if _, found := k.GetVscSendTimestamp(ctx, chainID, data.ValsetUpdateId); !found {
+			k.SetVscSendTimestamp(ctx, chainID, data.ValsetUpdateId, ctx.BlockTime())
+		}
+
 		// send packet over IBC
-		err := utils.SendIBCPacket(
+		seq, err := utils.SendIBCPacket(
 			ctx,
 			k.scopedKeeper,
 			k.channelKeeper,
-			channelID,          // source channel id
-			ccv.ProviderPortID, // source port id
+			channelID,      // source channel id
+			k.GetPort(ctx), // source port id
 			data.GetBytes(),
 			k.GetCCVTimeoutPeriod(ctx),
 		)
@@ -197,20 +245,110 @@ func (k Keeper) SendVSCPacketsToChain(ctx sdk.Context, chainID, channelID string
 			if clienttypes.ErrClientNotActive.Is(err) {
 				// IBC client is expired!
 				// leave the packet data stored to be sent once the client is upgraded
-				// the client cannot expire during iteration (in the middle of a block)
 				k.Logger(ctx).Debug("IBC client is expired, cannot send VSC, leaving packet data stored:", "chainID", chainID, "vscid", data.ValsetUpdateId)
-				return
+			} else {
+				k.Logger(ctx).Error("cannot send VSC packet, leaving packet data stored to retry in the next block",
+					"chainID", chainID,
+					"vscid", data.ValsetUpdateId,
+					"error", err.Error(),
+				)
+				incrVSCPacketSendFailuresCounter(chainID)
+			}
+			// the packets sent before the failure are removed from the queue
+			k.SetPendingVSCPackets(ctx, chainID, pendingPackets[i:])
+			if i != 0 {
+				k.updatePacketSequenceGauge(ctx, chainID)
 			}
-			// TODO do not panic if the send fails
-			// https://github.com/cosmos/interchain-security/issues/649
-			panic(fmt.Errorf("packet could not be sent over IBC: %w", err))
+			return
 		}
-		// set the VSC send timestamp for this packet;
-		// note that the VSC send timestamp are set when the packets
-		// are actually sent over IBC
-		k.SetVscSendTimestamp(ctx, chainID, data.ValsetUpdateId, ctx.BlockTime())
+		if logDiffs {
+			k.logValsetUpdateDiffs(ctx, chainID, data.ValsetUpdateId, data.ValidatorUpdates)
+		}
+		k.ApplyConsumerValSetUpdates(ctx, chainID, data.ValsetUpdateId, data.ValidatorUpdates)
+		k.SetLastSentSequence(ctx, chainID, seq)
+		incrVSCPacketsSentCounter(chainID)
 	}
 	k.DeletePendingVSCPackets(ctx, chainID)
+	if len(pendingPackets) != 0 {
+		k.updatePacketSequenceGauge(ctx, chainID)
+	}
+}
+
+// isConsumerClientActive returns false if the IBC client to the consumer chain
+// with the given chain ID is expired or frozen. Unlike checkConsumerClientActive,
+// it neither records when the client became inactive nor stops the consumer chain.
+func (k Keeper) isConsumerClientActive(ctx sdk.Context, chainID string) bool {
+	clientID, found := k.GetConsumerClientId(ctx, chainID)
+	if !found {
+		return true
+	}
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return true
+	}
+	status := clientState.Status(ctx, k.clientKeeper.ClientStore(ctx, clientID), k.cdc)
+	return status != exported.Expired && status != exported.Frozen
+}
+
+// checkConsumerClientActive returns whether the client to the consumer chain with the given chain ID
+// is active, i.e., whether packets can be sent to the consumer chain.
+//
+// If the client is expired or frozen, the provider waits for the client to be recovered
+// for ClientExpirationGracePeriod, starting from the first time it observed the inactive client.
+// Once the grace period elapsed, the CCV channel is closed, i.e., it becomes INVALID,
+// and the consumer chain is stopped.
+func (k Keeper) checkConsumerClientActive(ctx sdk.Context, chainID string) bool {
+	clientID, found := k.GetConsumerClientId(ctx, chainID)
+	if !found {
+		return true
+	}
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return true
+	}
+
+	status := clientState.Status(ctx, k.clientKeeper.ClientStore(ctx, clientID), k.cdc)
+	if status != exported.Expired && status != exported.Frozen {
+		// the client is active or was recovered
+		k.DeleteClientInactiveTimestamp(ctx, chainID)
+		return true
+	}
+
+	inactiveTimestamp, found := k.GetClientInactiveTimestamp(ctx, chainID)
+	if !found {
+		k.SetClientInactiveTimestamp(ctx, chainID, ctx.BlockTime())
+		k.Logger(ctx).Info("IBC client to consumer chain is not active, cannot send VSC packets",
+			"chainID", chainID,
+			"clientID", clientID,
+			"status", status,
+		)
+		return false
+	}
+	if ctx.BlockTime().Before(inactiveTimestamp.Add(k.GetClientExpirationGracePeriod(ctx))) {
+		return false
+	}
+
+	// the grace period elapsed;
+	// close the CCV channel and stop the consumer chain
+	k.Logger(ctx).Info("about to remove consumer chain - IBC client is not active after grace period",
+		"chainID", chainID,
+		"clientID", clientID,
+		"status", status,
+	)
+	if err := k.StopConsumerChain(ctx, chainID, true); err != nil {
+		panic(fmt.Errorf("consumer chain failed to stop: %w", err))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			ccv.EventTypeCCVChannelInvalidated,
+			sdk.NewAttribute(sdk.AttributeKeyModule, providertypes.ModuleName),
+			sdk.NewAttribute(ccv.AttributeChainID, chainID),
+			sdk.NewAttribute(clienttypes.AttributeKeyClientID, clientID),
+			sdk.NewAttribute(ccv.AttributeClientStatus, status.String()),
+		),
+	)
+	return false
 }
 
 // QueueVSCPackets queues latest validator updates for every registered consumer chain
@@ -221,9 +359,26 @@ func (k Keeper) QueueVSCPackets(ctx sdk.Context) {
 	// of cosmos-sdk is invalid.
 	valUpdates := k.stakingKeeper.GetValidatorUpdates(ctx)
 
-	for _, chain := range k.GetAllConsumerChains(ctx) {
+	chains := k.GetAllConsumerChains(ctx)
+	updateConsumerChainsGauge(len(chains))
+	for _, chain := range chains {
 		// Apply the key assignment to the validator updates.
 		valUpdates := k.MustApplyKeyAssignmentToValUpdates(ctx, chain.ChainId, valUpdates)
+		// If the consumer chain has validator lists, or they were just removed,
+		// or if it is validated by its top N validators only,
+		// or if validators are or were opted out of validating it,
+		// the validator updates are instead computed from the filtered validator set.
+		// The filtered validator set only changes if the powers of the validators changed,
+		// i.e., if there are validator updates, or if the filters themselves were updated;
+		// thus, it is not recomputed otherwise.
+		if listsUpdated := k.GetValidatorListsUpdated(ctx, chain.ChainId); listsUpdated ||
+			k.HasValidatorLists(ctx, chain.ChainId) || k.GetTopN(ctx, chain.ChainId) != 0 ||
+			k.IsSoftOptOutEnabled(ctx, chain.ChainId) || len(k.GetAllSoftOptedOut(ctx, chain.ChainId)) != 0 {
+			if len(valUpdates) != 0 || listsUpdated {
+				valUpdates = k.FilterValidatorUpdates(ctx, chain.ChainId)
+				k.DeleteValidatorListsUpdated(ctx, chain.ChainId)
+			}
+		}
 
 		// check whether there are changes in the validator set;
 		// note that this also entails unbonding operations
@@ -242,17 +397,21 @@ func (k Keeper) QueueVSCPackets(ctx sdk.Context) {
 		}
 	}
 
-	k.IncrementValidatorSetUpdateId(ctx)
+	k.CommitValidatorSetUpdate(ctx)
 }
 
 // EndBlockCIS contains the EndBlock logic needed for
 // the Consumer Initiated Slashing sub-protocol
 func (k Keeper) EndBlockCIS(ctx sdk.Context) {
-	// set the ValsetUpdateBlockHeight
-	blockHeight := uint64(ctx.BlockHeight()) + 1
+	// set the ValsetUpdateBlockHeight, unless it was already set by CommitValidatorSetUpdate
+	// in the previous block; this is the case for every vscID except the one set at genesis
 	valUpdateID := k.GetValidatorSetUpdateId(ctx)
-	k.SetValsetUpdateBlockHeight(ctx, valUpdateID, blockHeight)
-	k.Logger(ctx).Debug("vscID was mapped to block height", "vscID", valUpdateID, "height", blockHeight)
+	if _, found := k.GetValsetUpdateBlockHeight(ctx, valUpdateID); !found {
+		blockHeight := uint64(ctx.BlockHeight()) + 1
+		k.SetValsetUpdateBlockHeight(ctx, valUpdateID, blockHeight)
+		k.SetValsetUpdateTimestamp(ctx, valUpdateID, ctx.BlockTime())
+		k.Logger(ctx).Debug("vscID was mapped to block height", "vscID", valUpdateID, "height", blockHeight)
+	}
 
 	// Replenish slash meter if necessary, BEFORE executing slash packet throttling logic.
 	// This ensures the meter value is replenished, and not greater than the allowance (max value)
@@ -284,11 +443,88 @@ func (k Keeper) EndBlockCIS(ctx sdk.Context) {
 	k.HandleThrottleQueues(ctx)
 }
 
+// OnRecvConsumerRemovalPacket handles a ConsumerRemoval packet, i.e., a request of a consumer chain
+// to voluntarily leave. The packet is only acted on if it is received on the CCV channel of
+// a validating consumer chain. In this case, the consumer chain is stopped in the BeginBlock
+// of the next block, in the same way as for a consumer removal proposal, i.e., its CCV channel
+// is closed, its state is deleted and the unbonding operations waiting on it are released.
+//
+// Note that the consumer chain is not stopped right away, since the CCV channel cannot be closed
+// before the acknowledgement of the packet is written.
+func (k Keeper) OnRecvConsumerRemovalPacket(ctx sdk.Context, packet channeltypes.Packet, data ccv.ConsumerRemovalPacketData) exported.Acknowledgement {
+	if k.IsChannelInvalidated(ctx, packet.DestinationChannel) {
+		k.Logger(ctx).Error("ConsumerRemovalPacket received on invalidated channel",
+			"channelID", packet.DestinationChannel,
+		)
+		return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrapf(ccv.ErrInvalidatedChannel,
+			"ConsumerRemovalPacket received on invalidated channel %s", packet.DestinationChannel))
+	}
+
+	// the consumer chain cannot be removed by a packet received on any other channel
+	// than the CCV channel of a validating consumer chain
+	chainID, found := k.GetChannelToChain(ctx, packet.DestinationChannel)
+	if !found {
+		k.Logger(ctx).Error("ConsumerRemovalPacket received on unknown channel",
+			"channelID", packet.DestinationChannel,
+		)
+		return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrapf(providertypes.ErrUnknownConsumerChannelId,
+			"ConsumerRemovalPacket received on unknown channel %s", packet.DestinationChannel))
+	}
+	if channelID, found := k.GetChainToChannel(ctx, chainID); !found || channelID != packet.DestinationChannel {
+		k.Logger(ctx).Error("ConsumerRemovalPacket received on a channel that is not validating",
+			"chainID", chainID,
+			"channelID", packet.DestinationChannel,
+		)
+		return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrapf(providertypes.ErrUnknownConsumerChannelId,
+			"ConsumerRemovalPacket received on channel %s that is not validating consumer chain %s", packet.DestinationChannel, chainID))
+	}
+	k.recordConsumerActivity(ctx, chainID)
+
+	if err := data.ValidateBasic(); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	// schedule the removal of the consumer chain in the next BeginBlockCCR
+	k.SetPendingConsumerRemovalProp(ctx, &providertypes.ConsumerRemovalProposal{
+		Title:       fmt.Sprintf("Removal of consumer chain %s", chainID),
+		Description: data.Reason,
+		ChainId:     chainID,
+		StopTime:    ctx.BlockTime(),
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			ccv.EventTypeConsumerRemovalRequest,
+			sdk.NewAttribute(sdk.AttributeKeyModule, providertypes.ModuleName),
+			sdk.NewAttribute(ccv.AttributeChainID, chainID),
+			sdk.NewAttribute(ccv.AttributeRemovalReason, data.Reason),
+		),
+	)
+
+	k.Logger(ctx).Info("ConsumerRemovalPacket received, consumer chain will be removed",
+		"chainID", chainID,
+		"reason", data.Reason,
+	)
+
+	return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+}
+
 // OnRecvSlashPacket delivers a received slash packet, validates it and
 // then queues the slash packet as pending if valid.
 func (k Keeper) OnRecvSlashPacket(ctx sdk.Context, packet channeltypes.Packet, data ccv.SlashPacketData) exported.Acknowledgement {
 
-	// check that the channel is established, panic if not
+	// the slash packets received on an invalidated CCV channel, i.e., the channel of a removed
+	// consumer chain that could not be closed, are not acted on, since the chain is not validating
+	if k.IsChannelInvalidated(ctx, packet.DestinationChannel) {
+		k.Logger(ctx).Error("SlashPacket received on invalidated channel",
+			"channelID", packet.DestinationChannel,
+		)
+		return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrapf(ccv.ErrInvalidatedChannel,
+			"SlashPacket received on invalidated channel %s", packet.DestinationChannel))
+	}
+
+	// check that the channel is established, panic if not;
+	// note that IBC only delivers packets on open channels, i.e., once the handshake completed
 	chainID, found := k.GetChannelToChain(ctx, packet.DestinationChannel)
 	if !found {
 		// SlashPacket packet was sent on a channel different than any of the established CCV channels;
@@ -296,8 +532,10 @@ func (k Keeper) OnRecvSlashPacket(ctx sdk.Context, packet channeltypes.Packet, d
 		k.Logger(ctx).Error("SlashPacket received on unknown channel",
 			"channelID", packet.DestinationChannel,
 		)
-		panic(fmt.Errorf("SlashPacket received on unknown channel %s", packet.DestinationChannel))
+		panic(sdkerrors.Wrapf(providertypes.ErrUnknownConsumerChannelId,
+			"SlashPacket received on unknown channel %s", packet.DestinationChannel))
 	}
+	k.recordConsumerActivity(ctx, chainID)
 
 	if err := k.ValidateSlashPacket(ctx, chainID, packet, data); err != nil {
 		k.Logger(ctx).Error("invalid slash packet",
@@ -328,9 +566,13 @@ func (k Keeper) OnRecvSlashPacket(ctx sdk.Context, packet channeltypes.Packet, d
 			"infractionHeight", infractionHeight,
 		)
 
-		// return successful ack, as an error would result
-		// in the consumer closing the CCV channel
-		return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+		// Unless the consumer chain opted in to have its double-sign slash packets applied,
+		// the double-sign infraction must be executed via an equivocation proposal.
+		if !k.GetSlashDoubleSigns(ctx, chainID) {
+			// return successful ack, as an error would result
+			// in the consumer closing the CCV channel
+			return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+		}
 	}
 
 	// Queue a slash entry to the global queue, which will be seen by the throttling logic
@@ -366,20 +608,32 @@ func (k Keeper) ValidateSlashPacket(ctx sdk.Context, chainID string,
 	_, found := k.getMappedInfractionHeight(ctx, chainID, data.ValsetUpdateId)
 	// return error if we cannot find infraction height matching the validator update id
 	if !found {
-		return fmt.Errorf("cannot find infraction height matching "+
-			"the validator update id %d for chain %s", data.ValsetUpdateId, chainID)
+		return sdkerrors.Wrapf(providertypes.ErrUnknownInfractionHeight,
+			"cannot find infraction height matching the validator update id %d for chain %s", data.ValsetUpdateId, chainID)
 	}
 
 	if data.Infraction != stakingtypes.DoubleSign && data.Infraction != stakingtypes.Downtime {
-		return fmt.Errorf("invalid infraction type: %s", data.Infraction)
+		return sdkerrors.Wrapf(providertypes.ErrInvalidInfractionType, "%s", data.Infraction)
 	}
 
 	return nil
 }
 
-// HandleSlashPacket potentially jails a misbehaving validator for a downtime infraction.
-// This method should NEVER be called with a double-sign infraction.
-func (k Keeper) HandleSlashPacket(ctx sdk.Context, chainID string, data ccv.SlashPacketData) {
+// HandleSlashPacket potentially jails a misbehaving validator for a downtime infraction,
+// or slashes, jails and tombstones a misbehaving validator for a double-sign infraction.
+// If the validator is not found, true is returned and the slash packet is retried in a following
+// block through the throttle queues, see HandleThrottleQueues and HandleSlashRetry.
+// This method should be called with a double-sign infraction ONLY if the consumer chain
+// opted in to have its double-sign slash packets applied, see OnRecvSlashPacket.
+func (k Keeper) HandleSlashPacket(ctx sdk.Context, chainID string, data ccv.SlashPacketData) (retry bool) {
+	retry = k.applySlashPacket(ctx, chainID, data)
+	return k.HandleSlashRetry(ctx, chainID, data, retry)
+}
+
+// applySlashPacket applies a slash packet received from a consumer chain.
+// It returns true if the slash packet could not be applied because the validator
+// was not found, and false otherwise, i.e., if it was either applied or dropped.
+func (k Keeper) applySlashPacket(ctx sdk.Context, chainID string, data ccv.SlashPacketData) (retry bool) {
 
 	consumerConsAddr := providertypes.NewConsumerConsAddress(data.Validator.Address)
 	// Obtain provider chain consensus address using the consumer chain consensus address
@@ -396,15 +650,23 @@ func (k Keeper) HandleSlashPacket(ctx sdk.Context, chainID string, data ccv.Slas
 	// Obtain validator from staking keeper
 	validator, found := k.stakingKeeper.GetValidatorByConsAddr(ctx, providerConsAddr.ToSdkConsAddr())
 
-	// make sure the validator is not yet unbonded;
-	// stakingKeeper.Slash() panics otherwise
-	if !found || validator.IsUnbonded() {
-		// if validator is not found or is unbonded, drop slash packet and log error.
-		// Note that it is impossible for the validator to be not found or unbonded if both the provider
+	if !found {
+		// if validator is not found, retry to apply the slash packet in the following blocks.
+		// Note that it is impossible for the validator to be not found if both the provider
 		// and the consumer are following the protocol. Thus if this branch is taken then one or both
 		// chains is incorrect, but it is impossible to tell which.
-		k.Logger(ctx).Error("validator not found or is unbonded", "validator", providerConsAddr.String())
-		return
+		k.Logger(ctx).Error("validator not found", "validator", providerConsAddr.String())
+		return true
+	}
+
+	// make sure the validator is not yet unbonded;
+	// stakingKeeper.Slash() panics otherwise
+	if validator.IsUnbonded() {
+		// if validator is unbonded, drop slash packet and log error.
+		// Note that it is impossible for the validator to be unbonded if both the provider
+		// and the consumer are following the protocol.
+		k.Logger(ctx).Error("validator is unbonded", "validator", providerConsAddr.String())
+		return false
 	}
 
 	// tombstoned validators should not be slashed multiple times.
@@ -414,29 +676,79 @@ func (k Keeper) HandleSlashPacket(ctx sdk.Context, chainID string, data ccv.Slas
 			"slash packet dropped because validator is already tombstoned",
 			"provider cons addr", providerConsAddr.String(),
 		)
-		return
+		return false
 	}
 
 	infractionHeight, found := k.getMappedInfractionHeight(ctx, chainID, data.ValsetUpdateId)
 	if !found {
 		k.Logger(ctx).Error("infraction height not found. But was found during slash packet validation")
 		// drop packet
-		return
+		return false
 	}
 
-	// Note: the SlashPacket is for downtime infraction, as SlashPackets
-	// for double-signing infractions are already dropped when received
-
 	// append the validator address to the slash ack for its chain id
 	// TODO: consumer cons address should be accepted here
-	k.AppendSlashAck(ctx, chainID, consumerConsAddr.String())
+	k.AppendSlashAck(ctx, chainID, consumerConsAddr.String(), data.Infraction)
 
-	// jail validator
-	if !validator.IsJailed() {
+	// Note that the slash ack is sent even if the downtime infraction does not cause the validator
+	// to be jailed below, so that the consumer chain clears the outstanding downtime
+	if data.Infraction == stakingtypes.DoubleSign {
+		// Note: SlashPackets for double-signing infractions reach this point only
+		// if the consumer chain opted in to have them applied, see OnRecvSlashPacket.
+		k.slashAndTombstone(ctx, providerConsAddr, validator, infractionHeight)
+	} else if k.IsSoftOptedOut(ctx, chainID, providerConsAddr) {
+		// validators opted out of validating the consumer chain are never jailed for downtime on it
+		k.Logger(ctx).Info("validator not jailed for downtime since it is opted out of validating the consumer chain",
+			"chainID", chainID,
+			"provider cons addr", providerConsAddr.String(),
+		)
+	} else if lastHeight, found := k.GetLastDowntimeInfractionHeight(ctx, chainID, providerConsAddr); found && data.ValsetUpdateId != 0 && infractionHeight <= lastHeight {
+		// a downtime infraction of the validator on this consumer chain that was committed at the same height
+		// or later was already applied, e.g., the slash packet is a replay under an older valset update ID;
+		// thus, the infraction must not be applied again.
+		// Infractions with vscID zero are always applied, since they cannot be ordered (see below).
+		k.Logger(ctx).Info("validator not jailed for downtime since a later downtime infraction was already applied",
+			"chainID", chainID,
+			"provider cons addr", providerConsAddr.String(),
+			"infractionHeight", infractionHeight,
+			"last infractionHeight", lastHeight,
+		)
+	} else if jailVscID, found := k.GetValidatorJailRecord(ctx, providerConsAddr); found && data.ValsetUpdateId != 0 && data.ValsetUpdateId <= jailVscID {
+		// the validator was already jailed, possibly because of a slash packet from another consumer chain,
+		// after this infraction was committed; thus, the infraction must not cause the validator to be jailed again.
+		// Infractions committed before the consumer chain received any VSC packet, i.e., with vscID zero,
+		// cannot be ordered with respect to the jailing and are always applied.
+		k.Logger(ctx).Info("validator not jailed for downtime since it was already jailed after the infraction",
+			"chainID", chainID,
+			"provider cons addr", providerConsAddr.String(),
+			"vscID", data.ValsetUpdateId,
+			"jail vscID", jailVscID,
+		)
+	} else if !validator.IsJailed() {
+		// slash validator, unless the downtime slash fraction is zero, which is the default
+		if fraction := sdk.MustNewDecFromStr(k.GetSlashFractionDowntime(ctx)); fraction.IsPositive() {
+			power := validator.ConsensusPower(k.stakingKeeper.PowerReduction(ctx))
+			k.stakingKeeper.Slash(ctx, providerConsAddr.ToSdkConsAddr(), int64(infractionHeight), power,
+				fraction, stakingtypes.Downtime)
+		}
+		// jail validator
 		k.stakingKeeper.Jail(ctx, providerConsAddr.ToSdkConsAddr())
 		k.Logger(ctx).Info("validator jailed", "provider cons addr", providerConsAddr.String())
-		jailTime := ctx.BlockTime().Add(k.slashingKeeper.DowntimeJailDuration(ctx))
+		jailDuration, found := k.GetDowntimeJailDuration(ctx, chainID)
+		if !found {
+			jailDuration = k.slashingKeeper.DowntimeJailDuration(ctx)
+		}
+		jailTime := ctx.BlockTime().Add(jailDuration)
 		k.slashingKeeper.JailUntil(ctx, providerConsAddr.ToSdkConsAddr(), jailTime)
+		// the validator set change removing the validator from the consumer validator sets
+		// is sent with the current valset update ID
+		k.SetValidatorJailRecord(ctx, providerConsAddr, k.GetValidatorSetUpdateId(ctx))
+		k.SetLastDowntimeInfractionHeight(ctx, chainID, providerConsAddr, infractionHeight)
+	}
+
+	// when the slash acks are batched, they are sent in EndBlock instead, see SendPendingSlashAcks
+	if k.GetSendSlashConfirmations(ctx, chainID) && k.GetSlashAckBatchPeriod(ctx) == 0 {
+		k.SendSlashConfirmation(ctx, chainID)
 	}
 
 	ctx.EventManager().EmitEvent(
@@ -449,6 +761,119 @@ func (k Keeper) HandleSlashPacket(ctx sdk.Context, chainID string, data ccv.Slas
 			sdk.NewAttribute(ccv.AttributeValSetUpdateID, strconv.Itoa(int(data.ValsetUpdateId))),
 		),
 	)
+
+	return false
+}
+
+// slashAndTombstone slashes the given validator with the double-sign slash fraction of the provider params,
+// jails it permanently and tombstones it, as the evidence module does for double-sign infractions
+// committed on the provider chain
+func (k Keeper) slashAndTombstone(ctx sdk.Context, providerConsAddr providertypes.ProviderConsAddress,
+	validator stakingtypes.Validator, infractionHeight uint64,
+) {
+	consAddr := providerConsAddr.ToSdkConsAddr()
+	power := validator.ConsensusPower(k.stakingKeeper.PowerReduction(ctx))
+	k.stakingKeeper.Slash(ctx, consAddr, int64(infractionHeight), power,
+		sdk.MustNewDecFromStr(k.GetSlashFractionDoubleSign(ctx)), stakingtypes.DoubleSign)
+	if !validator.IsJailed() {
+		k.stakingKeeper.Jail(ctx, consAddr)
+	}
+	k.slashingKeeper.JailUntil(ctx, consAddr, evidencetypes.DoubleSignJailEndTime)
+	k.slashingKeeper.Tombstone(ctx, consAddr)
+
+	k.Logger(ctx).Info("validator slashed and tombstoned for double-signing",
+		"provider cons addr", providerConsAddr.String(),
+		"infraction height", infractionHeight,
+	)
+}
+
+// SendSlashConfirmation sends the slash acks of a consumer chain right away in a VSC packet
+// without validator updates, instead of waiting for the next VSC packet to the consumer.
+// The sequence number of the sent packet is recorded in store.
+// The packet is sent only if no VSC packets are pending for the consumer chain, the consumer
+// chain is not paused and the IBC client to it is active. Otherwise, or if the packet cannot
+// be sent, the slash acks remain stored and are included in the next VSC packet sent to the consumer.
+//
+// Note that the packet carries the vscID of the previous VSC, which was either already sent
+// to the consumer or had no changes for it. Since no VSC packets are pending, the confirmation
+// cannot overtake a VSC packet with a lower vscID. Thus, a VSCMatured packet for this confirmation
+// cannot cause unbonding operations to complete prematurely.
+func (k Keeper) SendSlashConfirmation(ctx sdk.Context, chainID string) {
+	channelID, found := k.GetChainToChannel(ctx, chainID)
+	if !found {
+		return
+	}
+	vscID := k.GetValidatorSetUpdateId(ctx)
+	if vscID <= providertypes.DefaultValsetUpdateID {
+		// no VSC was queued yet
+		return
+	}
+	if len(k.GetPendingVSCPackets(ctx, chainID)) != 0 ||
+		k.IsConsumerChainPaused(ctx, chainID) ||
+		!k.isConsumerClientActive(ctx, chainID) {
+		// the slash acks are sent with the pending VSC packets
+		return
+	}
+
+	data := ccv.NewValidatorSetChangePacketData(nil, vscID-1, k.GetSlashAcks(ctx, chainID))
+	seq, err := utils.SendIBCPacket(
+		ctx,
+		k.scopedKeeper,
+		k.channelKeeper,
+		channelID,      // source channel id
+		k.GetPort(ctx), // source port id
+		data.GetBytes(),
+		k.GetCCVTimeoutPeriod(ctx),
+	)
+	if err != nil {
+		k.Logger(ctx).Error("cannot send slash confirmation, slash acks remain stored",
+			"chainID", chainID,
+			"error", err.Error(),
+		)
+		return
+	}
+
+	k.ConsumeSlashAcks(ctx, chainID)
+	k.SetSlashConfirmationSeq(ctx, chainID, seq)
+	k.SetLastSentSequence(ctx, chainID, seq)
+	k.updatePacketSequenceGauge(ctx, chainID)
+	k.Logger(ctx).Info("slash confirmation sent", "chainID", chainID, "sequence", seq, "len slash acks", len(data.SlashAcks))
+}
+
+// SendPendingSlashAcks sends the slash acks accumulated for every consumer chain
+// with an established CCV channel in a VSC packet without validator updates.
+// It must be called after the VSC packets of the block were queued, so that
+// only the slash acks that could not be included in a VSC packet are sent.
+// The slash acks are sent through SendSlashConfirmation, i.e., only to consumer chains
+// without pending VSC packets, that are not paused and whose client is active; the VSC
+// packets that could not be sent in this block carry the slash acks queued with them.
+// The slash acks of other consumer chains, of consumer chains without an established
+// CCV channel, or that cannot be sent, remain stored until they can be sent in a later block.
+//
+// If the SlashAckBatchPeriod param is set, the slash acks of a consumer chain are batched,
+// i.e., they are sent only once the batch period elapsed since the first of them was appended,
+// or once MaxSlashAcksPerBatch slash acks are pending. The slash acks are always sent in the
+// order they were appended.
+func (k Keeper) SendPendingSlashAcks(ctx sdk.Context) {
+	batchPeriod := k.GetSlashAckBatchPeriod(ctx)
+	for _, chain := range k.GetAllConsumerChains(ctx) {
+		acks := k.getSlashAcks(ctx, chain.ChainId)
+		if len(acks.Addresses) == 0 || !slashAcksBatchReady(ctx, acks, batchPeriod) {
+			continue
+		}
+		// the slash acks are cleared only once they are sent
+		k.SendSlashConfirmation(ctx, chain.ChainId)
+	}
+}
+
+// slashAcksBatchReady returns whether the given batch of slash acks must be sent,
+// i.e., whether batching is disabled, the batch period elapsed or the batch is full.
+// Slash acks without batch start time, i.e., stored before batching, are always sent.
+func slashAcksBatchReady(ctx sdk.Context, acks providertypes.SlashAcks, batchPeriod time.Duration) bool {
+	if batchPeriod == 0 || len(acks.Addresses) >= providertypes.MaxSlashAcksPerBatch || acks.BatchStartTime == nil {
+		return true
+	}
+	return !ctx.BlockTime().Before(acks.BatchStartTime.Add(batchPeriod))
 }
 
 // EndBlockCCR contains the EndBlock logic needed for
@@ -473,6 +898,15 @@ func (k Keeper) EndBlockCCR(ctx sdk.Context) {
 				}
 				panic(fmt.Errorf("consumer chain failed to stop: %w", err))
 			}
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					ccv.EventTypeConsumerInitTimeout,
+					sdk.NewAttribute(sdk.AttributeKeyModule, providertypes.ModuleName),
+					sdk.NewAttribute(ccv.AttributeChainID, initTimeoutTimestamp.ChainId),
+					sdk.NewAttribute(ccv.AttributeInitializationTimeout, strconv.FormatUint(initTimeoutTimestamp.Timestamp, 10)),
+				),
+			)
 		}
 	}
 
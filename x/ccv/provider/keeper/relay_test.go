@@ -1,15 +1,22 @@
 package keeper_test
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 	exported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
 	ibcsimapp "github.com/cosmos/interchain-security/legacy_ibc_testing/simapp"
 	"github.com/cosmos/interchain-security/testutil/crypto"
 	cryptotestutil "github.com/cosmos/interchain-security/testutil/crypto"
@@ -20,6 +27,7 @@ import (
 	"github.com/golang/mock/gomock"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmtypes "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/stretchr/testify/require"
 )
@@ -92,6 +100,92 @@ func TestQueueVSCPackets(t *testing.T) {
 	}
 }
 
+// TestQueueVSCPacketsValidatorLeavesBondedSet tests that a validator that left the bonded set
+// of the provider is explicitly removed from the consumer validator set by a zero-power update,
+// both when the staking updates are forwarded and when the updates are computed from the filtered validator set
+func TestQueueVSCPacketsValidatorLeavesBondedSet(t *testing.T) {
+	chainID := "consumer"
+	valA := crypto.NewCryptoIdentityFromIntSeed(1)
+	// the consumer key assigned by validator A
+	valAConsumer := crypto.NewCryptoIdentityFromIntSeed(2)
+	valB := crypto.NewCryptoIdentityFromIntSeed(3)
+	valC := crypto.NewCryptoIdentityFromIntSeed(4)
+
+	for _, filtered := range []bool{false, true} {
+		providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+		providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+		providerKeeper.SetConsumerClientId(ctx, chainID, "clientID")
+		providerKeeper.SetValidatorConsumerPubKey(ctx, chainID, valA.ProviderConsAddress(), valAConsumer.TMProtoCryptoPublicKey())
+		providerKeeper.SetValidatorByConsumerAddr(ctx, chainID, valAConsumer.ConsumerConsAddress(), valA.ProviderConsAddress())
+		providerKeeper.SetConsumerValSet(ctx, chainID, 0, []abci.ValidatorUpdate{
+			{PubKey: valAConsumer.TMProtoCryptoPublicKey(), Power: 1},
+			{PubKey: valB.TMProtoCryptoPublicKey(), Power: 2},
+		})
+
+		// validator A leaves the bonded set, e.g., it is jailed
+		mocks.MockStakingKeeper.EXPECT().GetValidatorUpdates(ctx).Return([]abci.ValidatorUpdate{
+			{PubKey: valA.TMProtoCryptoPublicKey(), Power: 0},
+		}).Times(1)
+		if filtered {
+			// the denylisted validator C is not bonded
+			providerKeeper.SetValidatorDenylist(ctx, chainID, []providertypes.ProviderConsAddress{valC.ProviderConsAddress()})
+			mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, valB.SDKValConsAddress()).Return(
+				valB.SDKStakingValidator(), true).Times(1)
+			mocks.MockStakingKeeper.EXPECT().IterateLastValidatorPowers(ctx, gomock.Any()).DoAndReturn(
+				func(_ sdk.Context, cb func(sdk.ValAddress, int64) bool) {
+					cb(valB.SDKValOpAddress(), 2)
+				}).Times(1)
+			mocks.MockStakingKeeper.EXPECT().GetValidator(ctx, valB.SDKValOpAddress()).Return(
+				valB.SDKStakingValidator(), true).Times(1)
+		}
+
+		providerKeeper.QueueVSCPackets(ctx)
+
+		pending := providerKeeper.GetPendingVSCPackets(ctx, chainID)
+		require.Len(t, pending, 1)
+		require.Equal(t, []abci.ValidatorUpdate{
+			{PubKey: valAConsumer.TMProtoCryptoPublicKey(), Power: 0},
+		}, pending[0].ValidatorUpdates, "filtered: %t", filtered)
+
+		// once the VSC packet is sent, validator A is not in the consumer validator set anymore
+		providerKeeper.ApplyConsumerValSetUpdates(ctx, chainID, pending[0].ValsetUpdateId, pending[0].ValidatorUpdates)
+		providerAddrB := valB.ProviderConsAddress()
+		require.Equal(t, []providertypes.ConsumerValidator{{ProviderAddr: &providerAddrB, Power: 2}},
+			providerKeeper.GetConsumerValSet(ctx, chainID), "filtered: %t", filtered)
+
+		ctrl.Finish()
+	}
+}
+
+// TestCommitValidatorSetUpdate tests that every valset update ID produced
+// at the end of a block is mapped to a non-zero block height
+func TestCommitValidatorSetUpdate(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	mocks.MockStakingKeeper.EXPECT().GetValidatorUpdates(gomock.Any()).Return([]abci.ValidatorUpdate{}).AnyTimes()
+
+	providerKeeper.SetValidatorSetUpdateId(ctx, providertypes.DefaultValsetUpdateID)
+	for height := int64(1); height <= 10; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		providerKeeper.QueueVSCPackets(ctx)
+
+		valUpdateID := providerKeeper.GetValidatorSetUpdateId(ctx)
+		require.Equal(t, providertypes.DefaultValsetUpdateID+uint64(height), valUpdateID)
+		blockHeight, found := providerKeeper.GetValsetUpdateBlockHeight(ctx, valUpdateID)
+		require.True(t, found)
+		require.Equal(t, uint64(height)+2, blockHeight)
+	}
+
+	// every produced valset update ID is mapped to a non-zero block height
+	for valUpdateID := uint64(providertypes.DefaultValsetUpdateID + 1); valUpdateID <= providerKeeper.GetValidatorSetUpdateId(ctx); valUpdateID++ {
+		blockHeight, found := providerKeeper.GetValsetUpdateBlockHeight(ctx, valUpdateID)
+		require.True(t, found)
+		require.NotZero(t, blockHeight)
+	}
+}
+
 // TestOnRecvVSCMaturedPacket tests the OnRecvVSCMaturedPacket method of the keeper.
 // Particularly the behavior that VSC matured packet data should be handled immediately
 // if the pending packet data queue is empty, and should be queued otherwise.
@@ -263,6 +357,15 @@ func TestOnRecvDoubleSignSlashPacket(t *testing.T) {
 	// slash log should be empty for a random validator address in this testcase
 	randomAddress := cryptotestutil.NewCryptoIdentityFromIntSeed(100).ProviderConsAddress()
 	require.False(t, providerKeeper.GetSlashLog(ctx, randomAddress))
+
+	// Receive the double-sign slash packet for chain-2, which opted in to have
+	// its double-sign slash packets applied, and confirm the packet is queued
+	providerKeeper.SetSlashDoubleSigns(ctx, "chain-2", true)
+	ack = executeOnRecvSlashPacket(t, &providerKeeper, ctx, "channel-2", 1, packetData)
+	require.Equal(t, channeltypes.NewResultAcknowledgement([]byte{byte(1)}), ack)
+	require.Equal(t, uint64(0), providerKeeper.GetThrottledPacketDataSize(ctx, "chain-1"))
+	require.Equal(t, uint64(1), providerKeeper.GetThrottledPacketDataSize(ctx, "chain-2"))
+	require.Equal(t, 1, len(providerKeeper.GetAllGlobalSlashEntries(ctx)))
 }
 
 // TestOnRecvSlashPacket tests the OnRecvSlashPacket method specifically for downtime slash packets,
@@ -345,6 +448,126 @@ func executeOnRecvSlashPacket(t *testing.T, providerKeeper *keeper.Keeper, ctx s
 	)
 }
 
+// TestOnRecvPacketUnknownChannel tests that the packets received on a channel
+// that is not a CCV channel panic with ErrUnknownConsumerChannelId
+func TestOnRecvPacketUnknownChannel(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	packet := channeltypes.Packet{DestinationChannel: "unknown-channel"}
+	requirePanicsWithErrorIs(t, providertypes.ErrUnknownConsumerChannelId, func() {
+		providerKeeper.OnRecvVSCMaturedPacket(ctx, packet, ccv.VSCMaturedPacketData{ValsetUpdateId: 1})
+	}, "VSCMaturedPacket")
+	requirePanicsWithErrorIs(t, providertypes.ErrUnknownConsumerChannelId, func() {
+		providerKeeper.OnRecvSlashPacket(ctx, packet, ccv.SlashPacketData{ValsetUpdateId: 1})
+	}, "SlashPacket")
+}
+
+// TestOnRecvSlashPacketNonValidatingChannel tests that the slash packets received
+// on a channel that is not validating a consumer chain are not acted on
+func TestOnRecvSlashPacketNonValidatingChannel(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	packetData := testkeeper.GetNewSlashPacketData()
+	packetData.Infraction = stakingtypes.Downtime
+	providerKeeper.SetValsetUpdateBlockHeight(ctx, packetData.ValsetUpdateId, uint64(15))
+
+	// the handshake of the channel is not completed, i.e., the channel is initializing
+	requirePanicsWithErrorIs(t, providertypes.ErrUnknownConsumerChannelId, func() {
+		executeOnRecvSlashPacket(t, &providerKeeper, ctx, "channel-0", 1, packetData)
+	}, "initializing channel")
+
+	// the channel of a removed consumer chain is invalidated
+	providerKeeper.SetInvalidatedChannel(ctx, "channel-0")
+	ack := executeOnRecvSlashPacket(t, &providerKeeper, ctx, "channel-0", 1, packetData)
+	require.False(t, ack.Success())
+	require.Empty(t, providerKeeper.GetAllGlobalSlashEntries(ctx))
+
+	// the slash packets received on a validating channel are queued
+	providerKeeper.SetChannelToChain(ctx, "channel-1", "chain-1")
+	ack = executeOnRecvSlashPacket(t, &providerKeeper, ctx, "channel-1", 1, packetData)
+	require.True(t, ack.Success())
+	require.Equal(t, uint64(1), providerKeeper.GetThrottledPacketDataSize(ctx, "chain-1"))
+}
+
+// TestOnRecvConsumerRemovalPacket tests that a consumer chain that requests to leave
+// is stopped in the next BeginBlockCCR, and that the unbonding ops waiting on it are released
+func TestOnRecvConsumerRemovalPacket(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	chainID := "chainID"
+	channelID := "channelID"
+	providerKeeper.SetConsumerClientId(ctx, chainID, "clientID")
+	providerKeeper.SetChainToChannel(ctx, chainID, channelID)
+	providerKeeper.SetChannelToChain(ctx, channelID, chainID)
+	providerKeeper.SetUnbondingOp(ctx, providertypes.UnbondingOp{
+		Id:                      1,
+		UnbondingConsumerChains: []string{chainID},
+		Balance:                 sdk.NewInt(10),
+	})
+	providerKeeper.SetUnbondingOpIndex(ctx, chainID, 1, []uint64{1})
+
+	packet := channeltypes.Packet{DestinationChannel: channelID}
+	ack := providerKeeper.OnRecvConsumerRemovalPacket(ctx, packet, *ccv.NewConsumerRemovalPacketData("sunsetting"))
+	require.True(t, ack.Success())
+
+	// the consumer chain is not stopped before the ack is written
+	_, found := providerKeeper.GetConsumerClientId(ctx, chainID)
+	require.True(t, found)
+	props := providerKeeper.GetConsumerRemovalPropsToExecute(ctx)
+	require.Len(t, props, 1)
+	require.Equal(t, chainID, props[0].ChainId)
+	require.Equal(t, "sunsetting", props[0].Description)
+
+	// the consumer chain is stopped and its CCV channel is closed in the next block
+	gomock.InOrder(testkeeper.GetMocksForStopConsumerChain(ctx, &mocks)...)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(5 * time.Second))
+	providerKeeper.BeginBlockCCR(ctx)
+
+	_, found = providerKeeper.GetConsumerClientId(ctx, chainID)
+	require.False(t, found)
+	_, found = providerKeeper.GetChannelToChain(ctx, channelID)
+	require.False(t, found)
+	require.Empty(t, providerKeeper.GetConsumerRemovalPropsToExecute(ctx))
+
+	// the unbonding op is no longer waiting on the consumer chain
+	_, found = providerKeeper.GetUnbondingOpIndex(ctx, chainID, 1)
+	require.False(t, found)
+	require.Equal(t, []uint64{1}, providerKeeper.ConsumeMaturedUnbondingOps(ctx))
+}
+
+// TestOnRecvConsumerRemovalPacketNonValidatingChannel tests that the consumer removal packets
+// that are not received on the CCV channel of a validating consumer chain are not acted on
+func TestOnRecvConsumerRemovalPacketNonValidatingChannel(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerKeeper.SetConsumerClientId(ctx, "chain-1", "client-1")
+	providerKeeper.SetChainToChannel(ctx, "chain-1", "channel-1")
+	providerKeeper.SetChannelToChain(ctx, "channel-1", "chain-1")
+	// channel-2 is mapped to chain-1, but it is not the CCV channel of chain-1
+	providerKeeper.SetChannelToChain(ctx, "channel-2", "chain-1")
+	providerKeeper.SetInvalidatedChannel(ctx, "channel-3")
+
+	data := *ccv.NewConsumerRemovalPacketData("leaving")
+	for _, channelID := range []string{"unknown-channel", "channel-2", "channel-3"} {
+		packet := channeltypes.Packet{DestinationChannel: channelID}
+		ack := providerKeeper.OnRecvConsumerRemovalPacket(ctx, packet, data)
+		require.False(t, ack.Success(), channelID)
+	}
+	require.Empty(t, providerKeeper.GetConsumerRemovalPropsToExecute(ctx))
+
+	// a removal request with an invalid reason is not acted on either
+	packet := channeltypes.Packet{DestinationChannel: "channel-1"}
+	invalidData := *ccv.NewConsumerRemovalPacketData(strings.Repeat("a", ccv.MaxConsumerRemovalReasonLength+1))
+	ack := providerKeeper.OnRecvConsumerRemovalPacket(ctx, packet, invalidData)
+	require.False(t, ack.Success())
+	require.Empty(t, providerKeeper.GetConsumerRemovalPropsToExecute(ctx))
+}
+
 // TestValidateSlashPacket tests ValidateSlashPacket.
 func TestValidateSlashPacket(t *testing.T) {
 
@@ -353,29 +576,29 @@ func TestValidateSlashPacket(t *testing.T) {
 	testCases := []struct {
 		name       string
 		packetData ccv.SlashPacketData
-		expectErr  bool
+		expErr     error
 	}{
 		{"no block height found for given vscID",
 			ccv.SlashPacketData{ValsetUpdateId: 61},
-			true},
+			providertypes.ErrUnknownInfractionHeight},
 		{"non-set infraction type",
 			ccv.SlashPacketData{ValsetUpdateId: validVscID},
-			true},
+			providertypes.ErrInvalidInfractionType},
 		{"invalid infraction type",
 			ccv.SlashPacketData{ValsetUpdateId: validVscID, Infraction: stakingtypes.MaxMonikerLength},
-			true},
+			providertypes.ErrInvalidInfractionType},
 		{"valid double sign packet with non-zero vscID",
 			ccv.SlashPacketData{ValsetUpdateId: validVscID, Infraction: stakingtypes.DoubleSign},
-			false},
+			nil},
 		{"valid downtime packet with non-zero vscID",
 			ccv.SlashPacketData{ValsetUpdateId: validVscID, Infraction: stakingtypes.Downtime},
-			false},
+			nil},
 		{"valid double sign packet with zero vscID",
 			ccv.SlashPacketData{ValsetUpdateId: 0, Infraction: stakingtypes.DoubleSign},
-			false},
+			nil},
 		{"valid downtime packet with zero vscID",
 			ccv.SlashPacketData{ValsetUpdateId: 0, Infraction: stakingtypes.Downtime},
-			false},
+			nil},
 	}
 
 	for _, tc := range testCases {
@@ -396,8 +619,8 @@ func TestValidateSlashPacket(t *testing.T) {
 
 		// Test error behavior as specified in tc.
 		err := providerKeeper.ValidateSlashPacket(ctx, "consumer-chain-id", packet, tc.packetData)
-		if tc.expectErr {
-			require.Error(t, err, "expected error in case: '%s'", tc.name)
+		if tc.expErr != nil {
+			require.ErrorIs(t, err, tc.expErr, "expected error in case: '%s'", tc.name)
 		} else {
 			require.NoError(t, err, "unexpected error in case: '%s'", tc.name)
 		}
@@ -521,13 +744,49 @@ func TestHandleSlashPacket(t *testing.T) {
 			},
 			1,
 		},
-		// Note: double-sign slash packet handling should not occur, see OnRecvSlashPacket.
+		{
+			"full double-sign packet handling, uses valid vscID and non-jailed validator",
+			*ccv.NewSlashPacketData(
+				tmtypes.Validator{Address: consumerConsAddr.ToSdkConsAddr()},
+				validVscID,
+				stakingtypes.DoubleSign),
+			func(ctx sdk.Context, mocks testkeeper.MockedKeepers,
+				expectedPacketData ccv.SlashPacketData,
+			) []*gomock.Call {
+				return testkeeper.GetMocksForHandleDoubleSignSlashPacket(
+					ctx, mocks,
+					providerConsAddr, // expected provider cons addr returned from GetProviderAddrFromConsumerAddr
+					stakingtypes.Validator{Jailed: false, Tokens: sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)},
+					99) // expected infraction height mapped to validVscID
+			},
+			1,
+		},
+		{
+			"full double-sign packet handling, uses init chain height and jailed validator",
+			*ccv.NewSlashPacketData(
+				tmtypes.Validator{Address: consumerConsAddr.ToSdkConsAddr()},
+				0, // ValsetUpdateId = 0 uses init chain height.
+				stakingtypes.DoubleSign),
+			func(ctx sdk.Context, mocks testkeeper.MockedKeepers,
+				expectedPacketData ccv.SlashPacketData,
+			) []*gomock.Call {
+				return testkeeper.GetMocksForHandleDoubleSignSlashPacket(
+					ctx, mocks,
+					providerConsAddr,                     // expected provider cons addr returned from GetProviderAddrFromConsumerAddr
+					stakingtypes.Validator{Jailed: true}, // staking keeper val to return
+					5)                                    // expected infraction height is the init chain height
+			},
+			1,
+		},
+		// Note: double-sign slash packets are handled only if the consumer chain
+		// opted in to have them applied, see OnRecvSlashPacket.
 	}
 
 	for _, tc := range testCases {
 
 		providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(
 			t, testkeeper.NewInMemKeeperParams(t))
+		providerKeeper.SetParams(ctx, providertypes.DefaultParams())
 
 		// Setup expected mock calls
 		gomock.InOrder(tc.expectedCalls(ctx, mocks, tc.packetData)...)
@@ -546,6 +805,8 @@ func TestHandleSlashPacket(t *testing.T) {
 		require.Equal(t, tc.expectedSlashAcksLen, len(providerKeeper.GetSlashAcks(ctx, chainId)))
 
 		if tc.expectedSlashAcksLen == 1 {
+			// must record the infraction type
+			require.Equal(t, tc.packetData.Infraction, providerKeeper.GetSlashAckInfractions(ctx, chainId)[0])
 			// must match the consumer address
 			require.Equal(t, consumerConsAddr.String(), providerKeeper.GetSlashAcks(ctx, chainId)[0])
 			require.NotEqual(t, providerConsAddr.String(), providerKeeper.GetSlashAcks(ctx, chainId)[0])
@@ -556,11 +817,540 @@ func TestHandleSlashPacket(t *testing.T) {
 	}
 }
 
+// TestHandleSlashPacketConcurrentDowntime tests that a validator down on multiple consumer chains
+// is jailed only once, i.e., the slash packets for infractions committed before the validator was jailed
+// are acked without jailing it again, while later infractions jail it again once it is unjailed.
+func TestHandleSlashPacketConcurrentDowntime(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	val := crypto.NewCryptoIdentityFromIntSeed(7842334)
+	providerConsAddr := val.ProviderConsAddress()
+	chainIDs := []string{"consumer-a", "consumer-b"}
+	for _, chainID := range chainIDs {
+		providerKeeper.SetInitChainHeight(ctx, chainID, 5)
+	}
+	for vscID := uint64(1); vscID <= 12; vscID++ {
+		providerKeeper.SetValsetUpdateBlockHeight(ctx, vscID, 10+vscID)
+	}
+	downtimePacket := func(vscID uint64) ccv.SlashPacketData {
+		return *ccv.NewSlashPacketData(tmtypes.Validator{Address: val.SDKValConsAddress()}, vscID, stakingtypes.Downtime)
+	}
+
+	// the validator is down on both consumer chains and is jailed by the slash packet of the first chain
+	providerKeeper.SetValidatorSetUpdateId(ctx, 10)
+	gomock.InOrder(testkeeper.GetMocksForHandleSlashPacket(
+		ctx, mocks, providerConsAddr, stakingtypes.Validator{Jailed: false}, true)...)
+	providerKeeper.HandleSlashPacket(ctx, chainIDs[0], downtimePacket(8))
+	require.Len(t, providerKeeper.ConsumeSlashAcks(ctx, chainIDs[0]), 1)
+	jailVscID, found := providerKeeper.GetValidatorJailRecord(ctx, providerConsAddr)
+	require.True(t, found)
+	require.Equal(t, uint64(10), jailVscID)
+
+	// the validator is unjailed before the slash packet of the second chain is handled;
+	// the infraction was committed before the validator was jailed, so it is not jailed again
+	gomock.InOrder(testkeeper.GetMocksForHandleSlashPacket(
+		ctx, mocks, providerConsAddr, stakingtypes.Validator{Jailed: false}, false)...)
+	providerKeeper.HandleSlashPacket(ctx, chainIDs[1], downtimePacket(9))
+	require.Len(t, providerKeeper.ConsumeSlashAcks(ctx, chainIDs[1]), 1)
+
+	// the same holds for an infraction committed at the jailing valset update ID
+	gomock.InOrder(testkeeper.GetMocksForHandleSlashPacket(
+		ctx, mocks, providerConsAddr, stakingtypes.Validator{Jailed: false}, false)...)
+	providerKeeper.HandleSlashPacket(ctx, chainIDs[1], downtimePacket(10))
+	require.Len(t, providerKeeper.ConsumeSlashAcks(ctx, chainIDs[1]), 1)
+
+	// an infraction committed after the validator was unjailed jails it again
+	providerKeeper.SetValidatorSetUpdateId(ctx, 12)
+	gomock.InOrder(testkeeper.GetMocksForHandleSlashPacket(
+		ctx, mocks, providerConsAddr, stakingtypes.Validator{Jailed: false}, true)...)
+	providerKeeper.HandleSlashPacket(ctx, chainIDs[1], downtimePacket(11))
+	require.Len(t, providerKeeper.ConsumeSlashAcks(ctx, chainIDs[1]), 1)
+	jailVscID, found = providerKeeper.GetValidatorJailRecord(ctx, providerConsAddr)
+	require.True(t, found)
+	require.Equal(t, uint64(12), jailVscID)
+
+	// an infraction committed before the consumer chain received any VSC packet is always applied
+	gomock.InOrder(testkeeper.GetMocksForHandleSlashPacket(
+		ctx, mocks, providerConsAddr, stakingtypes.Validator{Jailed: false}, true)...)
+	providerKeeper.HandleSlashPacket(ctx, chainIDs[0], downtimePacket(0))
+	require.Len(t, providerKeeper.ConsumeSlashAcks(ctx, chainIDs[0]), 1)
+}
+
+// TestHandleSlashPacketDowntimeReplay tests that a downtime slash packet for an infraction
+// committed at or before the height of the last applied downtime infraction of the validator
+// on the same consumer chain is acked without being applied again
+func TestHandleSlashPacketDowntimeReplay(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	chainID := "consumer"
+	val := crypto.NewCryptoIdentityFromIntSeed(7842334)
+	providerConsAddr := val.ProviderConsAddress()
+	for vscID := uint64(1); vscID <= 10; vscID++ {
+		providerKeeper.SetValsetUpdateBlockHeight(ctx, vscID, 10+vscID)
+	}
+	downtimePacket := func(vscID uint64) ccv.SlashPacketData {
+		return *ccv.NewSlashPacketData(tmtypes.Validator{Address: val.SDKValConsAddress()}, vscID, stakingtypes.Downtime)
+	}
+	// the jail record is deleted after every jailing,
+	// so that only the last downtime infraction height prevents the replays
+	handleSlashPacket := func(vscID uint64, expectJailing bool) {
+		gomock.InOrder(testkeeper.GetMocksForHandleSlashPacket(
+			ctx, mocks, providerConsAddr, stakingtypes.Validator{Jailed: false}, expectJailing)...)
+		providerKeeper.HandleSlashPacket(ctx, chainID, downtimePacket(vscID))
+		require.Len(t, providerKeeper.ConsumeSlashAcks(ctx, chainID), 1)
+		providerKeeper.DeleteValidatorJailRecord(ctx, providerConsAddr)
+	}
+
+	// the first infraction is applied
+	providerKeeper.SetValidatorSetUpdateId(ctx, 8)
+	handleSlashPacket(5, true)
+	height, found := providerKeeper.GetLastDowntimeInfractionHeight(ctx, chainID, providerConsAddr)
+	require.True(t, found)
+	require.Equal(t, uint64(15), height)
+
+	// the replays of the infraction and the older infractions are acked but not applied
+	handleSlashPacket(5, false)
+	handleSlashPacket(4, false)
+
+	// a later infraction is applied
+	handleSlashPacket(6, true)
+	height, found = providerKeeper.GetLastDowntimeInfractionHeight(ctx, chainID, providerConsAddr)
+	require.True(t, found)
+	require.Equal(t, uint64(16), height)
+
+	// the last downtime infraction height is tracked per consumer chain
+	_, found = providerKeeper.GetLastDowntimeInfractionHeight(ctx, "other-consumer", providerConsAddr)
+	require.False(t, found)
+}
+
+// TestHandleSlashPacketSlashFractions tests that the slash packets of consumer chains
+// slash the validators with the slash fraction of the provider params for their infraction type
+func TestHandleSlashPacketSlashFractions(t *testing.T) {
+	chainID := "consumer"
+	vscID := uint64(4)
+	infractionHeight := int64(99)
+	val := crypto.NewCryptoIdentityFromIntSeed(7842334)
+	consAddr := val.SDKValConsAddress()
+	validator := stakingtypes.Validator{Jailed: false, Tokens: sdk.NewInt(1000000)}
+	power := validator.ConsensusPower(sdk.DefaultPowerReduction)
+
+	testCases := []struct {
+		name                  string
+		infraction            stakingtypes.InfractionType
+		slashFractionDowntime string
+		// the slash fraction expected to be applied, empty if the validator is not slashed
+		expectedFraction string
+	}{
+		{"downtime, default downtime slash fraction", stakingtypes.Downtime, providertypes.DefaultSlashFractionDowntime, ""},
+		{"downtime, custom downtime slash fraction", stakingtypes.Downtime, "0.01", "0.01"},
+		{"double-sign, custom double-sign slash fraction", stakingtypes.DoubleSign, "0.01", "0.1"},
+	}
+
+	for _, tc := range testCases {
+		providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+		params := providertypes.DefaultParams()
+		params.SlashFractionDoubleSign = "0.1"
+		params.SlashFractionDowntime = tc.slashFractionDowntime
+		providerKeeper.SetParams(ctx, params)
+		providerKeeper.SetValsetUpdateBlockHeight(ctx, vscID, uint64(infractionHeight))
+
+		calls := []*gomock.Call{
+			mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, consAddr).Return(validator, true).Times(1),
+			mocks.MockSlashingKeeper.EXPECT().IsTombstoned(ctx, consAddr).Return(false).Times(1),
+		}
+		if tc.expectedFraction != "" {
+			calls = append(calls,
+				mocks.MockStakingKeeper.EXPECT().PowerReduction(ctx).Return(sdk.DefaultPowerReduction).Times(1),
+				mocks.MockStakingKeeper.EXPECT().Slash(ctx, consAddr, infractionHeight, power,
+					sdk.MustNewDecFromStr(tc.expectedFraction), tc.infraction).Times(1),
+			)
+		}
+		calls = append(calls, mocks.MockStakingKeeper.EXPECT().Jail(ctx, consAddr).Times(1))
+		if tc.infraction == stakingtypes.Downtime {
+			calls = append(calls,
+				mocks.MockSlashingKeeper.EXPECT().DowntimeJailDuration(ctx).Return(time.Hour).Times(1),
+				mocks.MockSlashingKeeper.EXPECT().JailUntil(ctx, consAddr, gomock.Any()).Times(1),
+			)
+		} else {
+			calls = append(calls,
+				mocks.MockSlashingKeeper.EXPECT().JailUntil(ctx, consAddr, gomock.Any()).Times(1),
+				mocks.MockSlashingKeeper.EXPECT().Tombstone(ctx, consAddr).Times(1),
+			)
+		}
+		gomock.InOrder(calls...)
+
+		providerKeeper.HandleSlashPacket(ctx, chainID, *ccv.NewSlashPacketData(
+			tmtypes.Validator{Address: consAddr}, vscID, tc.infraction))
+
+		ctrl.Finish()
+	}
+}
+
+// TestSendSlashConfirmation tests that a slash confirmation packet is sent
+// to a consumer chain only when slash confirmations are enabled for that chain.
+func TestSendSlashConfirmation(t *testing.T) {
+	chainId := "consumer-id"
+	channelId := "channel-0"
+	validVscID := uint64(4)
+	providerConsAddr := crypto.NewCryptoIdentityFromIntSeed(7842334).ProviderConsAddress()
+	consumerConsAddr := crypto.NewCryptoIdentityFromIntSeed(784987634).ConsumerConsAddress()
+	packetData := *ccv.NewSlashPacketData(
+		tmtypes.Validator{Address: consumerConsAddr.ToSdkConsAddr()},
+		validVscID,
+		stakingtypes.Downtime)
+
+	testCases := []struct {
+		name                 string
+		enabled              bool
+		batchPeriod          time.Duration
+		expectSent           bool
+		expectedSlashAcksLen int
+	}{
+		{"slash confirmations disabled", false, 0, false, 2},
+		{"slash confirmations enabled", true, 0, true, 0},
+		// the slash acks are sent in EndBlock once the batch period elapsed
+		{"slash confirmations enabled with batched slash acks", true, time.Hour, false, 2},
+	}
+
+	for _, tc := range testCases {
+		providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(
+			t, testkeeper.NewInMemKeeperParams(t))
+		providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+		providerKeeper.SetSlashAckBatchPeriod(ctx, tc.batchPeriod)
+
+		providerKeeper.SetValidatorSetUpdateId(ctx, 5)
+		providerKeeper.SetValsetUpdateBlockHeight(ctx, validVscID, 99)
+		providerKeeper.SetChainToChannel(ctx, chainId, channelId)
+		providerKeeper.SetValidatorByConsumerAddr(ctx, chainId, consumerConsAddr, providerConsAddr)
+		providerKeeper.SetSendSlashConfirmations(ctx, chainId, tc.enabled)
+		providerKeeper.AppendSlashAck(ctx, chainId, "some-ack", stakingtypes.Downtime)
+
+		calls := testkeeper.GetMocksForHandleSlashPacket(
+			ctx, mocks,
+			providerConsAddr,
+			stakingtypes.Validator{Jailed: false},
+			true)
+		if tc.expectSent {
+			calls = append(calls,
+				mocks.MockChannelKeeper.EXPECT().GetChannel(
+					ctx, ccv.ProviderPortID, channelId).Return(channeltypes.Channel{}, true).Times(1),
+				mocks.MockScopedKeeper.EXPECT().GetCapability(ctx, gomock.Any()).Return(nil, true).Times(1),
+				mocks.MockChannelKeeper.EXPECT().GetNextSequenceSend(
+					ctx, ccv.ProviderPortID, channelId).Return(uint64(8), true).Times(1),
+				mocks.MockChannelKeeper.EXPECT().SendPacket(ctx, gomock.Any(), gomock.Any()).Return(nil).Times(1),
+			)
+		}
+		gomock.InOrder(calls...)
+
+		providerKeeper.HandleSlashPacket(ctx, chainId, packetData)
+
+		require.Equal(t, tc.expectedSlashAcksLen, len(providerKeeper.GetSlashAcks(ctx, chainId)), tc.name)
+		seq, found := providerKeeper.GetSlashConfirmationSeq(ctx, chainId)
+		require.Equal(t, tc.expectSent, found, tc.name)
+		if tc.expectSent {
+			require.Equal(t, uint64(8), seq, tc.name)
+		}
+
+		ctrl.Finish()
+	}
+}
+
+// TestSendPendingSlashAcks tests that the slash acks of a consumer chain accumulate
+// across blocks until its CCV channel is established and no VSC packets are pending,
+// and are cleared once they are sent
+func TestSendPendingSlashAcks(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	chainID := "consumer"
+	channelID := "channel-0"
+	providerKeeper.SetConsumerClientId(ctx, chainID, "clientID")
+	providerKeeper.SetValidatorSetUpdateId(ctx, 5)
+
+	// the slash acks accumulate while the CCV channel is not established
+	providerKeeper.AppendSlashAck(ctx, chainID, "ack-1", stakingtypes.Downtime)
+	providerKeeper.SendPendingSlashAcks(ctx)
+	providerKeeper.AppendSlashAck(ctx, chainID, "ack-2", stakingtypes.Downtime)
+	providerKeeper.SendPendingSlashAcks(ctx)
+	require.Equal(t, []string{"ack-1", "ack-2"}, providerKeeper.GetSlashAcks(ctx, chainID))
+
+	providerKeeper.SetChainToChannel(ctx, chainID, channelID)
+
+	// the slash acks remain stored while VSC packets are pending for the consumer chain
+	providerKeeper.AppendPendingVSCPackets(ctx, chainID, ccv.ValidatorSetChangePacketData{ValsetUpdateId: 4})
+	providerKeeper.SendPendingSlashAcks(ctx)
+	require.Equal(t, []string{"ack-1", "ack-2"}, providerKeeper.GetSlashAcks(ctx, chainID))
+	providerKeeper.DeletePendingVSCPackets(ctx, chainID)
+
+	// the slash acks remain stored if they cannot be sent
+	gomock.InOrder(
+		mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "clientID").Return(nil, false).Times(1),
+		mocks.MockChannelKeeper.EXPECT().GetChannel(
+			ctx, ccv.ProviderPortID, channelID).Return(channeltypes.Channel{}, false).Times(1),
+	)
+	providerKeeper.SendPendingSlashAcks(ctx)
+	require.Equal(t, []string{"ack-1", "ack-2"}, providerKeeper.GetSlashAcks(ctx, chainID))
+
+	// the slash acks are sent in a single packet and cleared
+	gomock.InOrder(
+		mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "clientID").Return(nil, false).Times(1),
+		mocks.MockChannelKeeper.EXPECT().GetChannel(
+			ctx, ccv.ProviderPortID, channelID).Return(channeltypes.Channel{}, true).Times(1),
+		mocks.MockScopedKeeper.EXPECT().GetCapability(ctx, gomock.Any()).Return(nil, true).Times(1),
+		mocks.MockChannelKeeper.EXPECT().GetNextSequenceSend(
+			ctx, ccv.ProviderPortID, channelID).Return(uint64(8), true).Times(1),
+		mocks.MockChannelKeeper.EXPECT().SendPacket(ctx, gomock.Any(), gomock.Any()).Return(nil).Times(1),
+	)
+	providerKeeper.SendPendingSlashAcks(ctx)
+	require.Empty(t, providerKeeper.GetSlashAcks(ctx, chainID))
+	seq, found := providerKeeper.GetSlashConfirmationSeq(ctx, chainID)
+	require.True(t, found)
+	require.Equal(t, uint64(8), seq)
+
+	// no packet is sent without slash acks
+	providerKeeper.SendPendingSlashAcks(ctx)
+}
+
+// TestSendPendingSlashAcksBatched tests that batched slash acks are sent only once
+// the batch period elapsed since the first slash ack, or once the batch is full
+func TestSendPendingSlashAcksBatched(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+	providerKeeper.SetSlashAckBatchPeriod(ctx, time.Minute)
+
+	chainID := "consumer"
+	channelID := "channel-0"
+	providerKeeper.SetConsumerClientId(ctx, chainID, "clientID")
+	providerKeeper.SetChainToChannel(ctx, chainID, channelID)
+	providerKeeper.SetValidatorSetUpdateId(ctx, 5)
+
+	expectSend := func(seq uint64) {
+		gomock.InOrder(
+			mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "clientID").Return(nil, false).Times(1),
+			mocks.MockChannelKeeper.EXPECT().GetChannel(
+				ctx, ccv.ProviderPortID, channelID).Return(channeltypes.Channel{}, true).Times(1),
+			mocks.MockScopedKeeper.EXPECT().GetCapability(ctx, gomock.Any()).Return(nil, true).Times(1),
+			mocks.MockChannelKeeper.EXPECT().GetNextSequenceSend(
+				ctx, ccv.ProviderPortID, channelID).Return(seq, true).Times(1),
+			mocks.MockChannelKeeper.EXPECT().SendPacket(ctx, gomock.Any(), gomock.Any()).Return(nil).Times(1),
+		)
+	}
+
+	// the batch starts with the first slash ack
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(start)
+	providerKeeper.AppendSlashAck(ctx, chainID, "ack-1", stakingtypes.Downtime)
+	providerKeeper.SendPendingSlashAcks(ctx)
+
+	// later slash acks do not extend the batch
+	ctx = ctx.WithBlockTime(start.Add(30 * time.Second))
+	providerKeeper.AppendSlashAck(ctx, chainID, "ack-2", stakingtypes.DoubleSign)
+	providerKeeper.SendPendingSlashAcks(ctx)
+	require.Equal(t, []string{"ack-1", "ack-2"}, providerKeeper.GetSlashAcks(ctx, chainID))
+
+	// the slash acks are sent, in order, once the batch period elapsed
+	ctx = ctx.WithBlockTime(start.Add(time.Minute))
+	expectSend(1)
+	providerKeeper.SendPendingSlashAcks(ctx)
+	require.Empty(t, providerKeeper.GetSlashAcks(ctx, chainID))
+
+	// a full batch is sent before the batch period elapsed
+	for i := 0; i < providertypes.MaxSlashAcksPerBatch-1; i++ {
+		providerKeeper.AppendSlashAck(ctx, chainID, fmt.Sprintf("ack-%d", i), stakingtypes.Downtime)
+	}
+	providerKeeper.SendPendingSlashAcks(ctx)
+	require.Len(t, providerKeeper.GetSlashAcks(ctx, chainID), providertypes.MaxSlashAcksPerBatch-1)
+	providerKeeper.AppendSlashAck(ctx, chainID, "last-ack", stakingtypes.Downtime)
+	expectSend(2)
+	providerKeeper.SendPendingSlashAcks(ctx)
+	require.Empty(t, providerKeeper.GetSlashAcks(ctx, chainID))
+
+	// slash acks without batch start time are sent right away
+	providerKeeper.SetSlashAcks(ctx, chainID, []string{"legacy-ack"})
+	expectSend(3)
+	providerKeeper.SendPendingSlashAcks(ctx)
+	require.Empty(t, providerKeeper.GetSlashAcks(ctx, chainID))
+}
+
+// TestSendSlashConfirmationWithheld tests that no slash confirmation is sent to a consumer chain
+// with pending VSC packets, that is paused or whose client is not active, and that its slash acks
+// remain stored until they can be sent
+func TestSendSlashConfirmationWithheld(t *testing.T) {
+	chainID := "consumer"
+	channelID := "channel-0"
+	clientID := "clientID"
+
+	testCases := []struct {
+		name  string
+		setup func(sdk.Context, *keeper.Keeper, testkeeper.MockedKeepers, prefix.Store)
+	}{
+		{
+			"pending VSC packets",
+			func(ctx sdk.Context, k *keeper.Keeper, mocks testkeeper.MockedKeepers, _ prefix.Store) {
+				k.AppendPendingVSCPackets(ctx, chainID, ccv.ValidatorSetChangePacketData{ValsetUpdateId: 4})
+			},
+		},
+		{
+			"paused consumer chain",
+			func(ctx sdk.Context, k *keeper.Keeper, mocks testkeeper.MockedKeepers, _ prefix.Store) {
+				k.SetConsumerChainPaused(ctx, chainID, true)
+			},
+		},
+		{
+			"expired client",
+			func(ctx sdk.Context, k *keeper.Keeper, mocks testkeeper.MockedKeepers, clientStore prefix.Store) {
+				// no consensus state is stored in the client store, i.e., the client is expired
+				mocks.MockClientKeeper.EXPECT().GetClientState(ctx, clientID).Return(&ibctmtypes.ClientState{}, true).Times(1)
+				mocks.MockClientKeeper.EXPECT().ClientStore(ctx, clientID).Return(clientStore).Times(1)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		keeperParams := testkeeper.NewInMemKeeperParams(t)
+		providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, keeperParams)
+		providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+		providerKeeper.SetConsumerClientId(ctx, chainID, clientID)
+		providerKeeper.SetChainToChannel(ctx, chainID, channelID)
+		providerKeeper.SetValidatorSetUpdateId(ctx, 5)
+		providerKeeper.AppendSlashAck(ctx, chainID, "ack-1", stakingtypes.Downtime)
+
+		clientStore := prefix.NewStore(ctx.KVStore(keeperParams.StoreKey), []byte("client"))
+		tc.setup(ctx, &providerKeeper, mocks, clientStore)
+
+		// no packet is sent
+		providerKeeper.SendSlashConfirmation(ctx, chainID)
+		require.Equal(t, []string{"ack-1"}, providerKeeper.GetSlashAcks(ctx, chainID), tc.name)
+		_, found := providerKeeper.GetSlashConfirmationSeq(ctx, chainID)
+		require.False(t, found, tc.name)
+		// the client is not considered inactive by the slash confirmation
+		_, found = providerKeeper.GetClientInactiveTimestamp(ctx, chainID)
+		require.False(t, found, tc.name)
+
+		ctrl.Finish()
+	}
+}
+
+// TestPacketSequenceGap tests that the provider tracks the packets sent to a consumer chain
+// and not yet acknowledged, e.g., because the relayer of the CCV channel lags behind
+func TestPacketSequenceGap(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	chainID := "consumer"
+	channelID := "channel-0"
+	providerKeeper.SetChainToChannel(ctx, chainID, channelID)
+	providerKeeper.SetChannelToChain(ctx, channelID, chainID)
+	providerKeeper.SetValidatorSetUpdateId(ctx, 5)
+
+	// no packet was sent yet
+	_, found := providerKeeper.GetLastSentSequence(ctx, chainID)
+	require.False(t, found)
+	require.Equal(t, uint64(0), providerKeeper.GetPacketSequenceGap(ctx, chainID))
+
+	// send three packets
+	for seq := uint64(1); seq <= 3; seq++ {
+		providerKeeper.AppendSlashAck(ctx, chainID, "ack", stakingtypes.Downtime)
+		gomock.InOrder(
+			mocks.MockChannelKeeper.EXPECT().GetChannel(
+				ctx, ccv.ProviderPortID, channelID).Return(channeltypes.Channel{}, true).Times(1),
+			mocks.MockScopedKeeper.EXPECT().GetCapability(ctx, gomock.Any()).Return(nil, true).Times(1),
+			mocks.MockChannelKeeper.EXPECT().GetNextSequenceSend(
+				ctx, ccv.ProviderPortID, channelID).Return(seq, true).Times(1),
+			mocks.MockChannelKeeper.EXPECT().SendPacket(ctx, gomock.Any(), gomock.Any()).Return(nil).Times(1),
+		)
+		providerKeeper.SendSlashConfirmation(ctx, chainID)
+	}
+	lastSent, found := providerKeeper.GetLastSentSequence(ctx, chainID)
+	require.True(t, found)
+	require.Equal(t, uint64(3), lastSent)
+	require.Equal(t, uint64(3), providerKeeper.GetPacketSequenceGap(ctx, chainID))
+
+	// the first packet is acknowledged
+	packet := channeltypes.Packet{SourceChannel: channelID, Sequence: 1}
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	require.NoError(t, providerKeeper.OnAcknowledgementPacket(ctx, packet, ack))
+	lastAcked, found := providerKeeper.GetLastAckedSequence(ctx, chainID)
+	require.True(t, found)
+	require.Equal(t, uint64(1), lastAcked)
+	require.Equal(t, uint64(2), providerKeeper.GetPacketSequenceGap(ctx, chainID))
+
+	// the remaining packets are acknowledged
+	packet.Sequence = 3
+	require.NoError(t, providerKeeper.OnAcknowledgementPacket(ctx, packet, ack))
+	require.Equal(t, uint64(0), providerKeeper.GetPacketSequenceGap(ctx, chainID))
+}
+
+// TestConsumerLiveness tests that the provider records the last block at which it heard from
+// a consumer chain, and reports the chain as inactive once the liveness window elapsed since then
+func TestConsumerLiveness(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+	providerKeeper.SetConsumerLivenessWindow(ctx, time.Hour)
+
+	chainID := "consumer"
+	channelID := "channel-0"
+	providerKeeper.SetChannelToChain(ctx, channelID, chainID)
+
+	// no activity is recorded before the CCV channel is established
+	_, found := providerKeeper.GetLastConsumerActivity(ctx, chainID)
+	require.False(t, found)
+
+	// the consumer chain acknowledges a packet
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(start)
+	packet := channeltypes.Packet{SourceChannel: channelID, Sequence: 1}
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	require.NoError(t, providerKeeper.OnAcknowledgementPacket(ctx, packet, ack))
+	activity, found := providerKeeper.GetLastConsumerActivity(ctx, chainID)
+	require.True(t, found)
+	require.Equal(t, providertypes.ConsumerActivity{Height: 10, Time: start}, activity)
+
+	// the consumer chain is active within the liveness window
+	ctx = ctx.WithBlockHeight(20).WithBlockTime(start.Add(time.Hour - time.Second))
+	require.False(t, providerKeeper.IsConsumerInactive(ctx, activity))
+
+	// the consumer chain is inactive once the liveness window elapsed
+	ctx = ctx.WithBlockHeight(30).WithBlockTime(start.Add(time.Hour))
+	require.True(t, providerKeeper.IsConsumerInactive(ctx, activity))
+	res, err := providerKeeper.QueryConsumerLiveness(sdk.WrapSDKContext(ctx),
+		&providertypes.QueryConsumerLivenessRequest{ChainId: chainID})
+	require.NoError(t, err)
+	require.Equal(t, time.Hour, res.InactiveDuration)
+	require.True(t, res.Inactive)
+
+	// consumer chains are never inactive if the liveness window is zero
+	providerKeeper.SetConsumerLivenessWindow(ctx, 0)
+	require.False(t, providerKeeper.IsConsumerInactive(ctx, activity))
+	providerKeeper.SetConsumerLivenessWindow(ctx, time.Hour)
+
+	// a VSCMatured packet received from the consumer chain makes it active again
+	executeOnRecvVSCMaturedPacket(t, &providerKeeper, ctx, channelID, 1)
+	activity, found = providerKeeper.GetLastConsumerActivity(ctx, chainID)
+	require.True(t, found)
+	require.Equal(t, int64(30), activity.Height)
+	require.False(t, providerKeeper.IsConsumerInactive(ctx, activity))
+
+	providerKeeper.DeleteLastConsumerActivity(ctx, chainID)
+	_, found = providerKeeper.GetLastConsumerActivity(ctx, chainID)
+	require.False(t, found)
+}
+
 // TestHandleVSCMaturedPacket tests the handling of VSCMatured packets.
 // Note that this method also tests the behaviour of AfterUnbondingInitiated.
 func TestHandleVSCMaturedPacket(t *testing.T) {
 	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
 	defer ctrl.Finish()
+	pk.SetParams(ctx, providertypes.DefaultParams())
 
 	// Init vscID
 	pk.SetValidatorSetUpdateId(ctx, 1)
@@ -583,6 +1373,13 @@ func TestHandleVSCMaturedPacket(t *testing.T) {
 	// Start second unbonding
 	unbondingOpId = 2
 	gomock.InOrder(
+		mocks.MockStakingKeeper.EXPECT().GetUnbondingType(ctx, unbondingOpId).Return(
+			stakingtypes.UnbondingType_UnbondingDelegation, true),
+		mocks.MockStakingKeeper.EXPECT().GetUnbondingDelegationByUnbondingID(ctx, unbondingOpId).Return(
+			stakingtypes.UnbondingDelegation{Entries: []stakingtypes.UnbondingDelegationEntry{
+				{UnbondingId: 1, Balance: sdk.NewInt(7)},
+				{UnbondingId: unbondingOpId, Balance: sdk.NewInt(100)},
+			}}, true),
 		mocks.MockStakingKeeper.EXPECT().PutUnbondingOnHold(ctx, unbondingOpId).Return(nil),
 	)
 	err = pk.Hooks().AfterUnbondingInitiated(ctx, unbondingOpId)
@@ -593,6 +1390,7 @@ func TestHandleVSCMaturedPacket(t *testing.T) {
 	require.True(t, found)
 	require.Equal(t, unbondingOpId, unbondingOp.Id)
 	require.Equal(t, expectedChains, unbondingOp.UnbondingConsumerChains)
+	require.Equal(t, sdk.NewInt(100), unbondingOp.Balance)
 	// Check that the unbonding op index was stored
 	expectedUnbondingOpIds := []uint64{unbondingOpId}
 	ids, found := pk.GetUnbondingOpIndex(ctx, "chain-1", pk.GetValidatorSetUpdateId(ctx))
@@ -607,11 +1405,23 @@ func TestHandleVSCMaturedPacket(t *testing.T) {
 	pk.SetConsumerClientId(ctx, "chain-2", "client-2")
 
 	// Start third and fourth unbonding
+	// the third unbonding is a redelegation, the fourth is a validator unbonding
 	unbondingOpIds := []uint64{3, 4}
+	gomock.InOrder(
+		mocks.MockStakingKeeper.EXPECT().GetUnbondingType(ctx, unbondingOpIds[0]).Return(
+			stakingtypes.UnbondingType_Redelegation, true),
+		mocks.MockStakingKeeper.EXPECT().GetRedelegationByUnbondingID(ctx, unbondingOpIds[0]).Return(
+			stakingtypes.Redelegation{Entries: []stakingtypes.RedelegationEntry{
+				{UnbondingId: unbondingOpIds[0], InitialBalance: sdk.NewInt(50)},
+			}}, true),
+		mocks.MockStakingKeeper.EXPECT().PutUnbondingOnHold(ctx, unbondingOpIds[0]).Return(nil),
+		mocks.MockStakingKeeper.EXPECT().GetUnbondingType(ctx, unbondingOpIds[1]).Return(
+			stakingtypes.UnbondingType_ValidatorUnbonding, true),
+		mocks.MockStakingKeeper.EXPECT().GetValidatorByUnbondingID(ctx, unbondingOpIds[1]).Return(
+			stakingtypes.Validator{Tokens: sdk.NewInt(30)}, true),
+		mocks.MockStakingKeeper.EXPECT().PutUnbondingOnHold(ctx, unbondingOpIds[1]).Return(nil),
+	)
 	for _, id := range unbondingOpIds {
-		gomock.InOrder(
-			mocks.MockStakingKeeper.EXPECT().PutUnbondingOnHold(ctx, id).Return(nil),
-		)
 		err = pk.Hooks().AfterUnbondingInitiated(ctx, id)
 		require.NoError(t, err)
 	}
@@ -629,6 +1439,9 @@ func TestHandleVSCMaturedPacket(t *testing.T) {
 		require.True(t, found)
 		require.Equal(t, unbondingOpIds, ids)
 	}
+	// Check the value of the unbonding ops held by each consumer
+	require.Equal(t, sdk.NewInt(180), pk.GetChainHeldUnbondingValue(ctx, "chain-1"))
+	require.Equal(t, sdk.NewInt(80), pk.GetChainHeldUnbondingValue(ctx, "chain-2"))
 
 	// Handle VSCMatured packet from chain-1 for vscID 1.
 	// Note that no VSCPacket was sent as the chain was not yet registered,
@@ -640,6 +1453,7 @@ func TestHandleVSCMaturedPacket(t *testing.T) {
 	pk.HandleVSCMaturedPacket(ctx, "chain-1", ccv.VSCMaturedPacketData{ValsetUpdateId: 2})
 	// Check that the unbonding operation with ID=2 can complete
 	require.Equal(t, []uint64{2}, pk.ConsumeMaturedUnbondingOps(ctx))
+	require.Equal(t, sdk.NewInt(80), pk.GetChainHeldUnbondingValue(ctx, "chain-1"))
 	// Check that the unbonding op index was removed
 	_, found = pk.GetUnbondingOpIndex(ctx, "chain-1", 2)
 	require.False(t, found)
@@ -669,3 +1483,543 @@ func TestHandleVSCMaturedPacket(t *testing.T) {
 	_, found = pk.GetUnbondingOpIndex(ctx, "chain-1", 3)
 	require.False(t, found)
 }
+
+// TestUnbondingOpLifecycle tests that an unbonding op is held until all the consumer chains
+// it waits for matured the corresponding VSC, and that it is completed in the next EndBlock
+func TestUnbondingOpLifecycle(t *testing.T) {
+	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	pk.SetParams(ctx, providertypes.DefaultParams())
+	pk.SetValidatorSetUpdateId(ctx, 1)
+
+	chainIDs := []string{"chain-1", "chain-2"}
+	for _, chainID := range chainIDs {
+		pk.SetConsumerClientId(ctx, chainID, "client-"+chainID)
+	}
+
+	// the unbonding op is created and put on hold
+	var unbondingOpId uint64 = 1
+	gomock.InOrder(
+		mocks.MockStakingKeeper.EXPECT().GetUnbondingType(ctx, unbondingOpId).Return(
+			stakingtypes.UnbondingType_UnbondingDelegation, false),
+		mocks.MockStakingKeeper.EXPECT().PutUnbondingOnHold(ctx, unbondingOpId).Return(nil),
+	)
+	require.NoError(t, pk.Hooks().AfterUnbondingInitiated(ctx, unbondingOpId))
+	require.Equal(t, chainIDs, pk.GetChainsBlockingUnbonding(ctx, unbondingOpId))
+
+	// the VSC of the unbonding op is sent in EndBlock
+	mocks.MockStakingKeeper.EXPECT().GetValidatorUpdates(gomock.Any()).Return(nil).Times(2)
+	pk.EndBlockVSU(ctx)
+	for _, chainID := range chainIDs {
+		require.Len(t, pk.GetPendingVSCPackets(ctx, chainID), 1)
+	}
+
+	// chain-1 matured the VSC, the unbonding op still waits for chain-2
+	pk.HandleVSCMaturedPacket(ctx, "chain-1", ccv.VSCMaturedPacketData{ValsetUpdateId: 1})
+	require.Equal(t, []string{"chain-2"}, pk.GetChainsBlockingUnbonding(ctx, unbondingOpId))
+	_, found := pk.GetUnbondingOpIndex(ctx, "chain-1", 1)
+	require.False(t, found)
+	_, found = pk.GetUnbondingOpIndex(ctx, "chain-2", 1)
+	require.True(t, found)
+
+	// chain-2 matured the VSC, the unbonding op and its indexes are deleted
+	pk.HandleVSCMaturedPacket(ctx, "chain-2", ccv.VSCMaturedPacketData{ValsetUpdateId: 1})
+	_, found = pk.GetUnbondingOp(ctx, unbondingOpId)
+	require.False(t, found)
+	require.Empty(t, pk.GetAllUnbondingOpIndexes(ctx, "chain-2"))
+	require.True(t, pk.GetChainHeldUnbondingValue(ctx, "chain-2").IsZero())
+
+	// the unbonding op is completed in the staking module in the next EndBlock
+	mocks.MockStakingKeeper.EXPECT().UnbondingCanComplete(gomock.Any(), unbondingOpId).Return(nil)
+	pk.EndBlockVSU(ctx)
+	require.Empty(t, pk.ConsumeMaturedUnbondingOps(ctx))
+}
+
+// TestOnTimeoutPacket tests that a timeout on a CCV channel stops the consumer chain, and that
+// the unbonding operations waiting on it are held until a force complete unbonding proposal
+func TestOnTimeoutPacket(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	chainID := "consumer"
+	channelID := "channel-0"
+	providerKeeper.SetConsumerClientId(ctx, chainID, "client-0")
+	providerKeeper.SetChainToChannel(ctx, chainID, channelID)
+	providerKeeper.SetChannelToChain(ctx, channelID, chainID)
+	providerKeeper.SetUnbondingOp(ctx, providertypes.UnbondingOp{
+		Id:                      1,
+		UnbondingConsumerChains: []string{chainID},
+		Balance:                 sdk.NewInt(10),
+	})
+	providerKeeper.SetUnbondingOpIndex(ctx, chainID, 1, []uint64{1})
+
+	// timeout on an unknown channel
+	err := providerKeeper.OnTimeoutPacket(ctx, channeltypes.Packet{SourceChannel: "channel-1"})
+	require.Error(t, err)
+	_, found := providerKeeper.GetConsumerClientId(ctx, chainID)
+	require.True(t, found)
+
+	// timeout on the CCV channel
+	err = providerKeeper.OnTimeoutPacket(ctx, channeltypes.Packet{SourceChannel: channelID})
+	require.NoError(t, err)
+	_, found = providerKeeper.GetConsumerClientId(ctx, chainID)
+	require.False(t, found)
+	_, found = providerKeeper.GetChannelToChain(ctx, channelID)
+	require.False(t, found)
+
+	require.True(t, providerKeeper.IsChannelInvalidated(ctx, channelID))
+
+	// the unbonding op is still waiting on the consumer chain
+	_, found = providerKeeper.GetUnbondingOpIndex(ctx, chainID, 1)
+	require.True(t, found)
+	unbondingOp, found := providerKeeper.GetUnbondingOp(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, []string{chainID}, unbondingOp.UnbondingConsumerChains)
+	require.Empty(t, providerKeeper.ConsumeMaturedUnbondingOps(ctx))
+
+	// the unbonding op is released by a force complete unbonding proposal
+	err = providerKeeper.HandleForceCompleteUnbondingProposal(ctx,
+		&providertypes.ForceCompleteUnbondingProposal{ChainId: chainID})
+	require.NoError(t, err)
+	_, found = providerKeeper.GetUnbondingOpIndex(ctx, chainID, 1)
+	require.False(t, found)
+	require.Equal(t, []uint64{1}, providerKeeper.ConsumeMaturedUnbondingOps(ctx))
+}
+
+// TestEndBlockCCRInitTimeout tests that the consumer chains whose CCV channel
+// is not established before the init timeout are stopped in EndBlockCCR
+func TestEndBlockCCRInitTimeout(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now)
+
+	// the init timeout of chain-1 elapsed, while the init timeout of chain-2 did not
+	providerKeeper.SetConsumerClientId(ctx, "chain-1", "client-1")
+	providerKeeper.SetInitTimeoutTimestamp(ctx, "chain-1", uint64(now.Add(-time.Second).UnixNano()))
+	providerKeeper.SetConsumerClientId(ctx, "chain-2", "client-2")
+	providerKeeper.SetInitTimeoutTimestamp(ctx, "chain-2", uint64(now.Add(time.Second).UnixNano()))
+
+	providerKeeper.EndBlockCCR(ctx)
+
+	// chain-1 is stopped
+	testProviderStateIsCleaned(t, ctx, providerKeeper, "chain-1", "")
+
+	// chain-2 is not stopped
+	_, found := providerKeeper.GetConsumerClientId(ctx, "chain-2")
+	require.True(t, found)
+	_, found = providerKeeper.GetInitTimeoutTimestamp(ctx, "chain-2")
+	require.True(t, found)
+
+	// an event is emitted for chain-1 only
+	initTimeoutEvents := []sdk.Event{}
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == ccv.EventTypeConsumerInitTimeout {
+			initTimeoutEvents = append(initTimeoutEvents, event)
+		}
+	}
+	require.Len(t, initTimeoutEvents, 1)
+	require.Contains(t, initTimeoutEvents[0].Attributes, abci.EventAttribute{
+		Key:   []byte(ccv.AttributeChainID),
+		Value: []byte("chain-1"),
+	})
+}
+
+// TestStaleChannelTransitions tests that the transitions of a CCV channel cannot be applied once
+// the channel moved past the state they expect, i.e., that a handshake completing after the init
+// timeout stopped the consumer chain is rejected, and that a timeout on a CCV channel whose consumer
+// chain was already stopped leaves the channel invalidated
+func TestStaleChannelTransitions(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now)
+
+	// the init timeout stops the consumer chain before its CCV channel is established
+	providerKeeper.SetConsumerClientId(ctx, "chainID", "clientID")
+	providerKeeper.SetInitTimeoutTimestamp(ctx, "chainID", uint64(now.Add(-time.Second).UnixNano()))
+	providerKeeper.EndBlockCCR(ctx)
+
+	// the handshake completes afterwards
+	gomock.InOrder(testkeeper.GetMocksForSetConsumerChain(ctx, &mocks, "chainID")...)
+	err := providerKeeper.SetConsumerChain(ctx, "channelID")
+	require.ErrorIs(t, err, ccv.ErrClientNotFound)
+	_, found := providerKeeper.GetChannelToChain(ctx, "channelID")
+	require.False(t, found)
+	_, found = providerKeeper.GetChainToChannel(ctx, "chainID")
+	require.False(t, found)
+
+	// a packet sent over the CCV channel of another consumer chain times out
+	providerKeeper.SetConsumerClientId(ctx, "chainID-1", "clientID-1")
+	providerKeeper.SetChainToChannel(ctx, "chainID-1", "channelID-1")
+	providerKeeper.SetChannelToChain(ctx, "channelID-1", "chainID-1")
+	packet := channeltypes.Packet{SourceChannel: "channelID-1"}
+	require.NoError(t, providerKeeper.OnTimeoutPacket(ctx, packet))
+	require.True(t, providerKeeper.IsChannelInvalidated(ctx, "channelID-1"))
+
+	// a second timeout on the channel does not stop the consumer chain again
+	err = providerKeeper.OnTimeoutPacket(ctx, packet)
+	require.ErrorIs(t, err, channeltypes.ErrInvalidChannelState)
+	require.True(t, providerKeeper.IsChannelInvalidated(ctx, "channelID-1"))
+
+	// nor can the handshake bring the channel back
+	err = providerKeeper.SetConsumerChain(ctx, "channelID-1")
+	require.ErrorIs(t, err, ccv.ErrInvalidatedChannel)
+	_, found = providerKeeper.GetChannelToChain(ctx, "channelID-1")
+	require.False(t, found)
+}
+
+// TestSendVSCPacketsToChainInactiveClient tests that the VSC packets to a consumer chain whose client
+// is expired or frozen remain queued, and that the consumer chain is stopped once the grace period elapsed
+func TestSendVSCPacketsToChainInactiveClient(t *testing.T) {
+	chainID := "consumer"
+	clientID := "client"
+
+	testCases := []struct {
+		name        string
+		clientState *ibctmtypes.ClientState
+		// the time elapsed since the provider first observed the inactive client
+		elapsed         time.Duration
+		expectedStopped bool
+	}{
+		{
+			"expired client, within the grace period",
+			&ibctmtypes.ClientState{},
+			providertypes.DefaultClientExpirationGracePeriod - time.Second,
+			false,
+		},
+		{
+			"expired client, after the grace period",
+			&ibctmtypes.ClientState{},
+			providertypes.DefaultClientExpirationGracePeriod,
+			true,
+		},
+		{
+			"frozen client, within the grace period",
+			&ibctmtypes.ClientState{FrozenHeight: clienttypes.NewHeight(0, 1)},
+			providertypes.DefaultClientExpirationGracePeriod - time.Second,
+			false,
+		},
+		{
+			"frozen client, after the grace period",
+			&ibctmtypes.ClientState{FrozenHeight: clienttypes.NewHeight(0, 1)},
+			providertypes.DefaultClientExpirationGracePeriod,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		keeperParams := testkeeper.NewInMemKeeperParams(t)
+		providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, keeperParams)
+		providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+		now := time.Now().UTC()
+		ctx = ctx.WithBlockTime(now)
+
+		providerKeeper.SetConsumerClientId(ctx, chainID, clientID)
+		providerKeeper.AppendPendingVSCPackets(ctx, chainID, ccv.ValidatorSetChangePacketData{ValsetUpdateId: 1})
+
+		// no consensus state is stored in the client store, i.e., a client that is not frozen is expired
+		clientStore := prefix.NewStore(ctx.KVStore(keeperParams.StoreKey), []byte("client"))
+		mocks.MockClientKeeper.EXPECT().GetClientState(gomock.Any(), clientID).Return(tc.clientState, true).Times(2)
+		mocks.MockClientKeeper.EXPECT().ClientStore(gomock.Any(), clientID).Return(clientStore).Times(2)
+
+		// the provider observes the inactive client and keeps the VSC packets queued
+		providerKeeper.SendVSCPacketsToChain(ctx, chainID, "channel")
+		ts, found := providerKeeper.GetClientInactiveTimestamp(ctx, chainID)
+		require.True(t, found, tc.name)
+		require.Equal(t, now, ts, tc.name)
+		require.Len(t, providerKeeper.GetPendingVSCPackets(ctx, chainID), 1, tc.name)
+
+		ctx = ctx.WithBlockTime(now.Add(tc.elapsed))
+		providerKeeper.SendVSCPacketsToChain(ctx, chainID, "channel")
+
+		invalidatedEvents := 0
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == ccv.EventTypeCCVChannelInvalidated {
+				invalidatedEvents++
+			}
+		}
+		if tc.expectedStopped {
+			testProviderStateIsCleaned(t, ctx, providerKeeper, chainID, "channel")
+			require.Empty(t, providerKeeper.GetPendingVSCPackets(ctx, chainID), tc.name)
+			require.Equal(t, 1, invalidatedEvents, tc.name)
+		} else {
+			_, found = providerKeeper.GetConsumerClientId(ctx, chainID)
+			require.True(t, found, tc.name)
+			require.Len(t, providerKeeper.GetPendingVSCPackets(ctx, chainID), 1, tc.name)
+			require.Zero(t, invalidatedEvents, tc.name)
+		}
+
+		ctrl.Finish()
+	}
+}
+
+// TestSendVSCPacketsToChainSendFailure tests that the VSC packets that cannot be sent
+// to a consumer chain remain queued, in order, and are sent in a later block, and that
+// their VSC send timestamps are set when they are first attempted to be sent
+func TestSendVSCPacketsToChainSendFailure(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now)
+
+	chainID := "consumer"
+	channelID := "channel"
+	providerKeeper.AppendPendingVSCPackets(ctx, chainID,
+		ccv.ValidatorSetChangePacketData{ValsetUpdateId: 1},
+		ccv.ValidatorSetChangePacketData{ValsetUpdateId: 2},
+	)
+
+	expectChannel := func(found bool) *gomock.Call {
+		return mocks.MockChannelKeeper.EXPECT().GetChannel(gomock.Any(), ccv.ProviderPortID, channelID).Return(
+			channeltypes.Channel{Counterparty: channeltypes.NewCounterparty(ccv.ConsumerPortID, "consumer-channel")}, found,
+		).Times(1)
+	}
+	expectSend := func(seq uint64, err error) []*gomock.Call {
+		return []*gomock.Call{
+			expectChannel(true),
+			mocks.MockScopedKeeper.EXPECT().GetCapability(gomock.Any(), gomock.Any()).Return(&capabilitytypes.Capability{}, true).Times(1),
+			mocks.MockChannelKeeper.EXPECT().GetNextSequenceSend(gomock.Any(), ccv.ProviderPortID, channelID).Return(seq, true).Times(1),
+			mocks.MockChannelKeeper.EXPECT().SendPacket(gomock.Any(), gomock.Any(), gomock.Any()).Return(err).Times(1),
+		}
+	}
+
+	// the CCV channel cannot be found, so none of the packets is sent,
+	// but the VSC timeout of the first packet starts
+	expectChannel(false)
+	require.NotPanics(t, func() { providerKeeper.SendVSCPacketsToChain(ctx, chainID, channelID) })
+	require.Len(t, providerKeeper.GetPendingVSCPackets(ctx, chainID), 2)
+	ts, found := providerKeeper.GetVscSendTimestamp(ctx, chainID, 1)
+	require.True(t, found)
+	require.Equal(t, now, ts)
+	_, found = providerKeeper.GetVscSendTimestamp(ctx, chainID, 2)
+	require.False(t, found)
+
+	// the first packet is sent, while sending the second one fails
+	ctx = ctx.WithBlockTime(now.Add(time.Hour))
+	calls := expectSend(1, nil)
+	calls = append(calls, expectSend(2, channeltypes.ErrInvalidChannelState)...)
+	gomock.InOrder(calls...)
+	require.NotPanics(t, func() { providerKeeper.SendVSCPacketsToChain(ctx, chainID, channelID) })
+	require.Equal(t, []ccv.ValidatorSetChangePacketData{{ValsetUpdateId: 2}}, providerKeeper.GetPendingVSCPackets(ctx, chainID))
+	ts, found = providerKeeper.GetVscSendTimestamp(ctx, chainID, 1)
+	require.True(t, found)
+	require.Equal(t, now, ts)
+	ts, found = providerKeeper.GetVscSendTimestamp(ctx, chainID, 2)
+	require.True(t, found)
+	require.Equal(t, now.Add(time.Hour), ts)
+	seq, _ := providerKeeper.GetLastSentSequence(ctx, chainID)
+	require.Equal(t, uint64(1), seq)
+
+	// the remaining packet is sent in the next block, which does not reset its VSC send timestamp
+	ctx = ctx.WithBlockTime(now.Add(2 * time.Hour))
+	gomock.InOrder(expectSend(2, nil)...)
+	providerKeeper.SendVSCPacketsToChain(ctx, chainID, channelID)
+	require.Empty(t, providerKeeper.GetPendingVSCPackets(ctx, chainID))
+	ts, found = providerKeeper.GetVscSendTimestamp(ctx, chainID, 2)
+	require.True(t, found)
+	require.Equal(t, now.Add(time.Hour), ts)
+	seq, _ = providerKeeper.GetLastSentSequence(ctx, chainID)
+	require.Equal(t, uint64(2), seq)
+}
+
+// TestSendVSCPacketsValidatingChainsOnly tests that the pending VSC packets are sent
+// only to the consumer chains with an established CCV channel, i.e., with a VALIDATING channel,
+// while the packets of the other consumer chains remain queued
+func TestSendVSCPacketsValidatingChainsOnly(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	// chain-1 has a VALIDATING channel, while the CCV channel to chain-2 is not yet established
+	providerKeeper.SetConsumerClientId(ctx, "chain-1", "client-1")
+	providerKeeper.SetChainToChannel(ctx, "chain-1", "channel-1")
+	providerKeeper.SetChannelToChain(ctx, "channel-1", "chain-1")
+	providerKeeper.SetConsumerClientId(ctx, "chain-2", "client-2")
+	for _, chainID := range []string{"chain-1", "chain-2"} {
+		providerKeeper.AppendPendingVSCPackets(ctx, chainID, ccv.ValidatorSetChangePacketData{ValsetUpdateId: 1})
+	}
+
+	gomock.InOrder(
+		mocks.MockClientKeeper.EXPECT().GetClientState(gomock.Any(), "client-1").Return(nil, false).Times(1),
+		mocks.MockChannelKeeper.EXPECT().GetChannel(gomock.Any(), ccv.ProviderPortID, "channel-1").Return(
+			channeltypes.Channel{Counterparty: channeltypes.NewCounterparty(ccv.ConsumerPortID, "consumer-channel")}, true,
+		).Times(1),
+		mocks.MockScopedKeeper.EXPECT().GetCapability(gomock.Any(), gomock.Any()).Return(&capabilitytypes.Capability{}, true).Times(1),
+		mocks.MockChannelKeeper.EXPECT().GetNextSequenceSend(gomock.Any(), ccv.ProviderPortID, "channel-1").Return(uint64(1), true).Times(1),
+		mocks.MockChannelKeeper.EXPECT().SendPacket(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1),
+	)
+
+	providerKeeper.SendVSCPackets(ctx)
+
+	// the packet is sent to chain-1
+	require.Empty(t, providerKeeper.GetPendingVSCPackets(ctx, "chain-1"))
+	_, found := providerKeeper.GetVscSendTimestamp(ctx, "chain-1", 1)
+	require.True(t, found)
+	seq, found := providerKeeper.GetLastSentSequence(ctx, "chain-1")
+	require.True(t, found)
+	require.Equal(t, uint64(1), seq)
+
+	// the packet remains queued for chain-2
+	require.Equal(t, []ccv.ValidatorSetChangePacketData{{ValsetUpdateId: 1}}, providerKeeper.GetPendingVSCPackets(ctx, "chain-2"))
+	_, found = providerKeeper.GetVscSendTimestamp(ctx, "chain-2", 1)
+	require.False(t, found)
+	_, found = providerKeeper.GetLastSentSequence(ctx, "chain-2")
+	require.False(t, found)
+}
+
+// TestSendVSCPacketsToPausedChain tests that the VSC packets are not sent
+// to a paused consumer chain, and that they are sent once it is resumed
+func TestSendVSCPacketsToPausedChain(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	chainID := "consumer"
+	channelID := "channel"
+	providerKeeper.SetChainToChannel(ctx, chainID, channelID)
+	providerKeeper.AppendPendingVSCPackets(ctx, chainID, ccv.ValidatorSetChangePacketData{ValsetUpdateId: 1})
+	require.NoError(t, providerKeeper.PauseConsumerChain(ctx, chainID))
+
+	// no packet is sent, i.e., no call to the channel keeper is expected
+	providerKeeper.SendVSCPacketsToChain(ctx, chainID, channelID)
+	providerKeeper.AppendPendingVSCPackets(ctx, chainID, ccv.ValidatorSetChangePacketData{ValsetUpdateId: 2})
+	require.Len(t, providerKeeper.GetPendingVSCPackets(ctx, chainID), 2)
+
+	require.NoError(t, providerKeeper.ResumeConsumerChain(ctx, chainID))
+	var calls []*gomock.Call
+	for seq := uint64(1); seq <= 2; seq++ {
+		calls = append(calls,
+			mocks.MockChannelKeeper.EXPECT().GetChannel(gomock.Any(), ccv.ProviderPortID, channelID).Return(
+				channeltypes.Channel{Counterparty: channeltypes.NewCounterparty(ccv.ConsumerPortID, "consumer-channel")}, true,
+			).Times(1),
+			mocks.MockScopedKeeper.EXPECT().GetCapability(gomock.Any(), gomock.Any()).Return(&capabilitytypes.Capability{}, true).Times(1),
+			mocks.MockChannelKeeper.EXPECT().GetNextSequenceSend(gomock.Any(), ccv.ProviderPortID, channelID).Return(seq, true).Times(1),
+			mocks.MockChannelKeeper.EXPECT().SendPacket(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1),
+		)
+	}
+	gomock.InOrder(calls...)
+	providerKeeper.SendVSCPacketsToChain(ctx, chainID, channelID)
+	require.Empty(t, providerKeeper.GetPendingVSCPackets(ctx, chainID))
+	seq, _ := providerKeeper.GetLastSentSequence(ctx, chainID)
+	require.Equal(t, uint64(2), seq)
+}
+
+// TestHandleSlashPacketDowntimeJailDuration tests that the validators are jailed for a downtime
+// infraction on a consumer chain for the downtime jail duration of that chain, if set,
+// and for the downtime jail duration of the slashing module otherwise
+func TestHandleSlashPacketDowntimeJailDuration(t *testing.T) {
+	vscID := uint64(4)
+	val := crypto.NewCryptoIdentityFromIntSeed(7842334)
+	consAddr := val.SDKValConsAddress()
+	validator := stakingtypes.Validator{Jailed: false, Tokens: sdk.NewInt(1000000)}
+	now := time.Now().UTC()
+
+	testCases := []struct {
+		name                 string
+		chainID              string
+		downtimeJailDuration time.Duration
+		expectedJailTime     time.Time
+	}{
+		{"chain without downtime jail duration", "test-chain", 0, now.Add(time.Hour)},
+		{"chain with downtime jail duration", "high-value-chain", 7 * 24 * time.Hour, now.Add(7 * 24 * time.Hour)},
+	}
+
+	for _, tc := range testCases {
+		providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+		ctx = ctx.WithBlockTime(now)
+		providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+		providerKeeper.SetValsetUpdateBlockHeight(ctx, vscID, 99)
+		providerKeeper.SetDowntimeJailDuration(ctx, tc.chainID, tc.downtimeJailDuration)
+
+		calls := []*gomock.Call{
+			mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, consAddr).Return(validator, true).Times(1),
+			mocks.MockSlashingKeeper.EXPECT().IsTombstoned(ctx, consAddr).Return(false).Times(1),
+			mocks.MockStakingKeeper.EXPECT().Jail(ctx, consAddr).Times(1),
+		}
+		if tc.downtimeJailDuration == 0 {
+			calls = append(calls, mocks.MockSlashingKeeper.EXPECT().DowntimeJailDuration(ctx).Return(time.Hour).Times(1))
+		}
+		calls = append(calls, mocks.MockSlashingKeeper.EXPECT().JailUntil(ctx, consAddr, tc.expectedJailTime).Times(1))
+		gomock.InOrder(calls...)
+
+		providerKeeper.HandleSlashPacket(ctx, tc.chainID, *ccv.NewSlashPacketData(
+			tmtypes.Validator{Address: consAddr}, vscID, stakingtypes.Downtime))
+
+		ctrl.Finish()
+	}
+}
+
+// TestSendVSCPacketsToChainLogValsetUpdateDiffs tests that the validator power changes of the VSC packets
+// sent to a consumer chain are logged only if the LogValsetUpdateDiffs param is enabled
+func TestSendVSCPacketsToChainLogValsetUpdateDiffs(t *testing.T) {
+	chainID := "consumer"
+	channelID := "channel"
+	valA := crypto.NewCryptoIdentityFromIntSeed(1)
+	valB := crypto.NewCryptoIdentityFromIntSeed(2)
+
+	for _, enabled := range []bool{false, true} {
+		providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+		var logs bytes.Buffer
+		ctx = ctx.WithLogger(log.NewTMJSONLogger(log.NewSyncWriter(&logs)))
+		params := providertypes.DefaultParams()
+		params.LogValsetUpdateDiffs = enabled
+		providerKeeper.SetParams(ctx, params)
+
+		// validator A is already validating the consumer chain, while validator B is added
+		valAProviderAddr := valA.ProviderConsAddress()
+		providerKeeper.SetConsumerValidator(ctx, chainID, providertypes.ConsumerValidator{ProviderAddr: &valAProviderAddr, Power: 10})
+		providerKeeper.AppendPendingVSCPackets(ctx, chainID, ccv.ValidatorSetChangePacketData{
+			ValsetUpdateId: 7,
+			ValidatorUpdates: []abci.ValidatorUpdate{
+				{PubKey: valA.TMProtoCryptoPublicKey(), Power: 20},
+				{PubKey: valB.TMProtoCryptoPublicKey(), Power: 5},
+			},
+		})
+
+		gomock.InOrder(
+			mocks.MockChannelKeeper.EXPECT().GetChannel(gomock.Any(), ccv.ProviderPortID, channelID).Return(
+				channeltypes.Channel{Counterparty: channeltypes.NewCounterparty(ccv.ConsumerPortID, "consumer-channel")}, true,
+			).Times(1),
+			mocks.MockScopedKeeper.EXPECT().GetCapability(gomock.Any(), gomock.Any()).Return(&capabilitytypes.Capability{}, true).Times(1),
+			mocks.MockChannelKeeper.EXPECT().GetNextSequenceSend(gomock.Any(), ccv.ProviderPortID, channelID).Return(uint64(1), true).Times(1),
+			mocks.MockChannelKeeper.EXPECT().SendPacket(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1),
+		)
+		providerKeeper.SendVSCPacketsToChain(ctx, chainID, channelID)
+
+		var entries []map[string]interface{}
+		for _, line := range strings.Split(strings.TrimSpace(logs.String()), "\n") {
+			if line == "" {
+				continue
+			}
+			var entry map[string]interface{}
+			require.NoError(t, json.Unmarshal([]byte(line), &entry))
+			if entry["_msg"] == "validator set update sent to consumer chain" {
+				entries = append(entries, entry)
+			}
+		}
+
+		if !enabled {
+			require.Empty(t, entries)
+			ctrl.Finish()
+			continue
+		}
+		require.Len(t, entries, 1)
+		require.Equal(t, chainID, entries[0]["chain_id"])
+		require.Equal(t, float64(7), entries[0]["valset_update_id"])
+		var diffs []map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(entries[0]["diffs"].(string)), &diffs))
+		require.Equal(t, []map[string]interface{}{
+			{"validator": valAProviderAddr.String(), "old_power": float64(10), "new_power": float64(20)},
+			{"validator": valB.SDKValConsAddress().String(), "old_power": float64(0), "new_power": float64(5)},
+		}, diffs)
+
+		ctrl.Finish()
+	}
+}
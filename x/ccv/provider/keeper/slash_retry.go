@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/x/ccv/types"
+)
+
+// HandleSlashRetry records the outcome of an attempt to apply a slash packet received from a consumer chain,
+// where retry is true if its validator was not found. A slash packet to be retried is recorded together
+// with its number of retries, and is kept in the throttle queues to be retried in a following block,
+// see HandleThrottleQueues. The record is removed once the slash packet is either applied or dropped.
+// If its validator is still not found after MaxSlashRetries retries, the slash packet is archived as
+// failed and removed from the throttle queues.
+//
+// It returns true if the slash packet must be retried.
+func (k Keeper) HandleSlashRetry(ctx sdk.Context, chainID string, data ccv.SlashPacketData, retry bool) bool {
+	consumerConsAddr := types.NewConsumerConsAddress(data.Validator.Address)
+	entry, found := k.GetSlashRetry(ctx, chainID, consumerConsAddr)
+	if !retry {
+		if found {
+			k.DeleteSlashRetry(ctx, chainID, consumerConsAddr)
+		}
+		return false
+	}
+
+	if !found {
+		k.SetSlashRetry(ctx, types.SlashRetry{ChainId: chainID, Data: data})
+		k.Logger(ctx).Info("slash packet queued for retry",
+			"chainID", chainID,
+			"consumer cons addr", consumerConsAddr.String(),
+			"vscID", data.ValsetUpdateId,
+		)
+		return true
+	}
+
+	entry.Retries++
+	if entry.Retries < k.GetMaxSlashRetries(ctx) {
+		k.SetSlashRetry(ctx, entry)
+		return true
+	}
+
+	k.DeleteSlashRetry(ctx, chainID, consumerConsAddr)
+	k.SetFailedSlash(ctx, entry)
+	k.Logger(ctx).Error("slash packet archived as failed, validator not found after max retries",
+		"chainID", chainID,
+		"consumer cons addr", consumerConsAddr.String(),
+		"vscID", data.ValsetUpdateId,
+		"retries", entry.Retries,
+	)
+	return false
+}
+
+// SetSlashRetry stores a slash packet queued for retry
+func (k Keeper) SetSlashRetry(ctx sdk.Context, entry types.SlashRetry) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := entry.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// SlashRetry is instantiated only by the provider.
+		panic(fmt.Errorf("failed to marshal slash retry: %w", err))
+	}
+	store.Set(types.SlashRetryKey(entry.ChainId, types.NewConsumerConsAddress(entry.Data.Validator.Address)), bz)
+}
+
+// GetSlashRetry returns the slash packet queued for retry
+// for the given consumer chain and consumer validator address
+func (k Keeper) GetSlashRetry(
+	ctx sdk.Context,
+	chainID string,
+	consumerAddr types.ConsumerConsAddress,
+) (entry types.SlashRetry, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.SlashRetryKey(chainID, consumerAddr))
+	if bz == nil {
+		return entry, false
+	}
+	if err := entry.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the SlashRetry is assumed to be correctly serialized in SetSlashRetry.
+		panic(fmt.Errorf("failed to unmarshal slash retry: %w", err))
+	}
+	return entry, true
+}
+
+// DeleteSlashRetry removes the slash packet queued for retry
+// for the given consumer chain and consumer validator address
+func (k Keeper) DeleteSlashRetry(ctx sdk.Context, chainID string, consumerAddr types.ConsumerConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.SlashRetryKey(chainID, consumerAddr))
+}
+
+// GetAllSlashRetries returns all the slash packets queued for retry for the given consumer chain.
+// If chainID is nil, it returns the slash packets queued for retry for all consumer chains.
+//
+// Note that the slash packets queued for retry are stored under keys with the following format:
+// SlashRetryBytePrefix | len(chainID) | chainID | consumerAddress
+// Thus, the returned array is in ascending order of consumer addresses, if chainID is not nil.
+func (k Keeper) GetAllSlashRetries(ctx sdk.Context, chainID *string) []types.SlashRetry {
+	return k.getAllSlashEntries(ctx, types.SlashRetryBytePrefix, chainID)
+}
+
+// DeleteAllSlashRetries removes all the slash packets queued for retry for the given consumer chain
+func (k Keeper) DeleteAllSlashRetries(ctx sdk.Context, chainID string) {
+	for _, entry := range k.GetAllSlashRetries(ctx, &chainID) {
+		k.DeleteSlashRetry(ctx, chainID, types.NewConsumerConsAddress(entry.Data.Validator.Address))
+	}
+}
+
+// SetFailedSlash archives a slash packet that could not be applied after the maximum number of retries
+func (k Keeper) SetFailedSlash(ctx sdk.Context, entry types.SlashRetry) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := entry.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// SlashRetry is instantiated only by the provider.
+		panic(fmt.Errorf("failed to marshal failed slash: %w", err))
+	}
+	store.Set(types.FailedSlashKey(entry.ChainId, types.NewConsumerConsAddress(entry.Data.Validator.Address)), bz)
+}
+
+// GetAllFailedSlashes returns all the slash packets archived as failed for the given consumer chain.
+// If chainID is nil, it returns the slash packets archived as failed for all consumer chains.
+func (k Keeper) GetAllFailedSlashes(ctx sdk.Context, chainID *string) []types.SlashRetry {
+	return k.getAllSlashEntries(ctx, types.FailedSlashBytePrefix, chainID)
+}
+
+// DeleteAllFailedSlashes removes all the slash packets archived as failed for the given consumer chain
+func (k Keeper) DeleteAllFailedSlashes(ctx sdk.Context, chainID string) {
+	store := ctx.KVStore(k.storeKey)
+	for _, entry := range k.GetAllFailedSlashes(ctx, &chainID) {
+		store.Delete(types.FailedSlashKey(chainID, types.NewConsumerConsAddress(entry.Data.Validator.Address)))
+	}
+}
+
+// getAllSlashEntries returns all the SlashRetry entries stored under the given prefix
+// for the given consumer chain, or for all consumer chains if chainID is nil
+func (k Keeper) getAllSlashEntries(ctx sdk.Context, bytePrefix byte, chainID *string) (entries []types.SlashRetry) {
+	store := ctx.KVStore(k.storeKey)
+	var prefix []byte
+	if chainID == nil {
+		prefix = []byte{bytePrefix}
+	} else {
+		prefix = types.ChainIdWithLenKey(bytePrefix, *chainID)
+	}
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.SlashRetry
+		if err := entry.Unmarshal(iterator.Value()); err != nil {
+			// An error here would indicate something is very wrong,
+			// the SlashRetry is assumed to be correctly serialized in SetSlashRetry or SetFailedSlash.
+			panic(fmt.Errorf("failed to unmarshal slash retry: %w", err))
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
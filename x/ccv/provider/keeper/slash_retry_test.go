@@ -0,0 +1,208 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/golang/mock/gomock"
+	tmtypes "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/interchain-security/testutil/crypto"
+	testkeeper "github.com/cosmos/interchain-security/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/x/ccv/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestSlashRetries tests the getter, setter and deletion methods
+// for the slash packets queued for retry and archived as failed
+func TestSlashRetries(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	consumerAddrA := crypto.NewCryptoIdentityFromIntSeed(1).ConsumerConsAddress()
+	consumerAddrB := crypto.NewCryptoIdentityFromIntSeed(2).ConsumerConsAddress()
+	dataA := *ccv.NewSlashPacketData(tmtypes.Validator{Address: consumerAddrA.ToSdkConsAddr()}, 1, stakingtypes.Downtime)
+	dataB := *ccv.NewSlashPacketData(tmtypes.Validator{Address: consumerAddrB.ToSdkConsAddr()}, 2, stakingtypes.Downtime)
+
+	_, found := providerKeeper.GetSlashRetry(ctx, "chain", consumerAddrA)
+	require.False(t, found)
+
+	providerKeeper.SetSlashRetry(ctx, providertypes.SlashRetry{ChainId: "chain", Data: dataA})
+	providerKeeper.SetSlashRetry(ctx, providertypes.SlashRetry{ChainId: "chain1", Data: dataB})
+	entry, found := providerKeeper.GetSlashRetry(ctx, "chain", consumerAddrA)
+	require.True(t, found)
+	require.Equal(t, providertypes.SlashRetry{ChainId: "chain", Data: dataA}, entry)
+
+	chainID := "chain"
+	require.Len(t, providerKeeper.GetAllSlashRetries(ctx, nil), 2)
+	require.Len(t, providerKeeper.GetAllSlashRetries(ctx, &chainID), 1)
+
+	providerKeeper.DeleteAllSlashRetries(ctx, chainID)
+	require.Empty(t, providerKeeper.GetAllSlashRetries(ctx, &chainID))
+	require.Len(t, providerKeeper.GetAllSlashRetries(ctx, nil), 1)
+
+	providerKeeper.SetFailedSlash(ctx, entry)
+	require.Equal(t, []providertypes.SlashRetry{entry}, providerKeeper.GetAllFailedSlashes(ctx, &chainID))
+	providerKeeper.DeleteAllFailedSlashes(ctx, chainID)
+	require.Empty(t, providerKeeper.GetAllFailedSlashes(ctx, nil))
+}
+
+// TestHandleSlashRetry tests that the outcome of an attempt to apply a slash packet is recorded,
+// i.e., that the number of retries is kept until the slash packet is applied or archived as failed
+func TestHandleSlashRetry(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	params := providertypes.DefaultParams()
+	params.MaxSlashRetries = 2
+	providerKeeper.SetParams(ctx, params)
+
+	chainID := "chain"
+	consumerAddr := crypto.NewCryptoIdentityFromIntSeed(1).ConsumerConsAddress()
+	data := *ccv.NewSlashPacketData(tmtypes.Validator{Address: consumerAddr.ToSdkConsAddr()}, 1, stakingtypes.Downtime)
+
+	// a slash packet that is applied is not recorded
+	require.False(t, providerKeeper.HandleSlashRetry(ctx, chainID, data, false))
+	require.Empty(t, providerKeeper.GetAllSlashRetries(ctx, nil))
+
+	// the first failed attempt records the slash packet
+	require.True(t, providerKeeper.HandleSlashRetry(ctx, chainID, data, true))
+	entry, found := providerKeeper.GetSlashRetry(ctx, chainID, consumerAddr)
+	require.True(t, found)
+	require.Equal(t, providertypes.SlashRetry{ChainId: chainID, Data: data}, entry)
+
+	// every failed retry increments the number of retries
+	require.True(t, providerKeeper.HandleSlashRetry(ctx, chainID, data, true))
+	entry, _ = providerKeeper.GetSlashRetry(ctx, chainID, consumerAddr)
+	require.Equal(t, int64(1), entry.Retries)
+
+	// a slash packet that is applied on a retry is no longer recorded
+	require.False(t, providerKeeper.HandleSlashRetry(ctx, chainID, data, false))
+	_, found = providerKeeper.GetSlashRetry(ctx, chainID, consumerAddr)
+	require.False(t, found)
+
+	// the slash packet is archived as failed after the maximum number of retries
+	require.True(t, providerKeeper.HandleSlashRetry(ctx, chainID, data, true))
+	require.True(t, providerKeeper.HandleSlashRetry(ctx, chainID, data, true))
+	require.False(t, providerKeeper.HandleSlashRetry(ctx, chainID, data, true))
+	require.Empty(t, providerKeeper.GetAllSlashRetries(ctx, nil))
+	require.Equal(t, []providertypes.SlashRetry{{ChainId: chainID, Data: data, Retries: 2}},
+		providerKeeper.GetAllFailedSlashes(ctx, &chainID))
+}
+
+// TestHandleSlashRetries tests that a slash packet whose validator is not found is retried through
+// the throttle queues in the following blocks, and archived as failed after the maximum number of retries.
+// The vsc matured packets received after the slash packet are only handled once the slash packet is.
+func TestHandleSlashRetries(t *testing.T) {
+	chainID := "consumer-id"
+	vscID, maturedVscID := uint64(234), uint64(235)
+	providerConsAddr := crypto.NewCryptoIdentityFromIntSeed(7842334).ProviderConsAddress()
+	consumerConsAddr := crypto.NewCryptoIdentityFromIntSeed(784987634).ConsumerConsAddress()
+	data := *ccv.NewSlashPacketData(tmtypes.Validator{Address: consumerConsAddr.ToSdkConsAddr()}, vscID, stakingtypes.Downtime)
+
+	testCases := []struct {
+		name string
+		// whether the validator is found by the staking keeper, in every attempt to apply the slash packet
+		found          []bool
+		expectedJailed bool
+		expectedFailed bool
+	}{
+		{
+			"no failure, the slash packet is applied right away",
+			[]bool{true},
+			true,
+			false,
+		},
+		{
+			"transient failure, the slash packet is applied on the first retry",
+			[]bool{false, true},
+			true,
+			false,
+		},
+		{
+			"transient failure, the slash packet is applied on the last retry",
+			[]bool{false, false, false, true},
+			true,
+			false,
+		},
+		{
+			"permanent failure, the slash packet is archived after the maximum number of retries",
+			[]bool{false, false, false, false},
+			false,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+
+		params := providertypes.DefaultParams()
+		params.MaxSlashRetries = 3
+		providerKeeper.SetParams(ctx, params)
+		providerKeeper.SetSlashMeter(ctx, sdk.NewInt(1000))
+		providerKeeper.SetValsetUpdateBlockHeight(ctx, vscID, 99)
+		providerKeeper.SetValidatorByConsumerAddr(ctx, chainID, consumerConsAddr, providerConsAddr)
+
+		// the slash packet is followed by a vsc matured packet
+		require.NoError(t, providerKeeper.QueueThrottledSlashPacketData(ctx, chainID, 1, data))
+		require.NoError(t, providerKeeper.QueueThrottledVSCMaturedPacketData(ctx, chainID, 2,
+			ccv.VSCMaturedPacketData{ValsetUpdateId: maturedVscID}))
+		providerKeeper.QueueGlobalSlashEntry(ctx, providertypes.NewGlobalSlashEntry(ctx.BlockTime(), chainID, 1, providerConsAddr))
+		providerKeeper.SetVscSendTimestamp(ctx, chainID, maturedVscID, ctx.BlockTime())
+
+		calls := []*gomock.Call{}
+		for _, found := range tc.found {
+			// the slash packet is charged to the slash meter in every attempt
+			if found {
+				calls = append(calls,
+					mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(
+						ctx, providerConsAddr.ToSdkConsAddr()).Return(stakingtypes.Validator{}, true).Times(1),
+					mocks.MockStakingKeeper.EXPECT().GetLastValidatorPower(ctx, gomock.Any()).Return(int64(10)).Times(1),
+				)
+				calls = append(calls, testkeeper.GetMocksForHandleSlashPacket(
+					ctx, mocks, providerConsAddr, stakingtypes.Validator{Jailed: false}, true)...)
+			} else {
+				calls = append(calls, mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(
+					ctx, providerConsAddr.ToSdkConsAddr()).Return(stakingtypes.Validator{}, false).Times(2))
+			}
+		}
+		gomock.InOrder(calls...)
+
+		for i := range tc.found {
+			providerKeeper.HandleThrottleQueues(ctx)
+			if i == len(tc.found)-1 {
+				break
+			}
+			// the slash packet is retried in the next block, and blocks the vsc matured packet
+			require.Len(t, providerKeeper.GetAllSlashRetries(ctx, &chainID), 1, tc.name)
+			require.Len(t, providerKeeper.GetAllGlobalSlashEntries(ctx), 1, tc.name)
+			_, found := providerKeeper.GetVscSendTimestamp(ctx, chainID, maturedVscID)
+			require.True(t, found, tc.name)
+		}
+
+		// the slash packet and the vsc matured packet are no longer queued
+		require.Empty(t, providerKeeper.GetAllSlashRetries(ctx, &chainID), tc.name)
+		require.Empty(t, providerKeeper.GetAllGlobalSlashEntries(ctx), tc.name)
+		require.Zero(t, providerKeeper.GetThrottledPacketDataSize(ctx, chainID), tc.name)
+		_, found := providerKeeper.GetVscSendTimestamp(ctx, chainID, maturedVscID)
+		require.False(t, found, tc.name)
+		if tc.expectedJailed {
+			require.Equal(t, []string{consumerConsAddr.String()}, providerKeeper.GetSlashAcks(ctx, chainID), tc.name)
+		} else {
+			require.Empty(t, providerKeeper.GetSlashAcks(ctx, chainID), tc.name)
+		}
+		if tc.expectedFailed {
+			require.Equal(t, []providertypes.SlashRetry{{ChainId: chainID, Data: data, Retries: 3}},
+				providerKeeper.GetAllFailedSlashes(ctx, &chainID), tc.name)
+		} else {
+			require.Empty(t, providerKeeper.GetAllFailedSlashes(ctx, &chainID), tc.name)
+		}
+
+		// handling the throttle queues again does not make any calls to the staking keeper
+		providerKeeper.HandleThrottleQueues(ctx)
+
+		ctrl.Finish()
+	}
+}
@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	"bytes"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/interchain-security/x/ccv/provider/types"
+)
+
+// SetSoftOptedOut records that the validator with the given provider address
+// is opted out of validating the consumer chain with the given chain ID
+func (k Keeper) SetSoftOptedOut(ctx sdk.Context, chainID string, providerAddr types.ProviderConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.SoftOptedOutKey(chainID, providerAddr), []byte{})
+}
+
+// IsSoftOptedOut returns whether the validator with the given provider address
+// is opted out of validating the consumer chain with the given chain ID
+func (k Keeper) IsSoftOptedOut(ctx sdk.Context, chainID string, providerAddr types.ProviderConsAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.SoftOptedOutKey(chainID, providerAddr))
+}
+
+// GetAllSoftOptedOut returns the provider addresses of the validators
+// opted out of validating the consumer chain with the given chain ID
+func (k Keeper) GetAllSoftOptedOut(ctx sdk.Context, chainID string) (providerAddrs []types.ProviderConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.ChainIdWithLenKey(types.SoftOptedOutBytePrefix, chainID)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		providerAddrs = append(providerAddrs, types.NewProviderConsAddress(iterator.Key()[len(prefix):]))
+	}
+	return providerAddrs
+}
+
+// DeleteAllSoftOptedOut removes the records of the validators
+// opted out of validating the consumer chain with the given chain ID
+func (k Keeper) DeleteAllSoftOptedOut(ctx sdk.Context, chainID string) {
+	store := ctx.KVStore(k.storeKey)
+	for _, providerAddr := range k.GetAllSoftOptedOut(ctx, chainID) {
+		store.Delete(types.SoftOptedOutKey(chainID, providerAddr))
+	}
+}
+
+// IsSoftOptOutEnabled returns whether the validators with the smallest powers are opted out
+// of validating the consumer chain with the given chain ID, i.e., whether its soft opt out threshold is not zero
+func (k Keeper) IsSoftOptOutEnabled(ctx sdk.Context, chainID string) bool {
+	return sdk.MustNewDecFromStr(k.GetConsumerChainSoftOptOutThreshold(ctx, chainID)).IsPositive()
+}
+
+// SmallestNonOptOutPower returns the smallest power a validator must have not to be opted out,
+// given the powers of all the validators and the soft opt out threshold. The validators are sorted
+// by power and, starting from the smallest power, they are opted out as long as the cumulative power
+// of the opted out validators, including their own power, is strictly below threshold * total power.
+// Validators with the same power are thus either all opted out or all included.
+// It returns zero if no validator is opted out.
+func SmallestNonOptOutPower(powers []int64, threshold sdk.Dec) int64 {
+	if !threshold.IsPositive() || len(powers) == 0 {
+		return 0
+	}
+
+	sorted := make([]int64, len(powers))
+	copy(sorted, powers)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	totalPower := sdk.ZeroInt()
+	for _, power := range sorted {
+		totalPower = totalPower.AddRaw(power)
+	}
+	optOutPower := threshold.MulInt(totalPower)
+
+	cumulativePower := sdk.ZeroInt()
+	for _, power := range sorted {
+		cumulativePower = cumulativePower.AddRaw(power)
+		if sdk.NewDecFromInt(cumulativePower).GTE(optOutPower) {
+			return power
+		}
+	}
+	// unreachable since the threshold is at most one
+	return sorted[len(sorted)-1]
+}
+
+// SelectTopN returns, for each of the validators with the given powers and provider addresses, whether
+// it is among the n validators with the largest powers. Validators with the same power are ordered by
+// provider address, so that no more than n validators are selected. All the validators are selected if n is zero.
+func SelectTopN(powers []int64, providerAddrs []types.ProviderConsAddress, n uint32) []bool {
+	selected := make([]bool, len(powers))
+	if n == 0 || int(n) >= len(powers) {
+		for i := range selected {
+			selected[i] = true
+		}
+		return selected
+	}
+
+	order := make([]int, len(powers))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		if powers[order[i]] != powers[order[j]] {
+			return powers[order[i]] > powers[order[j]]
+		}
+		return bytes.Compare(providerAddrs[order[i]].ToSdkConsAddr(), providerAddrs[order[j]].ToSdkConsAddr()) < 0
+	})
+	for _, i := range order[:n] {
+		selected[i] = true
+	}
+	return selected
+}
+
+// applySoftOptOut returns the given validator updates, of the validators with the given provider addresses,
+// without the updates of the validators opted out of validating the consumer chain with the given chain ID,
+// i.e., the validators that are not among the top N validators of the consumer chain, if it has a top N,
+// and, among the remaining validators, the validators with the smallest powers below the soft opt out threshold.
+// The opted out validators replace the ones previously recorded for the consumer chain, so that
+// validators whose power rose above the threshold are included again.
+func (k Keeper) applySoftOptOut(ctx sdk.Context, chainID string,
+	providerAddrs []types.ProviderConsAddress, updates []abci.ValidatorUpdate,
+) []abci.ValidatorUpdate {
+	k.DeleteAllSoftOptedOut(ctx, chainID)
+
+	powers := make([]int64, 0, len(updates))
+	for _, update := range updates {
+		powers = append(powers, update.Power)
+	}
+	inTopN := SelectTopN(powers, providerAddrs, k.GetTopN(ctx, chainID))
+
+	topNPowers := make([]int64, 0, len(updates))
+	for i, power := range powers {
+		if inTopN[i] {
+			topNPowers = append(topNPowers, power)
+		}
+	}
+	threshold := sdk.MustNewDecFromStr(k.GetConsumerChainSoftOptOutThreshold(ctx, chainID))
+	smallestPower := SmallestNonOptOutPower(topNPowers, threshold)
+
+	included := make([]abci.ValidatorUpdate, 0, len(updates))
+	for i, update := range updates {
+		if !inTopN[i] || update.Power < smallestPower {
+			k.SetSoftOptedOut(ctx, chainID, providerAddrs[i])
+			continue
+		}
+		included = append(included, update)
+	}
+	return included
+}
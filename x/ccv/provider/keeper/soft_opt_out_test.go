@@ -0,0 +1,436 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/golang/mock/gomock"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/interchain-security/testutil/crypto"
+	testkeeper "github.com/cosmos/interchain-security/testutil/keeper"
+	providerkeeper "github.com/cosmos/interchain-security/x/ccv/provider/keeper"
+	providertypes "github.com/cosmos/interchain-security/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/x/ccv/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestSoftOptedOut tests the getter, setter and deletion methods
+// for the validators opted out of validating consumer chains
+func TestSoftOptedOut(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerAddrA := crypto.NewCryptoIdentityFromIntSeed(1).ProviderConsAddress()
+	providerAddrB := crypto.NewCryptoIdentityFromIntSeed(2).ProviderConsAddress()
+
+	require.False(t, providerKeeper.IsSoftOptedOut(ctx, "chain", providerAddrA))
+	require.Empty(t, providerKeeper.GetAllSoftOptedOut(ctx, "chain"))
+
+	providerKeeper.SetSoftOptedOut(ctx, "chain", providerAddrA)
+	providerKeeper.SetSoftOptedOut(ctx, "chain", providerAddrB)
+	providerKeeper.SetSoftOptedOut(ctx, "chain1", providerAddrA)
+	require.True(t, providerKeeper.IsSoftOptedOut(ctx, "chain", providerAddrA))
+	require.ElementsMatch(t, []providertypes.ProviderConsAddress{providerAddrA, providerAddrB},
+		providerKeeper.GetAllSoftOptedOut(ctx, "chain"))
+
+	providerKeeper.DeleteAllSoftOptedOut(ctx, "chain")
+	require.False(t, providerKeeper.IsSoftOptedOut(ctx, "chain", providerAddrA))
+	require.Empty(t, providerKeeper.GetAllSoftOptedOut(ctx, "chain"))
+	// the validators opted out of other consumer chains are not affected
+	require.True(t, providerKeeper.IsSoftOptedOut(ctx, "chain1", providerAddrA))
+}
+
+// TestSmallestNonOptOutPower tests the smallest power of the validators
+// that are not opted out, in particular at the threshold boundary
+func TestSmallestNonOptOutPower(t *testing.T) {
+	testCases := []struct {
+		name      string
+		powers    []int64
+		threshold string
+		expPower  int64
+	}{
+		{"soft opt out disabled", []int64{1, 2, 7}, "0", 0},
+		{"no validators", nil, "0.05", 0},
+		{"cumulative power equal to the threshold, no validator opted out", []int64{7, 1, 2}, "0.1", 1},
+		{"cumulative power just below the threshold, smallest validator opted out", []int64{7, 1, 2}, "0.11", 2},
+		{"cumulative power of two validators below the threshold", []int64{1, 2, 7, 10}, "0.2", 7},
+		{"cumulative power of two validators equal to the threshold", []int64{1, 2, 7, 5}, "0.2", 2},
+		{"validators with the same power are all included", []int64{1, 1, 8}, "0.15", 1},
+		{"validators with the same power are all opted out", []int64{1, 1, 18}, "0.15", 18},
+		{"single validator is never opted out", []int64{5}, "0.2", 5},
+	}
+
+	for _, tc := range testCases {
+		power := providerkeeper.SmallestNonOptOutPower(tc.powers, sdk.MustNewDecFromStr(tc.threshold))
+		require.Equal(t, tc.expPower, power, tc.name)
+	}
+}
+
+// TestQueueVSCPacketsWithSoftOptOut tests that the VSC packets queued for a consumer chain exclude
+// the validators opted out of validating it, and include them again once their power rises above the threshold
+func TestQueueVSCPacketsWithSoftOptOut(t *testing.T) {
+	chainID := "consumer"
+	valA := crypto.NewCryptoIdentityFromIntSeed(1)
+	valB := crypto.NewCryptoIdentityFromIntSeed(2)
+	valC := crypto.NewCryptoIdentityFromIntSeed(3)
+	vals := []*crypto.CryptoIdentity{valA, valB, valC}
+
+	testCases := []struct {
+		name      string
+		threshold string
+		// the validators of the last validator set sent to the consumer chain
+		consumerVals []*crypto.CryptoIdentity
+		// whether validator A was opted out in a previous block
+		optedOutA       bool
+		powerA          int64
+		expectedUpdates []abci.ValidatorUpdate
+		expOptedOutA    bool
+	}{
+		{
+			name:            "cumulative power at the threshold, validator A is not opted out",
+			threshold:       "0.1",
+			consumerVals:    vals,
+			powerA:          1,
+			expectedUpdates: nil,
+			expOptedOutA:    false,
+		},
+		{
+			name:         "cumulative power below the threshold, validator A is removed",
+			threshold:    "0.11",
+			consumerVals: vals,
+			powerA:       1,
+			expectedUpdates: []abci.ValidatorUpdate{
+				{PubKey: valA.TMProtoCryptoPublicKey(), Power: 0},
+			},
+			expOptedOutA: true,
+		},
+		{
+			name:         "power of validator A rises above the threshold, validator A is included again",
+			threshold:    "0.11",
+			consumerVals: []*crypto.CryptoIdentity{valB, valC},
+			optedOutA:    true,
+			powerA:       2,
+			expectedUpdates: []abci.ValidatorUpdate{
+				{PubKey: valA.TMProtoCryptoPublicKey(), Power: 2},
+			},
+			expOptedOutA: false,
+		},
+		{
+			name:         "soft opt out disabled, validator A is included again",
+			threshold:    "0",
+			consumerVals: []*crypto.CryptoIdentity{valB, valC},
+			optedOutA:    true,
+			powerA:       1,
+			expectedUpdates: []abci.ValidatorUpdate{
+				{PubKey: valA.TMProtoCryptoPublicKey(), Power: 1},
+			},
+			expOptedOutA: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+		params := providertypes.DefaultParams()
+		params.SoftOptOutThreshold = tc.threshold
+		providerKeeper.SetParams(ctx, params)
+
+		lastPowers := map[*crypto.CryptoIdentity]int64{valA: tc.powerA, valB: 2, valC: 7}
+		providerKeeper.SetConsumerClientId(ctx, chainID, "clientID")
+		var consumerValSet []abci.ValidatorUpdate
+		for _, val := range tc.consumerVals {
+			consumerValSet = append(consumerValSet, abci.ValidatorUpdate{PubKey: val.TMProtoCryptoPublicKey(), Power: lastPowers[val]})
+		}
+		providerKeeper.SetConsumerValSet(ctx, chainID, 0, consumerValSet)
+		if tc.optedOutA {
+			providerKeeper.SetSoftOptedOut(ctx, chainID, valA.ProviderConsAddress())
+		}
+		// the threshold was just updated, so that the opted out validators are recomputed
+		providerKeeper.SetValidatorListsUpdated(ctx, chainID)
+
+		// the staking module does not return any validator updates
+		mocks.MockStakingKeeper.EXPECT().GetValidatorUpdates(ctx).Return([]abci.ValidatorUpdate{}).Times(1)
+		mocks.MockStakingKeeper.EXPECT().IterateLastValidatorPowers(ctx, gomock.Any()).DoAndReturn(
+			func(_ sdk.Context, cb func(sdk.ValAddress, int64) bool) {
+				for _, val := range vals {
+					if cb(val.SDKValOpAddress(), lastPowers[val]) {
+						return
+					}
+				}
+			}).Times(1)
+		for _, val := range vals {
+			mocks.MockStakingKeeper.EXPECT().GetValidator(ctx, val.SDKValOpAddress()).Return(
+				val.SDKStakingValidator(), true).Times(1)
+			mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, val.SDKValConsAddress()).Return(
+				val.SDKStakingValidator(), true).AnyTimes()
+		}
+
+		providerKeeper.QueueVSCPackets(ctx)
+
+		pending := providerKeeper.GetPendingVSCPackets(ctx, chainID)
+		if tc.expectedUpdates == nil {
+			require.Empty(t, pending, tc.name)
+		} else {
+			require.Len(t, pending, 1, tc.name)
+			require.Equal(t, tc.expectedUpdates, pending[0].ValidatorUpdates, tc.name)
+		}
+		require.Equal(t, tc.expOptedOutA, providerKeeper.IsSoftOptedOut(ctx, chainID, valA.ProviderConsAddress()), tc.name)
+		require.False(t, providerKeeper.IsSoftOptedOut(ctx, chainID, valB.ProviderConsAddress()), tc.name)
+
+		ctrl.Finish()
+	}
+}
+
+// TestSelectTopN tests the selection of the validators with the largest powers,
+// in particular at the N boundaries and with validators with the same power
+func TestSelectTopN(t *testing.T) {
+	// the provider addresses are ordered as the validators
+	addrs := make([]providertypes.ProviderConsAddress, 4)
+	for i := range addrs {
+		addrs[i] = providertypes.NewProviderConsAddress([]byte{byte(i + 1)})
+	}
+
+	testCases := []struct {
+		name        string
+		powers      []int64
+		n           uint32
+		expSelected []bool
+	}{
+		{"top N disabled", []int64{1, 2, 7}, 0, []bool{true, true, true}},
+		{"no validators", nil, 2, []bool{}},
+		{"N larger than the number of validators", []int64{1, 2, 7}, 4, []bool{true, true, true}},
+		{"N equal to the number of validators", []int64{1, 2, 7}, 3, []bool{true, true, true}},
+		{"N just below the number of validators", []int64{1, 2, 7}, 2, []bool{false, true, true}},
+		{"single validator selected", []int64{1, 7, 2}, 1, []bool{false, true, false}},
+		{"validators with the same power above the cut", []int64{5, 5, 1}, 2, []bool{true, true, false}},
+		{"validators with the same power at the cut are ordered by address", []int64{1, 5, 5, 7}, 2, []bool{false, true, false, true}},
+		{"all validators with the same power", []int64{3, 3, 3, 3}, 3, []bool{true, true, true, false}},
+	}
+
+	for _, tc := range testCases {
+		selected := providerkeeper.SelectTopN(tc.powers, addrs[:len(tc.powers)], tc.n)
+		require.Equal(t, tc.expSelected, selected, tc.name)
+	}
+}
+
+// TestQueueVSCPacketsWithTopN tests that the VSC packets queued for a consumer chain with a top N
+// exclude the validators below the cut, which are opted out of validating the consumer chain,
+// and that the cut applies to the validators allowed by the validator lists
+func TestQueueVSCPacketsWithTopN(t *testing.T) {
+	chainID := "consumer"
+	valA := crypto.NewCryptoIdentityFromIntSeed(1)
+	valB := crypto.NewCryptoIdentityFromIntSeed(2)
+	valC := crypto.NewCryptoIdentityFromIntSeed(3)
+	vals := []*crypto.CryptoIdentity{valA, valB, valC}
+
+	// with validators A and B having the same power, the one with the larger address is below the cut
+	tiedOut := valB
+	if bytes.Compare(valA.SDKValConsAddress(), valB.SDKValConsAddress()) > 0 {
+		tiedOut = valA
+	}
+
+	testCases := []struct {
+		name     string
+		topN     uint32
+		powerA   int64
+		denylist []*crypto.CryptoIdentity
+		// the validators of the last validator set sent to the consumer chain
+		consumerVals    []*crypto.CryptoIdentity
+		expectedUpdates []abci.ValidatorUpdate
+		expOptedOut     []*crypto.CryptoIdentity
+	}{
+		{
+			name:            "N equal to the number of validators, no validator is opted out",
+			topN:            3,
+			powerA:          1,
+			consumerVals:    vals,
+			expectedUpdates: nil,
+		},
+		{
+			name:         "validator A is below the cut and removed",
+			topN:         2,
+			powerA:       1,
+			consumerVals: vals,
+			expectedUpdates: []abci.ValidatorUpdate{
+				{PubKey: valA.TMProtoCryptoPublicKey(), Power: 0},
+			},
+			expOptedOut: []*crypto.CryptoIdentity{valA},
+		},
+		{
+			name:         "power of validator A rises above the cut, validator A replaces validator B",
+			topN:         2,
+			powerA:       10,
+			consumerVals: []*crypto.CryptoIdentity{valB, valC},
+			expectedUpdates: []abci.ValidatorUpdate{
+				{PubKey: valB.TMProtoCryptoPublicKey(), Power: 0},
+				{PubKey: valA.TMProtoCryptoPublicKey(), Power: 10},
+			},
+			expOptedOut: []*crypto.CryptoIdentity{valB},
+		},
+		{
+			name:         "denylisted validator does not take a place in the top N",
+			topN:         2,
+			powerA:       1,
+			denylist:     []*crypto.CryptoIdentity{valC},
+			consumerVals: vals,
+			expectedUpdates: []abci.ValidatorUpdate{
+				{PubKey: valC.TMProtoCryptoPublicKey(), Power: 0},
+			},
+		},
+		{
+			name:         "validators with the same power at the cut",
+			topN:         2,
+			powerA:       2,
+			consumerVals: vals,
+			expectedUpdates: []abci.ValidatorUpdate{
+				{PubKey: tiedOut.TMProtoCryptoPublicKey(), Power: 0},
+			},
+			expOptedOut: []*crypto.CryptoIdentity{tiedOut},
+		},
+	}
+
+	for _, tc := range testCases {
+		providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+		providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+		lastPowers := map[*crypto.CryptoIdentity]int64{valA: tc.powerA, valB: 2, valC: 7}
+		providerKeeper.SetConsumerClientId(ctx, chainID, "clientID")
+		providerKeeper.SetTopN(ctx, chainID, tc.topN)
+		var denylist []providertypes.ProviderConsAddress
+		for _, val := range tc.denylist {
+			denylist = append(denylist, val.ProviderConsAddress())
+		}
+		providerKeeper.SetValidatorDenylist(ctx, chainID, denylist)
+		var consumerValSet []abci.ValidatorUpdate
+		for _, val := range tc.consumerVals {
+			consumerValSet = append(consumerValSet, abci.ValidatorUpdate{PubKey: val.TMProtoCryptoPublicKey(), Power: lastPowers[val]})
+		}
+		providerKeeper.SetConsumerValSet(ctx, chainID, 0, consumerValSet)
+		// the top N was just updated, so that the opted out validators are recomputed
+		providerKeeper.SetValidatorListsUpdated(ctx, chainID)
+
+		// the staking module does not return any validator updates
+		mocks.MockStakingKeeper.EXPECT().GetValidatorUpdates(ctx).Return([]abci.ValidatorUpdate{}).Times(1)
+		mocks.MockStakingKeeper.EXPECT().IterateLastValidatorPowers(ctx, gomock.Any()).DoAndReturn(
+			func(_ sdk.Context, cb func(sdk.ValAddress, int64) bool) {
+				for _, val := range vals {
+					if cb(val.SDKValOpAddress(), lastPowers[val]) {
+						return
+					}
+				}
+			}).Times(1)
+		for _, val := range vals {
+			mocks.MockStakingKeeper.EXPECT().GetValidator(ctx, val.SDKValOpAddress()).Return(
+				val.SDKStakingValidator(), true).Times(1)
+			mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, val.SDKValConsAddress()).Return(
+				val.SDKStakingValidator(), true).AnyTimes()
+		}
+
+		providerKeeper.QueueVSCPackets(ctx)
+
+		pending := providerKeeper.GetPendingVSCPackets(ctx, chainID)
+		if tc.expectedUpdates == nil {
+			require.Empty(t, pending, tc.name)
+		} else {
+			require.Len(t, pending, 1, tc.name)
+			require.ElementsMatch(t, tc.expectedUpdates, pending[0].ValidatorUpdates, tc.name)
+		}
+		var expOptedOut []providertypes.ProviderConsAddress
+		for _, val := range tc.expOptedOut {
+			expOptedOut = append(expOptedOut, val.ProviderConsAddress())
+		}
+		require.ElementsMatch(t, expOptedOut, providerKeeper.GetAllSoftOptedOut(ctx, chainID), tc.name)
+
+		ctrl.Finish()
+	}
+}
+
+// TestHandleSlashPacketSoftOptedOut tests that a validator opted out of validating a consumer chain
+// is not jailed for downtime on that chain, while the slash ack is still sent
+func TestHandleSlashPacketSoftOptedOut(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	chainID := "consumer"
+	val := crypto.NewCryptoIdentityFromIntSeed(1)
+	providerKeeper.SetInitChainHeight(ctx, chainID, 5)
+	providerKeeper.SetSoftOptedOut(ctx, chainID, val.ProviderConsAddress())
+
+	// the validator is not jailed
+	gomock.InOrder(testkeeper.GetMocksForHandleSlashPacket(
+		ctx, mocks, val.ProviderConsAddress(), stakingtypes.Validator{Jailed: false}, false)...)
+
+	providerKeeper.HandleSlashPacket(ctx, chainID, *ccv.NewSlashPacketData(
+		abci.Validator{Address: val.SDKValConsAddress()}, 0, stakingtypes.Downtime))
+	consumerAddr := val.ConsumerConsAddress()
+	require.Equal(t, []string{consumerAddr.String()}, providerKeeper.GetSlashAcks(ctx, chainID))
+
+	// the validator is jailed once it is not opted out anymore
+	providerKeeper.DeleteAllSoftOptedOut(ctx, chainID)
+	gomock.InOrder(testkeeper.GetMocksForHandleSlashPacket(
+		ctx, mocks, val.ProviderConsAddress(), stakingtypes.Validator{Jailed: false}, true)...)
+
+	providerKeeper.HandleSlashPacket(ctx, chainID, *ccv.NewSlashPacketData(
+		abci.Validator{Address: val.SDKValConsAddress()}, 0, stakingtypes.Downtime))
+}
+
+// TestConsumerTotalPowerWithSoftOptOut tests that the total power of the validator set
+// sent to a consumer chain excludes the validators opted out of validating it
+func TestConsumerTotalPowerWithSoftOptOut(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	params := providertypes.DefaultParams()
+	params.SoftOptOutThreshold = "0.11"
+	providerKeeper.SetParams(ctx, params)
+
+	chainID := "consumer"
+	valA := crypto.NewCryptoIdentityFromIntSeed(1)
+	valB := crypto.NewCryptoIdentityFromIntSeed(2)
+	valC := crypto.NewCryptoIdentityFromIntSeed(3)
+	vals := []*crypto.CryptoIdentity{valA, valB, valC}
+	powers := map[*crypto.CryptoIdentity]int64{valA: 1, valB: 2, valC: 7}
+
+	// the consumer chain initially validated by all the validators
+	providerKeeper.SetConsumerClientId(ctx, chainID, "clientID")
+	var consumerValSet []abci.ValidatorUpdate
+	providerTotalPower := int64(0)
+	for _, val := range vals {
+		consumerValSet = append(consumerValSet, abci.ValidatorUpdate{PubKey: val.TMProtoCryptoPublicKey(), Power: powers[val]})
+		providerTotalPower += powers[val]
+	}
+	providerKeeper.SetConsumerValSet(ctx, chainID, 0, consumerValSet)
+	require.Equal(t, providerTotalPower, providerKeeper.GetConsumerTotalPower(ctx, chainID))
+
+	// validator A is opted out once the threshold is applied
+	providerKeeper.SetValidatorListsUpdated(ctx, chainID)
+	mocks.MockStakingKeeper.EXPECT().GetValidatorUpdates(ctx).Return([]abci.ValidatorUpdate{}).Times(1)
+	mocks.MockStakingKeeper.EXPECT().IterateLastValidatorPowers(ctx, gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, cb func(sdk.ValAddress, int64) bool) {
+			for _, val := range vals {
+				if cb(val.SDKValOpAddress(), powers[val]) {
+					return
+				}
+			}
+		}).Times(1)
+	for _, val := range vals {
+		mocks.MockStakingKeeper.EXPECT().GetValidator(ctx, val.SDKValOpAddress()).Return(
+			val.SDKStakingValidator(), true).Times(1)
+		mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, val.SDKValConsAddress()).Return(
+			val.SDKStakingValidator(), true).AnyTimes()
+	}
+	providerKeeper.QueueVSCPackets(ctx)
+	require.True(t, providerKeeper.IsSoftOptedOut(ctx, chainID, valA.ProviderConsAddress()))
+
+	// once the VSC packet is sent, the total power of the consumer chain excludes validator A
+	pending := providerKeeper.GetPendingVSCPackets(ctx, chainID)
+	require.Len(t, pending, 1)
+	providerKeeper.ApplyConsumerValSetUpdates(ctx, chainID, pending[0].ValsetUpdateId, pending[0].ValidatorUpdates)
+	require.Equal(t, providerTotalPower-powers[valA], providerKeeper.GetConsumerTotalPower(ctx, chainID))
+
+	// the total power of other consumer chains is zero
+	require.Equal(t, int64(0), providerKeeper.GetConsumerTotalPower(ctx, "other"))
+}
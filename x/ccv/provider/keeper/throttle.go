@@ -15,6 +15,11 @@ import (
 // HandleThrottleQueues iterates over the global slash entry queue, and
 // handles all or some portion of throttled (slash and/or VSC matured) packet data received from
 // consumer chains. The slash meter is decremented appropriately in this method.
+//
+// A slash packet whose validator is not found is kept at the head of its chain-specific queue,
+// in front of the VSC matured packet data received after it, and its global entry is queued again
+// with the current block time. Thus, the slash packet is retried in a following block, once it is
+// charged to the slash meter again, and the ordering of slash and VSC matured packets is preserved.
 func (k Keeper) HandleThrottleQueues(ctx sdktypes.Context) {
 
 	meter := k.GetSlashMeter(ctx)
@@ -27,15 +32,27 @@ func (k Keeper) HandleThrottleQueues(ctx sdktypes.Context) {
 	// depending on the value of the slash meter.
 	allEntries := k.GetAllGlobalSlashEntries(ctx)
 	handledEntries := []providertypes.GlobalSlashEntry{}
+	retriedEntries := []providertypes.GlobalSlashEntry{}
+	// the chains whose leading slash packet is retried in a following block
+	retriedChains := map[string]bool{}
 
 	for _, globalEntry := range allEntries {
+		if retriedChains[globalEntry.ConsumerChainID] {
+			// the queue of the chain is blocked by a slash packet to be retried
+			continue
+		}
+
 		// Subtract voting power that will be jailed/tombstoned from the slash meter
 		meter = meter.Sub(k.GetEffectiveValPower(ctx, *globalEntry.ProviderValConsAddr))
 
 		// Handle one slash and any trailing vsc matured packet data instances by passing in
 		// chainID and appropriate callbacks, relevant packet data is deleted in this method.
 
-		k.HandlePacketDataForChain(ctx, globalEntry.ConsumerChainID, k.HandleSlashPacket, k.HandleVSCMaturedPacket)
+		if retry := k.HandlePacketDataForChain(ctx, globalEntry.ConsumerChainID, k.HandleSlashPacket, k.HandleVSCMaturedPacket); retry {
+			retriedChains[globalEntry.ConsumerChainID] = true
+			retriedEntries = append(retriedEntries, providertypes.NewGlobalSlashEntry(
+				ctx.BlockTime(), globalEntry.ConsumerChainID, globalEntry.IbcSeqNum, *globalEntry.ProviderValConsAddr))
+		}
 		handledEntries = append(handledEntries, globalEntry)
 
 		// don't handle any more global entries if meter becomes negative in value
@@ -45,8 +62,12 @@ func (k Keeper) HandleThrottleQueues(ctx sdktypes.Context) {
 		}
 	}
 
-	// Handled global entries are deleted after iteration is completed
+	// Handled global entries are deleted after iteration is completed,
+	// before the entries of the slash packets to be retried are queued again
 	k.DeleteGlobalSlashEntries(ctx, handledEntries...)
+	for _, entry := range retriedEntries {
+		k.QueueGlobalSlashEntry(ctx, entry)
+	}
 
 	// Persist current value for slash meter
 	k.SetSlashMeter(ctx, meter)
@@ -79,15 +100,21 @@ func (k Keeper) GetEffectiveValPower(ctx sdktypes.Context,
 // and then handles any trailing vsc matured packets in that (consumer chain specific) throttled packet data queue.
 // The handled data is then deleted from the queue.
 //
+// If the slash packet handler returns true, i.e., the slash packet must be retried, nothing is deleted from
+// the queue and no vsc matured packet data is handled, so that it is not handled before the slash packet.
+// In this case, true is returned.
+//
 // Note: Any packet data which is handled in this method is also deleted from the (consumer chain specific) queue.
 func (k Keeper) HandlePacketDataForChain(ctx sdktypes.Context, consumerChainID string,
-	slashPacketHandler func(sdktypes.Context, string, ccvtypes.SlashPacketData),
+	slashPacketHandler func(sdktypes.Context, string, ccvtypes.SlashPacketData) (retry bool),
 	vscMaturedPacketHandler func(sdktypes.Context, string, ccvtypes.VSCMaturedPacketData),
-) {
+) (retry bool) {
 	// Get slash packet data and trailing vsc matured packet data, handle it all.
 	slashFound, slashData, vscMaturedData, seqNums := k.GetSlashAndTrailingData(ctx, consumerChainID)
 	if slashFound {
-		slashPacketHandler(ctx, consumerChainID, slashData)
+		if retry := slashPacketHandler(ctx, consumerChainID, slashData); retry {
+			return true
+		}
 	}
 	for _, vscMData := range vscMaturedData {
 		vscMaturedPacketHandler(ctx, consumerChainID, vscMData)
@@ -95,6 +122,7 @@ func (k Keeper) HandlePacketDataForChain(ctx sdktypes.Context, consumerChainID s
 
 	// Delete handled data after it has all been handled.
 	k.DeleteThrottledPacketData(ctx, consumerChainID, seqNums...)
+	return false
 }
 
 // InitializeSlashMeter initializes the slash meter to it's max value (also its allowance),
@@ -459,7 +487,8 @@ func (k Keeper) GetSlashAndTrailingData(ctx sdktypes.Context, consumerChainID st
 
 // GetAllThrottledPacketData returns all throttled packet data for a specific consumer chain.
 //
-// Note: This method is only used by tests and queries, hence why it returns redundant data as different types.
+// Note: This method is only used by tests, queries and the pruning of valset update block heights,
+// hence why it returns redundant data as different types.
 // Since this method executes on query, no panics are explicitly included.
 func (k Keeper) GetAllThrottledPacketData(ctx sdktypes.Context, consumerChainID string) (
 	slashData []ccvtypes.SlashPacketData, vscMaturedData []ccvtypes.VSCMaturedPacketData,
@@ -128,8 +128,9 @@ func TestHandlePacketDataForChain(t *testing.T) {
 
 		// Define our handler callbacks to simply store the data instances that are handled
 		handledData := []interface{}{}
-		slashHandleCounter := func(ctx sdktypes.Context, chainID string, data ccvtypes.SlashPacketData) {
+		slashHandleCounter := func(ctx sdktypes.Context, chainID string, data ccvtypes.SlashPacketData) bool {
 			handledData = append(handledData, data)
+			return false
 		}
 		vscMaturedHandleCounter := func(ctx sdktypes.Context, chainID string, data ccvtypes.VSCMaturedPacketData) {
 			handledData = append(handledData, data)
@@ -0,0 +1,242 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmprotocrypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
+
+	"github.com/cosmos/interchain-security/x/ccv/provider/types"
+	ccvutils "github.com/cosmos/interchain-security/x/ccv/utils"
+)
+
+// SetValidatorAllowlist replaces the validators allowed to validate
+// the consumer chain with the given chain ID by the given validators
+func (k Keeper) SetValidatorAllowlist(ctx sdk.Context, chainID string, providerAddrs []types.ProviderConsAddress) {
+	k.setValidatorList(ctx, types.ValidatorAllowlistBytePrefix, chainID, providerAddrs)
+}
+
+// GetValidatorAllowlist returns the validators allowed to validate the consumer chain with the given chain ID
+func (k Keeper) GetValidatorAllowlist(ctx sdk.Context, chainID string) []types.ProviderConsAddress {
+	return k.getValidatorList(ctx, types.ValidatorAllowlistBytePrefix, chainID)
+}
+
+// SetValidatorDenylist replaces the validators excluded from the validator set
+// of the consumer chain with the given chain ID by the given validators
+func (k Keeper) SetValidatorDenylist(ctx sdk.Context, chainID string, providerAddrs []types.ProviderConsAddress) {
+	k.setValidatorList(ctx, types.ValidatorDenylistBytePrefix, chainID, providerAddrs)
+}
+
+// GetValidatorDenylist returns the validators excluded from the validator set
+// of the consumer chain with the given chain ID
+func (k Keeper) GetValidatorDenylist(ctx sdk.Context, chainID string) []types.ProviderConsAddress {
+	return k.getValidatorList(ctx, types.ValidatorDenylistBytePrefix, chainID)
+}
+
+// DeleteValidatorLists removes the validator allowlist and denylist of the consumer chain with the given chain ID
+func (k Keeper) DeleteValidatorLists(ctx sdk.Context, chainID string) {
+	k.setValidatorList(ctx, types.ValidatorAllowlistBytePrefix, chainID, nil)
+	k.setValidatorList(ctx, types.ValidatorDenylistBytePrefix, chainID, nil)
+	k.DeleteValidatorListsUpdated(ctx, chainID)
+}
+
+// HasValidatorLists returns whether the consumer chain with the given chain ID
+// has a non-empty validator allowlist or denylist
+func (k Keeper) HasValidatorLists(ctx sdk.Context, chainID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	for _, prefix := range []byte{types.ValidatorAllowlistBytePrefix, types.ValidatorDenylistBytePrefix} {
+		iterator := sdk.KVStorePrefixIterator(store, types.ChainIdWithLenKey(prefix, chainID))
+		found := iterator.Valid()
+		iterator.Close()
+		if found {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValidatorAllowed returns whether the validator with the given provider address may be included
+// in the validator set of the consumer chain with the given chain ID. A validator in the denylist
+// is always excluded. If the allowlist is not empty, only the validators in the allowlist are included.
+func (k Keeper) IsValidatorAllowed(ctx sdk.Context, chainID string, providerAddr types.ProviderConsAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(types.ValidatorDenylistKey(chainID, providerAddr)) {
+		return false
+	}
+	if store.Has(types.ValidatorAllowlistKey(chainID, providerAddr)) {
+		return true
+	}
+	iterator := sdk.KVStorePrefixIterator(store, types.ChainIdWithLenKey(types.ValidatorAllowlistBytePrefix, chainID))
+	defer iterator.Close()
+	return !iterator.Valid()
+}
+
+// SetValidatorListsUpdated records that the validator lists of the consumer chain with the given chain ID,
+// or its soft opt out threshold, were updated, so that the validator set of the consumer chain
+// is reconciled in the next block
+func (k Keeper) SetValidatorListsUpdated(ctx sdk.Context, chainID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ValidatorListsUpdatedKey(chainID), []byte{})
+}
+
+// GetValidatorListsUpdated returns whether the validator lists of the consumer chain
+// with the given chain ID were updated since the last validator set change packet was queued
+func (k Keeper) GetValidatorListsUpdated(ctx sdk.Context, chainID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.ValidatorListsUpdatedKey(chainID))
+}
+
+// DeleteValidatorListsUpdated removes the record that the validator lists
+// of the consumer chain with the given chain ID were updated
+func (k Keeper) DeleteValidatorListsUpdated(ctx sdk.Context, chainID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ValidatorListsUpdatedKey(chainID))
+}
+
+// FilterValidatorUpdates returns the validator updates that bring the validator set of the consumer
+// chain with the given chain ID, as known once all the queued VSC packets are applied, to the set of
+// bonded provider validators allowed by the validator lists of the consumer chain and not opted out
+// of validating it by the top N or the soft opt out.
+// The validators that are not allowed are removed from the consumer validator set if they are in it;
+// the allowed validators are added with their current power. The returned updates use consumer keys.
+//
+// Note that no updates are returned if none of the bonded validators is allowed,
+// since the consumer validator set cannot be empty.
+func (k Keeper) FilterValidatorUpdates(ctx sdk.Context, chainID string) []abci.ValidatorUpdate {
+	// the validator set of the consumer chain once all the queued VSC packets are applied
+	current := k.getProjectedConsumerValSet(ctx, chainID)
+
+	// the consumer validator set that matches the validator lists
+	var next []abci.ValidatorUpdate
+	var nextProviderAddrs []types.ProviderConsAddress
+	k.stakingKeeper.IterateLastValidatorPowers(ctx, func(valAddr sdk.ValAddress, power int64) (stop bool) {
+		val, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+		if !found {
+			// An error here would indicate something is very wrong,
+			// the validators with last powers are stored by the staking module.
+			panic(fmt.Errorf("validator %s with last power not found", valAddr))
+		}
+		consAddr, err := val.GetConsAddr()
+		if err != nil {
+			panic(fmt.Errorf("invalid consensus address of validator %s: %w", valAddr, err))
+		}
+		providerAddr := types.NewProviderConsAddress(consAddr)
+		if !k.IsValidatorAllowed(ctx, chainID, providerAddr) {
+			return false
+		}
+
+		consumerKey, found := k.GetValidatorConsumerPubKey(ctx, chainID, providerAddr)
+		if !found {
+			consumerKey, err = val.TmConsPublicKey()
+			if err != nil {
+				panic(fmt.Errorf("invalid consensus public key of validator %s: %w", valAddr, err))
+			}
+		}
+		next = append(next, abci.ValidatorUpdate{PubKey: consumerKey, Power: power})
+		nextProviderAddrs = append(nextProviderAddrs, providerAddr)
+		return false
+	})
+	// exclude the validators opted out of validating the consumer chain
+	next = k.applySoftOptOut(ctx, chainID, nextProviderAddrs, next)
+
+	if len(next) == 0 {
+		k.Logger(ctx).Error("no bonded validator is allowed to validate the consumer chain, validator set not updated",
+			"chainID", chainID,
+		)
+		return nil
+	}
+
+	updates := ComputeValsetDiff(current, next)
+	if len(updates) == 0 {
+		return nil
+	}
+	return updates
+}
+
+// getProjectedConsumerValSet returns the validator set of the consumer chain with the given chain ID
+// once all the queued VSC packets are applied to the last validator set sent to the consumer chain.
+// The validators are returned as validator updates with consumer keys, in a deterministic order.
+func (k Keeper) getProjectedConsumerValSet(ctx sdk.Context, chainID string) []abci.ValidatorUpdate {
+	var addrs []string
+	vals := map[string]abci.ValidatorUpdate{}
+	apply := func(update abci.ValidatorUpdate) {
+		consumerAddr := k.mustConsumerAddrFromPubKey(update.PubKey)
+		addr := consumerAddr.String()
+		if _, found := vals[addr]; !found {
+			addrs = append(addrs, addr)
+		}
+		vals[addr] = update
+	}
+
+	for _, val := range k.GetConsumerValSet(ctx, chainID) {
+		consumerKey := val.ConsumerKey
+		if consumerKey == nil {
+			providerVal, found := k.stakingKeeper.GetValidatorByConsAddr(ctx, val.ProviderAddr.ToSdkConsAddr())
+			if !found {
+				k.Logger(ctx).Error("validator of the consumer validator set not found",
+					"chainID", chainID,
+					"provider cons addr", val.ProviderAddr.String(),
+				)
+				continue
+			}
+			pubKey, err := providerVal.TmConsPublicKey()
+			if err != nil {
+				panic(fmt.Errorf("invalid consensus public key of validator %s: %w", val.ProviderAddr.String(), err))
+			}
+			consumerKey = &pubKey
+		}
+		apply(abci.ValidatorUpdate{PubKey: *consumerKey, Power: val.Power})
+	}
+	for _, packet := range k.GetPendingVSCPackets(ctx, chainID) {
+		for _, update := range packet.ValidatorUpdates {
+			apply(update)
+		}
+	}
+
+	// remove the validators whose power was set to zero
+	projected := make([]abci.ValidatorUpdate, 0, len(addrs))
+	for _, addr := range addrs {
+		if vals[addr].Power == 0 {
+			continue
+		}
+		projected = append(projected, vals[addr])
+	}
+	return projected
+}
+
+// mustConsumerAddrFromPubKey returns the consumer address of the given consumer public key
+func (k Keeper) mustConsumerAddrFromPubKey(pubKey tmprotocrypto.PublicKey) types.ConsumerConsAddress {
+	addr, err := ccvutils.TMCryptoPublicKeyToConsAddr(pubKey)
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the consumer keys are validated when assigned or taken from the staking module.
+		panic(fmt.Errorf("invalid consumer public key: %w", err))
+	}
+	return types.NewConsumerConsAddress(addr)
+}
+
+// setValidatorList replaces the validators stored under the given prefix
+// for the consumer chain with the given chain ID by the given validators
+func (k Keeper) setValidatorList(ctx sdk.Context, bytePrefix byte, chainID string, providerAddrs []types.ProviderConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	for _, providerAddr := range k.getValidatorList(ctx, bytePrefix, chainID) {
+		store.Delete(types.ChainIdAndConsAddrKey(bytePrefix, chainID, providerAddr.ToSdkConsAddr()))
+	}
+	for _, providerAddr := range providerAddrs {
+		store.Set(types.ChainIdAndConsAddrKey(bytePrefix, chainID, providerAddr.ToSdkConsAddr()), []byte{})
+	}
+}
+
+// getValidatorList returns the validators stored under the given prefix for the consumer chain with the given chain ID
+func (k Keeper) getValidatorList(ctx sdk.Context, bytePrefix byte, chainID string) (providerAddrs []types.ProviderConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.ChainIdWithLenKey(bytePrefix, chainID)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		providerAddrs = append(providerAddrs, types.NewProviderConsAddress(iterator.Key()[len(prefix):]))
+	}
+	return providerAddrs
+}
@@ -0,0 +1,289 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
+	"github.com/golang/mock/gomock"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/interchain-security/testutil/crypto"
+	testkeeper "github.com/cosmos/interchain-security/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/x/ccv/provider/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestValidatorLists tests the getter, setter and deletion methods for the validator lists of consumer chains
+func TestValidatorLists(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerAddrA := crypto.NewCryptoIdentityFromIntSeed(1).ProviderConsAddress()
+	providerAddrB := crypto.NewCryptoIdentityFromIntSeed(2).ProviderConsAddress()
+	providerAddrC := crypto.NewCryptoIdentityFromIntSeed(3).ProviderConsAddress()
+
+	// without lists, every validator is allowed
+	require.False(t, providerKeeper.HasValidatorLists(ctx, "chain"))
+	require.True(t, providerKeeper.IsValidatorAllowed(ctx, "chain", providerAddrA))
+
+	// a validator in the denylist is excluded
+	providerKeeper.SetValidatorDenylist(ctx, "chain", []providertypes.ProviderConsAddress{providerAddrA})
+	require.True(t, providerKeeper.HasValidatorLists(ctx, "chain"))
+	require.False(t, providerKeeper.IsValidatorAllowed(ctx, "chain", providerAddrA))
+	require.True(t, providerKeeper.IsValidatorAllowed(ctx, "chain", providerAddrB))
+
+	// with a non-empty allowlist, only the listed validators are allowed,
+	// unless they are also in the denylist
+	providerKeeper.SetValidatorAllowlist(ctx, "chain", []providertypes.ProviderConsAddress{providerAddrA, providerAddrB})
+	require.ElementsMatch(t, []providertypes.ProviderConsAddress{providerAddrA, providerAddrB},
+		providerKeeper.GetValidatorAllowlist(ctx, "chain"))
+	require.False(t, providerKeeper.IsValidatorAllowed(ctx, "chain", providerAddrA))
+	require.True(t, providerKeeper.IsValidatorAllowed(ctx, "chain", providerAddrB))
+	require.False(t, providerKeeper.IsValidatorAllowed(ctx, "chain", providerAddrC))
+
+	// the lists of other consumer chains are not affected
+	require.False(t, providerKeeper.HasValidatorLists(ctx, "chain1"))
+	require.True(t, providerKeeper.IsValidatorAllowed(ctx, "chain1", providerAddrC))
+
+	// setting a list replaces it
+	providerKeeper.SetValidatorAllowlist(ctx, "chain", []providertypes.ProviderConsAddress{providerAddrC})
+	require.Equal(t, []providertypes.ProviderConsAddress{providerAddrC}, providerKeeper.GetValidatorAllowlist(ctx, "chain"))
+	require.False(t, providerKeeper.IsValidatorAllowed(ctx, "chain", providerAddrB))
+	require.True(t, providerKeeper.IsValidatorAllowed(ctx, "chain", providerAddrC))
+
+	providerKeeper.SetValidatorListsUpdated(ctx, "chain")
+	require.True(t, providerKeeper.GetValidatorListsUpdated(ctx, "chain"))
+
+	providerKeeper.DeleteValidatorLists(ctx, "chain")
+	require.False(t, providerKeeper.HasValidatorLists(ctx, "chain"))
+	require.Empty(t, providerKeeper.GetValidatorAllowlist(ctx, "chain"))
+	require.Empty(t, providerKeeper.GetValidatorDenylist(ctx, "chain"))
+	require.False(t, providerKeeper.GetValidatorListsUpdated(ctx, "chain"))
+}
+
+// TestQueueVSCPacketsWithValidatorLists tests that the VSC packets queued for a consumer chain
+// with validator lists bring the consumer validator set to the allowed bonded validators
+func TestQueueVSCPacketsWithValidatorLists(t *testing.T) {
+	chainID := "consumer"
+	valA := crypto.NewCryptoIdentityFromIntSeed(1)
+	valB := crypto.NewCryptoIdentityFromIntSeed(2)
+	valBConsumer := crypto.NewCryptoIdentityFromIntSeed(3)
+	valC := crypto.NewCryptoIdentityFromIntSeed(4)
+	lastPowers := map[*crypto.CryptoIdentity]int64{valA: 1, valB: 3, valC: 4}
+
+	testCases := []struct {
+		name      string
+		allowlist []providertypes.ProviderConsAddress
+		denylist  []providertypes.ProviderConsAddress
+		// whether the lists were updated, e.g., removed, in the current block
+		updated         bool
+		expectedUpdates []abci.ValidatorUpdate
+	}{
+		{
+			name:            "no validator lists",
+			expectedUpdates: nil,
+		},
+		{
+			name: "validator lists removed, all the bonded validators are added",
+			// the consumer validator set was filtered, i.e., validator C is not in it
+			updated: true,
+			expectedUpdates: []abci.ValidatorUpdate{
+				{PubKey: valBConsumer.TMProtoCryptoPublicKey(), Power: 3},
+				{PubKey: valC.TMProtoCryptoPublicKey(), Power: 4},
+			},
+		},
+		{
+			name:     "denylisted validator is removed",
+			denylist: []providertypes.ProviderConsAddress{valA.ProviderConsAddress()},
+			updated:  true,
+			expectedUpdates: []abci.ValidatorUpdate{
+				{PubKey: valA.TMProtoCryptoPublicKey(), Power: 0},
+				{PubKey: valBConsumer.TMProtoCryptoPublicKey(), Power: 3},
+				{PubKey: valC.TMProtoCryptoPublicKey(), Power: 4},
+			},
+		},
+		{
+			name:      "only the allowlisted validators are included",
+			allowlist: []providertypes.ProviderConsAddress{valA.ProviderConsAddress(), valC.ProviderConsAddress()},
+			updated:   true,
+			expectedUpdates: []abci.ValidatorUpdate{
+				{PubKey: valBConsumer.TMProtoCryptoPublicKey(), Power: 0},
+				{PubKey: valC.TMProtoCryptoPublicKey(), Power: 4},
+			},
+		},
+		{
+			name:            "no bonded validator is allowed, the consumer validator set is not updated",
+			allowlist:       []providertypes.ProviderConsAddress{valBConsumer.ProviderConsAddress()},
+			updated:         true,
+			expectedUpdates: nil,
+		},
+		{
+			name: "validator lists not updated and no validator updates, the filtered validator set is not recomputed",
+			// the consumer validator set was filtered before, i.e., validator C might not be in it
+			denylist:        []providertypes.ProviderConsAddress{valC.ProviderConsAddress()},
+			expectedUpdates: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+		providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+		providerKeeper.SetConsumerClientId(ctx, chainID, "clientID")
+		providerKeeper.SetValidatorConsumerPubKey(ctx, chainID, valB.ProviderConsAddress(), valBConsumer.TMProtoCryptoPublicKey())
+		providerKeeper.SetValidatorByConsumerAddr(ctx, chainID, valBConsumer.ConsumerConsAddress(), valB.ProviderConsAddress())
+		// the last validator set sent to the consumer chain, which does not include validator C
+		providerKeeper.SetConsumerValSet(ctx, chainID, 0, []abci.ValidatorUpdate{
+			{PubKey: valA.TMProtoCryptoPublicKey(), Power: 1},
+			{PubKey: valBConsumer.TMProtoCryptoPublicKey(), Power: 2},
+		})
+		providerKeeper.SetValidatorAllowlist(ctx, chainID, tc.allowlist)
+		providerKeeper.SetValidatorDenylist(ctx, chainID, tc.denylist)
+		if tc.updated {
+			providerKeeper.SetValidatorListsUpdated(ctx, chainID)
+		}
+
+		// the staking module does not return any validator updates
+		mocks.MockStakingKeeper.EXPECT().GetValidatorUpdates(ctx).Return([]abci.ValidatorUpdate{}).Times(1)
+		if tc.updated {
+			mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, valA.SDKValConsAddress()).Return(
+				valA.SDKStakingValidator(), true).Times(1)
+			mocks.MockStakingKeeper.EXPECT().IterateLastValidatorPowers(ctx, gomock.Any()).DoAndReturn(
+				func(_ sdk.Context, cb func(sdk.ValAddress, int64) bool) {
+					for _, val := range []*crypto.CryptoIdentity{valA, valB, valC} {
+						if cb(val.SDKValOpAddress(), lastPowers[val]) {
+							return
+						}
+					}
+				}).Times(1)
+			for _, val := range []*crypto.CryptoIdentity{valA, valB, valC} {
+				mocks.MockStakingKeeper.EXPECT().GetValidator(ctx, val.SDKValOpAddress()).Return(
+					val.SDKStakingValidator(), true).Times(1)
+			}
+		}
+
+		providerKeeper.QueueVSCPackets(ctx)
+
+		pending := providerKeeper.GetPendingVSCPackets(ctx, chainID)
+		if tc.expectedUpdates == nil {
+			require.Empty(t, pending, tc.name)
+		} else {
+			require.Len(t, pending, 1, tc.name)
+			require.Equal(t, tc.expectedUpdates, pending[0].ValidatorUpdates, tc.name)
+		}
+		require.False(t, providerKeeper.GetValidatorListsUpdated(ctx, chainID), tc.name)
+
+		ctrl.Finish()
+	}
+}
+
+// TestMakeConsumerGenesisWithValidatorLists tests that the initial validator set
+// of a consumer chain only contains the validators allowed by its validator lists
+func TestMakeConsumerGenesisWithValidatorLists(t *testing.T) {
+	chainID := "consumer"
+	valA := crypto.NewCryptoIdentityFromIntSeed(1)
+	valB := crypto.NewCryptoIdentityFromIntSeed(2)
+
+	testCases := []struct {
+		name            string
+		denylist        []providertypes.ProviderConsAddress
+		expectedInitial []abci.ValidatorUpdate
+		expectedError   bool
+	}{
+		{
+			name: "no validator lists",
+			expectedInitial: []abci.ValidatorUpdate{
+				{PubKey: valA.TMProtoCryptoPublicKey(), Power: 1},
+				{PubKey: valB.TMProtoCryptoPublicKey(), Power: 2},
+			},
+		},
+		{
+			name:     "denylisted validator is not in the initial validator set",
+			denylist: []providertypes.ProviderConsAddress{valA.ProviderConsAddress()},
+			expectedInitial: []abci.ValidatorUpdate{
+				{PubKey: valB.TMProtoCryptoPublicKey(), Power: 2},
+			},
+		},
+		{
+			name:          "no bonded validator is allowed",
+			denylist:      []providertypes.ProviderConsAddress{valA.ProviderConsAddress(), valB.ProviderConsAddress()},
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+		providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+		providerKeeper.SetValidatorDenylist(ctx, chainID, tc.denylist)
+
+		mocks.MockStakingKeeper.EXPECT().UnbondingTime(ctx).Return(time.Duration(1814400000000000)).Times(1)
+		mocks.MockClientKeeper.EXPECT().GetSelfConsensusState(ctx, clienttypes.GetSelfHeight(ctx)).Return(
+			&ibctmtypes.ConsensusState{}, nil).Times(1)
+		mocks.MockStakingKeeper.EXPECT().IterateLastValidatorPowers(ctx, gomock.Any()).DoAndReturn(
+			func(_ sdk.Context, cb func(sdk.ValAddress, int64) bool) {
+				cb(valA.SDKValOpAddress(), 1)
+				cb(valB.SDKValOpAddress(), 2)
+			}).Times(1)
+		for _, val := range []*crypto.CryptoIdentity{valA, valB} {
+			mocks.MockStakingKeeper.EXPECT().GetValidator(ctx, val.SDKValOpAddress()).Return(
+				val.SDKStakingValidator(), true).Times(1)
+		}
+
+		prop := providertypes.ConsumerAdditionProposal{
+			ChainId:                           chainID,
+			BlocksPerDistributionTransmission: 1000,
+			CcvTimeoutPeriod:                  2419200000000000,
+			TransferTimeoutPeriod:             3600000000000,
+			ConsumerRedistributionFraction:    "0.75",
+			HistoricalEntries:                 10000,
+			UnbondingPeriod:                   1728000000000000,
+		}
+		gen, _, err := providerKeeper.MakeConsumerGenesis(ctx, &prop)
+		if tc.expectedError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.expectedInitial, gen.InitialValSet, tc.name)
+		}
+
+		ctrl.Finish()
+	}
+}
+
+// TestHandleConsumerValidatorListsProposal tests that a consumer validator lists proposal
+// replaces the validator lists of a known consumer chain
+func TestHandleConsumerValidatorListsProposal(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerAddrA := crypto.NewCryptoIdentityFromIntSeed(1).ProviderConsAddress()
+	providerAddrB := crypto.NewCryptoIdentityFromIntSeed(2).ProviderConsAddress()
+	prop := providertypes.NewConsumerValidatorListsProposal("title", "description", "chain",
+		[]string{providerAddrA.String()}, []string{providerAddrB.String()},
+	).(*providertypes.ConsumerValidatorListsProposal)
+
+	// the consumer chain is unknown
+	err := providerKeeper.HandleConsumerValidatorListsProposal(ctx, prop)
+	require.Error(t, err)
+	require.False(t, providerKeeper.HasValidatorLists(ctx, "chain"))
+
+	providerKeeper.SetConsumerClientId(ctx, "chain", "clientID")
+	providerKeeper.SetValidatorDenylist(ctx, "chain", []providertypes.ProviderConsAddress{providerAddrA})
+	err = providerKeeper.HandleConsumerValidatorListsProposal(ctx, prop)
+	require.NoError(t, err)
+	require.Equal(t, []providertypes.ProviderConsAddress{providerAddrA}, providerKeeper.GetValidatorAllowlist(ctx, "chain"))
+	require.Equal(t, []providertypes.ProviderConsAddress{providerAddrB}, providerKeeper.GetValidatorDenylist(ctx, "chain"))
+	require.True(t, providerKeeper.GetValidatorListsUpdated(ctx, "chain"))
+
+	// the lists can be removed
+	prop.ValidatorAllowlist = nil
+	prop.ValidatorDenylist = nil
+	err = providerKeeper.HandleConsumerValidatorListsProposal(ctx, prop)
+	require.NoError(t, err)
+	require.False(t, providerKeeper.HasValidatorLists(ctx, "chain"))
+	require.True(t, providerKeeper.GetValidatorListsUpdated(ctx, "chain"))
+}
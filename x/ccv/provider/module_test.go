@@ -136,9 +136,16 @@ func TestInitGenesis(t *testing.T) {
 			)
 		}
 
-		// Last total power is queried in InitGenesis, only if method has not
-		// already panicked from unowned capability.
+		// The channel capabilities of the established CCV channels, and then the last total power,
+		// are queried in InitGenesis, only if method has not already panicked from unowned capability.
 		if !tc.expPanic {
+			for _, state := range tc.consumerStates {
+				orderedCalls = append(orderedCalls,
+					mocks.MockScopedKeeper.EXPECT().GetCapability(
+						ctx, host.ChannelCapabilityPath(ccv.ProviderPortID, state.ChannelId),
+					).Return(&capabilitytypes.Capability{}, true).Times(1),
+				)
+			}
 			orderedCalls = append(orderedCalls,
 				mocks.MockStakingKeeper.EXPECT().GetLastTotalPower(
 					ctx).Return(sdk.NewInt(100)).Times(1), // Return total voting power as 100
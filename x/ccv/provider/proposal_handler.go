@@ -9,7 +9,9 @@ import (
 )
 
 // NewProviderProposalHandler defines the handler for consumer addition,
-// consumer removal and equivocation proposals.
+// consumer removal, equivocation, consumer validator lists, consumer parameters update,
+// force complete unbonding, consumer addition cancellation, change reward denoms,
+// consumer pause, consumer metadata update and change consumer reward fraction proposals.
 // Passed proposals are executed during EndBlock.
 func NewProviderProposalHandler(k keeper.Keeper) govtypes.Handler {
 	return func(ctx sdk.Context, content govtypes.Content) error {
@@ -20,6 +22,22 @@ func NewProviderProposalHandler(k keeper.Keeper) govtypes.Handler {
 			return k.HandleConsumerRemovalProposal(ctx, c)
 		case *types.EquivocationProposal:
 			return k.HandleEquivocationProposal(ctx, c)
+		case *types.ConsumerValidatorListsProposal:
+			return k.HandleConsumerValidatorListsProposal(ctx, c)
+		case *types.ConsumerParametersUpdateProposal:
+			return k.HandleConsumerParametersUpdateProposal(ctx, c)
+		case *types.ForceCompleteUnbondingProposal:
+			return k.HandleForceCompleteUnbondingProposal(ctx, c)
+		case *types.ConsumerAdditionCancellationProposal:
+			return k.HandleConsumerAdditionCancellationProposal(ctx, c)
+		case *types.ChangeRewardDenomsProposal:
+			return k.HandleChangeRewardDenomsProposal(ctx, c)
+		case *types.ConsumerPauseProposal:
+			return k.HandleConsumerPauseProposal(ctx, c)
+		case *types.ConsumerMetadataUpdateProposal:
+			return k.HandleConsumerMetadataUpdateProposal(ctx, c)
+		case *types.ChangeConsumerRewardFractionProposal:
+			return k.HandleChangeConsumerRewardFractionProposal(ctx, c)
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized ccv proposal content type: %T", c)
 		}
@@ -1,10 +1,62 @@
 package types
 
 import (
+	"fmt"
+	"net/url"
+
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+
 	consumertypes "github.com/cosmos/interchain-security/x/ccv/consumer/types"
 	ccv "github.com/cosmos/interchain-security/x/ccv/types"
 )
 
+// ConsumerClientState is the state of a light client of a consumer chain that exposes the chain ID
+// of the consumer chain, so that the CCV channels can be matched to the consumer chains.
+// The light clients of the types allowed by the AllowedConsumerClientTypes param must implement it.
+type ConsumerClientState interface {
+	ibcexported.ClientState
+	GetChainID() string
+}
+
+const (
+	// MaxConsumerMetadataNameLength is the maximum length of the name of a consumer chain
+	MaxConsumerMetadataNameLength = 64
+	// MaxConsumerMetadataDescriptionLength is the maximum length of the description of a consumer chain
+	MaxConsumerMetadataDescriptionLength = 5000
+	// MaxConsumerMetadataWebsiteLength is the maximum length of the website URL of a consumer chain
+	MaxConsumerMetadataWebsiteLength = 256
+	// MaxConsumerMetadataContactLength is the maximum length of the contact information of a consumer chain
+	MaxConsumerMetadataContactLength = 256
+)
+
+// Validate validates the metadata of a consumer chain. All the fields are optional,
+// but they cannot exceed their maximum lengths and the website must be an HTTP(S) URL.
+func (m ConsumerMetadata) Validate() error {
+	if len(m.Name) > MaxConsumerMetadataNameLength {
+		return fmt.Errorf("name cannot be longer than %d characters, got %d", MaxConsumerMetadataNameLength, len(m.Name))
+	}
+	if len(m.Description) > MaxConsumerMetadataDescriptionLength {
+		return fmt.Errorf("description cannot be longer than %d characters, got %d",
+			MaxConsumerMetadataDescriptionLength, len(m.Description))
+	}
+	if len(m.Website) > MaxConsumerMetadataWebsiteLength {
+		return fmt.Errorf("website cannot be longer than %d characters, got %d", MaxConsumerMetadataWebsiteLength, len(m.Website))
+	}
+	if m.Website != "" {
+		u, err := url.ParseRequestURI(m.Website)
+		if err != nil {
+			return fmt.Errorf("website is not a valid URL: %s", err)
+		}
+		if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("website must be an HTTP or HTTPS URL, got %s", m.Website)
+		}
+	}
+	if len(m.Contact) > MaxConsumerMetadataContactLength {
+		return fmt.Errorf("contact cannot be longer than %d characters, got %d", MaxConsumerMetadataContactLength, len(m.Contact))
+	}
+	return nil
+}
+
 func NewConsumerStates(
 	chainID,
 	clientID,
@@ -13,7 +65,7 @@ func NewConsumerStates(
 	genesis consumertypes.GenesisState,
 	unbondingOpsIndexes []VscUnbondingOps,
 	pendingValsetChanges []ccv.ValidatorSetChangePacketData,
-	slashDowntimeAck []string,
+	slashAcks *SlashAcks,
 ) ConsumerState {
 	return ConsumerState{
 		ChainId:              chainID,
@@ -23,6 +75,6 @@ func NewConsumerStates(
 		UnbondingOpsIndex:    unbondingOpsIndexes,
 		PendingValsetChanges: pendingValsetChanges,
 		ConsumerGenesis:      genesis,
-		SlashDowntimeAck:     slashDowntimeAck,
+		SlashAcks:            slashAcks,
 	}
 }
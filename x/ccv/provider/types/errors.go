@@ -6,16 +6,35 @@ import (
 
 // Provider sentinel errors
 var (
-	ErrInvalidConsumerAdditionProposal = sdkerrors.Register(ModuleName, 1, "invalid consumer addition proposal")
-	ErrInvalidConsumerRemovalProp      = sdkerrors.Register(ModuleName, 2, "invalid consumer removal proposal")
-	ErrUnknownConsumerChainId          = sdkerrors.Register(ModuleName, 3, "no consumer chain with this chain id")
-	ErrUnknownConsumerChannelId        = sdkerrors.Register(ModuleName, 4, "no consumer chain with this channel id")
-	ErrInvalidConsumerConsensusPubKey  = sdkerrors.Register(ModuleName, 5, "empty consumer consensus public key")
-	ErrBlankConsumerChainID            = sdkerrors.Register(ModuleName, 6, "consumer chain id must not be blank")
-	ErrConsumerKeyNotFound             = sdkerrors.Register(ModuleName, 7, "consumer key not found")
-	ErrNoValidatorConsumerAddress      = sdkerrors.Register(ModuleName, 8, "error getting validator consumer address")
-	ErrNoValidatorProviderAddress      = sdkerrors.Register(ModuleName, 9, "error getting validator provider address")
-	ErrConsumerKeyInUse                = sdkerrors.Register(ModuleName, 10, "consumer key is already in use by a validator")
-	ErrInvalidConsumerParams           = sdkerrors.Register(ModuleName, 11, "invalid consumer params")
-	ErrInvalidProviderAddress          = sdkerrors.Register(ModuleName, 12, "invalid provider address")
+	ErrInvalidConsumerAdditionProposal     = sdkerrors.Register(ModuleName, 1, "invalid consumer addition proposal")
+	ErrInvalidConsumerRemovalProp          = sdkerrors.Register(ModuleName, 2, "invalid consumer removal proposal")
+	ErrUnknownConsumerChainId              = sdkerrors.Register(ModuleName, 3, "no consumer chain with this chain id")
+	ErrUnknownConsumerChannelId            = sdkerrors.Register(ModuleName, 4, "no consumer chain with this channel id")
+	ErrInvalidConsumerConsensusPubKey      = sdkerrors.Register(ModuleName, 5, "empty consumer consensus public key")
+	ErrBlankConsumerChainID                = sdkerrors.Register(ModuleName, 6, "consumer chain id must not be blank")
+	ErrConsumerKeyNotFound                 = sdkerrors.Register(ModuleName, 7, "consumer key not found")
+	ErrNoValidatorConsumerAddress          = sdkerrors.Register(ModuleName, 8, "error getting validator consumer address")
+	ErrNoValidatorProviderAddress          = sdkerrors.Register(ModuleName, 9, "error getting validator provider address")
+	ErrConsumerKeyInUse                    = sdkerrors.Register(ModuleName, 10, "consumer key is already in use by a validator")
+	ErrInvalidConsumerParams               = sdkerrors.Register(ModuleName, 11, "invalid consumer params")
+	ErrInvalidProviderAddress              = sdkerrors.Register(ModuleName, 12, "invalid provider address")
+	ErrUnknownValidator                    = sdkerrors.Register(ModuleName, 13, "unknown validator")
+	ErrInvalidConsumerMisbehaviour         = sdkerrors.Register(ModuleName, 14, "invalid consumer misbehaviour")
+	ErrInvalidConsumerValidatorListsProp   = sdkerrors.Register(ModuleName, 15, "invalid consumer validator lists proposal")
+	ErrInvalidConsumerParametersUpdateProp = sdkerrors.Register(ModuleName, 16, "invalid consumer parameters update proposal")
+	ErrInvalidForceCompleteUnbondingProp   = sdkerrors.Register(ModuleName, 17, "invalid force complete unbonding proposal")
+	ErrUnknownInfractionHeight             = sdkerrors.Register(ModuleName, 18, "no infraction height for this valset update id")
+	ErrInvalidInfractionType               = sdkerrors.Register(ModuleName, 19, "invalid infraction type")
+	ErrNoEquivocationRecord                = sdkerrors.Register(ModuleName, 20, "no equivocation record found for validator")
+	ErrInvalidConsumerGenesis              = sdkerrors.Register(ModuleName, 21, "invalid consumer genesis")
+	ErrUnknownUnbondingOp                  = sdkerrors.Register(ModuleName, 22, "no unbonding op with this id")
+	ErrInvalidStoreVersion                 = sdkerrors.Register(ModuleName, 23, "invalid provider store version")
+	ErrInvalidAdditionCancellationProp     = sdkerrors.Register(ModuleName, 24, "invalid consumer addition cancellation proposal")
+	ErrConsumerIsProviderChainId           = sdkerrors.Register(ModuleName, 25, "consumer chain id is the provider chain id")
+	ErrInvalidChangeRewardDenomsProp       = sdkerrors.Register(ModuleName, 26, "invalid change reward denoms proposal")
+	ErrInvalidConsumerClientState          = sdkerrors.Register(ModuleName, 27, "invalid consumer client state")
+	ErrInvalidConsumerPauseProp            = sdkerrors.Register(ModuleName, 28, "invalid consumer pause proposal")
+	ErrInvalidConsumerPauseChange          = sdkerrors.Register(ModuleName, 29, "invalid consumer chain pause or resume")
+	ErrInvalidConsumerMetadataUpdateProp   = sdkerrors.Register(ModuleName, 30, "invalid consumer metadata update proposal")
+	ErrInvalidChangeRewardFractionProp     = sdkerrors.Register(ModuleName, 31, "invalid change consumer reward fraction proposal")
 )
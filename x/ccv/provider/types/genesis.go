@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -81,6 +82,51 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	for _, channelID := range gs.InvalidatedChannelIds {
+		if err := host.ChannelIdentifierValidator(channelID); err != nil {
+			return sdkerrors.Wrap(ccv.ErrInvalidGenesis, fmt.Sprintf("invalid invalidated channel ID: %s", err))
+		}
+		for _, cs := range gs.ConsumerStates {
+			if cs.ChannelId == channelID {
+				return sdkerrors.Wrap(ccv.ErrInvalidGenesis,
+					fmt.Sprintf("channel %s of consumer chain %s was invalidated", channelID, cs.ChainId))
+			}
+		}
+	}
+
+	for _, record := range gs.ValidatorJailRecords {
+		if record.ProviderAddr == nil {
+			return sdkerrors.Wrap(ccv.ErrInvalidGenesis, "validator jail record cannot have an empty provider address")
+		}
+		if err := sdk.VerifyAddressFormat(record.ProviderAddr.ToSdkConsAddr()); err != nil {
+			return sdkerrors.Wrap(ccv.ErrInvalidGenesis, fmt.Sprintf("invalid provider address in validator jail record: %s", err))
+		}
+	}
+
+	for _, held := range gs.HeldUnbondingOps {
+		if strings.TrimSpace(held.ChainId) == "" {
+			return sdkerrors.Wrap(ccv.ErrInvalidGenesis, "held unbonding operations cannot have a blank chain id")
+		}
+		for _, cs := range gs.ConsumerStates {
+			if cs.ChainId == held.ChainId {
+				return sdkerrors.Wrap(ccv.ErrInvalidGenesis,
+					fmt.Sprintf("unbonding operations of running consumer chain %s cannot be held", held.ChainId))
+			}
+		}
+	}
+
+	if gs.PortId != "" {
+		if err := host.PortIdentifierValidator(gs.PortId); err != nil {
+			return sdkerrors.Wrap(ccv.ErrInvalidGenesis, fmt.Sprintf("invalid port ID: %s", err))
+		}
+	}
+
+	for _, denom := range gs.ConsumerRewardDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return sdkerrors.Wrap(ccv.ErrInvalidGenesis, fmt.Sprintf("invalid consumer reward denom: %s", err))
+		}
+	}
+
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
@@ -99,6 +145,10 @@ func (gs GenesisState) ValidateUnbondingOp(ubdOp UnbondingOp) error {
 	if len(ubdOp.UnbondingConsumerChains) == 0 {
 		return sdkerrors.Wrap(ccv.ErrInvalidGenesis, "unbonding operations cannot have an empty consumer chain list")
 	}
+	if !ubdOp.Balance.IsNil() && ubdOp.Balance.IsNegative() {
+		return sdkerrors.Wrap(ccv.ErrInvalidGenesis,
+			fmt.Sprintf("unbonding operation cannot have a negative balance, opID=%d", ubdOp.Id))
+	}
 
 	// Check that the ID is set correctly in the UnbondingOpsIndex
 	for _, chainID := range ubdOp.UnbondingConsumerChains {
@@ -109,14 +159,14 @@ func (gs GenesisState) ValidateUnbondingOp(ubdOp UnbondingOp) error {
 			if cs.ChainId != chainID {
 				continue
 			}
-			for _, vscUnbondingOps := range cs.UnbondingOpsIndex {
-				for _, id := range vscUnbondingOps.GetUnbondingOpIds() {
-					if id == ubdOp.Id {
-						found = true
-						break
-					}
-				}
+			found = found || indexContains(cs.UnbondingOpsIndex, ubdOp.Id)
+		}
+		// or the held unbonding operations of a consumer chain stopped after a packet timeout
+		for _, held := range gs.HeldUnbondingOps {
+			if held.ChainId != chainID {
+				continue
 			}
+			found = found || indexContains(held.UnbondingOpsIndex, ubdOp.Id)
 		}
 		if !found {
 			return sdkerrors.Wrap(ccv.ErrInvalidGenesis,
@@ -127,6 +177,18 @@ func (gs GenesisState) ValidateUnbondingOp(ubdOp UnbondingOp) error {
 	return nil
 }
 
+// indexContains returns whether the given unbonding op indexes contain the given unbonding op ID
+func indexContains(index []VscUnbondingOps, id uint64) bool {
+	for _, vscUnbondingOps := range index {
+		for _, ubdOpID := range vscUnbondingOps.GetUnbondingOpIds() {
+			if ubdOpID == id {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // Validate performs a consumer state validation returning an error upon any failure.
 // It ensures that the chain id, client id and consumer genesis states are valid and non-empty.
 func (cs ConsumerState) Validate() error {
@@ -150,6 +212,17 @@ func (cs ConsumerState) Validate() error {
 	if err := validateSlashAcksAddress(cs.SlashDowntimeAck); err != nil {
 		return err
 	}
+	if cs.SlashAcks != nil {
+		if len(cs.SlashDowntimeAck) > 0 {
+			return fmt.Errorf("slash downtime acks cannot be set together with slash acks")
+		}
+		if err := validateSlashAcksAddress(cs.SlashAcks.Addresses); err != nil {
+			return err
+		}
+		if len(cs.SlashAcks.Infractions) > len(cs.SlashAcks.Addresses) {
+			return fmt.Errorf("slash acks cannot have more infraction types than addresses")
+		}
+	}
 
 	for _, pVSC := range cs.PendingValsetChanges {
 		if pVSC.ValsetUpdateId == 0 {
@@ -169,6 +242,77 @@ func (cs ConsumerState) Validate() error {
 		}
 	}
 
+	if cs.PreferredRewardDenom != "" {
+		if err := sdk.ValidateDenom(cs.PreferredRewardDenom); err != nil {
+			return fmt.Errorf("invalid preferred reward denom: %s", err)
+		}
+	}
+
+	if err := cs.RewardsAllocation.Rewards.Validate(); err != nil {
+		return fmt.Errorf("invalid rewards allocation: %s", err)
+	}
+	if err := cs.RewardsAllocation.ToDistribute.Validate(); err != nil {
+		return fmt.Errorf("invalid rewards to distribute: %s", err)
+	}
+	if !cs.RewardsAllocation.Rewards.IsAllGTE(cs.RewardsAllocation.ToDistribute) {
+		return fmt.Errorf("rewards to distribute %s exceed the rewards allocation %s",
+			cs.RewardsAllocation.ToDistribute, cs.RewardsAllocation.Rewards)
+	}
+
+	for _, valAddr := range cs.OptedInValidators {
+		if err := sdk.VerifyAddressFormat(valAddr); err != nil {
+			return fmt.Errorf("invalid opted in validator address: %s", err)
+		}
+	}
+
+	for _, val := range cs.ConsumerValSet {
+		if val.ProviderAddr == nil {
+			return fmt.Errorf("consumer validator set cannot contain a validator without provider address")
+		}
+		if val.Power <= 0 {
+			return fmt.Errorf("consumer validator power must be positive: %#v", val)
+		}
+	}
+
+	if _, err := ParseValidatorList(cs.ValidatorAllowlist); err != nil {
+		return fmt.Errorf("invalid validator allowlist: %s", err)
+	}
+	if _, err := ParseValidatorList(cs.ValidatorDenylist); err != nil {
+		return fmt.Errorf("invalid validator denylist: %s", err)
+	}
+	if _, err := ParseValidatorList(cs.SoftOptedOutValidators); err != nil {
+		return fmt.Errorf("invalid soft opted out validators: %s", err)
+	}
+	for _, record := range cs.LastDowntimeInfractionHeights {
+		if record.ProviderAddr == nil {
+			return fmt.Errorf("last downtime infraction height cannot have an empty provider address")
+		}
+		if err := sdk.VerifyAddressFormat(record.ProviderAddr.ToSdkConsAddr()); err != nil {
+			return fmt.Errorf("invalid provider address in last downtime infraction height: %s", err)
+		}
+	}
+	if cs.DowntimeJailDuration < 0 {
+		return fmt.Errorf("downtime jail duration cannot be negative: %s", cs.DowntimeJailDuration)
+	}
+	if cs.ConsumerParameters != nil {
+		if err := cs.ConsumerParameters.Validate(); err != nil {
+			return fmt.Errorf("invalid consumer parameters: %s", err)
+		}
+	}
+	if cs.Metadata != nil {
+		if err := cs.Metadata.Validate(); err != nil {
+			return fmt.Errorf("invalid consumer metadata: %s", err)
+		}
+	}
+	if cs.RewardsWindow != nil {
+		if err := cs.RewardsWindow.Received.Validate(); err != nil {
+			return fmt.Errorf("invalid rewards received during the current rewards window: %s", err)
+		}
+		if err := cs.RewardsWindow.LastWindowReceived.Validate(); err != nil {
+			return fmt.Errorf("invalid rewards received during the last rewards window: %s", err)
+		}
+	}
+
 	return nil
 }
 
@@ -5,20 +5,26 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types1 "github.com/cosmos/interchain-security/x/ccv/consumer/types"
 	types "github.com/cosmos/interchain-security/x/ccv/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/tendermint/tendermint/proto/tendermint/crypto"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -49,6 +55,23 @@ type GenesisState struct {
 	ValidatorsByConsumerAddr []ValidatorByConsumerAddr `protobuf:"bytes,10,rep,name=validators_by_consumer_addr,json=validatorsByConsumerAddr,proto3" json:"validators_by_consumer_addr"`
 	// empty for a new chain
 	ConsumerAddrsToPrune []ConsumerAddrsToPrune `protobuf:"bytes,11,rep,name=consumer_addrs_to_prune,json=consumerAddrsToPrune,proto3" json:"consumer_addrs_to_prune"`
+	// empty for a new chain
+	InitTimeoutTimestamps []InitTimeoutTimestamp `protobuf:"bytes,12,rep,name=init_timeout_timestamps,json=initTimeoutTimestamps,proto3" json:"init_timeout_timestamps"`
+	// empty for a new chain
+	SlashRetries []SlashRetry `protobuf:"bytes,13,rep,name=slash_retries,json=slashRetries,proto3" json:"slash_retries"`
+	// empty for a new chain
+	FailedSlashes []SlashRetry `protobuf:"bytes,14,rep,name=failed_slashes,json=failedSlashes,proto3" json:"failed_slashes"`
+	// empty for a new chain
+	InvalidatedChannelIds []string `protobuf:"bytes,15,rep,name=invalidated_channel_ids,json=invalidatedChannelIds,proto3" json:"invalidated_channel_ids,omitempty"`
+	// empty for a new chain
+	ValidatorJailRecords []ValidatorJailRecord `protobuf:"bytes,16,rep,name=validator_jail_records,json=validatorJailRecords,proto3" json:"validator_jail_records"`
+	// empty for a new chain
+	ConsumerRewardDenoms []string `protobuf:"bytes,17,rep,name=consumer_reward_denoms,json=consumerRewardDenoms,proto3" json:"consumer_reward_denoms,omitempty"`
+	// empty for a new chain
+	HeldUnbondingOps []HeldUnbondingOps `protobuf:"bytes,18,rep,name=held_unbonding_ops,json=heldUnbondingOps,proto3" json:"held_unbonding_ops"`
+	// the port ID the provider CCV module binds to on InitChain,
+	// defaults to the provider port if empty
+	PortId string `protobuf:"bytes,19,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -161,6 +184,117 @@ func (m *GenesisState) GetConsumerAddrsToPrune() []ConsumerAddrsToPrune {
 	return nil
 }
 
+func (m *GenesisState) GetInitTimeoutTimestamps() []InitTimeoutTimestamp {
+	if m != nil {
+		return m.InitTimeoutTimestamps
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSlashRetries() []SlashRetry {
+	if m != nil {
+		return m.SlashRetries
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFailedSlashes() []SlashRetry {
+	if m != nil {
+		return m.FailedSlashes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetInvalidatedChannelIds() []string {
+	if m != nil {
+		return m.InvalidatedChannelIds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorJailRecords() []ValidatorJailRecord {
+	if m != nil {
+		return m.ValidatorJailRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetConsumerRewardDenoms() []string {
+	if m != nil {
+		return m.ConsumerRewardDenoms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHeldUnbondingOps() []HeldUnbondingOps {
+	if m != nil {
+		return m.HeldUnbondingOps
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+// HeldUnbondingOps defines the unbonding op indexes of a consumer chain that was stopped
+// after a packet timeout, whose unbonding operations are held until a force complete
+// unbonding proposal releases them
+type HeldUnbondingOps struct {
+	ChainId           string            `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	UnbondingOpsIndex []VscUnbondingOps `protobuf:"bytes,2,rep,name=unbonding_ops_index,json=unbondingOpsIndex,proto3" json:"unbonding_ops_index"`
+}
+
+func (m *HeldUnbondingOps) Reset()         { *m = HeldUnbondingOps{} }
+func (m *HeldUnbondingOps) String() string { return proto.CompactTextString(m) }
+func (*HeldUnbondingOps) ProtoMessage()    {}
+func (*HeldUnbondingOps) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48411d9c7900d48e, []int{1}
+}
+func (m *HeldUnbondingOps) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeldUnbondingOps) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeldUnbondingOps.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HeldUnbondingOps) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeldUnbondingOps.Merge(m, src)
+}
+func (m *HeldUnbondingOps) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeldUnbondingOps) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeldUnbondingOps.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeldUnbondingOps proto.InternalMessageInfo
+
+func (m *HeldUnbondingOps) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *HeldUnbondingOps) GetUnbondingOpsIndex() []VscUnbondingOps {
+	if m != nil {
+		return m.UnbondingOpsIndex
+	}
+	return nil
+}
+
 // consumer chain
 type ConsumerState struct {
 	// ChainID defines the chain ID for the consumer chain
@@ -175,16 +309,83 @@ type ConsumerState struct {
 	ConsumerGenesis types1.GenesisState `protobuf:"bytes,5,opt,name=consumer_genesis,json=consumerGenesis,proto3" json:"consumer_genesis"`
 	// PendingValsetChanges defines the pending validator set changes for the consumer chain
 	PendingValsetChanges []types.ValidatorSetChangePacketData `protobuf:"bytes,6,rep,name=pending_valset_changes,json=pendingValsetChanges,proto3" json:"pending_valset_changes"`
-	SlashDowntimeAck     []string                             `protobuf:"bytes,7,rep,name=slash_downtime_ack,json=slashDowntimeAck,proto3" json:"slash_downtime_ack,omitempty"`
+	// SlashDowntimeAck defines the addresses of the slash acks of the consumer chain,
+	// only imported if slash_acks is not set
+	SlashDowntimeAck []string `protobuf:"bytes,7,rep,name=slash_downtime_ack,json=slashDowntimeAck,proto3" json:"slash_downtime_ack,omitempty"`
 	// UnbondingOpsIndex defines the unbonding operations waiting on this consumer chain
 	UnbondingOpsIndex []VscUnbondingOps `protobuf:"bytes,8,rep,name=unbonding_ops_index,json=unbondingOpsIndex,proto3" json:"unbonding_ops_index"`
+	// SendSlashConfirmations defines whether slash confirmations are sent to the consumer chain
+	SendSlashConfirmations bool `protobuf:"varint,9,opt,name=send_slash_confirmations,json=sendSlashConfirmations,proto3" json:"send_slash_confirmations,omitempty"`
+	// SlashDoubleSigns defines whether double-sign slash packets from the consumer chain are applied
+	SlashDoubleSigns bool `protobuf:"varint,10,opt,name=slash_double_signs,json=slashDoubleSigns,proto3" json:"slash_double_signs,omitempty"`
+	// PreferredRewardDenom defines the denom under which the consumer chain rewards are tracked
+	PreferredRewardDenom string `protobuf:"bytes,11,opt,name=preferred_reward_denom,json=preferredRewardDenom,proto3" json:"preferred_reward_denom,omitempty"`
+	// RewardsAllocation defines the rewards received from the consumer chain that are not yet distributed
+	RewardsAllocation ConsumerRewardsAllocation `protobuf:"bytes,12,opt,name=rewards_allocation,json=rewardsAllocation,proto3" json:"rewards_allocation"`
+	// OptedInValidators defines the validators opted in to validate the consumer chain
+	OptedInValidators []github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,13,rep,name=opted_in_validators,json=optedInValidators,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"opted_in_validators,omitempty"`
+	// ConsumerValSetUpdateId defines the valset update ID of the last validator set sent to the consumer chain
+	ConsumerValSetUpdateId uint64 `protobuf:"varint,14,opt,name=consumer_val_set_update_id,json=consumerValSetUpdateId,proto3" json:"consumer_val_set_update_id,omitempty"`
+	// ConsumerValSet defines the last validator set sent to the consumer chain
+	ConsumerValSet []ConsumerValidator `protobuf:"bytes,15,rep,name=consumer_val_set,json=consumerValSet,proto3" json:"consumer_val_set"`
+	// VscSendTimestamps defines the send timestamps of the VSC packets not yet acknowledged by the consumer chain
+	VscSendTimestamps []VscSendTimestamp `protobuf:"bytes,16,rep,name=vsc_send_timestamps,json=vscSendTimestamps,proto3" json:"vsc_send_timestamps"`
+	// ClientInactiveTimestamp defines when the consumer client was first seen expired or frozen, if it still is
+	ClientInactiveTimestamp *time.Time `protobuf:"bytes,17,opt,name=client_inactive_timestamp,json=clientInactiveTimestamp,proto3,stdtime" json:"client_inactive_timestamp,omitempty"`
+	// ValidatorAllowlist defines the consensus addresses of the validators allowed to validate the consumer chain
+	ValidatorAllowlist []string `protobuf:"bytes,18,rep,name=validator_allowlist,json=validatorAllowlist,proto3" json:"validator_allowlist,omitempty"`
+	// ValidatorDenylist defines the consensus addresses of the validators excluded from the consumer validator set
+	ValidatorDenylist []string `protobuf:"bytes,19,rep,name=validator_denylist,json=validatorDenylist,proto3" json:"validator_denylist,omitempty"`
+	// SoftOptedOutValidators defines the consensus addresses of the validators opted out of validating the consumer chain
+	SoftOptedOutValidators []string `protobuf:"bytes,20,rep,name=soft_opted_out_validators,json=softOptedOutValidators,proto3" json:"soft_opted_out_validators,omitempty"`
+	// ConsumerParameters defines the parameters of the consumer chain set by a consumer parameters update proposal
+	ConsumerParameters *ConsumerParameters `protobuf:"bytes,21,opt,name=consumer_parameters,json=consumerParameters,proto3" json:"consumer_parameters,omitempty"`
+	// RewardsWindow defines the rewards received from the consumer chain during the current and last rewards windows
+	RewardsWindow *ConsumerRewardsWindow `protobuf:"bytes,22,opt,name=rewards_window,json=rewardsWindow,proto3" json:"rewards_window,omitempty"`
+	// DowntimeJailDuration defines the duration for which the validators are jailed for a downtime
+	// infraction on the consumer chain, zero if the provider slashing module default applies
+	DowntimeJailDuration time.Duration `protobuf:"bytes,23,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration"`
+	// Paused defines whether the consumer chain is paused, i.e., whether the validator set updates are withheld
+	Paused bool `protobuf:"varint,24,opt,name=paused,proto3" json:"paused,omitempty"`
+	// LastDowntimeInfractionHeights defines the provider block heights of the last downtime infractions
+	// of the validators on the consumer chain that were applied
+	LastDowntimeInfractionHeights []ValidatorInfractionHeight `protobuf:"bytes,25,rep,name=last_downtime_infraction_heights,json=lastDowntimeInfractionHeights,proto3" json:"last_downtime_infraction_heights"`
+	// TopN defines the number of provider validators with the largest powers that validate
+	// the consumer chain, zero if all the validators validate it
+	TopN uint32 `protobuf:"varint,26,opt,name=top_n,json=topN,proto3" json:"top_n,omitempty"`
+	// Metadata defines the descriptive metadata of the consumer chain, nil if it has none
+	Metadata *ConsumerMetadata `protobuf:"bytes,27,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// SlashConfirmationSeq defines the sequence number of the last slash confirmation packet
+	// sent to the consumer chain, zero if none was sent
+	SlashConfirmationSeq uint64 `protobuf:"varint,28,opt,name=slash_confirmation_seq,json=slashConfirmationSeq,proto3" json:"slash_confirmation_seq,omitempty"`
+	// LastSentSequence defines the sequence number of the last packet sent to the consumer chain,
+	// zero if none was sent
+	LastSentSequence uint64 `protobuf:"varint,29,opt,name=last_sent_sequence,json=lastSentSequence,proto3" json:"last_sent_sequence,omitempty"`
+	// LastAckedSequence defines the sequence number of the last acknowledged packet sent to
+	// the consumer chain, zero if none was acknowledged
+	LastAckedSequence uint64 `protobuf:"varint,30,opt,name=last_acked_sequence,json=lastAckedSequence,proto3" json:"last_acked_sequence,omitempty"`
+	// LastConsumerActivity defines the provider block at which the provider last heard from
+	// the consumer chain, nil if it was not heard from yet
+	LastConsumerActivity *ConsumerActivity `protobuf:"bytes,31,opt,name=last_consumer_activity,json=lastConsumerActivity,proto3" json:"last_consumer_activity,omitempty"`
+	// ClientExpiryWarningTimestamp defines when the consumer client was first reported as about
+	// to expire, if it still is
+	ClientExpiryWarningTimestamp *time.Time `protobuf:"bytes,32,opt,name=client_expiry_warning_timestamp,json=clientExpiryWarningTimestamp,proto3,stdtime" json:"client_expiry_warning_timestamp,omitempty"`
+	// ValidatorListsUpdated defines whether the validator lists of the consumer chain were updated
+	// since the last validator set change packet was queued
+	ValidatorListsUpdated bool `protobuf:"varint,33,opt,name=validator_lists_updated,json=validatorListsUpdated,proto3" json:"validator_lists_updated,omitempty"`
+	// GenesisHashExempt defines whether the consumer chain may open its CCV channel
+	// without sending the hash of its genesis on the handshake
+	GenesisHashExempt bool `protobuf:"varint,34,opt,name=genesis_hash_exempt,json=genesisHashExempt,proto3" json:"genesis_hash_exempt,omitempty"`
+	// SlashAcks defines the slash acks of the consumer chain, including their infraction
+	// types and the start time of their batch
+	SlashAcks *SlashAcks `protobuf:"bytes,35,opt,name=slash_acks,json=slashAcks,proto3" json:"slash_acks,omitempty"`
 }
 
 func (m *ConsumerState) Reset()         { *m = ConsumerState{} }
 func (m *ConsumerState) String() string { return proto.CompactTextString(m) }
 func (*ConsumerState) ProtoMessage()    {}
 func (*ConsumerState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48411d9c7900d48e, []int{1}
+	return fileDescriptor_48411d9c7900d48e, []int{2}
 }
 func (m *ConsumerState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -269,18 +470,210 @@ func (m *ConsumerState) GetUnbondingOpsIndex() []VscUnbondingOps {
 	return nil
 }
 
+func (m *ConsumerState) GetSendSlashConfirmations() bool {
+	if m != nil {
+		return m.SendSlashConfirmations
+	}
+	return false
+}
+
+func (m *ConsumerState) GetSlashDoubleSigns() bool {
+	if m != nil {
+		return m.SlashDoubleSigns
+	}
+	return false
+}
+
+func (m *ConsumerState) GetPreferredRewardDenom() string {
+	if m != nil {
+		return m.PreferredRewardDenom
+	}
+	return ""
+}
+
+func (m *ConsumerState) GetRewardsAllocation() ConsumerRewardsAllocation {
+	if m != nil {
+		return m.RewardsAllocation
+	}
+	return ConsumerRewardsAllocation{}
+}
+
+func (m *ConsumerState) GetOptedInValidators() []github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.OptedInValidators
+	}
+	return nil
+}
+
+func (m *ConsumerState) GetConsumerValSetUpdateId() uint64 {
+	if m != nil {
+		return m.ConsumerValSetUpdateId
+	}
+	return 0
+}
+
+func (m *ConsumerState) GetConsumerValSet() []ConsumerValidator {
+	if m != nil {
+		return m.ConsumerValSet
+	}
+	return nil
+}
+
+func (m *ConsumerState) GetVscSendTimestamps() []VscSendTimestamp {
+	if m != nil {
+		return m.VscSendTimestamps
+	}
+	return nil
+}
+
+func (m *ConsumerState) GetClientInactiveTimestamp() *time.Time {
+	if m != nil {
+		return m.ClientInactiveTimestamp
+	}
+	return nil
+}
+
+func (m *ConsumerState) GetValidatorAllowlist() []string {
+	if m != nil {
+		return m.ValidatorAllowlist
+	}
+	return nil
+}
+
+func (m *ConsumerState) GetValidatorDenylist() []string {
+	if m != nil {
+		return m.ValidatorDenylist
+	}
+	return nil
+}
+
+func (m *ConsumerState) GetSoftOptedOutValidators() []string {
+	if m != nil {
+		return m.SoftOptedOutValidators
+	}
+	return nil
+}
+
+func (m *ConsumerState) GetConsumerParameters() *ConsumerParameters {
+	if m != nil {
+		return m.ConsumerParameters
+	}
+	return nil
+}
+
+func (m *ConsumerState) GetRewardsWindow() *ConsumerRewardsWindow {
+	if m != nil {
+		return m.RewardsWindow
+	}
+	return nil
+}
+
+func (m *ConsumerState) GetDowntimeJailDuration() time.Duration {
+	if m != nil {
+		return m.DowntimeJailDuration
+	}
+	return 0
+}
+
+func (m *ConsumerState) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *ConsumerState) GetLastDowntimeInfractionHeights() []ValidatorInfractionHeight {
+	if m != nil {
+		return m.LastDowntimeInfractionHeights
+	}
+	return nil
+}
+
+func (m *ConsumerState) GetTopN() uint32 {
+	if m != nil {
+		return m.TopN
+	}
+	return 0
+}
+
+func (m *ConsumerState) GetMetadata() *ConsumerMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *ConsumerState) GetSlashConfirmationSeq() uint64 {
+	if m != nil {
+		return m.SlashConfirmationSeq
+	}
+	return 0
+}
+
+func (m *ConsumerState) GetLastSentSequence() uint64 {
+	if m != nil {
+		return m.LastSentSequence
+	}
+	return 0
+}
+
+func (m *ConsumerState) GetLastAckedSequence() uint64 {
+	if m != nil {
+		return m.LastAckedSequence
+	}
+	return 0
+}
+
+func (m *ConsumerState) GetLastConsumerActivity() *ConsumerActivity {
+	if m != nil {
+		return m.LastConsumerActivity
+	}
+	return nil
+}
+
+func (m *ConsumerState) GetClientExpiryWarningTimestamp() *time.Time {
+	if m != nil {
+		return m.ClientExpiryWarningTimestamp
+	}
+	return nil
+}
+
+func (m *ConsumerState) GetValidatorListsUpdated() bool {
+	if m != nil {
+		return m.ValidatorListsUpdated
+	}
+	return false
+}
+
+func (m *ConsumerState) GetGenesisHashExempt() bool {
+	if m != nil {
+		return m.GenesisHashExempt
+	}
+	return false
+}
+
+func (m *ConsumerState) GetSlashAcks() *SlashAcks {
+	if m != nil {
+		return m.SlashAcks
+	}
+	return nil
+}
+
 // ValsetUpdateIdToHeight defines the genesis information for the mapping
 // of each valset udpate id to a block height
 type ValsetUpdateIdToHeight struct {
 	ValsetUpdateId uint64 `protobuf:"varint,1,opt,name=valset_update_id,json=valsetUpdateId,proto3" json:"valset_update_id,omitempty"`
 	Height         uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// Timestamp defines the block time at which the valset update id was mapped to the block height,
+	// nil if it is unknown
+	Timestamp *time.Time `protobuf:"bytes,3,opt,name=timestamp,proto3,stdtime" json:"timestamp,omitempty"`
 }
 
 func (m *ValsetUpdateIdToHeight) Reset()         { *m = ValsetUpdateIdToHeight{} }
 func (m *ValsetUpdateIdToHeight) String() string { return proto.CompactTextString(m) }
 func (*ValsetUpdateIdToHeight) ProtoMessage()    {}
 func (*ValsetUpdateIdToHeight) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48411d9c7900d48e, []int{2}
+	return fileDescriptor_48411d9c7900d48e, []int{3}
 }
 func (m *ValsetUpdateIdToHeight) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -323,8 +716,16 @@ func (m *ValsetUpdateIdToHeight) GetHeight() uint64 {
 	return 0
 }
 
+func (m *ValsetUpdateIdToHeight) GetTimestamp() *time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "interchain_security.ccv.provider.v1.GenesisState")
+	proto.RegisterType((*HeldUnbondingOps)(nil), "interchain_security.ccv.provider.v1.HeldUnbondingOps")
 	proto.RegisterType((*ConsumerState)(nil), "interchain_security.ccv.provider.v1.ConsumerState")
 	proto.RegisterType((*ValsetUpdateIdToHeight)(nil), "interchain_security.ccv.provider.v1.ValsetUpdateIdToHeight")
 }
@@ -334,61 +735,121 @@ func init() {
 }
 
 var fileDescriptor_48411d9c7900d48e = []byte{
-	// 853 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x41, 0x8f, 0xdb, 0x44,
-	0x14, 0x5e, 0xef, 0xa6, 0xdb, 0xcd, 0x6c, 0x77, 0x59, 0x86, 0x55, 0x70, 0xb3, 0x90, 0xae, 0x02,
-	0x48, 0x91, 0x00, 0x1b, 0x07, 0x0e, 0x50, 0xe0, 0xd0, 0xb4, 0x12, 0x44, 0x08, 0x11, 0xa5, 0xdb,
-	0x1e, 0xca, 0xc1, 0x9a, 0x8c, 0x47, 0xc9, 0x10, 0x7b, 0xc6, 0x9a, 0x19, 0x9b, 0x5a, 0x08, 0x89,
-	0x8a, 0x3f, 0xc0, 0xbf, 0xa2, 0xc7, 0x1e, 0x39, 0x55, 0x68, 0xf7, 0x1f, 0xf0, 0x0b, 0x90, 0xc7,
-	0x63, 0xd7, 0x5e, 0x12, 0x48, 0xb8, 0xc5, 0xef, 0x9b, 0xf7, 0x7d, 0xef, 0xcd, 0x9b, 0xf9, 0x26,
-	0xc0, 0xa3, 0x4c, 0x11, 0x81, 0x17, 0x88, 0x32, 0x5f, 0x12, 0x9c, 0x08, 0xaa, 0x32, 0x17, 0xe3,
-	0xd4, 0x8d, 0x05, 0x4f, 0x69, 0x40, 0x84, 0x9b, 0x7a, 0xee, 0x9c, 0x30, 0x22, 0xa9, 0x74, 0x62,
-	0xc1, 0x15, 0x87, 0xef, 0xac, 0x48, 0x71, 0x30, 0x4e, 0x9d, 0x32, 0xc5, 0x49, 0xbd, 0xee, 0xe9,
-	0x9c, 0xcf, 0xb9, 0x5e, 0xef, 0xe6, 0xbf, 0x8a, 0xd4, 0xee, 0xbb, 0xeb, 0xd4, 0x52, 0xcf, 0x35,
-	0x0c, 0x8a, 0x77, 0x87, 0x9b, 0xd4, 0x54, 0x89, 0xfd, 0x47, 0x0e, 0xe6, 0x4c, 0x26, 0x51, 0x91,
-	0x53, 0xfe, 0x36, 0x39, 0xde, 0x26, 0x39, 0x8d, 0xde, 0xbb, 0x6f, 0x29, 0xc2, 0x02, 0x22, 0x22,
-	0xca, 0x94, 0x8b, 0x45, 0x16, 0x2b, 0xee, 0x2e, 0x49, 0x66, 0xd0, 0xfe, 0xef, 0x6d, 0x70, 0xeb,
-	0xab, 0x62, 0xfd, 0x43, 0x85, 0x14, 0x81, 0x03, 0x70, 0x92, 0xa2, 0x50, 0x12, 0xe5, 0x27, 0x71,
-	0x80, 0x14, 0xf1, 0x69, 0x60, 0x5b, 0xe7, 0xd6, 0xa0, 0x35, 0x3d, 0x2e, 0xe2, 0x8f, 0x74, 0x78,
-	0x1c, 0xc0, 0x9f, 0xc0, 0x6b, 0xa5, 0xaa, 0x2f, 0xf3, 0x5c, 0x69, 0xef, 0x9e, 0xef, 0x0d, 0x0e,
-	0x87, 0x43, 0x67, 0x83, 0xed, 0x76, 0xee, 0x9b, 0x5c, 0x2d, 0x3b, 0xea, 0x3d, 0x7f, 0x79, 0x67,
-	0xe7, 0xaf, 0x97, 0x77, 0x3a, 0x19, 0x8a, 0xc2, 0xbb, 0xfd, 0x6b, 0xc4, 0xfd, 0xe9, 0x31, 0xae,
-	0x2f, 0x97, 0xf0, 0x7b, 0x70, 0x94, 0xb0, 0x19, 0x67, 0x01, 0x65, 0x73, 0x9f, 0xc7, 0xd2, 0xde,
-	0xd3, 0xd2, 0x1f, 0x6d, 0x24, 0xfd, 0xa8, 0xcc, 0xfc, 0x2e, 0x1e, 0xb5, 0x72, 0xe1, 0xe9, 0xad,
-	0xe4, 0x55, 0x48, 0x42, 0x04, 0x4e, 0x23, 0xa4, 0x12, 0x41, 0xfc, 0xa6, 0x46, 0xeb, 0xdc, 0x1a,
-	0x1c, 0x0e, 0xdd, 0xb5, 0x1a, 0xa9, 0xe7, 0x7c, 0xab, 0xf3, 0x82, 0x9a, 0x82, 0x9c, 0xc2, 0x82,
-	0xac, 0x1e, 0x83, 0x3f, 0x83, 0xee, 0xf5, 0x6d, 0xf6, 0x15, 0xf7, 0x17, 0x84, 0xce, 0x17, 0xca,
-	0xbe, 0xa1, 0x9b, 0xf9, 0x7c, 0xa3, 0x66, 0x1e, 0x37, 0xa6, 0x72, 0xc1, 0xbf, 0xd6, 0x14, 0xa6,
-	0xaf, 0x4e, 0xba, 0x12, 0x85, 0xbf, 0x5a, 0xe0, 0xac, 0xda, 0x63, 0x14, 0x04, 0x54, 0x51, 0xce,
-	0xfc, 0x58, 0xf0, 0x98, 0x4b, 0x14, 0x4a, 0x7b, 0x5f, 0x17, 0xf0, 0xe5, 0x56, 0x83, 0xbc, 0x67,
-	0x68, 0x26, 0x86, 0xc5, 0x94, 0x70, 0x1b, 0xaf, 0xc1, 0x25, 0xfc, 0xc5, 0x02, 0xdd, 0xaa, 0x0a,
-	0x41, 0x22, 0x9e, 0xa2, 0xb0, 0x56, 0xc4, 0x4d, 0x5d, 0xc4, 0x17, 0x5b, 0x15, 0x31, 0x2d, 0x58,
-	0xae, 0xd5, 0x60, 0xe3, 0xd5, 0xb0, 0x84, 0x63, 0xb0, 0x1f, 0x23, 0x81, 0x22, 0x69, 0x1f, 0xe8,
-	0xe1, 0xbe, 0xbf, 0x91, 0xda, 0x44, 0xa7, 0x18, 0x72, 0x43, 0xa0, 0xbb, 0x49, 0x51, 0x48, 0x03,
-	0xa4, 0xb8, 0xf0, 0xab, 0xbe, 0xe2, 0x64, 0x96, 0xdf, 0x37, 0xbb, 0xbd, 0x45, 0x37, 0x8f, 0x4b,
-	0x9a, 0xb2, 0xad, 0x49, 0x32, 0xfb, 0x86, 0x64, 0x65, 0x37, 0xe9, 0x0a, 0x38, 0xd7, 0x80, 0xcf,
-	0x2c, 0x70, 0x56, 0x81, 0xd2, 0x9f, 0x65, 0x7e, 0x7d, 0xc8, 0xc2, 0x06, 0xff, 0xa7, 0x86, 0x51,
-	0x56, 0x9b, 0xb0, 0xf8, 0x47, 0x0d, 0xb2, 0x89, 0xc3, 0x14, 0xbc, 0xd9, 0x10, 0x95, 0xf9, 0xb9,
-	0x8e, 0x45, 0xc2, 0x88, 0x7d, 0xa8, 0xe5, 0x3f, 0xdb, 0xf6, 0x54, 0x09, 0x79, 0xc1, 0x27, 0x39,
-	0x81, 0xd1, 0x3e, 0xc5, 0x2b, 0xb0, 0xfe, 0xb3, 0x16, 0x38, 0x6a, 0x78, 0x0a, 0xbc, 0x0d, 0x0e,
-	0x0a, 0x11, 0x63, 0x61, 0xed, 0xe9, 0x4d, 0xfd, 0x3d, 0x0e, 0xe0, 0xdb, 0x00, 0xe0, 0x05, 0x62,
-	0x8c, 0x84, 0x39, 0xb8, 0xab, 0xc1, 0xb6, 0x89, 0x8c, 0x03, 0x78, 0x06, 0xda, 0x38, 0xa4, 0x84,
-	0xa9, 0x1c, 0xdd, 0xd3, 0xe8, 0x41, 0x11, 0x18, 0x07, 0xf0, 0x3d, 0x70, 0x4c, 0x19, 0x55, 0x14,
-	0x85, 0xe5, 0x75, 0x6d, 0x69, 0x7f, 0x3c, 0x32, 0x51, 0x73, 0xc5, 0x66, 0xe0, 0xa4, 0xda, 0x07,
-	0xe3, 0xc8, 0xf6, 0x0d, 0x7d, 0xc6, 0xbc, 0xb5, 0x1b, 0x50, 0xb9, 0x7d, 0xea, 0x39, 0x75, 0x57,
-	0x36, 0x8d, 0x57, 0x7e, 0x6b, 0x30, 0xa8, 0x40, 0x27, 0x26, 0x85, 0x3f, 0x19, 0x37, 0xc9, 0x7b,
-	0x98, 0x93, 0xf2, 0x02, 0x7f, 0xfa, 0x6f, 0x56, 0x55, 0x0d, 0xf8, 0x21, 0x51, 0xf7, 0x75, 0xda,
-	0x04, 0xe1, 0x25, 0x51, 0x0f, 0x90, 0x42, 0xe5, 0x4e, 0x1b, 0xf6, 0xc2, 0x63, 0x8a, 0x45, 0x12,
-	0x7e, 0x00, 0xa0, 0x0c, 0x91, 0x5c, 0xf8, 0x01, 0xff, 0x91, 0x29, 0x1a, 0x11, 0x1f, 0xe1, 0xa5,
-	0xbe, 0xad, 0xed, 0xe9, 0x89, 0x46, 0x1e, 0x18, 0xe0, 0x1e, 0x5e, 0xc2, 0x1f, 0xc0, 0x1b, 0x0d,
-	0x17, 0xf5, 0x29, 0x0b, 0xc8, 0x53, 0xfb, 0x40, 0x17, 0xf8, 0xc9, 0x66, 0x47, 0x51, 0xe2, 0xba,
-	0x79, 0x9a, 0xe2, 0x5e, 0xaf, 0x7b, 0xf6, 0x38, 0x27, 0xed, 0x3f, 0x01, 0x9d, 0xd5, 0x76, 0xb8,
-	0xc5, 0xb3, 0xd6, 0x01, 0xfb, 0x66, 0xac, 0xbb, 0x1a, 0x37, 0x5f, 0xa3, 0x8b, 0xe7, 0x97, 0x3d,
-	0xeb, 0xc5, 0x65, 0xcf, 0xfa, 0xf3, 0xb2, 0x67, 0xfd, 0x76, 0xd5, 0xdb, 0x79, 0x71, 0xd5, 0xdb,
-	0xf9, 0xe3, 0xaa, 0xb7, 0xf3, 0xe4, 0xee, 0x9c, 0xaa, 0x45, 0x32, 0x73, 0x30, 0x8f, 0x5c, 0xcc,
-	0x65, 0xc4, 0xa5, 0xfb, 0xaa, 0xab, 0x0f, 0xab, 0x67, 0xfa, 0x69, 0xf3, 0x0f, 0x81, 0xca, 0x62,
-	0x22, 0x67, 0xfb, 0xfa, 0x19, 0xfe, 0xf8, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9c, 0x19, 0x84,
-	0x4a, 0xd5, 0x08, 0x00, 0x00,
+	// 1810 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xdf, 0x6f, 0x1b, 0x49,
+	0x1d, 0xef, 0x36, 0x69, 0x1a, 0x4f, 0x12, 0x9f, 0x33, 0x76, 0x9d, 0x49, 0xda, 0x3a, 0x26, 0x07,
+	0x52, 0x24, 0xa8, 0x4d, 0xc2, 0x51, 0x7a, 0x05, 0x4e, 0x4a, 0x9a, 0x13, 0x35, 0x50, 0x1a, 0xd6,
+	0xb9, 0x9e, 0x38, 0x90, 0x56, 0xe3, 0xdd, 0x89, 0x3d, 0xe7, 0xf5, 0xce, 0x76, 0x66, 0x76, 0x53,
+	0x0b, 0x21, 0x81, 0x78, 0x46, 0xba, 0x47, 0xe0, 0x2f, 0xba, 0xc7, 0x3e, 0x22, 0x1e, 0x0a, 0x4a,
+	0xff, 0x03, 0x1e, 0x79, 0x42, 0x33, 0x3b, 0xfb, 0xc3, 0x8e, 0x53, 0xec, 0x22, 0x9e, 0xec, 0x9d,
+	0xcf, 0x7c, 0x7f, 0xcd, 0xf7, 0x3b, 0x9f, 0xef, 0x77, 0x17, 0x1c, 0xd0, 0x40, 0x12, 0xee, 0x0e,
+	0x30, 0x0d, 0x1c, 0x41, 0xdc, 0x88, 0x53, 0x39, 0x6e, 0xbb, 0x6e, 0xdc, 0x0e, 0x39, 0x8b, 0xa9,
+	0x47, 0x78, 0x3b, 0x3e, 0x68, 0xf7, 0x49, 0x40, 0x04, 0x15, 0xad, 0x90, 0x33, 0xc9, 0xe0, 0x87,
+	0x33, 0x44, 0x5a, 0xae, 0x1b, 0xb7, 0x52, 0x91, 0x56, 0x7c, 0xb0, 0x53, 0xeb, 0xb3, 0x3e, 0xd3,
+	0xfb, 0xdb, 0xea, 0x5f, 0x22, 0xba, 0xf3, 0xcd, 0xeb, 0xac, 0xc5, 0x07, 0x6d, 0xa3, 0x41, 0xb2,
+	0x9d, 0xc3, 0x79, 0x7c, 0xca, 0x8c, 0xfd, 0x17, 0x19, 0x97, 0x05, 0x22, 0x1a, 0x25, 0x32, 0xe9,
+	0x7f, 0x23, 0x73, 0x30, 0x8f, 0xcc, 0x44, 0xec, 0x3b, 0xf7, 0x24, 0x09, 0x3c, 0xc2, 0x47, 0x34,
+	0x90, 0x6d, 0x97, 0x8f, 0x43, 0xc9, 0xda, 0x43, 0x32, 0x4e, 0xd1, 0xdd, 0x3e, 0x63, 0x7d, 0x9f,
+	0xb4, 0xf5, 0x53, 0x2f, 0x3a, 0x6f, 0x4b, 0x3a, 0x22, 0x42, 0xe2, 0x51, 0x68, 0x36, 0x34, 0xa6,
+	0x37, 0x78, 0x11, 0xc7, 0x92, 0xb2, 0x20, 0xc1, 0xf7, 0x2e, 0xcb, 0x60, 0xfd, 0x27, 0x89, 0xc1,
+	0xae, 0xc4, 0x92, 0xc0, 0x7d, 0x50, 0x89, 0xb1, 0x2f, 0x88, 0x74, 0xa2, 0xd0, 0xc3, 0x92, 0x38,
+	0xd4, 0x43, 0x56, 0xd3, 0xda, 0x5f, 0xb6, 0xcb, 0xc9, 0xfa, 0x67, 0x7a, 0xb9, 0xe3, 0xc1, 0xdf,
+	0x82, 0x0f, 0x52, 0xb7, 0x1d, 0xa1, 0x64, 0x05, 0xba, 0xd9, 0x5c, 0xda, 0x5f, 0x3b, 0x3c, 0x6c,
+	0xcd, 0x91, 0xaf, 0xd6, 0x13, 0x23, 0xab, 0xcd, 0x1e, 0x37, 0xbe, 0x7e, 0xb3, 0x7b, 0xe3, 0x5f,
+	0x6f, 0x76, 0xeb, 0x63, 0x3c, 0xf2, 0x1f, 0xef, 0x4d, 0x29, 0xde, 0xb3, 0xcb, 0x6e, 0x71, 0xbb,
+	0x80, 0xbf, 0x06, 0x1b, 0x51, 0xd0, 0x63, 0x81, 0x47, 0x83, 0xbe, 0xc3, 0x42, 0x81, 0x96, 0xb4,
+	0xe9, 0xef, 0xce, 0x65, 0xfa, 0xb3, 0x54, 0xf2, 0x79, 0x78, 0xbc, 0xac, 0x0c, 0xdb, 0xeb, 0x51,
+	0xbe, 0x24, 0x20, 0x06, 0xb5, 0x11, 0x96, 0x11, 0x27, 0xce, 0xa4, 0x8d, 0xe5, 0xa6, 0xb5, 0xbf,
+	0x76, 0xd8, 0xbe, 0xd6, 0x46, 0x7c, 0xd0, 0x7a, 0xa6, 0xe5, 0xbc, 0x82, 0x05, 0x61, 0xc3, 0x44,
+	0x59, 0x71, 0x0d, 0xfe, 0x0e, 0xec, 0x4c, 0x1f, 0xb3, 0x23, 0x99, 0x33, 0x20, 0xb4, 0x3f, 0x90,
+	0xe8, 0x96, 0x0e, 0xe6, 0x87, 0x73, 0x05, 0xf3, 0x62, 0x22, 0x2b, 0x67, 0xec, 0xa9, 0x56, 0x61,
+	0xe2, 0xaa, 0xc7, 0x33, 0x51, 0xf8, 0x47, 0x0b, 0xdc, 0xcd, 0xce, 0x18, 0x7b, 0x1e, 0x55, 0x25,
+	0xe1, 0x84, 0x9c, 0x85, 0x4c, 0x60, 0x5f, 0xa0, 0x15, 0xed, 0xc0, 0x8f, 0x17, 0x4a, 0xe4, 0x91,
+	0x51, 0x73, 0x6a, 0xb4, 0x18, 0x17, 0xb6, 0xdd, 0x6b, 0x70, 0x01, 0x7f, 0x6f, 0x81, 0x9d, 0xcc,
+	0x0b, 0x4e, 0x46, 0x2c, 0xc6, 0x7e, 0xc1, 0x89, 0xdb, 0xda, 0x89, 0x1f, 0x2d, 0xe4, 0x84, 0x9d,
+	0x68, 0x99, 0xf2, 0x01, 0xb9, 0xb3, 0x61, 0x01, 0x3b, 0x60, 0x25, 0xc4, 0x1c, 0x8f, 0x04, 0x5a,
+	0xd5, 0xc9, 0xfd, 0xf6, 0x5c, 0xd6, 0x4e, 0xb5, 0x88, 0x51, 0x6e, 0x14, 0xe8, 0x68, 0x62, 0xec,
+	0x53, 0x0f, 0x4b, 0xc6, 0x9d, 0x2c, 0xae, 0x30, 0xea, 0xa9, 0x0b, 0x8b, 0x4a, 0x0b, 0x44, 0xf3,
+	0x22, 0x55, 0x93, 0x86, 0x75, 0x1a, 0xf5, 0x7e, 0x46, 0xc6, 0x69, 0x34, 0xf1, 0x0c, 0x58, 0xd9,
+	0x80, 0x7f, 0xb0, 0xc0, 0xdd, 0x0c, 0x14, 0x4e, 0x6f, 0xec, 0x14, 0x93, 0xcc, 0x11, 0x78, 0x1f,
+	0x1f, 0x8e, 0xc7, 0x85, 0x0c, 0xf3, 0x2b, 0x3e, 0x88, 0x49, 0x1c, 0xc6, 0x60, 0x6b, 0xc2, 0xa8,
+	0x50, 0x75, 0x1d, 0xf2, 0x28, 0x20, 0x68, 0x4d, 0x9b, 0xff, 0x78, 0xd1, 0xaa, 0xe2, 0xe2, 0x8c,
+	0x9d, 0x2a, 0x05, 0xc6, 0x76, 0xcd, 0x9d, 0x81, 0xc1, 0x0b, 0xb0, 0x45, 0x03, 0x2a, 0x1d, 0xc5,
+	0x80, 0x2c, 0x92, 0x4e, 0xc6, 0x84, 0x02, 0xad, 0x2f, 0x60, 0xb7, 0x13, 0x50, 0x79, 0x96, 0xa8,
+	0x38, 0x4b, 0x35, 0x18, 0xbb, 0x77, 0xe8, 0x0c, 0x4c, 0xc0, 0x2f, 0xc0, 0x86, 0xf0, 0xb1, 0x18,
+	0x38, 0x9c, 0x48, 0x4e, 0x89, 0x40, 0x1b, 0xcd, 0xa5, 0x77, 0xd2, 0x44, 0xd1, 0x5c, 0x57, 0x49,
+	0xda, 0x44, 0xf2, 0x34, 0xb9, 0xeb, 0x22, 0x5d, 0xa1, 0x44, 0xc0, 0xdf, 0x80, 0xf2, 0x39, 0xa6,
+	0x3e, 0xf1, 0x1c, 0xbd, 0x4c, 0x04, 0x2a, 0xff, 0x2f, 0xca, 0x37, 0x12, 0x65, 0xdd, 0x44, 0x17,
+	0x7c, 0xa8, 0x8e, 0xcc, 0x24, 0x92, 0x78, 0x8e, 0x3b, 0xc0, 0x41, 0x40, 0x7c, 0x87, 0x7a, 0x02,
+	0x7d, 0xd0, 0x5c, 0xda, 0x2f, 0xd9, 0x77, 0x0a, 0xf0, 0x93, 0x04, 0xed, 0x78, 0x02, 0x4a, 0x50,
+	0xcf, 0x0b, 0xfd, 0x4b, 0x4c, 0x7d, 0x87, 0x13, 0x97, 0x71, 0x4f, 0xa0, 0x8a, 0xf6, 0xee, 0xd1,
+	0x62, 0x05, 0xf6, 0x53, 0x4c, 0x7d, 0x5b, 0x2b, 0x48, 0x13, 0x1c, 0x5f, 0x85, 0x04, 0xfc, 0x08,
+	0xd4, 0x0b, 0x64, 0x71, 0x81, 0xb9, 0xe7, 0x78, 0x24, 0x60, 0x23, 0x81, 0x36, 0xb5, 0xb3, 0xb5,
+	0xfc, 0x92, 0x2b, 0xf0, 0x44, 0x63, 0x90, 0x02, 0x38, 0x20, 0xbe, 0x37, 0xc5, 0xe4, 0x50, 0xfb,
+	0xf9, 0xfd, 0xb9, 0xfc, 0x7c, 0x4a, 0xfc, 0x09, 0x3e, 0x37, 0x4e, 0x56, 0x06, 0x53, 0xeb, 0x70,
+	0x0b, 0xdc, 0x0e, 0x19, 0x97, 0xaa, 0x63, 0x56, 0x9b, 0xd6, 0x7e, 0xc9, 0x5e, 0x51, 0x8f, 0x1d,
+	0x6f, 0xef, 0x2f, 0x16, 0xa8, 0x4c, 0x6b, 0x81, 0xdb, 0x60, 0x35, 0x31, 0x6c, 0x1a, 0x6c, 0xc9,
+	0xbe, 0xad, 0x9f, 0x3b, 0x1e, 0xfc, 0x12, 0x54, 0x27, 0xdc, 0x75, 0x68, 0xe0, 0x91, 0x57, 0xa6,
+	0xbb, 0x7e, 0x34, 0xdf, 0xe1, 0x0a, 0x77, 0x86, 0xcf, 0x9b, 0xc5, 0x36, 0xd7, 0x51, 0x4a, 0xf7,
+	0xfe, 0x5e, 0x05, 0x1b, 0x13, 0xad, 0xf8, 0x5d, 0x8e, 0xdd, 0x07, 0x20, 0x2f, 0x12, 0x74, 0x53,
+	0x83, 0x25, 0x37, 0x2d, 0x0c, 0x78, 0x17, 0x94, 0x5c, 0x9f, 0x92, 0x40, 0x1f, 0xc1, 0x92, 0x46,
+	0x57, 0x93, 0x85, 0x8e, 0x07, 0xbf, 0x05, 0xca, 0xea, 0xfe, 0x50, 0xec, 0xa7, 0x5d, 0x6e, 0x59,
+	0x8f, 0x15, 0x1b, 0x66, 0xd5, 0x74, 0xa6, 0x1e, 0xa8, 0x64, 0x59, 0x36, 0x93, 0x10, 0xba, 0xa5,
+	0xa9, 0xf9, 0xe0, 0xda, 0xc0, 0x53, 0x01, 0x15, 0x78, 0x71, 0x98, 0x31, 0x51, 0x67, 0x63, 0x8a,
+	0xc1, 0x54, 0xfd, 0x86, 0x24, 0x39, 0x5d, 0xd3, 0x84, 0x55, 0x0c, 0x7d, 0x92, 0xf6, 0xbd, 0x47,
+	0xef, 0xea, 0xf0, 0x59, 0xd9, 0x76, 0x89, 0x7c, 0xa2, 0xc5, 0x4e, 0xb1, 0x3b, 0x24, 0xf2, 0x04,
+	0x4b, 0x9c, 0xd6, 0xaf, 0xd1, 0x9e, 0xb4, 0xe6, 0x64, 0x93, 0x80, 0xdf, 0x01, 0x30, 0xe1, 0x09,
+	0x8f, 0x5d, 0x04, 0x8a, 0x9d, 0x1c, 0xec, 0x0e, 0x75, 0x93, 0x2b, 0xd9, 0x15, 0x8d, 0x9c, 0x18,
+	0xe0, 0xc8, 0x1d, 0x5e, 0x57, 0x03, 0xab, 0xff, 0x87, 0x1a, 0x80, 0x8f, 0x00, 0x12, 0x24, 0x30,
+	0x1c, 0xa3, 0x5a, 0xc6, 0x39, 0xe5, 0x23, 0x3d, 0x25, 0xaa, 0xb6, 0x65, 0xed, 0xaf, 0xda, 0x75,
+	0x85, 0x6b, 0xda, 0x78, 0x52, 0x44, 0x8b, 0x31, 0x45, 0x3d, 0x9f, 0x38, 0x82, 0xf6, 0x03, 0x81,
+	0x80, 0x96, 0x49, 0x63, 0x52, 0x40, 0x57, 0xad, 0xab, 0x1b, 0x1c, 0x72, 0x72, 0x4e, 0x38, 0x27,
+	0xde, 0xc4, 0x15, 0x46, 0x6b, 0xba, 0x58, 0x6a, 0x19, 0x5a, 0xb8, 0xc2, 0x50, 0x00, 0x98, 0xec,
+	0x15, 0x0e, 0xf6, 0x7d, 0xe6, 0x6a, 0xd3, 0x68, 0x5d, 0xd7, 0xc4, 0x27, 0x0b, 0x0e, 0x07, 0x5a,
+	0xcd, 0x51, 0xa6, 0x25, 0x3d, 0x12, 0x3e, 0x0d, 0x40, 0x0c, 0xaa, 0x2c, 0x54, 0xa4, 0x48, 0x03,
+	0x27, 0x6f, 0x75, 0x9a, 0xda, 0xd7, 0x8f, 0x0f, 0xfe, 0xfd, 0x66, 0xf7, 0x41, 0x9f, 0xca, 0x41,
+	0xd4, 0x6b, 0xb9, 0x6c, 0xd4, 0x76, 0x99, 0x18, 0x31, 0x61, 0x7e, 0x1e, 0x08, 0x6f, 0xd8, 0x96,
+	0xe3, 0x90, 0x08, 0x55, 0x2a, 0xaa, 0x45, 0x11, 0x21, 0xec, 0x4d, 0xad, 0xad, 0x13, 0x64, 0xd5,
+	0x23, 0xe0, 0xe3, 0xc2, 0xf0, 0xa3, 0x06, 0x9f, 0xc9, 0x99, 0xbb, 0xac, 0x2f, 0x47, 0xc6, 0x78,
+	0x2f, 0xb0, 0xdf, 0x2d, 0xcc, 0xde, 0xe7, 0xa0, 0x32, 0x2d, 0xab, 0x29, 0x7b, 0xed, 0xf0, 0xe1,
+	0x42, 0x27, 0x92, 0x37, 0xf9, 0xe4, 0x24, 0xca, 0x93, 0xf6, 0xe0, 0x10, 0x54, 0x63, 0xe1, 0x3a,
+	0xba, 0x3a, 0x0a, 0x0d, 0xb5, 0xb2, 0x00, 0x7d, 0xbe, 0x10, 0x6e, 0x97, 0x04, 0xde, 0x74, 0x33,
+	0xdd, 0x8c, 0xa7, 0xd6, 0x55, 0xb3, 0xdb, 0x4e, 0xe9, 0x23, 0xc0, 0xae, 0xa4, 0x31, 0xc9, 0x6d,
+	0xa2, 0x4d, 0x9d, 0xef, 0x9d, 0x56, 0xf2, 0x3e, 0xd3, 0x4a, 0xdf, 0x67, 0x5a, 0x05, 0xbd, 0x5f,
+	0xfd, 0x63, 0xd7, 0xb2, 0xb7, 0x0c, 0xe1, 0x18, 0x0d, 0x19, 0x0c, 0xdb, 0xa0, 0x9a, 0x37, 0x2d,
+	0x55, 0x48, 0x17, 0x3e, 0x15, 0x52, 0x77, 0x82, 0x92, 0x0d, 0x33, 0xe8, 0x28, 0x45, 0xe0, 0x03,
+	0x90, 0xaf, 0xaa, 0x32, 0x1d, 0xeb, 0xfd, 0x55, 0xbd, 0x7f, 0x33, 0x43, 0x4e, 0x0c, 0x00, 0x3f,
+	0x06, 0xdb, 0x82, 0x9d, 0x4b, 0x27, 0x29, 0x1b, 0x35, 0x81, 0x14, 0xea, 0xa6, 0xa6, 0xa5, 0xea,
+	0x6a, 0xc3, 0x73, 0x85, 0x3f, 0x8f, 0x64, 0xa1, 0x12, 0x06, 0xa0, 0x9a, 0x8f, 0x8b, 0x6a, 0x98,
+	0x24, 0x92, 0x70, 0x81, 0xee, 0xe8, 0x90, 0x7f, 0xb0, 0x50, 0x42, 0x4f, 0x33, 0x71, 0x1b, 0xba,
+	0x57, 0xd6, 0x20, 0x06, 0xe5, 0xf4, 0x2e, 0x5d, 0xd0, 0xc0, 0x63, 0x17, 0xa8, 0xae, 0x8d, 0x3c,
+	0x7e, 0x9f, 0x7b, 0xf4, 0xb9, 0xd6, 0x60, 0x6f, 0xf0, 0xe2, 0x23, 0xfc, 0x15, 0xa8, 0x67, 0x04,
+	0xa7, 0x67, 0x83, 0xf4, 0x8d, 0x13, 0x6d, 0x69, 0x53, 0xdb, 0x57, 0x52, 0x78, 0x62, 0x36, 0x1c,
+	0xaf, 0xaa, 0xca, 0xf8, 0xb3, 0xca, 0x62, 0x2d, 0x55, 0xa1, 0x06, 0x80, 0x14, 0x87, 0x75, 0x35,
+	0xac, 0x47, 0x82, 0x78, 0x08, 0x69, 0x86, 0x31, 0x4f, 0xf0, 0x4f, 0x16, 0x68, 0xfa, 0x58, 0xc8,
+	0x9c, 0x59, 0x69, 0x70, 0xce, 0x55, 0x01, 0xb0, 0xc0, 0x34, 0x1b, 0x81, 0xb6, 0x9b, 0x4b, 0x73,
+	0x13, 0x46, 0x96, 0x9b, 0x4e, 0xa6, 0x67, 0xe2, 0xb5, 0xea, 0xbe, 0xb2, 0x96, 0xb2, 0xf5, 0xf4,
+	0x1e, 0x01, 0xab, 0xe0, 0x96, 0x64, 0xa1, 0x13, 0xa0, 0x9d, 0xa6, 0xb5, 0xbf, 0x61, 0x2f, 0x4b,
+	0x16, 0xfe, 0x02, 0xfe, 0x12, 0xac, 0x8e, 0x88, 0xc4, 0x1e, 0x96, 0x18, 0xdd, 0x6d, 0x5a, 0x73,
+	0xdf, 0x9f, 0xf4, 0xd0, 0x9f, 0x19, 0x61, 0x3b, 0x53, 0xa3, 0xf8, 0xf4, 0x2a, 0x65, 0x3b, 0x82,
+	0xbc, 0x44, 0xf7, 0x34, 0x7b, 0xd4, 0xc4, 0x34, 0x63, 0x77, 0xc9, 0x4b, 0xc5, 0xd9, 0xfa, 0xb0,
+	0x84, 0xba, 0x69, 0x82, 0xbc, 0x8c, 0x48, 0xe0, 0x12, 0x74, 0x5f, 0x4b, 0x54, 0x14, 0xd2, 0x25,
+	0x81, 0xec, 0x9a, 0x75, 0xd8, 0x02, 0x55, 0xbd, 0x5b, 0xf5, 0x38, 0x2f, 0xdf, 0xde, 0xd0, 0xdb,
+	0x37, 0x15, 0x74, 0xa4, 0x90, 0x6c, 0xff, 0x10, 0xd4, 0xf5, 0xfe, 0xfc, 0x1d, 0x40, 0xdd, 0x43,
+	0x2a, 0xc7, 0x68, 0xf7, 0x3d, 0x82, 0x3e, 0x32, 0xc2, 0x76, 0x4d, 0x29, 0x9d, 0x5e, 0x85, 0x7d,
+	0xb0, 0x6b, 0x18, 0x83, 0xbc, 0x0a, 0x29, 0x1f, 0x3b, 0x17, 0x98, 0x07, 0xaa, 0x61, 0xe6, 0xbc,
+	0xd1, 0x9c, 0x93, 0x37, 0xee, 0x25, 0x8a, 0x3e, 0xd5, 0x7a, 0x3e, 0x4f, 0xd4, 0xe4, 0xe4, 0xf1,
+	0x10, 0x6c, 0xe5, 0x5c, 0xa0, 0xae, 0xbb, 0x30, 0x54, 0xed, 0xa1, 0x6f, 0xe8, 0x52, 0xbc, 0x93,
+	0xc1, 0x3f, 0x57, 0x68, 0x42, 0xd4, 0x9e, 0x3a, 0x3d, 0x33, 0xc4, 0x38, 0x03, 0x95, 0x28, 0xf2,
+	0x8a, 0x8c, 0x42, 0x89, 0xf6, 0xb4, 0xcc, 0xa6, 0x81, 0x9e, 0x62, 0x31, 0xf8, 0x54, 0x03, 0xf0,
+	0x19, 0x00, 0x49, 0x46, 0xb1, 0x3b, 0x14, 0xe8, 0x43, 0xed, 0x7b, 0x6b, 0xfe, 0x59, 0xff, 0xc8,
+	0x1d, 0x0a, 0xbb, 0x24, 0xd2, 0xbf, 0x7b, 0x7f, 0xb5, 0x40, 0x7d, 0xf6, 0xf7, 0x81, 0x05, 0xbe,
+	0xf3, 0xd4, 0xc1, 0x8a, 0x19, 0xd8, 0x6e, 0x6a, 0xdc, 0x3c, 0xc1, 0x4f, 0x40, 0x29, 0x3f, 0xe6,
+	0xa5, 0x39, 0x8f, 0x39, 0x17, 0x39, 0x3e, 0xfb, 0xfa, 0xb2, 0x61, 0xbd, 0xbe, 0x6c, 0x58, 0xff,
+	0xbc, 0x6c, 0x58, 0x5f, 0xbd, 0x6d, 0xdc, 0x78, 0xfd, 0xb6, 0x71, 0xe3, 0x6f, 0x6f, 0x1b, 0x37,
+	0xbe, 0x78, 0x7c, 0xb5, 0xb7, 0xe6, 0x47, 0xf0, 0x20, 0xfb, 0x70, 0xf6, 0x6a, 0xf2, 0x13, 0x9d,
+	0xee, 0xb9, 0xbd, 0x15, 0x6d, 0xfa, 0x7b, 0xff, 0x19, 0x00, 0x6c, 0xc2, 0x07, 0xdd, 0x67, 0x14,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -411,10 +872,19 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.ConsumerAddrsToPrune) > 0 {
-		for iNdEx := len(m.ConsumerAddrsToPrune) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.HeldUnbondingOps) > 0 {
+		for iNdEx := len(m.HeldUnbondingOps) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ConsumerAddrsToPrune[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.HeldUnbondingOps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -422,13 +892,26 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x5a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
 		}
 	}
-	if len(m.ValidatorsByConsumerAddr) > 0 {
-		for iNdEx := len(m.ValidatorsByConsumerAddr) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ConsumerRewardDenoms) > 0 {
+		for iNdEx := len(m.ConsumerRewardDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ConsumerRewardDenoms[iNdEx])
+			copy(dAtA[i:], m.ConsumerRewardDenoms[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.ConsumerRewardDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.ValidatorJailRecords) > 0 {
+		for iNdEx := len(m.ValidatorJailRecords) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ValidatorsByConsumerAddr[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ValidatorJailRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -436,13 +919,24 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x52
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
 		}
 	}
-	if len(m.ValidatorConsumerPubkeys) > 0 {
-		for iNdEx := len(m.ValidatorConsumerPubkeys) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.InvalidatedChannelIds) > 0 {
+		for iNdEx := len(m.InvalidatedChannelIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.InvalidatedChannelIds[iNdEx])
+			copy(dAtA[i:], m.InvalidatedChannelIds[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.InvalidatedChannelIds[iNdEx])))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.FailedSlashes) > 0 {
+		for iNdEx := len(m.FailedSlashes) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ValidatorConsumerPubkeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.FailedSlashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -450,23 +944,13 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x4a
-		}
-	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+			dAtA[i] = 0x72
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x42
-	if len(m.ConsumerRemovalProposals) > 0 {
-		for iNdEx := len(m.ConsumerRemovalProposals) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.SlashRetries) > 0 {
+		for iNdEx := len(m.SlashRetries) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ConsumerRemovalProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.SlashRetries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -474,7 +958,87 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3a
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.InitTimeoutTimestamps) > 0 {
+		for iNdEx := len(m.InitTimeoutTimestamps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InitTimeoutTimestamps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.ConsumerAddrsToPrune) > 0 {
+		for iNdEx := len(m.ConsumerAddrsToPrune) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsumerAddrsToPrune[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ValidatorsByConsumerAddr) > 0 {
+		for iNdEx := len(m.ValidatorsByConsumerAddr) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorsByConsumerAddr[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.ValidatorConsumerPubkeys) > 0 {
+		for iNdEx := len(m.ValidatorConsumerPubkeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorConsumerPubkeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.ConsumerRemovalProposals) > 0 {
+		for iNdEx := len(m.ConsumerRemovalProposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsumerRemovalProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
 		}
 	}
 	if len(m.ConsumerAdditionProposals) > 0 {
@@ -553,7 +1117,7 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ConsumerState) Marshal() (dAtA []byte, err error) {
+func (m *HeldUnbondingOps) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -563,12 +1127,12 @@ func (m *ConsumerState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ConsumerState) MarshalTo(dAtA []byte) (int, error) {
+func (m *HeldUnbondingOps) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ConsumerState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *HeldUnbondingOps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -584,60 +1148,8 @@ func (m *ConsumerState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x42
-		}
-	}
-	if len(m.SlashDowntimeAck) > 0 {
-		for iNdEx := len(m.SlashDowntimeAck) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.SlashDowntimeAck[iNdEx])
-			copy(dAtA[i:], m.SlashDowntimeAck[iNdEx])
-			i = encodeVarintGenesis(dAtA, i, uint64(len(m.SlashDowntimeAck[iNdEx])))
-			i--
-			dAtA[i] = 0x3a
-		}
-	}
-	if len(m.PendingValsetChanges) > 0 {
-		for iNdEx := len(m.PendingValsetChanges) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PendingValsetChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	{
-		size, err := m.ConsumerGenesis.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+			dAtA[i] = 0x12
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if m.InitialHeight != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.InitialHeight))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0x12
 	}
 	if len(m.ChainId) > 0 {
 		i -= len(m.ChainId)
@@ -649,7 +1161,7 @@ func (m *ConsumerState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ValsetUpdateIdToHeight) Marshal() (dAtA []byte, err error) {
+func (m *ConsumerState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -659,205 +1171,1760 @@ func (m *ValsetUpdateIdToHeight) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ValsetUpdateIdToHeight) MarshalTo(dAtA []byte) (int, error) {
+func (m *ConsumerState) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ValsetUpdateIdToHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ConsumerState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+	if m.SlashAcks != nil {
+		{
+			size, err := m.SlashAcks.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
-	}
-	if m.ValsetUpdateId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.ValsetUpdateId))
+		dAtA[i] = 0x2
 		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+		dAtA[i] = 0x9a
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.GenesisHashExempt {
+		i--
+		if m.GenesisHashExempt {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x90
 	}
-	var l int
-	_ = l
-	if m.ValsetUpdateId != 0 {
-		n += 1 + sovGenesis(uint64(m.ValsetUpdateId))
+	if m.ValidatorListsUpdated {
+		i--
+		if m.ValidatorListsUpdated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x88
 	}
-	if len(m.ConsumerStates) > 0 {
-		for _, e := range m.ConsumerStates {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if m.ClientExpiryWarningTimestamp != nil {
+		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ClientExpiryWarningTimestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ClientExpiryWarningTimestamp):])
+		if err4 != nil {
+			return 0, err4
 		}
+		i -= n4
+		i = encodeVarintGenesis(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x82
 	}
-	if len(m.UnbondingOps) > 0 {
-		for _, e := range m.UnbondingOps {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if m.LastConsumerActivity != nil {
+		{
+			size, err := m.LastConsumerActivity.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
 	}
-	if m.MatureUnbondingOps != nil {
-		l = m.MatureUnbondingOps.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	if m.LastAckedSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastAckedSequence))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
 	}
-	if len(m.ValsetUpdateIdToHeight) > 0 {
-		for _, e := range m.ValsetUpdateIdToHeight {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if m.LastSentSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSentSequence))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe8
 	}
-	if len(m.ConsumerAdditionProposals) > 0 {
-		for _, e := range m.ConsumerAdditionProposals {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if m.SlashConfirmationSeq != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SlashConfirmationSeq))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
 	}
-	if len(m.ConsumerRemovalProposals) > 0 {
-		for _, e := range m.ConsumerRemovalProposals {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.ValidatorConsumerPubkeys) > 0 {
-		for _, e := range m.ValidatorConsumerPubkeys {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if m.TopN != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopN))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if len(m.LastDowntimeInfractionHeights) > 0 {
+		for iNdEx := len(m.LastDowntimeInfractionHeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LastDowntimeInfractionHeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
 		}
 	}
-	if len(m.ValidatorsByConsumerAddr) > 0 {
-		for _, e := range m.ValidatorsByConsumerAddr {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
 	}
-	if len(m.ConsumerAddrsToPrune) > 0 {
-		for _, e := range m.ConsumerAddrsToPrune {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintGenesis(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xba
+	if m.RewardsWindow != nil {
+		{
+			size, err := m.RewardsWindow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
 	}
-	return n
-}
-
-func (m *ConsumerState) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.ConsumerParameters != nil {
+		{
+			size, err := m.ConsumerParameters.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
 	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.SoftOptedOutValidators) > 0 {
+		for iNdEx := len(m.SoftOptedOutValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SoftOptedOutValidators[iNdEx])
+			copy(dAtA[i:], m.SoftOptedOutValidators[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.SoftOptedOutValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
 	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ValidatorDenylist) > 0 {
+		for iNdEx := len(m.ValidatorDenylist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ValidatorDenylist[iNdEx])
+			copy(dAtA[i:], m.ValidatorDenylist[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorDenylist[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
 	}
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ValidatorAllowlist) > 0 {
+		for iNdEx := len(m.ValidatorAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ValidatorAllowlist[iNdEx])
+			copy(dAtA[i:], m.ValidatorAllowlist[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAllowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
 	}
-	if m.InitialHeight != 0 {
-		n += 1 + sovGenesis(uint64(m.InitialHeight))
+	if m.ClientInactiveTimestamp != nil {
+		n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ClientInactiveTimestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ClientInactiveTimestamp):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintGenesis(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
 	}
-	l = m.ConsumerGenesis.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.PendingValsetChanges) > 0 {
-		for _, e := range m.PendingValsetChanges {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.VscSendTimestamps) > 0 {
+		for iNdEx := len(m.VscSendTimestamps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VscSendTimestamps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
 		}
 	}
-	if len(m.SlashDowntimeAck) > 0 {
-		for _, s := range m.SlashDowntimeAck {
-			l = len(s)
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ConsumerValSet) > 0 {
+		for iNdEx := len(m.ConsumerValSet) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsumerValSet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.ConsumerValSetUpdateId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ConsumerValSetUpdateId))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.OptedInValidators) > 0 {
+		for iNdEx := len(m.OptedInValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OptedInValidators[iNdEx])
+			copy(dAtA[i:], m.OptedInValidators[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.OptedInValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	{
+		size, err := m.RewardsAllocation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if len(m.PreferredRewardDenom) > 0 {
+		i -= len(m.PreferredRewardDenom)
+		copy(dAtA[i:], m.PreferredRewardDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PreferredRewardDenom)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.SlashDoubleSigns {
+		i--
+		if m.SlashDoubleSigns {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.SendSlashConfirmations {
+		i--
+		if m.SendSlashConfirmations {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x48
 	}
 	if len(m.UnbondingOpsIndex) > 0 {
-		for _, e := range m.UnbondingOpsIndex {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+		for iNdEx := len(m.UnbondingOpsIndex) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingOpsIndex[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
 		}
 	}
-	return n
+	if len(m.SlashDowntimeAck) > 0 {
+		for iNdEx := len(m.SlashDowntimeAck) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SlashDowntimeAck[iNdEx])
+			copy(dAtA[i:], m.SlashDowntimeAck[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.SlashDowntimeAck[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PendingValsetChanges) > 0 {
+		for iNdEx := len(m.PendingValsetChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingValsetChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.ConsumerGenesis.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.InitialHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.InitialHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ValsetUpdateIdToHeight) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValsetUpdateIdToHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValsetUpdateIdToHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValsetUpdateIdToHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ValsetUpdateId != 0 {
-		n += 1 + sovGenesis(uint64(m.ValsetUpdateId))
+	if m.Timestamp != nil {
+		n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Timestamp):])
+		if err13 != nil {
+			return 0, err13
+		}
+		i -= n13
+		i = encodeVarintGenesis(dAtA, i, uint64(n13))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.Height != 0 {
-		n += 1 + sovGenesis(uint64(m.Height))
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.ValsetUpdateId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ValsetUpdateId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValsetUpdateId != 0 {
+		n += 1 + sovGenesis(uint64(m.ValsetUpdateId))
+	}
+	if len(m.ConsumerStates) > 0 {
+		for _, e := range m.ConsumerStates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnbondingOps) > 0 {
+		for _, e := range m.UnbondingOps {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MatureUnbondingOps != nil {
+		l = m.MatureUnbondingOps.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.ValsetUpdateIdToHeight) > 0 {
+		for _, e := range m.ValsetUpdateIdToHeight {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ConsumerAdditionProposals) > 0 {
+		for _, e := range m.ConsumerAdditionProposals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ConsumerRemovalProposals) > 0 {
+		for _, e := range m.ConsumerRemovalProposals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ValidatorConsumerPubkeys) > 0 {
+		for _, e := range m.ValidatorConsumerPubkeys {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorsByConsumerAddr) > 0 {
+		for _, e := range m.ValidatorsByConsumerAddr {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ConsumerAddrsToPrune) > 0 {
+		for _, e := range m.ConsumerAddrsToPrune {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.InitTimeoutTimestamps) > 0 {
+		for _, e := range m.InitTimeoutTimestamps {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SlashRetries) > 0 {
+		for _, e := range m.SlashRetries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FailedSlashes) > 0 {
+		for _, e := range m.FailedSlashes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.InvalidatedChannelIds) > 0 {
+		for _, s := range m.InvalidatedChannelIds {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorJailRecords) > 0 {
+		for _, e := range m.ValidatorJailRecords {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ConsumerRewardDenoms) > 0 {
+		for _, s := range m.ConsumerRewardDenoms {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HeldUnbondingOps) > 0 {
+		for _, e := range m.HeldUnbondingOps {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *HeldUnbondingOps) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.UnbondingOpsIndex) > 0 {
+		for _, e := range m.UnbondingOpsIndex {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConsumerState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.InitialHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.InitialHeight))
+	}
+	l = m.ConsumerGenesis.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PendingValsetChanges) > 0 {
+		for _, e := range m.PendingValsetChanges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SlashDowntimeAck) > 0 {
+		for _, s := range m.SlashDowntimeAck {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnbondingOpsIndex) > 0 {
+		for _, e := range m.UnbondingOpsIndex {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.SendSlashConfirmations {
+		n += 2
+	}
+	if m.SlashDoubleSigns {
+		n += 2
+	}
+	l = len(m.PreferredRewardDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.RewardsAllocation.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.OptedInValidators) > 0 {
+		for _, b := range m.OptedInValidators {
+			l = len(b)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ConsumerValSetUpdateId != 0 {
+		n += 1 + sovGenesis(uint64(m.ConsumerValSetUpdateId))
+	}
+	if len(m.ConsumerValSet) > 0 {
+		for _, e := range m.ConsumerValSet {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VscSendTimestamps) > 0 {
+		for _, e := range m.VscSendTimestamps {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ClientInactiveTimestamp != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ClientInactiveTimestamp)
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.ValidatorAllowlist) > 0 {
+		for _, s := range m.ValidatorAllowlist {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorDenylist) > 0 {
+		for _, s := range m.ValidatorDenylist {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SoftOptedOutValidators) > 0 {
+		for _, s := range m.SoftOptedOutValidators {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ConsumerParameters != nil {
+		l = m.ConsumerParameters.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if m.RewardsWindow != nil {
+		l = m.RewardsWindow.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration)
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.Paused {
+		n += 3
+	}
+	if len(m.LastDowntimeInfractionHeights) > 0 {
+		for _, e := range m.LastDowntimeInfractionHeights {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.TopN != 0 {
+		n += 2 + sovGenesis(uint64(m.TopN))
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if m.SlashConfirmationSeq != 0 {
+		n += 2 + sovGenesis(uint64(m.SlashConfirmationSeq))
+	}
+	if m.LastSentSequence != 0 {
+		n += 2 + sovGenesis(uint64(m.LastSentSequence))
+	}
+	if m.LastAckedSequence != 0 {
+		n += 2 + sovGenesis(uint64(m.LastAckedSequence))
+	}
+	if m.LastConsumerActivity != nil {
+		l = m.LastConsumerActivity.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if m.ClientExpiryWarningTimestamp != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ClientExpiryWarningTimestamp)
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if m.ValidatorListsUpdated {
+		n += 3
+	}
+	if m.GenesisHashExempt {
+		n += 3
+	}
+	if m.SlashAcks != nil {
+		l = m.SlashAcks.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *ValsetUpdateIdToHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValsetUpdateId != 0 {
+		n += 1 + sovGenesis(uint64(m.ValsetUpdateId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	if m.Timestamp != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Timestamp)
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetUpdateId", wireType)
+			}
+			m.ValsetUpdateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValsetUpdateId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerStates = append(m.ConsumerStates, ConsumerState{})
+			if err := m.ConsumerStates[len(m.ConsumerStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingOps = append(m.UnbondingOps, UnbondingOp{})
+			if err := m.UnbondingOps[len(m.UnbondingOps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatureUnbondingOps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MatureUnbondingOps == nil {
+				m.MatureUnbondingOps = &types.MaturedUnbondingOps{}
+			}
+			if err := m.MatureUnbondingOps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetUpdateIdToHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValsetUpdateIdToHeight = append(m.ValsetUpdateIdToHeight, ValsetUpdateIdToHeight{})
+			if err := m.ValsetUpdateIdToHeight[len(m.ValsetUpdateIdToHeight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAdditionProposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerAdditionProposals = append(m.ConsumerAdditionProposals, ConsumerAdditionProposal{})
+			if err := m.ConsumerAdditionProposals[len(m.ConsumerAdditionProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerRemovalProposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerRemovalProposals = append(m.ConsumerRemovalProposals, ConsumerRemovalProposal{})
+			if err := m.ConsumerRemovalProposals[len(m.ConsumerRemovalProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorConsumerPubkeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorConsumerPubkeys = append(m.ValidatorConsumerPubkeys, ValidatorConsumerPubKey{})
+			if err := m.ValidatorConsumerPubkeys[len(m.ValidatorConsumerPubkeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsByConsumerAddr", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorsByConsumerAddr = append(m.ValidatorsByConsumerAddr, ValidatorByConsumerAddr{})
+			if err := m.ValidatorsByConsumerAddr[len(m.ValidatorsByConsumerAddr)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAddrsToPrune", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerAddrsToPrune = append(m.ConsumerAddrsToPrune, ConsumerAddrsToPrune{})
+			if err := m.ConsumerAddrsToPrune[len(m.ConsumerAddrsToPrune)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitTimeoutTimestamps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitTimeoutTimestamps = append(m.InitTimeoutTimestamps, InitTimeoutTimestamp{})
+			if err := m.InitTimeoutTimestamps[len(m.InitTimeoutTimestamps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRetries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashRetries = append(m.SlashRetries, SlashRetry{})
+			if err := m.SlashRetries[len(m.SlashRetries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedSlashes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedSlashes = append(m.FailedSlashes, SlashRetry{})
+			if err := m.FailedSlashes[len(m.FailedSlashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidatedChannelIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvalidatedChannelIds = append(m.InvalidatedChannelIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorJailRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorJailRecords = append(m.ValidatorJailRecords, ValidatorJailRecord{})
+			if err := m.ValidatorJailRecords[len(m.ValidatorJailRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerRewardDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerRewardDenoms = append(m.ConsumerRewardDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeldUnbondingOps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeldUnbondingOps = append(m.HeldUnbondingOps, HeldUnbondingOps{})
+			if err := m.HeldUnbondingOps[len(m.HeldUnbondingOps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HeldUnbondingOps) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeldUnbondingOps: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeldUnbondingOps: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOpsIndex", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingOpsIndex = append(m.UnbondingOpsIndex, VscUnbondingOps{})
+			if err := m.UnbondingOpsIndex[len(m.UnbondingOpsIndex)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialHeight", wireType)
+			}
+			m.InitialHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGenesis", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConsumerGenesis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingValsetChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.PendingValsetChanges = append(m.PendingValsetChanges, types.ValidatorSetChangePacketData{})
+			if err := m.PendingValsetChanges[len(m.PendingValsetChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValsetUpdateId", wireType)
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashDowntimeAck", wireType)
 			}
-			m.ValsetUpdateId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -867,14 +2934,27 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValsetUpdateId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashDowntimeAck = append(m.SlashDowntimeAck, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerStates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOpsIndex", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -901,14 +2981,86 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerStates = append(m.ConsumerStates, ConsumerState{})
-			if err := m.ConsumerStates[len(m.ConsumerStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.UnbondingOpsIndex = append(m.UnbondingOpsIndex, VscUnbondingOps{})
+			if err := m.UnbondingOpsIndex[len(m.UnbondingOpsIndex)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendSlashConfirmations", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SendSlashConfirmations = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashDoubleSigns", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SlashDoubleSigns = bool(v != 0)
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreferredRewardDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreferredRewardDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsAllocation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -935,14 +3087,64 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnbondingOps = append(m.UnbondingOps, UnbondingOp{})
-			if err := m.UnbondingOps[len(m.UnbondingOps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RewardsAllocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MatureUnbondingOps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OptedInValidators", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptedInValidators = append(m.OptedInValidators, make([]byte, postIndex-iNdEx))
+			copy(m.OptedInValidators[len(m.OptedInValidators)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerValSetUpdateId", wireType)
+			}
+			m.ConsumerValSetUpdateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsumerValSetUpdateId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerValSet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -969,16 +3171,48 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MatureUnbondingOps == nil {
-				m.MatureUnbondingOps = &types.MaturedUnbondingOps{}
+			m.ConsumerValSet = append(m.ConsumerValSet, ConsumerValidator{})
+			if err := m.ConsumerValSet[len(m.ConsumerValSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.MatureUnbondingOps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VscSendTimestamps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VscSendTimestamps = append(m.VscSendTimestamps, VscSendTimestamp{})
+			if err := m.VscSendTimestamps[len(m.VscSendTimestamps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValsetUpdateIdToHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientInactiveTimestamp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -995,26 +3229,92 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClientInactiveTimestamp == nil {
+				m.ClientInactiveTimestamp = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ClientInactiveTimestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAllowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAllowlist = append(m.ValidatorAllowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDenylist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValsetUpdateIdToHeight = append(m.ValsetUpdateIdToHeight, ValsetUpdateIdToHeight{})
-			if err := m.ValsetUpdateIdToHeight[len(m.ValsetUpdateIdToHeight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorDenylist = append(m.ValidatorDenylist, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 6:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAdditionProposals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SoftOptedOutValidators", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1024,29 +3324,27 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerAdditionProposals = append(m.ConsumerAdditionProposals, ConsumerAdditionProposal{})
-			if err := m.ConsumerAdditionProposals[len(m.ConsumerAdditionProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SoftOptedOutValidators = append(m.SoftOptedOutValidators, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 7:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerRemovalProposals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerParameters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1073,14 +3371,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerRemovalProposals = append(m.ConsumerRemovalProposals, ConsumerRemovalProposal{})
-			if err := m.ConsumerRemovalProposals[len(m.ConsumerRemovalProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ConsumerParameters == nil {
+				m.ConsumerParameters = &ConsumerParameters{}
+			}
+			if err := m.ConsumerParameters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsWindow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1107,13 +3407,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.RewardsWindow == nil {
+				m.RewardsWindow = &ConsumerRewardsWindow{}
+			}
+			if err := m.RewardsWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorConsumerPubkeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailDuration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1140,16 +3443,15 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorConsumerPubkeys = append(m.ValidatorConsumerPubkeys, ValidatorConsumerPubKey{})
-			if err := m.ValidatorConsumerPubkeys[len(m.ValidatorConsumerPubkeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DowntimeJailDuration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsByConsumerAddr", wireType)
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1159,29 +3461,15 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorsByConsumerAddr = append(m.ValidatorsByConsumerAddr, ValidatorByConsumerAddr{})
-			if err := m.ValidatorsByConsumerAddr[len(m.ValidatorsByConsumerAddr)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 11:
+			m.Paused = bool(v != 0)
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAddrsToPrune", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDowntimeInfractionHeights", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1208,66 +3496,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerAddrsToPrune = append(m.ConsumerAddrsToPrune, ConsumerAddrsToPrune{})
-			if err := m.ConsumerAddrsToPrune[len(m.ConsumerAddrsToPrune)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LastDowntimeInfractionHeights = append(m.LastDowntimeInfractionHeights, ValidatorInfractionHeight{})
+			if err := m.LastDowntimeInfractionHeights[len(m.LastDowntimeInfractionHeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ConsumerState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopN", wireType)
 			}
-			var stringLen uint64
+			m.TopN = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1277,29 +3515,16 @@ func (m *ConsumerState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TopN |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1309,29 +3534,33 @@ func (m *ConsumerState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			if m.Metadata == nil {
+				m.Metadata = &ConsumerMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashConfirmationSeq", wireType)
 			}
-			var stringLen uint64
+			m.SlashConfirmationSeq = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1341,29 +3570,35 @@ func (m *ConsumerState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SlashConfirmationSeq |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSentSequence", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.LastSentSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSentSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ClientId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		case 30:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAckedSequence", wireType)
 			}
-			m.InitialHeight = 0
+			m.LastAckedSequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1373,14 +3608,14 @@ func (m *ConsumerState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InitialHeight |= uint64(b&0x7F) << shift
+				m.LastAckedSequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 31:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGenesis", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastConsumerActivity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1407,13 +3642,16 @@ func (m *ConsumerState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ConsumerGenesis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LastConsumerActivity == nil {
+				m.LastConsumerActivity = &ConsumerActivity{}
+			}
+			if err := m.LastConsumerActivity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 32:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingValsetChanges", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientExpiryWarningTimestamp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1440,16 +3678,18 @@ func (m *ConsumerState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingValsetChanges = append(m.PendingValsetChanges, types.ValidatorSetChangePacketData{})
-			if err := m.PendingValsetChanges[len(m.PendingValsetChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ClientExpiryWarningTimestamp == nil {
+				m.ClientExpiryWarningTimestamp = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ClientExpiryWarningTimestamp, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashDowntimeAck", wireType)
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorListsUpdated", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1459,27 +3699,35 @@ func (m *ConsumerState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
+			m.ValidatorListsUpdated = bool(v != 0)
+		case 34:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisHashExempt", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.SlashDowntimeAck = append(m.SlashDowntimeAck, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 8:
+			m.GenesisHashExempt = bool(v != 0)
+		case 35:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOpsIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashAcks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1506,8 +3754,10 @@ func (m *ConsumerState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnbondingOpsIndex = append(m.UnbondingOpsIndex, VscUnbondingOps{})
-			if err := m.UnbondingOpsIndex[len(m.UnbondingOpsIndex)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SlashAcks == nil {
+				m.SlashAcks = &SlashAcks{}
+			}
+			if err := m.SlashAcks.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1599,6 +3849,42 @@ func (m *ValsetUpdateIdToHeight) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -4,6 +4,8 @@ import (
 	"testing"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v4/modules/core/23-commitment/types"
 	ibctmtypes "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
@@ -75,7 +77,7 @@ func TestValidateGenesisState(t *testing.T) {
 				nil,
 				types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 					time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
-					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, 30*time.Minute, time.Hour, "0.1", 400),
+					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, 30*time.Minute, time.Hour, "0.1", 400, types.DefaultConsumerRedistributeFraction, types.DefaultMaxSlashRetries, types.DefaultClientExpirationGracePeriod, types.DefaultHistoricalValsetEntries, types.DefaultSoftOptOutThreshold, types.DefaultConsumerRewardsWindowPeriod, types.DefaultSlashFractionDoubleSign, types.DefaultSlashFractionDowntime, types.DefaultMaxUnbondingOpsPerChain, types.DefaultLogValsetUpdateDiffs, types.DefaultSlashAckBatchPeriod, types.DefaultConsumerLivenessWindow, types.DefaultAllowedConsumerClientTypes, types.DefaultClientExpiryWarningFraction),
 				nil,
 				nil,
 				nil,
@@ -94,7 +96,7 @@ func TestValidateGenesisState(t *testing.T) {
 				nil,
 				types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 					time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
-					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, 30*time.Minute, time.Hour, "0.1", 400),
+					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, 30*time.Minute, time.Hour, "0.1", 400, types.DefaultConsumerRedistributeFraction, types.DefaultMaxSlashRetries, types.DefaultClientExpirationGracePeriod, types.DefaultHistoricalValsetEntries, types.DefaultSoftOptOutThreshold, types.DefaultConsumerRewardsWindowPeriod, types.DefaultSlashFractionDoubleSign, types.DefaultSlashFractionDowntime, types.DefaultMaxUnbondingOpsPerChain, types.DefaultLogValsetUpdateDiffs, types.DefaultSlashAckBatchPeriod, types.DefaultConsumerLivenessWindow, types.DefaultAllowedConsumerClientTypes, types.DefaultClientExpiryWarningFraction),
 				nil,
 				nil,
 				nil,
@@ -113,7 +115,7 @@ func TestValidateGenesisState(t *testing.T) {
 				nil,
 				types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 					time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
-					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, 30*time.Minute, time.Hour, "0.1", 400),
+					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, 30*time.Minute, time.Hour, "0.1", 400, types.DefaultConsumerRedistributeFraction, types.DefaultMaxSlashRetries, types.DefaultClientExpirationGracePeriod, types.DefaultHistoricalValsetEntries, types.DefaultSoftOptOutThreshold, types.DefaultConsumerRewardsWindowPeriod, types.DefaultSlashFractionDoubleSign, types.DefaultSlashFractionDowntime, types.DefaultMaxUnbondingOpsPerChain, types.DefaultLogValsetUpdateDiffs, types.DefaultSlashAckBatchPeriod, types.DefaultConsumerLivenessWindow, types.DefaultAllowedConsumerClientTypes, types.DefaultClientExpiryWarningFraction),
 				nil,
 				nil,
 				nil,
@@ -132,7 +134,7 @@ func TestValidateGenesisState(t *testing.T) {
 				nil,
 				types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 					time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
-					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, 30*time.Minute, time.Hour, "0.1", 400),
+					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, 30*time.Minute, time.Hour, "0.1", 400, types.DefaultConsumerRedistributeFraction, types.DefaultMaxSlashRetries, types.DefaultClientExpirationGracePeriod, types.DefaultHistoricalValsetEntries, types.DefaultSoftOptOutThreshold, types.DefaultConsumerRewardsWindowPeriod, types.DefaultSlashFractionDoubleSign, types.DefaultSlashFractionDowntime, types.DefaultMaxUnbondingOpsPerChain, types.DefaultLogValsetUpdateDiffs, types.DefaultSlashAckBatchPeriod, types.DefaultConsumerLivenessWindow, types.DefaultAllowedConsumerClientTypes, types.DefaultClientExpiryWarningFraction),
 				nil,
 				nil,
 				nil,
@@ -157,7 +159,7 @@ func TestValidateGenesisState(t *testing.T) {
 					types.DefaultVscTimeoutPeriod,
 					types.DefaultSlashMeterReplenishPeriod,
 					types.DefaultSlashMeterReplenishFraction,
-					types.DefaultMaxThrottledPackets),
+					types.DefaultMaxThrottledPackets, types.DefaultConsumerRedistributeFraction, types.DefaultMaxSlashRetries, types.DefaultClientExpirationGracePeriod, types.DefaultHistoricalValsetEntries, types.DefaultSoftOptOutThreshold, types.DefaultConsumerRewardsWindowPeriod, types.DefaultSlashFractionDoubleSign, types.DefaultSlashFractionDowntime, types.DefaultMaxUnbondingOpsPerChain, types.DefaultLogValsetUpdateDiffs, types.DefaultSlashAckBatchPeriod, types.DefaultConsumerLivenessWindow, types.DefaultAllowedConsumerClientTypes, types.DefaultClientExpiryWarningFraction),
 				nil,
 				nil,
 				nil,
@@ -182,7 +184,7 @@ func TestValidateGenesisState(t *testing.T) {
 					types.DefaultVscTimeoutPeriod,
 					types.DefaultSlashMeterReplenishPeriod,
 					types.DefaultSlashMeterReplenishFraction,
-					types.DefaultMaxThrottledPackets),
+					types.DefaultMaxThrottledPackets, types.DefaultConsumerRedistributeFraction, types.DefaultMaxSlashRetries, types.DefaultClientExpirationGracePeriod, types.DefaultHistoricalValsetEntries, types.DefaultSoftOptOutThreshold, types.DefaultConsumerRewardsWindowPeriod, types.DefaultSlashFractionDoubleSign, types.DefaultSlashFractionDowntime, types.DefaultMaxUnbondingOpsPerChain, types.DefaultLogValsetUpdateDiffs, types.DefaultSlashAckBatchPeriod, types.DefaultConsumerLivenessWindow, types.DefaultAllowedConsumerClientTypes, types.DefaultClientExpiryWarningFraction),
 				nil,
 				nil,
 				nil,
@@ -207,7 +209,7 @@ func TestValidateGenesisState(t *testing.T) {
 					types.DefaultVscTimeoutPeriod,
 					types.DefaultSlashMeterReplenishPeriod,
 					types.DefaultSlashMeterReplenishFraction,
-					types.DefaultMaxThrottledPackets),
+					types.DefaultMaxThrottledPackets, types.DefaultConsumerRedistributeFraction, types.DefaultMaxSlashRetries, types.DefaultClientExpirationGracePeriod, types.DefaultHistoricalValsetEntries, types.DefaultSoftOptOutThreshold, types.DefaultConsumerRewardsWindowPeriod, types.DefaultSlashFractionDoubleSign, types.DefaultSlashFractionDowntime, types.DefaultMaxUnbondingOpsPerChain, types.DefaultLogValsetUpdateDiffs, types.DefaultSlashAckBatchPeriod, types.DefaultConsumerLivenessWindow, types.DefaultAllowedConsumerClientTypes, types.DefaultClientExpiryWarningFraction),
 				nil,
 				nil,
 				nil,
@@ -232,7 +234,7 @@ func TestValidateGenesisState(t *testing.T) {
 					types.DefaultVscTimeoutPeriod,
 					types.DefaultSlashMeterReplenishPeriod,
 					types.DefaultSlashMeterReplenishFraction,
-					types.DefaultMaxThrottledPackets),
+					types.DefaultMaxThrottledPackets, types.DefaultConsumerRedistributeFraction, types.DefaultMaxSlashRetries, types.DefaultClientExpirationGracePeriod, types.DefaultHistoricalValsetEntries, types.DefaultSoftOptOutThreshold, types.DefaultConsumerRewardsWindowPeriod, types.DefaultSlashFractionDoubleSign, types.DefaultSlashFractionDowntime, types.DefaultMaxUnbondingOpsPerChain, types.DefaultLogValsetUpdateDiffs, types.DefaultSlashAckBatchPeriod, types.DefaultConsumerLivenessWindow, types.DefaultAllowedConsumerClientTypes, types.DefaultClientExpiryWarningFraction),
 				nil,
 				nil,
 				nil,
@@ -257,7 +259,7 @@ func TestValidateGenesisState(t *testing.T) {
 					0, // 0 vsc timeout here
 					types.DefaultSlashMeterReplenishPeriod,
 					types.DefaultSlashMeterReplenishFraction,
-					types.DefaultMaxThrottledPackets),
+					types.DefaultMaxThrottledPackets, types.DefaultConsumerRedistributeFraction, types.DefaultMaxSlashRetries, types.DefaultClientExpirationGracePeriod, types.DefaultHistoricalValsetEntries, types.DefaultSoftOptOutThreshold, types.DefaultConsumerRewardsWindowPeriod, types.DefaultSlashFractionDoubleSign, types.DefaultSlashFractionDowntime, types.DefaultMaxUnbondingOpsPerChain, types.DefaultLogValsetUpdateDiffs, types.DefaultSlashAckBatchPeriod, types.DefaultConsumerLivenessWindow, types.DefaultAllowedConsumerClientTypes, types.DefaultClientExpiryWarningFraction),
 				nil,
 				nil,
 				nil,
@@ -282,7 +284,7 @@ func TestValidateGenesisState(t *testing.T) {
 					types.DefaultVscTimeoutPeriod,
 					0, // 0 slash meter replenish period here
 					types.DefaultSlashMeterReplenishFraction,
-					types.DefaultMaxThrottledPackets),
+					types.DefaultMaxThrottledPackets, types.DefaultConsumerRedistributeFraction, types.DefaultMaxSlashRetries, types.DefaultClientExpirationGracePeriod, types.DefaultHistoricalValsetEntries, types.DefaultSoftOptOutThreshold, types.DefaultConsumerRewardsWindowPeriod, types.DefaultSlashFractionDoubleSign, types.DefaultSlashFractionDowntime, types.DefaultMaxUnbondingOpsPerChain, types.DefaultLogValsetUpdateDiffs, types.DefaultSlashAckBatchPeriod, types.DefaultConsumerLivenessWindow, types.DefaultAllowedConsumerClientTypes, types.DefaultClientExpiryWarningFraction),
 				nil,
 				nil,
 				nil,
@@ -307,7 +309,7 @@ func TestValidateGenesisState(t *testing.T) {
 					types.DefaultVscTimeoutPeriod,
 					types.DefaultSlashMeterReplenishPeriod,
 					"1.15",
-					types.DefaultMaxThrottledPackets),
+					types.DefaultMaxThrottledPackets, types.DefaultConsumerRedistributeFraction, types.DefaultMaxSlashRetries, types.DefaultClientExpirationGracePeriod, types.DefaultHistoricalValsetEntries, types.DefaultSoftOptOutThreshold, types.DefaultConsumerRewardsWindowPeriod, types.DefaultSlashFractionDoubleSign, types.DefaultSlashFractionDowntime, types.DefaultMaxUnbondingOpsPerChain, types.DefaultLogValsetUpdateDiffs, types.DefaultSlashAckBatchPeriod, types.DefaultConsumerLivenessWindow, types.DefaultAllowedConsumerClientTypes, types.DefaultClientExpiryWarningFraction),
 				nil,
 				nil,
 				nil,
@@ -332,7 +334,7 @@ func TestValidateGenesisState(t *testing.T) {
 					types.DefaultVscTimeoutPeriod,
 					types.DefaultSlashMeterReplenishPeriod,
 					"1.15",
-					-1),
+					-1, types.DefaultConsumerRedistributeFraction, types.DefaultMaxSlashRetries, types.DefaultClientExpirationGracePeriod, types.DefaultHistoricalValsetEntries, types.DefaultSoftOptOutThreshold, types.DefaultConsumerRewardsWindowPeriod, types.DefaultSlashFractionDoubleSign, types.DefaultSlashFractionDowntime, types.DefaultMaxUnbondingOpsPerChain, types.DefaultLogValsetUpdateDiffs, types.DefaultSlashAckBatchPeriod, types.DefaultConsumerLivenessWindow, types.DefaultAllowedConsumerClientTypes, types.DefaultClientExpiryWarningFraction),
 				nil,
 				nil,
 				nil,
@@ -444,6 +446,127 @@ func TestValidateGenesisState(t *testing.T) {
 			),
 			false,
 		},
+		{
+			"invalid consumer state slash acks, more infraction types than addresses",
+			types.NewGenesisState(
+				types.DefaultValsetUpdateID,
+				nil,
+				[]types.ConsumerState{{ChainId: "chainid", ChannelId: "channel-0", ClientId: "client-id",
+					ConsumerGenesis: getInitialConsumerGenesis(t, "chainid"),
+					SlashAcks: &types.SlashAcks{
+						Infractions: []stakingtypes.InfractionType{stakingtypes.DoubleSign},
+					}}},
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultParams(),
+				nil,
+				nil,
+				nil,
+			),
+			false,
+		},
+		{
+			"invalid consumer state preferred reward denom",
+			types.NewGenesisState(
+				types.DefaultValsetUpdateID,
+				nil,
+				[]types.ConsumerState{{ChainId: "chainid", ChannelId: "channel-0", ClientId: "client-id",
+					ConsumerGenesis:      getInitialConsumerGenesis(t, "chainid"),
+					PreferredRewardDenom: "1"}},
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultParams(),
+				nil,
+				nil,
+				nil,
+			),
+			false,
+		},
+		{
+			"invalid consumer state consumer validator set, zero power",
+			types.NewGenesisState(
+				types.DefaultValsetUpdateID,
+				nil,
+				[]types.ConsumerState{{ChainId: "chainid", ChannelId: "channel-0", ClientId: "client-id",
+					ConsumerGenesis: getInitialConsumerGenesis(t, "chainid"),
+					ConsumerValSet:  []types.ConsumerValidator{{ProviderAddr: &types.ProviderConsAddress{}, Power: 0}}}},
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultParams(),
+				nil,
+				nil,
+				nil,
+			),
+			false,
+		},
+		{
+			"invalid consumer state validator denylist",
+			types.NewGenesisState(
+				types.DefaultValsetUpdateID,
+				nil,
+				[]types.ConsumerState{{ChainId: "chainid", ChannelId: "channel-0", ClientId: "client-id",
+					ConsumerGenesis:   getInitialConsumerGenesis(t, "chainid"),
+					ValidatorDenylist: []string{"invalidAddr"}}},
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultParams(),
+				nil,
+				nil,
+				nil,
+			),
+			false,
+		},
+		{
+			"invalid consumer state consumer parameters",
+			types.NewGenesisState(
+				types.DefaultValsetUpdateID,
+				nil,
+				[]types.ConsumerState{{ChainId: "chainid", ChannelId: "channel-0", ClientId: "client-id",
+					ConsumerGenesis: getInitialConsumerGenesis(t, "chainid"),
+					ConsumerParameters: &types.ConsumerParameters{
+						ConsumerRedistributeFraction: "0.5",
+						SoftOptOutThreshold:          "0.3",
+					}}},
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultParams(),
+				nil,
+				nil,
+				nil,
+			),
+			false,
+		},
+		{
+			"invalid consumer state rewards window",
+			types.NewGenesisState(
+				types.DefaultValsetUpdateID,
+				nil,
+				[]types.ConsumerState{{ChainId: "chainid", ChannelId: "channel-0", ClientId: "client-id",
+					ConsumerGenesis: getInitialConsumerGenesis(t, "chainid"),
+					RewardsWindow: &types.ConsumerRewardsWindow{
+						Received: sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)}},
+					}}},
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultParams(),
+				nil,
+				nil,
+				nil,
+			),
+			false,
+		},
 		{
 			"invalid consumer state pending VSC packets",
 			types.NewGenesisState(
@@ -648,6 +771,185 @@ func TestValidateGenesisState(t *testing.T) {
 	}
 }
 
+// TestValidateGenesisInvalidatedChannels tests the validation of the invalidated CCV channels in the genesis state
+func TestValidateGenesisInvalidatedChannels(t *testing.T) {
+	testCases := []struct {
+		name       string
+		channelIDs []string
+		expPass    bool
+	}{
+		{"no invalidated channels", nil, true},
+		{"valid invalidated channels", []string{"channel-1", "channel-2"}, true},
+		{"invalid channel ID", []string{"invalidChannel{}"}, false},
+		{"channel of a consumer chain invalidated", []string{"channel-0"}, false},
+	}
+
+	for _, tc := range testCases {
+		genState := types.NewGenesisState(
+			types.DefaultValsetUpdateID,
+			nil,
+			[]types.ConsumerState{{ChainId: "chainid", ChannelId: "channel-0", ClientId: "client-id", ConsumerGenesis: getInitialConsumerGenesis(t, "chainid")}},
+			nil,
+			nil,
+			nil,
+			nil,
+			types.DefaultParams(),
+			nil,
+			nil,
+			nil,
+		)
+		genState.InvalidatedChannelIds = tc.channelIDs
+
+		err := genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, "test case: %s must pass", tc.name)
+		} else {
+			require.Error(t, err, "test case: %s must fail", tc.name)
+		}
+	}
+}
+
+// TestValidateGenesisHeldUnbondingOps tests the validation of the unbonding operations
+// held for the consumer chains stopped after a packet timeout
+func TestValidateGenesisHeldUnbondingOps(t *testing.T) {
+	ubdOp := types.UnbondingOp{Id: 1, UnbondingConsumerChains: []string{"stopped"}}
+	index := []types.VscUnbondingOps{{VscId: 1, UnbondingOpIds: []uint64{1}}}
+	testCases := []struct {
+		name    string
+		held    []types.HeldUnbondingOps
+		expPass bool
+	}{
+		{"unbonding operation held for a stopped consumer chain", []types.HeldUnbondingOps{{ChainId: "stopped", UnbondingOpsIndex: index}}, true},
+		{"no held unbonding operations", nil, false},
+		{"held unbonding operations of another consumer chain", []types.HeldUnbondingOps{{ChainId: "other", UnbondingOpsIndex: index}}, false},
+		{"held unbonding operations with a blank chain id", []types.HeldUnbondingOps{{ChainId: " ", UnbondingOpsIndex: index}}, false},
+		{"held unbonding operations of a running consumer chain", []types.HeldUnbondingOps{
+			{ChainId: "stopped", UnbondingOpsIndex: index},
+			{ChainId: "chainid", UnbondingOpsIndex: index},
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		genState := types.NewGenesisState(
+			types.DefaultValsetUpdateID,
+			nil,
+			[]types.ConsumerState{{ChainId: "chainid", ChannelId: "channel-0", ClientId: "client-id", ConsumerGenesis: getInitialConsumerGenesis(t, "chainid")}},
+			[]types.UnbondingOp{ubdOp},
+			nil,
+			nil,
+			nil,
+			types.DefaultParams(),
+			nil,
+			nil,
+			nil,
+		)
+		genState.HeldUnbondingOps = tc.held
+
+		err := genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, "test case: %s must pass", tc.name)
+		} else {
+			require.Error(t, err, "test case: %s must fail", tc.name)
+		}
+	}
+}
+
+// TestValidateGenesisConsumerRewardDenoms tests the validation of the consumer reward denoms in the genesis state
+func TestValidateGenesisConsumerRewardDenoms(t *testing.T) {
+	testCases := []struct {
+		name    string
+		denoms  []string
+		expPass bool
+	}{
+		{"no consumer reward denoms", nil, true},
+		{"valid consumer reward denoms", []string{"stake", "ibc/3C3D7B3BE4ECC85A0E5B52A3AEC3B7DFC2AA9CA47C37821E57020D6807043BE9"}, true},
+		{"invalid consumer reward denom", []string{"!denom"}, false},
+	}
+
+	for _, tc := range testCases {
+		genState := types.DefaultGenesisState()
+		genState.ConsumerRewardDenoms = tc.denoms
+
+		err := genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, "test case: %s must pass", tc.name)
+		} else {
+			require.Error(t, err, "test case: %s must fail", tc.name)
+		}
+	}
+}
+
+func TestValidateGenesisValidatorJailRecords(t *testing.T) {
+	validAddr := types.NewProviderConsAddress(sdk.ConsAddress([]byte("validator_address_1")))
+	invalidAddr := types.NewProviderConsAddress(sdk.ConsAddress{})
+
+	testCases := []struct {
+		name    string
+		records []types.ValidatorJailRecord
+		expPass bool
+	}{
+		{"no jail records", nil, true},
+		{"valid jail record", []types.ValidatorJailRecord{{ProviderAddr: &validAddr, VscId: 3}}, true},
+		{"missing provider address", []types.ValidatorJailRecord{{VscId: 3}}, false},
+		{"invalid provider address", []types.ValidatorJailRecord{{ProviderAddr: &invalidAddr, VscId: 3}}, false},
+	}
+
+	for _, tc := range testCases {
+		genState := types.DefaultGenesisState()
+		genState.ValidatorJailRecords = tc.records
+
+		err := genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, "test case: %s must pass", tc.name)
+		} else {
+			require.Error(t, err, "test case: %s must fail", tc.name)
+		}
+	}
+}
+
+func TestValidateGenesisLastDowntimeInfractionHeights(t *testing.T) {
+	validAddr := types.NewProviderConsAddress(sdk.ConsAddress([]byte("validator_address_1")))
+	invalidAddr := types.NewProviderConsAddress(sdk.ConsAddress{})
+
+	testCases := []struct {
+		name    string
+		heights []types.ValidatorInfractionHeight
+		expPass bool
+	}{
+		{"no infraction heights", nil, true},
+		{"valid infraction height", []types.ValidatorInfractionHeight{{ProviderAddr: &validAddr, InfractionHeight: 3}}, true},
+		{"missing provider address", []types.ValidatorInfractionHeight{{InfractionHeight: 3}}, false},
+		{"invalid provider address", []types.ValidatorInfractionHeight{{ProviderAddr: &invalidAddr, InfractionHeight: 3}}, false},
+	}
+
+	for _, tc := range testCases {
+		genState := types.NewGenesisState(
+			types.DefaultValsetUpdateID,
+			nil,
+			[]types.ConsumerState{{
+				ChainId: "chainid", ChannelId: "channel-0", ClientId: "client-id",
+				ConsumerGenesis:               getInitialConsumerGenesis(t, "chainid"),
+				LastDowntimeInfractionHeights: tc.heights,
+			}},
+			nil,
+			nil,
+			nil,
+			nil,
+			types.DefaultParams(),
+			nil,
+			nil,
+			nil,
+		)
+
+		err := genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, "test case: %s must pass", tc.name)
+		} else {
+			require.Error(t, err, "test case: %s must fail", tc.name)
+		}
+	}
+}
+
 func getInitialConsumerGenesis(t *testing.T, chainID string) consumertypes.GenesisState {
 	// generate validator public key
 	pubKey, err := testutil.GenPubKey()
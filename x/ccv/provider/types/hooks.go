@@ -0,0 +1,45 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ProviderHooks event hooks for the lifecycle of the consumer chains
+type ProviderHooks interface {
+	// AfterConsumerChainAdded is called once the client to a consumer chain is created,
+	// i.e., once the consumer addition proposal of the consumer chain is executed
+	AfterConsumerChainAdded(ctx sdk.Context, chainID string)
+	// AfterChannelValidating is called once the CCV channel to a consumer chain is established
+	AfterChannelValidating(ctx sdk.Context, chainID, channelID string)
+	// AfterConsumerChainRemoved is called once a consumer chain is stopped
+	// and its state is removed from the provider
+	AfterConsumerChainRemoved(ctx sdk.Context, chainID string)
+}
+
+var _ ProviderHooks = MultiProviderHooks{}
+
+// MultiProviderHooks combines multiple provider hooks, all hook functions are run in array sequence
+type MultiProviderHooks []ProviderHooks
+
+// NewMultiProviderHooks returns the provider hooks that run all the given hooks in sequence
+func NewMultiProviderHooks(hooks ...ProviderHooks) MultiProviderHooks {
+	return hooks
+}
+
+func (h MultiProviderHooks) AfterConsumerChainAdded(ctx sdk.Context, chainID string) {
+	for i := range h {
+		h[i].AfterConsumerChainAdded(ctx, chainID)
+	}
+}
+
+func (h MultiProviderHooks) AfterChannelValidating(ctx sdk.Context, chainID, channelID string) {
+	for i := range h {
+		h[i].AfterChannelValidating(ctx, chainID, channelID)
+	}
+}
+
+func (h MultiProviderHooks) AfterConsumerChainRemoved(ctx sdk.Context, chainID string) {
+	for i := range h {
+		h[i].AfterConsumerChainRemoved(ctx, chainID)
+	}
+}
@@ -4,9 +4,14 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	ccvtypes "github.com/cosmos/interchain-security/x/ccv/types"
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+	tmjson "github.com/tendermint/tendermint/libs/json"
 )
 
 // NewProviderConsAddress creates a new ProviderConsAddress,
@@ -47,6 +52,27 @@ func (c *ConsumerConsAddress) String() string {
 	return c.ToSdkConsAddr().String()
 }
 
+// ParseConsumerKeyFromJson parses a consumer consensus public key from its JSON encoding, either
+// in the proto JSON format of the SDK, e.g., {"@type":"/cosmos.crypto.ed25519.PubKey","key":"..."},
+// as printed by `<appd> tendermint show-validator`, or in the JSON format of Tendermint, e.g.,
+// {"type":"tendermint/PubKeyEd25519","value":"..."}, as printed by `tendermint show-validator`.
+func ParseConsumerKeyFromJson(cdc codec.Codec, bz []byte) (cryptotypes.PubKey, error) {
+	var pubKey cryptotypes.PubKey
+	if err := cdc.UnmarshalInterfaceJSON(bz, &pubKey); err == nil {
+		return pubKey, nil
+	}
+
+	var tmPubKey tmcrypto.PubKey
+	if err := tmjson.Unmarshal(bz, &tmPubKey); err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidConsumerConsensusPubKey, "cannot parse consumer key %s: %s", bz, err)
+	}
+	pubKey, err := cryptocodec.FromTmPubKeyInterface(tmPubKey)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidConsumerConsensusPubKey, "unsupported consumer key %s: %s", bz, err)
+	}
+	return pubKey, nil
+}
+
 // KeyAssignmentValidateBasic validates all the genesis state for key assignment
 // This is a utility. Key Assignment does not define any new proto types, but
 // has a lot of nested data.
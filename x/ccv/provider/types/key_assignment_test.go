@@ -0,0 +1,57 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/stretchr/testify/require"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+
+	"github.com/cosmos/interchain-security/x/ccv/provider/types"
+)
+
+// TestParseConsumerKeyFromJson tests the parsing of ed25519 and secp256k1 consumer keys
+// encoded in the JSON formats of both the SDK and Tendermint
+func TestParseConsumerKeyFromJson(t *testing.T) {
+	ir := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(ir)
+	cdc := codec.NewProtoCodec(ir)
+
+	for _, pubKey := range []cryptotypes.PubKey{
+		ed25519.GenPrivKey().PubKey(),
+		secp256k1.GenPrivKey().PubKey(),
+	} {
+		// the proto JSON format of the SDK
+		bz, err := cdc.MarshalInterfaceJSON(pubKey)
+		require.NoError(t, err)
+		parsed, err := types.ParseConsumerKeyFromJson(cdc, bz)
+		require.NoError(t, err, pubKey.Type())
+		require.True(t, pubKey.Equals(parsed), pubKey.Type())
+
+		// the JSON format of Tendermint
+		tmPubKey, err := cryptocodec.ToTmPubKeyInterface(pubKey)
+		require.NoError(t, err)
+		bz, err = tmjson.Marshal(tmPubKey)
+		require.NoError(t, err)
+		parsed, err = types.ParseConsumerKeyFromJson(cdc, bz)
+		require.NoError(t, err, pubKey.Type())
+		require.True(t, pubKey.Equals(parsed), pubKey.Type())
+	}
+
+	for _, invalid := range []string{
+		"",
+		"not json",
+		`{"@type":"/cosmos.crypto.unknown.PubKey","key":"AAAA"}`,
+		`{"type":"tendermint/PubKeyUnknown","value":"AAAA"}`,
+		`{"type":"tendermint/PubKeyEd25519","value":"invalid"}`,
+		"null",
+	} {
+		_, err := types.ParseConsumerKeyFromJson(cdc, []byte(invalid))
+		require.ErrorIs(t, err, types.ErrInvalidConsumerConsensusPubKey, invalid)
+	}
+}
@@ -28,6 +28,24 @@ const (
 
 	// Default validator set update ID
 	DefaultValsetUpdateID = 1
+
+	// ConsumerRewardsPool is the name of the module account that holds
+	// the rewards received from consumer chains until they are distributed
+	ConsumerRewardsPool = "consumer_rewards_pool"
+
+	// MaxSlashAcksPerChain is the number of slash acks pending to be sent to a consumer chain above
+	// which every appended slash ack is reported. Since a slash ack is appended only once per validator
+	// and infraction type, the cap is only reached if a consumer chain reports many more validators
+	// than the active set.
+	MaxSlashAcksPerChain = 1000
+
+	// MaxSlashAcksPerBatch is the number of pending slash acks of a consumer chain at which
+	// the slash acks are sent, even if the SlashAckBatchPeriod did not yet elapse
+	MaxSlashAcksPerBatch = 100
+
+	// MaxValsetUpdateIdsCheckedPerBlock is the number of valset update IDs
+	// whose block heights are checked for pruning in a single block
+	MaxValsetUpdateIdsCheckedPerBlock = 100
 )
 
 // Iota generated keys/byte prefixes (as a byte), supports 256 possible values
@@ -127,6 +145,146 @@ const (
 	// SlashLogBytePrefix is the byte prefix that will store the mapping from provider address to boolean
 	// denoting whether the provider address has commited any double signign infractions
 	SlashLogBytePrefix
+
+	// SendSlashConfirmationsBytePrefix is the byte prefix that will store whether a consumer chain
+	// expects a confirmation packet after the provider handled one of its slash requests
+	SendSlashConfirmationsBytePrefix
+
+	// SlashConfirmationSeqBytePrefix is the byte prefix that will store the IBC sequence number
+	// of the last slash confirmation packet sent to a consumer chain
+	SlashConfirmationSeqBytePrefix
+
+	// ConsumerRewardsAllocationBytePrefix is the byte prefix that will store, for every consumer chain,
+	// the rewards received from that chain that are not yet distributed
+	ConsumerRewardsAllocationBytePrefix
+
+	// PreferredRewardDenomBytePrefix is the byte prefix that will store the denom
+	// under which the rewards received from a consumer chain are tracked
+	PreferredRewardDenomBytePrefix
+
+	// OptedInBytePrefix is the byte prefix that will store the validators
+	// that are registered to validate a consumer chain
+	OptedInBytePrefix
+
+	// SlashRetryBytePrefix is the byte prefix that will store the slash packets
+	// that could not be applied and are retried in the following blocks
+	SlashRetryBytePrefix
+
+	// FailedSlashBytePrefix is the byte prefix that will store the slash packets
+	// that could not be applied after the maximum number of retries
+	FailedSlashBytePrefix
+
+	// SlashDoubleSignsBytePrefix is the byte prefix that will store whether the provider
+	// applies the double-sign slash packets received from a consumer chain
+	SlashDoubleSignsBytePrefix
+
+	// ConsumerValSetBytePrefix is the byte prefix that will store the validators
+	// of the last validator set the provider sent to a consumer chain
+	ConsumerValSetBytePrefix
+
+	// ConsumerValSetUpdateIdBytePrefix is the byte prefix that will store the valset update ID
+	// of the last validator set the provider sent to a consumer chain
+	ConsumerValSetUpdateIdBytePrefix
+
+	// ClientInactiveTimestampBytePrefix is the byte prefix that will store the time at which
+	// the provider first observed that the client to a consumer chain is expired or frozen
+	ClientInactiveTimestampBytePrefix
+
+	// ValidatorAllowlistBytePrefix is the byte prefix that will store the validators
+	// allowed to validate a consumer chain
+	ValidatorAllowlistBytePrefix
+
+	// ValidatorDenylistBytePrefix is the byte prefix that will store the validators
+	// excluded from the validator set of a consumer chain
+	ValidatorDenylistBytePrefix
+
+	// ValidatorListsUpdatedBytePrefix is the byte prefix that will store whether the validator
+	// lists of a consumer chain were updated since the last validator set change packet was queued
+	ValidatorListsUpdatedBytePrefix
+
+	// ConsumerAddrChainsBytePrefix is the byte prefix that will store, for every consensus address
+	// assigned on a consumer chain, the consumer chains it is assigned on and the provider address
+	// of the validator that assigned it; this is the reverse index of ValidatorsByConsumerAddr
+	ConsumerAddrChainsBytePrefix
+
+	// InvalidatedChannelBytePrefix is the byte prefix that will store the IDs of the CCV channels
+	// that were invalidated, i.e., the channels of the consumer chains that were stopped
+	InvalidatedChannelBytePrefix
+
+	// SoftOptedOutBytePrefix is the byte prefix that will store the validators
+	// opted out of validating a consumer chain by the soft opt out
+	SoftOptedOutBytePrefix
+
+	// ConsumerParametersBytePrefix is the byte prefix that will store the parameters
+	// of a consumer chain set by a consumer parameters update proposal
+	ConsumerParametersBytePrefix
+
+	// ValidatorJailRecordBytePrefix is the byte prefix that will store the valset update ID
+	// at which a validator was jailed for a downtime infraction on a consumer chain
+	ValidatorJailRecordBytePrefix
+
+	// ConsumerRewardsWindowBytePrefix is the byte prefix that will store the rewards
+	// received from a consumer chain during the current and last rewards windows
+	ConsumerRewardsWindowBytePrefix
+
+	// StoreVersionByteKey is the byte key that stores the version of the provider store,
+	// i.e., the number of store migrations applied to it
+	StoreVersionByteKey
+
+	// LastSentSequenceBytePrefix is the byte prefix that will store the sequence number
+	// of the last packet sent to a consumer chain over its CCV channel
+	LastSentSequenceBytePrefix
+
+	// LastAckedSequenceBytePrefix is the byte prefix that will store the sequence number
+	// of the last packet sent to a consumer chain over its CCV channel that was acknowledged
+	LastAckedSequenceBytePrefix
+
+	// DowntimeJailDurationBytePrefix is the byte prefix that will store the duration for which
+	// the validators are jailed for a downtime infraction on a consumer chain
+	DowntimeJailDurationBytePrefix
+
+	// ConsumerRewardDenomsBytePrefix is the byte prefix that will store the denoms
+	// registered as consumer reward denoms
+	ConsumerRewardDenomsBytePrefix
+
+	// ConsumerPausedBytePrefix is the byte prefix that will store whether a consumer chain
+	// is paused, i.e., whether the validator set updates sent to it are withheld
+	ConsumerPausedBytePrefix
+
+	// LastDowntimeInfractionHeightBytePrefix is the byte prefix that will store the provider block height
+	// of the last downtime infraction of a validator on a consumer chain that was applied
+	LastDowntimeInfractionHeightBytePrefix
+
+	// LastConsumerActivityBytePrefix is the byte prefix that will store the provider block
+	// at which the provider last heard from a consumer chain
+	LastConsumerActivityBytePrefix
+
+	// TopNBytePrefix is the byte prefix that will store the number of provider validators
+	// with the largest powers that validate a consumer chain
+	TopNBytePrefix
+
+	// ConsumerMetadataBytePrefix is the byte prefix that will store the descriptive metadata of a consumer chain
+	ConsumerMetadataBytePrefix
+
+	// ClientExpiryWarningBytePrefix is the byte prefix that will store the time at which the provider
+	// first reported that the client to a consumer chain is about to expire
+	ClientExpiryWarningBytePrefix
+
+	// ValsetUpdateTimestampBytePrefix is the byte prefix that will store the block time
+	// at which each vscID was mapped to a block height
+	ValsetUpdateTimestampBytePrefix
+
+	// PendingUnbondingOpsCountBytePrefix is the byte prefix that will store the number of
+	// unbonding operations that are waiting for VSCMaturedPackets from a consumer chain
+	PendingUnbondingOpsCountBytePrefix
+
+	// GenesisHashExemptBytePrefix is the byte prefix that will store whether a consumer chain
+	// may open its CCV channel without sending the hash of its genesis on the handshake
+	GenesisHashExemptBytePrefix
+
+	// ValsetUpdatePruneCursorByteKey is the byte key that stores the valset update ID
+	// from which the block heights of the valset update IDs are checked for pruning
+	ValsetUpdatePruneCursorByteKey
 )
 
 // PortKey returns the key to the port ID in the store
@@ -144,6 +302,11 @@ func ValidatorSetUpdateIdKey() []byte {
 	return []byte{ValidatorSetUpdateIdByteKey}
 }
 
+// StoreVersionKey returns the key storing the version of the provider store
+func StoreVersionKey() []byte {
+	return []byte{StoreVersionByteKey}
+}
+
 // SlashMeterKey returns the key storing the slash meter
 func SlashMeterKey() []byte {
 	return []byte{SlashMeterByteKey}
@@ -444,6 +607,237 @@ func ParseChainIdAndConsAddrKey(prefix byte, bz []byte) (string, sdk.ConsAddress
 	return chainID, addr, nil
 }
 
+// SendSlashConfirmationsKey returns the key under which it is stored whether
+// the consumer chain with the given chain ID expects slash confirmation packets
+func SendSlashConfirmationsKey(chainID string) []byte {
+	return append([]byte{SendSlashConfirmationsBytePrefix}, []byte(chainID)...)
+}
+
+// SlashConfirmationSeqKey returns the key under which the sequence number of the last
+// slash confirmation packet sent to the consumer chain with the given chain ID is stored
+func SlashConfirmationSeqKey(chainID string) []byte {
+	return append([]byte{SlashConfirmationSeqBytePrefix}, []byte(chainID)...)
+}
+
+// ConsumerRewardsAllocationKey returns the key under which the rewards allocation
+// of the consumer chain with the given chain ID is stored
+func ConsumerRewardsAllocationKey(chainID string) []byte {
+	return append([]byte{ConsumerRewardsAllocationBytePrefix}, []byte(chainID)...)
+}
+
+// PreferredRewardDenomKey returns the key under which the preferred reward denom
+// of the consumer chain with the given chain ID is stored
+func PreferredRewardDenomKey(chainID string) []byte {
+	return append([]byte{PreferredRewardDenomBytePrefix}, []byte(chainID)...)
+}
+
+// OptedInKey returns the key under which it is stored that the validator
+// with the given operator address is registered to validate the consumer chain with the given chain ID
+func OptedInKey(chainID string, valAddr sdk.ValAddress) []byte {
+	return ccvutils.AppendMany(
+		// Append the chainID with length prefix
+		ChainIdWithLenKey(OptedInBytePrefix, chainID),
+		// Append the validator operator address
+		valAddr,
+	)
+}
+
+// ParseOptedInKey returns the chain ID and the validator operator address for an OptedInKey key
+func ParseOptedInKey(bz []byte) (string, sdk.ValAddress, error) {
+	chainID, addr, err := ParseChainIdAndConsAddrKey(OptedInBytePrefix, bz)
+	if err != nil {
+		return "", nil, err
+	}
+	return chainID, sdk.ValAddress(addr), nil
+}
+
+// SlashDoubleSignsKey returns the key under which it is stored whether the provider applies
+// the double-sign slash packets received from the consumer chain with the given chain ID
+func SlashDoubleSignsKey(chainID string) []byte {
+	return append([]byte{SlashDoubleSignsBytePrefix}, []byte(chainID)...)
+}
+
+// SlashRetryKey returns the key under which the slash packet received from the consumer chain
+// with the given chain ID for the validator with the given consumer address is stored for retry
+func SlashRetryKey(chainID string, addr ConsumerConsAddress) []byte {
+	return ChainIdAndConsAddrKey(SlashRetryBytePrefix, chainID, addr.ToSdkConsAddr())
+}
+
+// FailedSlashKey returns the key under which the slash packet received from the consumer chain
+// with the given chain ID for the validator with the given consumer address is archived as failed
+func FailedSlashKey(chainID string, addr ConsumerConsAddress) []byte {
+	return ChainIdAndConsAddrKey(FailedSlashBytePrefix, chainID, addr.ToSdkConsAddr())
+}
+
+// ConsumerValSetKey returns the key under which the validator with the given consumer address,
+// of the last validator set sent to the consumer chain with the given chain ID, is stored
+func ConsumerValSetKey(chainID string, addr ConsumerConsAddress) []byte {
+	return ChainIdAndConsAddrKey(ConsumerValSetBytePrefix, chainID, addr.ToSdkConsAddr())
+}
+
+// ConsumerValSetUpdateIdKey returns the key under which the valset update ID of the last
+// validator set sent to the consumer chain with the given chain ID is stored
+func ConsumerValSetUpdateIdKey(chainID string) []byte {
+	return append([]byte{ConsumerValSetUpdateIdBytePrefix}, []byte(chainID)...)
+}
+
+// ClientInactiveTimestampKey returns the key under which the time at which the provider first observed
+// that the client to the consumer chain with the given chain ID is expired or frozen is stored
+func ClientInactiveTimestampKey(chainID string) []byte {
+	return append([]byte{ClientInactiveTimestampBytePrefix}, []byte(chainID)...)
+}
+
+// ValidatorAllowlistKey returns the key under which it is stored that the validator with the given
+// provider address is allowed to validate the consumer chain with the given chain ID
+func ValidatorAllowlistKey(chainID string, addr ProviderConsAddress) []byte {
+	return ChainIdAndConsAddrKey(ValidatorAllowlistBytePrefix, chainID, addr.ToSdkConsAddr())
+}
+
+// ValidatorDenylistKey returns the key under which it is stored that the validator with the given
+// provider address is excluded from the validator set of the consumer chain with the given chain ID
+func ValidatorDenylistKey(chainID string, addr ProviderConsAddress) []byte {
+	return ChainIdAndConsAddrKey(ValidatorDenylistBytePrefix, chainID, addr.ToSdkConsAddr())
+}
+
+// ValidatorListsUpdatedKey returns the key under which it is stored that the validator lists
+// of the consumer chain with the given chain ID were updated
+func ValidatorListsUpdatedKey(chainID string) []byte {
+	return append([]byte{ValidatorListsUpdatedBytePrefix}, []byte(chainID)...)
+}
+
+// ConsumerAddrChainsPrefix returns the prefix of the keys under which the consumer chains
+// the given consumer address is assigned on are stored, with the following format:
+// ConsumerAddrChainsBytePrefix | len(consumerAddr) | consumerAddr
+func ConsumerAddrChainsPrefix(addr ConsumerConsAddress) []byte {
+	return ccvutils.AppendMany(
+		[]byte{ConsumerAddrChainsBytePrefix},
+		sdk.Uint64ToBigEndian(uint64(len(addr.ToSdkConsAddr()))),
+		addr.ToSdkConsAddr(),
+	)
+}
+
+// ConsumerAddrChainKey returns the key under which the provider address of the validator
+// that assigned the given consumer address on the consumer chain with the given chain ID is stored,
+// with the following format: ConsumerAddrChainsBytePrefix | len(consumerAddr) | consumerAddr | chainID
+func ConsumerAddrChainKey(addr ConsumerConsAddress, chainID string) []byte {
+	return append(ConsumerAddrChainsPrefix(addr), []byte(chainID)...)
+}
+
+// SoftOptedOutKey returns the key under which it is stored that the validator with the given
+// provider address is opted out of validating the consumer chain with the given chain ID
+func SoftOptedOutKey(chainID string, addr ProviderConsAddress) []byte {
+	return ChainIdAndConsAddrKey(SoftOptedOutBytePrefix, chainID, addr.ToSdkConsAddr())
+}
+
+// InvalidatedChannelKey returns the key under which it is stored that the CCV channel
+// with the given channel ID was invalidated
+func InvalidatedChannelKey(channelID string) []byte {
+	return append([]byte{InvalidatedChannelBytePrefix}, []byte(channelID)...)
+}
+
+// ConsumerParametersKey returns the key under which the parameters of the consumer chain
+// with the given chain ID, set by a consumer parameters update proposal, are stored
+func ConsumerParametersKey(chainID string) []byte {
+	return append([]byte{ConsumerParametersBytePrefix}, []byte(chainID)...)
+}
+
+// ValidatorJailRecordKey returns the key under which the valset update ID at which
+// the validator with the given provider address was jailed is stored
+func ValidatorJailRecordKey(providerAddr ProviderConsAddress) []byte {
+	return append([]byte{ValidatorJailRecordBytePrefix}, providerAddr.ToSdkConsAddr().Bytes()...)
+}
+
+// ConsumerRewardsWindowKey returns the key under which the rewards received from the consumer chain
+// with the given chain ID during the current and last rewards windows are stored
+func ConsumerRewardsWindowKey(chainID string) []byte {
+	return append([]byte{ConsumerRewardsWindowBytePrefix}, []byte(chainID)...)
+}
+
+// LastSentSequenceKey returns the key under which the sequence number of the last packet
+// sent to the consumer chain with the given chain ID is stored
+func LastSentSequenceKey(chainID string) []byte {
+	return append([]byte{LastSentSequenceBytePrefix}, []byte(chainID)...)
+}
+
+// LastAckedSequenceKey returns the key under which the sequence number of the last acknowledged
+// packet sent to the consumer chain with the given chain ID is stored
+func LastAckedSequenceKey(chainID string) []byte {
+	return append([]byte{LastAckedSequenceBytePrefix}, []byte(chainID)...)
+}
+
+// DowntimeJailDurationKey returns the key under which the downtime jail duration
+// of the consumer chain with the given chain ID is stored
+func DowntimeJailDurationKey(chainID string) []byte {
+	return append([]byte{DowntimeJailDurationBytePrefix}, []byte(chainID)...)
+}
+
+// ConsumerRewardDenomKey returns the key under which it is stored that
+// the given denom is registered as a consumer reward denom
+func ConsumerRewardDenomKey(denom string) []byte {
+	return append([]byte{ConsumerRewardDenomsBytePrefix}, []byte(denom)...)
+}
+
+// ConsumerPausedKey returns the key under which it is stored whether
+// the consumer chain with the given chain ID is paused
+func ConsumerPausedKey(chainID string) []byte {
+	return append([]byte{ConsumerPausedBytePrefix}, []byte(chainID)...)
+}
+
+// LastDowntimeInfractionHeightKey returns the key under which the provider block height of the last applied
+// downtime infraction of the validator with the given provider address on the given consumer chain is stored
+func LastDowntimeInfractionHeightKey(chainID string, addr ProviderConsAddress) []byte {
+	return ChainIdAndConsAddrKey(LastDowntimeInfractionHeightBytePrefix, chainID, addr.ToSdkConsAddr())
+}
+
+// LastConsumerActivityKey returns the key under which the provider block at which the provider
+// last heard from the consumer chain with the given chain ID is stored
+func LastConsumerActivityKey(chainID string) []byte {
+	return append([]byte{LastConsumerActivityBytePrefix}, []byte(chainID)...)
+}
+
+// TopNKey returns the key under which the number of provider validators with the largest powers
+// that validate the consumer chain with the given chain ID is stored
+func TopNKey(chainID string) []byte {
+	return append([]byte{TopNBytePrefix}, []byte(chainID)...)
+}
+
+// ConsumerMetadataKey returns the key under which the metadata of the consumer chain with the given chain ID is stored
+func ConsumerMetadataKey(chainID string) []byte {
+	return append([]byte{ConsumerMetadataBytePrefix}, []byte(chainID)...)
+}
+
+// ClientExpiryWarningKey returns the key under which the time at which the provider first reported
+// that the client to the consumer chain with the given chain ID is about to expire is stored
+func ClientExpiryWarningKey(chainID string) []byte {
+	return append([]byte{ClientExpiryWarningBytePrefix}, []byte(chainID)...)
+}
+
+// ValsetUpdateTimestampKey returns the key under which the block time
+// at which the given valset update ID was mapped to a block height is stored
+func ValsetUpdateTimestampKey(valsetUpdateId uint64) []byte {
+	vuidBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(vuidBytes, valsetUpdateId)
+	return append([]byte{ValsetUpdateTimestampBytePrefix}, vuidBytes...)
+}
+
+// PendingUnbondingOpsCountKey returns the key under which the number of unbonding operations
+// that are waiting for VSCMaturedPackets from the consumer chain with the given chain ID is stored
+func PendingUnbondingOpsCountKey(chainID string) []byte {
+	return append([]byte{PendingUnbondingOpsCountBytePrefix}, []byte(chainID)...)
+}
+
+// ValsetUpdatePruneCursorKey returns the key storing the valset update ID
+// from which the block heights of the valset update IDs are checked for pruning
+func ValsetUpdatePruneCursorKey() []byte {
+	return []byte{ValsetUpdatePruneCursorByteKey}
+}
+
+// GenesisHashExemptKey returns the key under which it is stored that the consumer chain
+// with the given chain ID may open its CCV channel without sending the hash of its genesis
+func GenesisHashExemptKey(chainID string) []byte {
+	return append([]byte{GenesisHashExemptBytePrefix}, []byte(chainID)...)
+}
+
 // SlashLogKey returns the key to a validator's slash log
 func SlashLogKey(providerAddr ProviderConsAddress) []byte {
 	return append([]byte{SlashAcksBytePrefix}, providerAddr.ToSdkConsAddr().Bytes()...)
@@ -37,7 +37,7 @@ func TestNoDuplicates(t *testing.T) {
 // any of which should be a single, unique byte.
 func getSingleByteKeys() [][]byte {
 
-	keys := make([][]byte, 32)
+	keys := make([][]byte, 61)
 	i := 0
 
 	keys[i], i = providertypes.PortKey(), i+1
@@ -66,6 +66,41 @@ func getSingleByteKeys() [][]byte {
 	keys[i], i = []byte{providertypes.ThrottledPacketDataSizeBytePrefix}, i+1
 	keys[i], i = []byte{providertypes.ThrottledPacketDataBytePrefix}, i+1
 	keys[i], i = []byte{providertypes.GlobalSlashEntryBytePrefix}, i+1
+	keys[i], i = []byte{providertypes.SendSlashConfirmationsBytePrefix}, i+1
+	keys[i], i = []byte{providertypes.SlashConfirmationSeqBytePrefix}, i+1
+	keys[i], i = []byte{providertypes.ConsumerRewardsAllocationBytePrefix}, i+1
+	keys[i], i = []byte{providertypes.PreferredRewardDenomBytePrefix}, i+1
+	keys[i], i = []byte{providertypes.OptedInBytePrefix}, i+1
+	keys[i], i = []byte{providertypes.SlashRetryBytePrefix}, i+1
+	keys[i], i = []byte{providertypes.FailedSlashBytePrefix}, i+1
+	keys[i], i = []byte{providertypes.SlashDoubleSignsBytePrefix}, i+1
+	keys[i], i = []byte{providertypes.ConsumerValSetBytePrefix}, i+1
+	keys[i], i = []byte{providertypes.ConsumerValSetUpdateIdBytePrefix}, i+1
+	keys[i], i = []byte{providertypes.ClientInactiveTimestampBytePrefix}, i+1
+	keys[i], i = []byte{providertypes.ValidatorAllowlistBytePrefix}, i+1
+	keys[i], i = []byte{providertypes.ValidatorDenylistBytePrefix}, i+1
+	keys[i], i = []byte{providertypes.ValidatorListsUpdatedBytePrefix}, i+1
+	keys[i], i = []byte{providertypes.ConsumerAddrChainsBytePrefix}, i+1
+	keys[i], i = []byte{providertypes.InvalidatedChannelBytePrefix}, i+1
+	keys[i], i = []byte{providertypes.SoftOptedOutBytePrefix}, i+1
+	keys[i], i = []byte{providertypes.ConsumerParametersBytePrefix}, i+1
+	keys[i], i = []byte{providertypes.ValidatorJailRecordBytePrefix}, i+1
+	keys[i], i = []byte{providertypes.ConsumerRewardsWindowBytePrefix}, i+1
+	keys[i], i = []byte{providertypes.StoreVersionByteKey}, i+1
+	keys[i], i = []byte{providertypes.LastSentSequenceBytePrefix}, i+1
+	keys[i], i = []byte{providertypes.LastAckedSequenceBytePrefix}, i+1
+	keys[i], i = []byte{providertypes.DowntimeJailDurationBytePrefix}, i+1
+	keys[i], i = []byte{providertypes.ConsumerRewardDenomsBytePrefix}, i+1
+	keys[i], i = []byte{providertypes.ConsumerPausedBytePrefix}, i+1
+	keys[i], i = []byte{providertypes.LastDowntimeInfractionHeightBytePrefix}, i+1
+	keys[i], i = []byte{providertypes.LastConsumerActivityBytePrefix}, i+1
+	keys[i], i = []byte{providertypes.TopNBytePrefix}, i+1
+	keys[i], i = []byte{providertypes.ConsumerMetadataBytePrefix}, i+1
+	keys[i], i = []byte{providertypes.ClientExpiryWarningBytePrefix}, i+1
+	keys[i], i = []byte{providertypes.ValsetUpdateTimestampBytePrefix}, i+1
+	keys[i], i = []byte{providertypes.PendingUnbondingOpsCountBytePrefix}, i+1
+	keys[i], i = []byte{providertypes.GenesisHashExemptBytePrefix}, i+1
+	keys[i], i = providertypes.ValsetUpdatePruneCursorKey(), i+1
 
 	return keys[:i]
 }
@@ -0,0 +1,64 @@
+package types
+
+// Telemetry keys and labels for the provider module
+var (
+	// MetricKeyPendingUnbondingOps is the gauge key for the number of unbonding operations
+	// that are waiting for a VSCMaturedPacket from a given consumer chain
+	MetricKeyPendingUnbondingOps = []string{"ccv_parent_pending_ubde"}
+
+	// MetricKeyPendingSlashAcks is the gauge key for the number of slash acks
+	// that are waiting to be sent to a given consumer chain
+	MetricKeyPendingSlashAcks = []string{"ccv_parent_pending_slash_acks"}
+
+	// MetricKeyConsumerChains is the gauge key for the number of registered consumer chains
+	MetricKeyConsumerChains = []string{"ccv_parent_consumer_chains"}
+
+	// MetricKeySlashAcks is the counter key for the number of slash acks
+	// queued to be sent to a given consumer chain, i.e., of slash packets handled
+	MetricKeySlashAcks = []string{"ccv_parent_slash_acks"}
+
+	// MetricKeyVSCPacketsSent is the counter key for the number of VSC packets sent to a given consumer chain
+	MetricKeyVSCPacketsSent = []string{"ccv_parent_vsc_packets_sent"}
+
+	// MetricKeyVSCPacketSendFailures is the counter key for the number of times a VSC packet
+	// could not be sent to a given consumer chain, and remained queued to be sent in a later block
+	MetricKeyVSCPacketSendFailures = []string{"ccv_parent_vsc_packet_send_failures"}
+
+	// MetricKeyPacketSequenceGap is the gauge key for the number of packets sent to a given
+	// consumer chain that are not acknowledged yet
+	MetricKeyPacketSequenceGap = []string{"ccv_parent_packet_sequence_gap"}
+
+	// MetricKeyPacketSequenceGapExceeded is the counter key for the number of times the number of
+	// packets sent to a given consumer chain that are not acknowledged exceeded PacketSequenceGapThreshold
+	MetricKeyPacketSequenceGapExceeded = []string{"ccv_parent_packet_sequence_gap_exceeded"}
+
+	// MetricKeyUnbondingOpsCapExceeded is the counter key for the number of unbonding operations
+	// that do not wait for a given consumer chain, since the chain reached MaxUnbondingOpsPerChain
+	MetricKeyUnbondingOpsCapExceeded = []string{"ccv_parent_unbonding_ops_cap_exceeded"}
+
+	// MetricKeySlashAcksCapExceeded is the counter key for the number of slash acks
+	// appended while a given consumer chain had MaxSlashAcksPerChain pending slash acks
+	MetricKeySlashAcksCapExceeded = []string{"ccv_parent_slash_acks_cap_exceeded"}
+
+	// MetricKeyConsumerInactive is the counter key for the number of blocks at the end of which
+	// a given consumer chain was not heard from within the ConsumerLivenessWindow param
+	MetricKeyConsumerInactive = []string{"ccv_parent_consumer_inactive"}
+
+	// MetricKeyClientTimeToExpiry is the gauge key for the number of seconds left
+	// before the client to a given consumer chain expires
+	MetricKeyClientTimeToExpiry = []string{"ccv_parent_client_time_to_expiry"}
+
+	// MetricKeyClientExpiring is the counter key for the number of blocks at the end of which the time
+	// left before the client to a given consumer chain expires is below the ClientExpiryWarningFraction
+	// of its trusting period
+	MetricKeyClientExpiring = []string{"ccv_parent_client_expiring"}
+)
+
+const (
+	// MetricLabelChainID is the label name used to identify the consumer chain of a metric
+	MetricLabelChainID = "chain_id"
+
+	// PacketSequenceGapThreshold is the number of packets sent to a consumer chain that are not
+	// acknowledged above which the relaying of the CCV channel is considered to be lagging
+	PacketSequenceGapThreshold = 100
+)
@@ -6,16 +6,20 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ibctmtypes "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
 )
 
 // provider message types
 const (
-	TypeMsgAssignConsumerKey = "assign_consumer_key"
+	TypeMsgAssignConsumerKey          = "assign_consumer_key"
+	TypeMsgSubmitConsumerMisbehaviour = "submit_consumer_misbehaviour"
 )
 
 var (
 	_ sdk.Msg                            = &MsgAssignConsumerKey{}
 	_ codectypes.UnpackInterfacesMessage = (*MsgAssignConsumerKey)(nil)
+	_ sdk.Msg                            = &MsgSubmitConsumerMisbehaviour{}
 )
 
 // NewMsgAssignConsumerKey creates a new MsgAssignConsumerKey instance.
@@ -91,3 +95,50 @@ func (msg MsgAssignConsumerKey) UnpackInterfaces(unpacker codectypes.AnyUnpacker
 	var pubKey cryptotypes.PubKey
 	return unpacker.UnpackAny(msg.ConsumerKey, &pubKey)
 }
+
+// NewMsgSubmitConsumerMisbehaviour creates a new MsgSubmitConsumerMisbehaviour instance.
+func NewMsgSubmitConsumerMisbehaviour(submitter sdk.AccAddress, misbehaviour *ibctmtypes.Misbehaviour) *MsgSubmitConsumerMisbehaviour {
+	return &MsgSubmitConsumerMisbehaviour{
+		Submitter:    submitter.String(),
+		Misbehaviour: misbehaviour,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgSubmitConsumerMisbehaviour) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgSubmitConsumerMisbehaviour) Type() string {
+	return TypeMsgSubmitConsumerMisbehaviour
+}
+
+// GetSigners implements the sdk.Msg interface. It returns the address(es) that
+// must sign over msg.GetSignBytes().
+func (msg MsgSubmitConsumerMisbehaviour) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Submitter)
+	if err != nil {
+		// same behavior as in cosmos-sdk
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgSubmitConsumerMisbehaviour) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgSubmitConsumerMisbehaviour) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Submitter); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid submitter address: %s", err)
+	}
+	if msg.Misbehaviour == nil {
+		return sdkerrors.Wrap(ErrInvalidConsumerMisbehaviour, "misbehaviour cannot be empty")
+	}
+	if err := msg.Misbehaviour.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidConsumerMisbehaviour, err.Error())
+	}
+	return nil
+}
@@ -4,12 +4,16 @@ import (
 	"fmt"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v4/modules/core/23-commitment/types"
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
 	ibctmtypes "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
 	consumertypes "github.com/cosmos/interchain-security/x/ccv/consumer/types"
 	ccvtypes "github.com/cosmos/interchain-security/x/ccv/types"
+	"github.com/tendermint/tendermint/light"
 )
 
 const (
@@ -38,17 +42,95 @@ const (
 	// DefaultMaxThrottledPackets defines the default amount of throttled slash or vsc matured packets
 	// that can be queued for a single consumer before the provider chain halts.
 	DefaultMaxThrottledPackets = 100000
+
+	// DefaultConsumerRedistributeFraction defines the default fraction of the rewards allocation
+	// of a consumer chain that is distributed to the fee collector every block
+	DefaultConsumerRedistributeFraction = "1.0"
+
+	// DefaultMaxSlashRetries defines the default number of times the provider retries
+	// to apply a slash packet whose validator is not found
+	DefaultMaxSlashRetries = 3
+
+	// DefaultClientExpirationGracePeriod defines the default period during which the provider
+	// waits for the expired or frozen client of a consumer chain to be recovered
+	DefaultClientExpirationGracePeriod = 7 * 24 * time.Hour
+
+	// DefaultHistoricalValsetEntries defines the default number of most recent
+	// valset update IDs whose block heights are kept by the provider
+	DefaultHistoricalValsetEntries = 10000
+
+	// DefaultSoftOptOutThreshold defines the default fraction of the total voting power, held by the
+	// validators with the smallest powers, whose validators are opted out of validating the consumer chains.
+	// The soft opt out is disabled by default.
+	DefaultSoftOptOutThreshold = "0"
+
+	// MaxSoftOptOutThreshold defines the largest fraction of the total voting power
+	// whose validators can be opted out of validating the consumer chains
+	MaxSoftOptOutThreshold = "0.2"
+
+	// DefaultConsumerRewardsWindowPeriod defines the default period over which the rewards
+	// received from a consumer chain are compared to its expected rewards
+	DefaultConsumerRewardsWindowPeriod = 7 * 24 * time.Hour
+
+	// DefaultSlashFractionDoubleSign defines the default fraction of the stake of a validator
+	// that is slashed for a double-sign infraction reported by a consumer chain,
+	// which matches the default of the slashing module
+	DefaultSlashFractionDoubleSign = "0.05"
+
+	// DefaultSlashFractionDowntime defines the default fraction of the stake of a validator
+	// that is slashed for a downtime infraction reported by a consumer chain.
+	// Validators are only jailed for downtime on consumer chains by default.
+	DefaultSlashFractionDowntime = "0"
+
+	// DefaultMaxUnbondingOpsPerChain defines the default maximum number of unbonding operations
+	// that can wait for VSCMaturedPackets from a single consumer chain.
+	// The cap is disabled by default.
+	DefaultMaxUnbondingOpsPerChain = 0
+
+	// DefaultLogValsetUpdateDiffs defines whether the validator power changes sent
+	// to the consumer chains are logged by default
+	DefaultLogValsetUpdateDiffs = false
+
+	// DefaultSlashAckBatchPeriod defines the default period during which the slash acks
+	// of a consumer chain are batched. The slash acks are not batched by default.
+	DefaultSlashAckBatchPeriod = time.Duration(0)
+
+	// DefaultConsumerLivenessWindow defines the default period after which a consumer chain
+	// that was not heard from is reported as inactive. The reporting is disabled by default.
+	DefaultConsumerLivenessWindow = time.Duration(0)
+
+	// DefaultClientExpiryWarningFraction defines the default fraction of the trusting period
+	// of a consumer client below which its time to expiry is reported. The reporting is disabled by default.
+	DefaultClientExpiryWarningFraction = "0"
 )
 
+// DefaultAllowedConsumerClientTypes defines the default types of the light clients
+// that the CCV channels can be built on, i.e., Tendermint light clients only
+var DefaultAllowedConsumerClientTypes = []string{ibcexported.Tendermint}
+
 // Reflection based keys for params subspace
 var (
-	KeyTemplateClient              = []byte("TemplateClient")
-	KeyTrustingPeriodFraction      = []byte("TrustingPeriodFraction")
-	KeyInitTimeoutPeriod           = []byte("InitTimeoutPeriod")
-	KeyVscTimeoutPeriod            = []byte("VscTimeoutPeriod")
-	KeySlashMeterReplenishPeriod   = []byte("SlashMeterReplenishPeriod")
-	KeySlashMeterReplenishFraction = []byte("SlashMeterReplenishFraction")
-	KeyMaxThrottledPackets         = []byte("MaxThrottledPackets")
+	KeyTemplateClient               = []byte("TemplateClient")
+	KeyTrustingPeriodFraction       = []byte("TrustingPeriodFraction")
+	KeyInitTimeoutPeriod            = []byte("InitTimeoutPeriod")
+	KeyVscTimeoutPeriod             = []byte("VscTimeoutPeriod")
+	KeySlashMeterReplenishPeriod    = []byte("SlashMeterReplenishPeriod")
+	KeySlashMeterReplenishFraction  = []byte("SlashMeterReplenishFraction")
+	KeyMaxThrottledPackets          = []byte("MaxThrottledPackets")
+	KeyConsumerRedistributeFraction = []byte("ConsumerRedistributeFraction")
+	KeyMaxSlashRetries              = []byte("MaxSlashRetries")
+	KeyClientExpirationGracePeriod  = []byte("ClientExpirationGracePeriod")
+	KeyHistoricalValsetEntries      = []byte("HistoricalValsetEntries")
+	KeySoftOptOutThreshold          = []byte("SoftOptOutThreshold")
+	KeyConsumerRewardsWindowPeriod  = []byte("ConsumerRewardsWindowPeriod")
+	KeySlashFractionDoubleSign      = []byte("SlashFractionDoubleSign")
+	KeySlashFractionDowntime        = []byte("SlashFractionDowntime")
+	KeyMaxUnbondingOpsPerChain      = []byte("MaxUnbondingOpsPerChain")
+	KeyLogValsetUpdateDiffs         = []byte("LogValsetUpdateDiffs")
+	KeySlashAckBatchPeriod          = []byte("SlashAckBatchPeriod")
+	KeyConsumerLivenessWindow       = []byte("ConsumerLivenessWindow")
+	KeyAllowedConsumerClientTypes   = []byte("AllowedConsumerClientTypes")
+	KeyClientExpiryWarningFraction  = []byte("ClientExpiryWarningFraction")
 )
 
 // ParamKeyTable returns a key table with the necessary registered provider params
@@ -66,16 +148,44 @@ func NewParams(
 	slashMeterReplenishPeriod time.Duration,
 	slashMeterReplenishFraction string,
 	maxThrottledPackets int64,
+	consumerRedistributeFraction string,
+	maxSlashRetries int64,
+	clientExpirationGracePeriod time.Duration,
+	historicalValsetEntries int64,
+	softOptOutThreshold string,
+	consumerRewardsWindowPeriod time.Duration,
+	slashFractionDoubleSign string,
+	slashFractionDowntime string,
+	maxUnbondingOpsPerChain int64,
+	logValsetUpdateDiffs bool,
+	slashAckBatchPeriod time.Duration,
+	consumerLivenessWindow time.Duration,
+	allowedConsumerClientTypes []string,
+	clientExpiryWarningFraction string,
 ) Params {
 	return Params{
-		TemplateClient:              cs,
-		TrustingPeriodFraction:      trustingPeriodFraction,
-		CcvTimeoutPeriod:            ccvTimeoutPeriod,
-		InitTimeoutPeriod:           initTimeoutPeriod,
-		VscTimeoutPeriod:            vscTimeoutPeriod,
-		SlashMeterReplenishPeriod:   slashMeterReplenishPeriod,
-		SlashMeterReplenishFraction: slashMeterReplenishFraction,
-		MaxThrottledPackets:         maxThrottledPackets,
+		TemplateClient:               cs,
+		TrustingPeriodFraction:       trustingPeriodFraction,
+		CcvTimeoutPeriod:             ccvTimeoutPeriod,
+		InitTimeoutPeriod:            initTimeoutPeriod,
+		VscTimeoutPeriod:             vscTimeoutPeriod,
+		SlashMeterReplenishPeriod:    slashMeterReplenishPeriod,
+		SlashMeterReplenishFraction:  slashMeterReplenishFraction,
+		MaxThrottledPackets:          maxThrottledPackets,
+		ConsumerRedistributeFraction: consumerRedistributeFraction,
+		MaxSlashRetries:              maxSlashRetries,
+		ClientExpirationGracePeriod:  clientExpirationGracePeriod,
+		HistoricalValsetEntries:      historicalValsetEntries,
+		SoftOptOutThreshold:          softOptOutThreshold,
+		ConsumerRewardsWindowPeriod:  consumerRewardsWindowPeriod,
+		SlashFractionDoubleSign:      slashFractionDoubleSign,
+		SlashFractionDowntime:        slashFractionDowntime,
+		MaxUnbondingOpsPerChain:      maxUnbondingOpsPerChain,
+		LogValsetUpdateDiffs:         logValsetUpdateDiffs,
+		SlashAckBatchPeriod:          slashAckBatchPeriod,
+		ConsumerLivenessWindow:       consumerLivenessWindow,
+		AllowedConsumerClientTypes:   allowedConsumerClientTypes,
+		ClientExpiryWarningFraction:  clientExpiryWarningFraction,
 	}
 }
 
@@ -103,6 +213,20 @@ func DefaultParams() Params {
 		DefaultSlashMeterReplenishPeriod,
 		DefaultSlashMeterReplenishFraction,
 		DefaultMaxThrottledPackets,
+		DefaultConsumerRedistributeFraction,
+		DefaultMaxSlashRetries,
+		DefaultClientExpirationGracePeriod,
+		DefaultHistoricalValsetEntries,
+		DefaultSoftOptOutThreshold,
+		DefaultConsumerRewardsWindowPeriod,
+		DefaultSlashFractionDoubleSign,
+		DefaultSlashFractionDowntime,
+		DefaultMaxUnbondingOpsPerChain,
+		DefaultLogValsetUpdateDiffs,
+		DefaultSlashAckBatchPeriod,
+		DefaultConsumerLivenessWindow,
+		DefaultAllowedConsumerClientTypes,
+		DefaultClientExpiryWarningFraction,
 	)
 }
 
@@ -135,6 +259,45 @@ func (p Params) Validate() error {
 	if err := ccvtypes.ValidatePositiveInt64(p.MaxThrottledPackets); err != nil {
 		return fmt.Errorf("max throttled packets is invalid: %s", err)
 	}
+	if err := ccvtypes.ValidateStringFraction(p.ConsumerRedistributeFraction); err != nil {
+		return fmt.Errorf("consumer redistribute fraction is invalid: %s", err)
+	}
+	if err := ccvtypes.ValidatePositiveInt64(p.MaxSlashRetries); err != nil {
+		return fmt.Errorf("max slash retries is invalid: %s", err)
+	}
+	if err := ccvtypes.ValidateDuration(p.ClientExpirationGracePeriod); err != nil {
+		return fmt.Errorf("client expiration grace period is invalid: %s", err)
+	}
+	if err := ccvtypes.ValidatePositiveInt64(p.HistoricalValsetEntries); err != nil {
+		return fmt.Errorf("historical valset entries is invalid: %s", err)
+	}
+	if err := validateSoftOptOutThreshold(p.SoftOptOutThreshold); err != nil {
+		return fmt.Errorf("soft opt out threshold is invalid: %s", err)
+	}
+	if err := ccvtypes.ValidateDuration(p.ConsumerRewardsWindowPeriod); err != nil {
+		return fmt.Errorf("consumer rewards window period is invalid: %s", err)
+	}
+	if err := ccvtypes.ValidateStringFraction(p.SlashFractionDoubleSign); err != nil {
+		return fmt.Errorf("double-sign slash fraction is invalid: %s", err)
+	}
+	if err := ccvtypes.ValidateStringFraction(p.SlashFractionDowntime); err != nil {
+		return fmt.Errorf("downtime slash fraction is invalid: %s", err)
+	}
+	if err := validateMaxUnbondingOpsPerChain(p.MaxUnbondingOpsPerChain); err != nil {
+		return fmt.Errorf("max unbonding ops per chain is invalid: %s", err)
+	}
+	if err := validateSlashAckBatchPeriod(p.SlashAckBatchPeriod); err != nil {
+		return fmt.Errorf("slash ack batch period is invalid: %s", err)
+	}
+	if err := validateConsumerLivenessWindow(p.ConsumerLivenessWindow); err != nil {
+		return fmt.Errorf("consumer liveness window is invalid: %s", err)
+	}
+	if err := validateAllowedConsumerClientTypes(p.AllowedConsumerClientTypes); err != nil {
+		return fmt.Errorf("allowed consumer client types are invalid: %s", err)
+	}
+	if err := ccvtypes.ValidateStringFraction(p.ClientExpiryWarningFraction); err != nil {
+		return fmt.Errorf("client expiry warning fraction is invalid: %s", err)
+	}
 	return nil
 }
 
@@ -149,7 +312,109 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeySlashMeterReplenishPeriod, p.SlashMeterReplenishPeriod, ccvtypes.ValidateDuration),
 		paramtypes.NewParamSetPair(KeySlashMeterReplenishFraction, p.SlashMeterReplenishFraction, ccvtypes.ValidateStringFraction),
 		paramtypes.NewParamSetPair(KeyMaxThrottledPackets, p.MaxThrottledPackets, ccvtypes.ValidatePositiveInt64),
+		paramtypes.NewParamSetPair(KeyConsumerRedistributeFraction, p.ConsumerRedistributeFraction, ccvtypes.ValidateStringFraction),
+		paramtypes.NewParamSetPair(KeyMaxSlashRetries, p.MaxSlashRetries, ccvtypes.ValidatePositiveInt64),
+		paramtypes.NewParamSetPair(KeyClientExpirationGracePeriod, p.ClientExpirationGracePeriod, ccvtypes.ValidateDuration),
+		paramtypes.NewParamSetPair(KeyHistoricalValsetEntries, p.HistoricalValsetEntries, ccvtypes.ValidatePositiveInt64),
+		paramtypes.NewParamSetPair(KeySoftOptOutThreshold, p.SoftOptOutThreshold, validateSoftOptOutThreshold),
+		paramtypes.NewParamSetPair(KeyConsumerRewardsWindowPeriod, p.ConsumerRewardsWindowPeriod, ccvtypes.ValidateDuration),
+		paramtypes.NewParamSetPair(KeySlashFractionDoubleSign, p.SlashFractionDoubleSign, ccvtypes.ValidateStringFraction),
+		paramtypes.NewParamSetPair(KeySlashFractionDowntime, p.SlashFractionDowntime, ccvtypes.ValidateStringFraction),
+		paramtypes.NewParamSetPair(KeyMaxUnbondingOpsPerChain, p.MaxUnbondingOpsPerChain, validateMaxUnbondingOpsPerChain),
+		paramtypes.NewParamSetPair(KeyLogValsetUpdateDiffs, p.LogValsetUpdateDiffs, ccvtypes.ValidateBool),
+		paramtypes.NewParamSetPair(KeySlashAckBatchPeriod, p.SlashAckBatchPeriod, validateSlashAckBatchPeriod),
+		paramtypes.NewParamSetPair(KeyConsumerLivenessWindow, p.ConsumerLivenessWindow, validateConsumerLivenessWindow),
+		paramtypes.NewParamSetPair(KeyAllowedConsumerClientTypes, p.AllowedConsumerClientTypes, validateAllowedConsumerClientTypes),
+		paramtypes.NewParamSetPair(KeyClientExpiryWarningFraction, p.ClientExpiryWarningFraction, ccvtypes.ValidateStringFraction),
+	}
+}
+
+// Validate validates the consumer parameters set by a consumer parameters update proposal.
+// The empty fields are not overridden and are therefore valid.
+func (cp ConsumerParameters) Validate() error {
+	if cp.ConsumerRedistributeFraction != "" {
+		if err := ccvtypes.ValidateStringFraction(cp.ConsumerRedistributeFraction); err != nil {
+			return fmt.Errorf("consumer redistribute fraction is invalid: %s", err)
+		}
+	}
+	if cp.SoftOptOutThreshold != "" {
+		if err := validateSoftOptOutThreshold(cp.SoftOptOutThreshold); err != nil {
+			return fmt.Errorf("soft opt out threshold is invalid: %s", err)
+		}
+	}
+	if err := cp.ExpectedRewardsPerWindow.Validate(); err != nil {
+		return fmt.Errorf("expected rewards per window are invalid: %s", err)
+	}
+	return nil
+}
+
+func validateSoftOptOutThreshold(i interface{}) error {
+	if err := ccvtypes.ValidateStringFraction(i); err != nil {
+		return err
+	}
+	dec := sdk.MustNewDecFromStr(i.(string))
+	if dec.GT(sdk.MustNewDecFromStr(MaxSoftOptOutThreshold)) {
+		return fmt.Errorf("soft opt out threshold cannot be greater than %s, got %s", MaxSoftOptOutThreshold, i)
+	}
+	return nil
+}
+
+func validateMaxUnbondingOpsPerChain(i interface{}) error {
+	if err := ccvtypes.ValidateInt64(i); err != nil {
+		return err
+	}
+	if i.(int64) < 0 {
+		return fmt.Errorf("max unbonding ops per chain cannot be negative, got %d", i)
 	}
+	return nil
+}
+
+func validateSlashAckBatchPeriod(i interface{}) error {
+	period, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if period < 0 {
+		return fmt.Errorf("slash ack batch period cannot be negative, got %s", period)
+	}
+	return nil
+}
+
+func validateConsumerLivenessWindow(i interface{}) error {
+	window, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if window < 0 {
+		return fmt.Errorf("consumer liveness window cannot be negative, got %s", window)
+	}
+	return nil
+}
+
+func validateAllowedConsumerClientTypes(i interface{}) error {
+	clientTypes, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if len(clientTypes) == 0 {
+		return fmt.Errorf("at least one client type must be allowed")
+	}
+	seen := map[string]bool{}
+	for _, clientType := range clientTypes {
+		if err := clienttypes.ValidateClientType(clientType); err != nil {
+			return err
+		}
+		if seen[clientType] {
+			return fmt.Errorf("duplicate client type %s", clientType)
+		}
+		seen[clientType] = true
+	}
+	// The provider only ever creates Tendermint clients to the consumer chains,
+	// so disallowing them would render all the CCV channels unusable.
+	if !seen[ibcexported.Tendermint] {
+		return fmt.Errorf("client type %s must be allowed", ibcexported.Tendermint)
+	}
+	return nil
 }
 
 func validateTemplateClient(i interface{}) error {
@@ -178,3 +443,31 @@ func validateTemplateClient(i interface{}) error {
 	}
 	return nil
 }
+
+// ValidateConsumerClientState validates the trust parameters of the client state
+// created by the provider for a consumer chain, i.e., the template client filled in
+// with the unbonding period of the consumer chain and the derived trusting period.
+// The trust level must be within [1/3, 1], and the trusting period must be positive
+// and strictly shorter than the unbonding period, otherwise the client could trust
+// headers signed by validators that already unbonded.
+func ValidateConsumerClientState(cs *ibctmtypes.ClientState) error {
+	if cs == nil {
+		return sdkerrors.Wrap(ErrInvalidConsumerClientState, "client state cannot be nil")
+	}
+	if err := light.ValidateTrustLevel(cs.TrustLevel.ToTendermint()); err != nil {
+		return sdkerrors.Wrap(ErrInvalidConsumerClientState, err.Error())
+	}
+	if cs.TrustingPeriod <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidConsumerClientState,
+			"trusting period must be positive, got %s", cs.TrustingPeriod)
+	}
+	if cs.UnbondingPeriod <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidConsumerClientState,
+			"unbonding period must be positive, got %s", cs.UnbondingPeriod)
+	}
+	if cs.TrustingPeriod >= cs.UnbondingPeriod {
+		return sdkerrors.Wrapf(ErrInvalidConsumerClientState,
+			"trusting period %s must be shorter than the unbonding period %s", cs.TrustingPeriod, cs.UnbondingPeriod)
+	}
+	return nil
+}
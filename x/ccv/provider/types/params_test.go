@@ -21,35 +21,113 @@ func TestValidateParams(t *testing.T) {
 		{"default params", types.DefaultParams(), true},
 		{"custom valid params", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
-			"0.33", time.Hour, time.Hour, time.Hour, 30*time.Minute, "0.1", 100), true},
+			"0.33", time.Hour, time.Hour, time.Hour, 30*time.Minute, "0.1", 100, types.DefaultConsumerRedistributeFraction, types.DefaultMaxSlashRetries, types.DefaultClientExpirationGracePeriod, types.DefaultHistoricalValsetEntries, types.DefaultSoftOptOutThreshold, types.DefaultConsumerRewardsWindowPeriod, types.DefaultSlashFractionDoubleSign, types.DefaultSlashFractionDowntime, types.DefaultMaxUnbondingOpsPerChain, types.DefaultLogValsetUpdateDiffs, types.DefaultSlashAckBatchPeriod, types.DefaultConsumerLivenessWindow, types.DefaultAllowedConsumerClientTypes, types.DefaultClientExpiryWarningFraction), true},
 		{"custom invalid params", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 			0, clienttypes.Height{}, nil, []string{"ibc", "upgradedIBCState"}, true, false),
-			"0.33", time.Hour, time.Hour, time.Hour, 30*time.Minute, "0.1", 100), false},
+			"0.33", time.Hour, time.Hour, time.Hour, 30*time.Minute, "0.1", 100, types.DefaultConsumerRedistributeFraction, types.DefaultMaxSlashRetries, types.DefaultClientExpirationGracePeriod, types.DefaultHistoricalValsetEntries, types.DefaultSoftOptOutThreshold, types.DefaultConsumerRewardsWindowPeriod, types.DefaultSlashFractionDoubleSign, types.DefaultSlashFractionDowntime, types.DefaultMaxUnbondingOpsPerChain, types.DefaultLogValsetUpdateDiffs, types.DefaultSlashAckBatchPeriod, types.DefaultConsumerLivenessWindow, types.DefaultAllowedConsumerClientTypes, types.DefaultClientExpiryWarningFraction), false},
 		{"blank client", types.NewParams(&ibctmtypes.ClientState{},
-			"0.33", time.Hour, time.Hour, time.Hour, 30*time.Minute, "0.1", 100), false},
-		{"nil client", types.NewParams(nil, "0.33", time.Hour, time.Hour, time.Hour, 30*time.Minute, "0.1", 100), false},
+			"0.33", time.Hour, time.Hour, time.Hour, 30*time.Minute, "0.1", 100, types.DefaultConsumerRedistributeFraction, types.DefaultMaxSlashRetries, types.DefaultClientExpirationGracePeriod, types.DefaultHistoricalValsetEntries, types.DefaultSoftOptOutThreshold, types.DefaultConsumerRewardsWindowPeriod, types.DefaultSlashFractionDoubleSign, types.DefaultSlashFractionDowntime, types.DefaultMaxUnbondingOpsPerChain, types.DefaultLogValsetUpdateDiffs, types.DefaultSlashAckBatchPeriod, types.DefaultConsumerLivenessWindow, types.DefaultAllowedConsumerClientTypes, types.DefaultClientExpiryWarningFraction), false},
+		{"nil client", types.NewParams(nil, "0.33", time.Hour, time.Hour, time.Hour, 30*time.Minute, "0.1", 100, types.DefaultConsumerRedistributeFraction, types.DefaultMaxSlashRetries, types.DefaultClientExpirationGracePeriod, types.DefaultHistoricalValsetEntries, types.DefaultSoftOptOutThreshold, types.DefaultConsumerRewardsWindowPeriod, types.DefaultSlashFractionDoubleSign, types.DefaultSlashFractionDowntime, types.DefaultMaxUnbondingOpsPerChain, types.DefaultLogValsetUpdateDiffs, types.DefaultSlashAckBatchPeriod, types.DefaultConsumerLivenessWindow, types.DefaultAllowedConsumerClientTypes, types.DefaultClientExpiryWarningFraction), false},
 		// Check if "0.00" is valid or if a zero dec TrustFraction needs to return an error
 		{"0 trusting period fraction", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
-			"0.00", time.Hour, time.Hour, time.Hour, 30*time.Minute, "0.1", 100), true},
+			"0.00", time.Hour, time.Hour, time.Hour, 30*time.Minute, "0.1", 100, types.DefaultConsumerRedistributeFraction, types.DefaultMaxSlashRetries, types.DefaultClientExpirationGracePeriod, types.DefaultHistoricalValsetEntries, types.DefaultSoftOptOutThreshold, types.DefaultConsumerRewardsWindowPeriod, types.DefaultSlashFractionDoubleSign, types.DefaultSlashFractionDowntime, types.DefaultMaxUnbondingOpsPerChain, types.DefaultLogValsetUpdateDiffs, types.DefaultSlashAckBatchPeriod, types.DefaultConsumerLivenessWindow, types.DefaultAllowedConsumerClientTypes, types.DefaultClientExpiryWarningFraction), true},
 		{"0 ccv timeout period", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
-			"0.33", 0, time.Hour, time.Hour, 30*time.Minute, "0.1", 100), false},
+			"0.33", 0, time.Hour, time.Hour, 30*time.Minute, "0.1", 100, types.DefaultConsumerRedistributeFraction, types.DefaultMaxSlashRetries, types.DefaultClientExpirationGracePeriod, types.DefaultHistoricalValsetEntries, types.DefaultSoftOptOutThreshold, types.DefaultConsumerRewardsWindowPeriod, types.DefaultSlashFractionDoubleSign, types.DefaultSlashFractionDowntime, types.DefaultMaxUnbondingOpsPerChain, types.DefaultLogValsetUpdateDiffs, types.DefaultSlashAckBatchPeriod, types.DefaultConsumerLivenessWindow, types.DefaultAllowedConsumerClientTypes, types.DefaultClientExpiryWarningFraction), false},
 		{"0 init timeout period", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
-			"0.33", time.Hour, 0, time.Hour, 30*time.Minute, "0.1", 100), false},
+			"0.33", time.Hour, 0, time.Hour, 30*time.Minute, "0.1", 100, types.DefaultConsumerRedistributeFraction, types.DefaultMaxSlashRetries, types.DefaultClientExpirationGracePeriod, types.DefaultHistoricalValsetEntries, types.DefaultSoftOptOutThreshold, types.DefaultConsumerRewardsWindowPeriod, types.DefaultSlashFractionDoubleSign, types.DefaultSlashFractionDowntime, types.DefaultMaxUnbondingOpsPerChain, types.DefaultLogValsetUpdateDiffs, types.DefaultSlashAckBatchPeriod, types.DefaultConsumerLivenessWindow, types.DefaultAllowedConsumerClientTypes, types.DefaultClientExpiryWarningFraction), false},
 		{"0 vsc timeout period", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
-			"0.33", time.Hour, time.Hour, 0, 30*time.Minute, "0.1", 100), false},
+			"0.33", time.Hour, time.Hour, 0, 30*time.Minute, "0.1", 100, types.DefaultConsumerRedistributeFraction, types.DefaultMaxSlashRetries, types.DefaultClientExpirationGracePeriod, types.DefaultHistoricalValsetEntries, types.DefaultSoftOptOutThreshold, types.DefaultConsumerRewardsWindowPeriod, types.DefaultSlashFractionDoubleSign, types.DefaultSlashFractionDowntime, types.DefaultMaxUnbondingOpsPerChain, types.DefaultLogValsetUpdateDiffs, types.DefaultSlashAckBatchPeriod, types.DefaultConsumerLivenessWindow, types.DefaultAllowedConsumerClientTypes, types.DefaultClientExpiryWarningFraction), false},
 		{"0 slash meter replenish period", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
-			"0.33", time.Hour, time.Hour, 24*time.Hour, 0, "0.1", 100), false},
+			"0.33", time.Hour, time.Hour, 24*time.Hour, 0, "0.1", 100, types.DefaultConsumerRedistributeFraction, types.DefaultMaxSlashRetries, types.DefaultClientExpirationGracePeriod, types.DefaultHistoricalValsetEntries, types.DefaultSoftOptOutThreshold, types.DefaultConsumerRewardsWindowPeriod, types.DefaultSlashFractionDoubleSign, types.DefaultSlashFractionDowntime, types.DefaultMaxUnbondingOpsPerChain, types.DefaultLogValsetUpdateDiffs, types.DefaultSlashAckBatchPeriod, types.DefaultConsumerLivenessWindow, types.DefaultAllowedConsumerClientTypes, types.DefaultClientExpiryWarningFraction), false},
 		{"slash meter replenish fraction over 1", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
-			"0.33", time.Hour, time.Hour, 24*time.Hour, time.Hour, "1.5", 100), false},
+			"0.33", time.Hour, time.Hour, 24*time.Hour, time.Hour, "1.5", 100, types.DefaultConsumerRedistributeFraction, types.DefaultMaxSlashRetries, types.DefaultClientExpirationGracePeriod, types.DefaultHistoricalValsetEntries, types.DefaultSoftOptOutThreshold, types.DefaultConsumerRewardsWindowPeriod, types.DefaultSlashFractionDoubleSign, types.DefaultSlashFractionDowntime, types.DefaultMaxUnbondingOpsPerChain, types.DefaultLogValsetUpdateDiffs, types.DefaultSlashAckBatchPeriod, types.DefaultConsumerLivenessWindow, types.DefaultAllowedConsumerClientTypes, types.DefaultClientExpiryWarningFraction), false},
 		{"negative max pending slash packets", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
-			"0.33", time.Hour, time.Hour, 24*time.Hour, time.Hour, "0.1", -100), false},
+			"0.33", time.Hour, time.Hour, 24*time.Hour, time.Hour, "0.1", -100, types.DefaultConsumerRedistributeFraction, types.DefaultMaxSlashRetries, types.DefaultClientExpirationGracePeriod, types.DefaultHistoricalValsetEntries, types.DefaultSoftOptOutThreshold, types.DefaultConsumerRewardsWindowPeriod, types.DefaultSlashFractionDoubleSign, types.DefaultSlashFractionDowntime, types.DefaultMaxUnbondingOpsPerChain, types.DefaultLogValsetUpdateDiffs, types.DefaultSlashAckBatchPeriod, types.DefaultConsumerLivenessWindow, types.DefaultAllowedConsumerClientTypes, types.DefaultClientExpiryWarningFraction), false},
+		{"consumer redistribute fraction over 1", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
+			"0.33", time.Hour, time.Hour, 24*time.Hour, time.Hour, "0.1", 100, "1.1", types.DefaultMaxSlashRetries, types.DefaultClientExpirationGracePeriod, types.DefaultHistoricalValsetEntries, types.DefaultSoftOptOutThreshold, types.DefaultConsumerRewardsWindowPeriod, types.DefaultSlashFractionDoubleSign, types.DefaultSlashFractionDowntime, types.DefaultMaxUnbondingOpsPerChain, types.DefaultLogValsetUpdateDiffs, types.DefaultSlashAckBatchPeriod, types.DefaultConsumerLivenessWindow, types.DefaultAllowedConsumerClientTypes, types.DefaultClientExpiryWarningFraction), false},
+		{"zero max slash retries", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
+			"0.33", time.Hour, time.Hour, 24*time.Hour, time.Hour, "0.1", 100, types.DefaultConsumerRedistributeFraction, 0, types.DefaultClientExpirationGracePeriod, types.DefaultHistoricalValsetEntries, types.DefaultSoftOptOutThreshold, types.DefaultConsumerRewardsWindowPeriod, types.DefaultSlashFractionDoubleSign, types.DefaultSlashFractionDowntime, types.DefaultMaxUnbondingOpsPerChain, types.DefaultLogValsetUpdateDiffs, types.DefaultSlashAckBatchPeriod, types.DefaultConsumerLivenessWindow, types.DefaultAllowedConsumerClientTypes, types.DefaultClientExpiryWarningFraction), false},
+		{"zero client expiration grace period", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
+			"0.33", time.Hour, time.Hour, 24*time.Hour, time.Hour, "0.1", 100, types.DefaultConsumerRedistributeFraction, types.DefaultMaxSlashRetries, 0, types.DefaultHistoricalValsetEntries, types.DefaultSoftOptOutThreshold, types.DefaultConsumerRewardsWindowPeriod, types.DefaultSlashFractionDoubleSign, types.DefaultSlashFractionDowntime, types.DefaultMaxUnbondingOpsPerChain, types.DefaultLogValsetUpdateDiffs, types.DefaultSlashAckBatchPeriod, types.DefaultConsumerLivenessWindow, types.DefaultAllowedConsumerClientTypes, types.DefaultClientExpiryWarningFraction), false},
+		{"zero historical valset entries", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
+			"0.33", time.Hour, time.Hour, 24*time.Hour, time.Hour, "0.1", 100, types.DefaultConsumerRedistributeFraction, types.DefaultMaxSlashRetries, types.DefaultClientExpirationGracePeriod, 0, types.DefaultSoftOptOutThreshold, types.DefaultConsumerRewardsWindowPeriod, types.DefaultSlashFractionDoubleSign, types.DefaultSlashFractionDowntime, types.DefaultMaxUnbondingOpsPerChain, types.DefaultLogValsetUpdateDiffs, types.DefaultSlashAckBatchPeriod, types.DefaultConsumerLivenessWindow, types.DefaultAllowedConsumerClientTypes, types.DefaultClientExpiryWarningFraction), false},
+		{"zero consumer rewards window period", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
+			"0.33", time.Hour, time.Hour, 24*time.Hour, time.Hour, "0.1", 100, types.DefaultConsumerRedistributeFraction, types.DefaultMaxSlashRetries, types.DefaultClientExpirationGracePeriod, types.DefaultHistoricalValsetEntries, types.DefaultSoftOptOutThreshold, 0, types.DefaultSlashFractionDoubleSign, types.DefaultSlashFractionDowntime, types.DefaultMaxUnbondingOpsPerChain, types.DefaultLogValsetUpdateDiffs, types.DefaultSlashAckBatchPeriod, types.DefaultConsumerLivenessWindow, types.DefaultAllowedConsumerClientTypes, types.DefaultClientExpiryWarningFraction), false},
+		{"max soft opt out threshold", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
+			"0.33", time.Hour, time.Hour, 24*time.Hour, time.Hour, "0.1", 100, types.DefaultConsumerRedistributeFraction, types.DefaultMaxSlashRetries, types.DefaultClientExpirationGracePeriod, types.DefaultHistoricalValsetEntries, "0.2", types.DefaultConsumerRewardsWindowPeriod, types.DefaultSlashFractionDoubleSign, types.DefaultSlashFractionDowntime, types.DefaultMaxUnbondingOpsPerChain, types.DefaultLogValsetUpdateDiffs, types.DefaultSlashAckBatchPeriod, types.DefaultConsumerLivenessWindow, types.DefaultAllowedConsumerClientTypes, types.DefaultClientExpiryWarningFraction), true},
+		{"too large soft opt out threshold", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
+			"0.33", time.Hour, time.Hour, 24*time.Hour, time.Hour, "0.1", 100, types.DefaultConsumerRedistributeFraction, types.DefaultMaxSlashRetries, types.DefaultClientExpirationGracePeriod, types.DefaultHistoricalValsetEntries, "0.21", types.DefaultConsumerRewardsWindowPeriod, types.DefaultSlashFractionDoubleSign, types.DefaultSlashFractionDowntime, types.DefaultMaxUnbondingOpsPerChain, types.DefaultLogValsetUpdateDiffs, types.DefaultSlashAckBatchPeriod, types.DefaultConsumerLivenessWindow, types.DefaultAllowedConsumerClientTypes, types.DefaultClientExpiryWarningFraction), false},
+		{"invalid soft opt out threshold", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
+			"0.33", time.Hour, time.Hour, 24*time.Hour, time.Hour, "0.1", 100, types.DefaultConsumerRedistributeFraction, types.DefaultMaxSlashRetries, types.DefaultClientExpirationGracePeriod, types.DefaultHistoricalValsetEntries, "", types.DefaultConsumerRewardsWindowPeriod, types.DefaultSlashFractionDoubleSign, types.DefaultSlashFractionDowntime, types.DefaultMaxUnbondingOpsPerChain, types.DefaultLogValsetUpdateDiffs, types.DefaultSlashAckBatchPeriod, types.DefaultConsumerLivenessWindow, types.DefaultAllowedConsumerClientTypes, types.DefaultClientExpiryWarningFraction), false},
+		{"custom slash fractions", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
+			"0.33", time.Hour, time.Hour, 24*time.Hour, time.Hour, "0.1", 100, types.DefaultConsumerRedistributeFraction, types.DefaultMaxSlashRetries, types.DefaultClientExpirationGracePeriod, types.DefaultHistoricalValsetEntries, types.DefaultSoftOptOutThreshold, types.DefaultConsumerRewardsWindowPeriod, "0.1", "0.01", types.DefaultMaxUnbondingOpsPerChain, types.DefaultLogValsetUpdateDiffs, types.DefaultSlashAckBatchPeriod, types.DefaultConsumerLivenessWindow, types.DefaultAllowedConsumerClientTypes, types.DefaultClientExpiryWarningFraction), true},
+		{"double-sign slash fraction over 1", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
+			"0.33", time.Hour, time.Hour, 24*time.Hour, time.Hour, "0.1", 100, types.DefaultConsumerRedistributeFraction, types.DefaultMaxSlashRetries, types.DefaultClientExpirationGracePeriod, types.DefaultHistoricalValsetEntries, types.DefaultSoftOptOutThreshold, types.DefaultConsumerRewardsWindowPeriod, "1.1", types.DefaultSlashFractionDowntime, types.DefaultMaxUnbondingOpsPerChain, types.DefaultLogValsetUpdateDiffs, types.DefaultSlashAckBatchPeriod, types.DefaultConsumerLivenessWindow, types.DefaultAllowedConsumerClientTypes, types.DefaultClientExpiryWarningFraction), false},
+		{"invalid double-sign slash fraction", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
+			"0.33", time.Hour, time.Hour, 24*time.Hour, time.Hour, "0.1", 100, types.DefaultConsumerRedistributeFraction, types.DefaultMaxSlashRetries, types.DefaultClientExpirationGracePeriod, types.DefaultHistoricalValsetEntries, types.DefaultSoftOptOutThreshold, types.DefaultConsumerRewardsWindowPeriod, "", types.DefaultSlashFractionDowntime, types.DefaultMaxUnbondingOpsPerChain, types.DefaultLogValsetUpdateDiffs, types.DefaultSlashAckBatchPeriod, types.DefaultConsumerLivenessWindow, types.DefaultAllowedConsumerClientTypes, types.DefaultClientExpiryWarningFraction), false},
+		{"negative downtime slash fraction", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
+			"0.33", time.Hour, time.Hour, 24*time.Hour, time.Hour, "0.1", 100, types.DefaultConsumerRedistributeFraction, types.DefaultMaxSlashRetries, types.DefaultClientExpirationGracePeriod, types.DefaultHistoricalValsetEntries, types.DefaultSoftOptOutThreshold, types.DefaultConsumerRewardsWindowPeriod, types.DefaultSlashFractionDoubleSign, "-0.01", types.DefaultMaxUnbondingOpsPerChain, types.DefaultLogValsetUpdateDiffs, types.DefaultSlashAckBatchPeriod, types.DefaultConsumerLivenessWindow, types.DefaultAllowedConsumerClientTypes, types.DefaultClientExpiryWarningFraction), false},
+		{"custom max unbonding ops per chain", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
+			"0.33", time.Hour, time.Hour, 24*time.Hour, time.Hour, "0.1", 100, types.DefaultConsumerRedistributeFraction, types.DefaultMaxSlashRetries, types.DefaultClientExpirationGracePeriod, types.DefaultHistoricalValsetEntries, types.DefaultSoftOptOutThreshold, types.DefaultConsumerRewardsWindowPeriod, types.DefaultSlashFractionDoubleSign, types.DefaultSlashFractionDowntime, 1000, types.DefaultLogValsetUpdateDiffs, types.DefaultSlashAckBatchPeriod, types.DefaultConsumerLivenessWindow, types.DefaultAllowedConsumerClientTypes, types.DefaultClientExpiryWarningFraction), true},
+		{"negative max unbonding ops per chain", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
+			"0.33", time.Hour, time.Hour, 24*time.Hour, time.Hour, "0.1", 100, types.DefaultConsumerRedistributeFraction, types.DefaultMaxSlashRetries, types.DefaultClientExpirationGracePeriod, types.DefaultHistoricalValsetEntries, types.DefaultSoftOptOutThreshold, types.DefaultConsumerRewardsWindowPeriod, types.DefaultSlashFractionDoubleSign, types.DefaultSlashFractionDowntime, -1, types.DefaultLogValsetUpdateDiffs, types.DefaultSlashAckBatchPeriod, types.DefaultConsumerLivenessWindow, types.DefaultAllowedConsumerClientTypes, types.DefaultClientExpiryWarningFraction), false},
+		{"custom slash ack batch period", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
+			"0.33", time.Hour, time.Hour, 24*time.Hour, time.Hour, "0.1", 100, types.DefaultConsumerRedistributeFraction, types.DefaultMaxSlashRetries, types.DefaultClientExpirationGracePeriod, types.DefaultHistoricalValsetEntries, types.DefaultSoftOptOutThreshold, types.DefaultConsumerRewardsWindowPeriod, types.DefaultSlashFractionDoubleSign, types.DefaultSlashFractionDowntime, types.DefaultMaxUnbondingOpsPerChain, types.DefaultLogValsetUpdateDiffs, time.Minute, types.DefaultConsumerLivenessWindow, types.DefaultAllowedConsumerClientTypes, types.DefaultClientExpiryWarningFraction), true},
+		{"negative slash ack batch period", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
+			"0.33", time.Hour, time.Hour, 24*time.Hour, time.Hour, "0.1", 100, types.DefaultConsumerRedistributeFraction, types.DefaultMaxSlashRetries, types.DefaultClientExpirationGracePeriod, types.DefaultHistoricalValsetEntries, types.DefaultSoftOptOutThreshold, types.DefaultConsumerRewardsWindowPeriod, types.DefaultSlashFractionDoubleSign, types.DefaultSlashFractionDowntime, types.DefaultMaxUnbondingOpsPerChain, types.DefaultLogValsetUpdateDiffs, -time.Minute, types.DefaultConsumerLivenessWindow, types.DefaultAllowedConsumerClientTypes, types.DefaultClientExpiryWarningFraction), false},
+		{"custom consumer liveness window", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
+			"0.33", time.Hour, time.Hour, 24*time.Hour, time.Hour, "0.1", 100, types.DefaultConsumerRedistributeFraction, types.DefaultMaxSlashRetries, types.DefaultClientExpirationGracePeriod, types.DefaultHistoricalValsetEntries, types.DefaultSoftOptOutThreshold, types.DefaultConsumerRewardsWindowPeriod, types.DefaultSlashFractionDoubleSign, types.DefaultSlashFractionDowntime, types.DefaultMaxUnbondingOpsPerChain, types.DefaultLogValsetUpdateDiffs, types.DefaultSlashAckBatchPeriod, time.Hour, types.DefaultAllowedConsumerClientTypes, types.DefaultClientExpiryWarningFraction), true},
+		{"negative consumer liveness window", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
+			"0.33", time.Hour, time.Hour, 24*time.Hour, time.Hour, "0.1", 100, types.DefaultConsumerRedistributeFraction, types.DefaultMaxSlashRetries, types.DefaultClientExpirationGracePeriod, types.DefaultHistoricalValsetEntries, types.DefaultSoftOptOutThreshold, types.DefaultConsumerRewardsWindowPeriod, types.DefaultSlashFractionDoubleSign, types.DefaultSlashFractionDowntime, types.DefaultMaxUnbondingOpsPerChain, types.DefaultLogValsetUpdateDiffs, types.DefaultSlashAckBatchPeriod, -time.Hour, types.DefaultAllowedConsumerClientTypes, types.DefaultClientExpiryWarningFraction), false},
+		{"allowed non-Tendermint consumer client type", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
+			"0.33", time.Hour, time.Hour, 24*time.Hour, time.Hour, "0.1", 100, types.DefaultConsumerRedistributeFraction, types.DefaultMaxSlashRetries, types.DefaultClientExpirationGracePeriod, types.DefaultHistoricalValsetEntries, types.DefaultSoftOptOutThreshold, types.DefaultConsumerRewardsWindowPeriod, types.DefaultSlashFractionDoubleSign, types.DefaultSlashFractionDowntime, types.DefaultMaxUnbondingOpsPerChain, types.DefaultLogValsetUpdateDiffs, types.DefaultSlashAckBatchPeriod, types.DefaultConsumerLivenessWindow, []string{"07-tendermint", "99-mock"}, types.DefaultClientExpiryWarningFraction), true},
+		{"no allowed consumer client type", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
+			"0.33", time.Hour, time.Hour, 24*time.Hour, time.Hour, "0.1", 100, types.DefaultConsumerRedistributeFraction, types.DefaultMaxSlashRetries, types.DefaultClientExpirationGracePeriod, types.DefaultHistoricalValsetEntries, types.DefaultSoftOptOutThreshold, types.DefaultConsumerRewardsWindowPeriod, types.DefaultSlashFractionDoubleSign, types.DefaultSlashFractionDowntime, types.DefaultMaxUnbondingOpsPerChain, types.DefaultLogValsetUpdateDiffs, types.DefaultSlashAckBatchPeriod, types.DefaultConsumerLivenessWindow, []string{}, types.DefaultClientExpiryWarningFraction), false},
+		{"invalid consumer client type", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
+			"0.33", time.Hour, time.Hour, 24*time.Hour, time.Hour, "0.1", 100, types.DefaultConsumerRedistributeFraction, types.DefaultMaxSlashRetries, types.DefaultClientExpirationGracePeriod, types.DefaultHistoricalValsetEntries, types.DefaultSoftOptOutThreshold, types.DefaultConsumerRewardsWindowPeriod, types.DefaultSlashFractionDoubleSign, types.DefaultSlashFractionDowntime, types.DefaultMaxUnbondingOpsPerChain, types.DefaultLogValsetUpdateDiffs, types.DefaultSlashAckBatchPeriod, types.DefaultConsumerLivenessWindow, []string{"07-tendermint", "07/tendermint"}, types.DefaultClientExpiryWarningFraction), false},
+		{"duplicate consumer client type", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
+			"0.33", time.Hour, time.Hour, 24*time.Hour, time.Hour, "0.1", 100, types.DefaultConsumerRedistributeFraction, types.DefaultMaxSlashRetries, types.DefaultClientExpirationGracePeriod, types.DefaultHistoricalValsetEntries, types.DefaultSoftOptOutThreshold, types.DefaultConsumerRewardsWindowPeriod, types.DefaultSlashFractionDoubleSign, types.DefaultSlashFractionDowntime, types.DefaultMaxUnbondingOpsPerChain, types.DefaultLogValsetUpdateDiffs, types.DefaultSlashAckBatchPeriod, types.DefaultConsumerLivenessWindow, []string{"07-tendermint", "07-tendermint"}, types.DefaultClientExpiryWarningFraction), false},
+		{"Tendermint consumer client type not allowed", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
+			"0.33", time.Hour, time.Hour, 24*time.Hour, time.Hour, "0.1", 100, types.DefaultConsumerRedistributeFraction, types.DefaultMaxSlashRetries, types.DefaultClientExpirationGracePeriod, types.DefaultHistoricalValsetEntries, types.DefaultSoftOptOutThreshold, types.DefaultConsumerRewardsWindowPeriod, types.DefaultSlashFractionDoubleSign, types.DefaultSlashFractionDowntime, types.DefaultMaxUnbondingOpsPerChain, types.DefaultLogValsetUpdateDiffs, types.DefaultSlashAckBatchPeriod, types.DefaultConsumerLivenessWindow, []string{"99-mock"}, types.DefaultClientExpiryWarningFraction), false},
+		{"custom client expiry warning fraction", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
+			"0.33", time.Hour, time.Hour, 24*time.Hour, time.Hour, "0.1", 100, types.DefaultConsumerRedistributeFraction, types.DefaultMaxSlashRetries, types.DefaultClientExpirationGracePeriod, types.DefaultHistoricalValsetEntries, types.DefaultSoftOptOutThreshold, types.DefaultConsumerRewardsWindowPeriod, types.DefaultSlashFractionDoubleSign, types.DefaultSlashFractionDowntime, types.DefaultMaxUnbondingOpsPerChain, types.DefaultLogValsetUpdateDiffs, types.DefaultSlashAckBatchPeriod, types.DefaultConsumerLivenessWindow, types.DefaultAllowedConsumerClientTypes, "0.25"), true},
+		{"client expiry warning fraction above one", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
+			"0.33", time.Hour, time.Hour, 24*time.Hour, time.Hour, "0.1", 100, types.DefaultConsumerRedistributeFraction, types.DefaultMaxSlashRetries, types.DefaultClientExpirationGracePeriod, types.DefaultHistoricalValsetEntries, types.DefaultSoftOptOutThreshold, types.DefaultConsumerRewardsWindowPeriod, types.DefaultSlashFractionDoubleSign, types.DefaultSlashFractionDowntime, types.DefaultMaxUnbondingOpsPerChain, types.DefaultLogValsetUpdateDiffs, types.DefaultSlashAckBatchPeriod, types.DefaultConsumerLivenessWindow, types.DefaultAllowedConsumerClientTypes, "1.1"), false},
+		{"empty client expiry warning fraction", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
+			"0.33", time.Hour, time.Hour, 24*time.Hour, time.Hour, "0.1", 100, types.DefaultConsumerRedistributeFraction, types.DefaultMaxSlashRetries, types.DefaultClientExpirationGracePeriod, types.DefaultHistoricalValsetEntries, types.DefaultSoftOptOutThreshold, types.DefaultConsumerRewardsWindowPeriod, types.DefaultSlashFractionDoubleSign, types.DefaultSlashFractionDowntime, types.DefaultMaxUnbondingOpsPerChain, types.DefaultLogValsetUpdateDiffs, types.DefaultSlashAckBatchPeriod, types.DefaultConsumerLivenessWindow, types.DefaultAllowedConsumerClientTypes, ""), false},
 	}
 
 	for _, tc := range testCases {
@@ -61,3 +139,39 @@ func TestValidateParams(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateConsumerClientState(t *testing.T) {
+	validClient := func() *ibctmtypes.ClientState {
+		return ibctmtypes.NewClientState("chainID", ibctmtypes.DefaultTrustLevel, 2*7*24*time.Hour, 3*7*24*time.Hour,
+			time.Second*10, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"upgrade", "upgradedIBCState"}, true, true)
+	}
+
+	testCases := []struct {
+		name    string
+		modify  func(*ibctmtypes.ClientState)
+		expPass bool
+	}{
+		{"valid client state", func(cs *ibctmtypes.ClientState) {}, true},
+		{"trust level of one", func(cs *ibctmtypes.ClientState) { cs.TrustLevel = ibctmtypes.Fraction{Numerator: 1, Denominator: 1} }, true},
+		{"zero trust level", func(cs *ibctmtypes.ClientState) { cs.TrustLevel = ibctmtypes.Fraction{Numerator: 0, Denominator: 1} }, false},
+		{"zero trust level denominator", func(cs *ibctmtypes.ClientState) { cs.TrustLevel = ibctmtypes.Fraction{Numerator: 1, Denominator: 0} }, false},
+		{"trust level below 1/3", func(cs *ibctmtypes.ClientState) { cs.TrustLevel = ibctmtypes.Fraction{Numerator: 1, Denominator: 4} }, false},
+		{"trust level above 1", func(cs *ibctmtypes.ClientState) { cs.TrustLevel = ibctmtypes.Fraction{Numerator: 4, Denominator: 3} }, false},
+		{"zero trusting period", func(cs *ibctmtypes.ClientState) { cs.TrustingPeriod = 0 }, false},
+		{"zero unbonding period", func(cs *ibctmtypes.ClientState) { cs.UnbondingPeriod = 0 }, false},
+		{"trusting period equal to unbonding period", func(cs *ibctmtypes.ClientState) { cs.TrustingPeriod = cs.UnbondingPeriod }, false},
+		{"trusting period exceeding unbonding period", func(cs *ibctmtypes.ClientState) { cs.TrustingPeriod = cs.UnbondingPeriod + time.Hour }, false},
+	}
+
+	for _, tc := range testCases {
+		cs := validClient()
+		tc.modify(cs)
+		err := types.ValidateConsumerClientState(cs)
+		if tc.expPass {
+			require.NoError(t, err, "case %s should not have been an error", tc.name)
+		} else {
+			require.ErrorIs(t, err, types.ErrInvalidConsumerClientState, "case %s should have been an error", tc.name)
+		}
+	}
+	require.Error(t, types.ValidateConsumerClientState(nil))
+}
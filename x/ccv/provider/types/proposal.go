@@ -1,34 +1,61 @@
 package types
 
 import (
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"strings"
 	time "time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	ccvtypes "github.com/cosmos/interchain-security/x/ccv/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 )
 
 const (
-	ProposalTypeConsumerAddition = "ConsumerAddition"
-	ProposalTypeConsumerRemoval  = "ConsumerRemoval"
-	ProposalTypeEquivocation     = "Equivocation"
+	ProposalTypeConsumerAddition       = "ConsumerAddition"
+	ProposalTypeConsumerRemoval        = "ConsumerRemoval"
+	ProposalTypeEquivocation           = "Equivocation"
+	ProposalTypeValidatorLists         = "ConsumerValidatorLists"
+	ProposalTypeParametersUpdate       = "ConsumerParametersUpdate"
+	ProposalTypeForceCompleteUnbonding = "ForceCompleteUnbonding"
+	ProposalTypeAdditionCancellation   = "ConsumerAdditionCancellation"
+	ProposalTypeChangeRewardDenoms     = "ChangeRewardDenoms"
+	ProposalTypeConsumerPause          = "ConsumerPause"
+	ProposalTypeMetadataUpdate         = "ConsumerMetadataUpdate"
+	ProposalTypeChangeRewardFraction   = "ChangeConsumerRewardFraction"
 )
 
 var (
 	_ govtypes.Content = &ConsumerAdditionProposal{}
 	_ govtypes.Content = &ConsumerRemovalProposal{}
 	_ govtypes.Content = &EquivocationProposal{}
+	_ govtypes.Content = &ConsumerValidatorListsProposal{}
+	_ govtypes.Content = &ConsumerParametersUpdateProposal{}
+	_ govtypes.Content = &ForceCompleteUnbondingProposal{}
+	_ govtypes.Content = &ConsumerAdditionCancellationProposal{}
+	_ govtypes.Content = &ChangeRewardDenomsProposal{}
+	_ govtypes.Content = &ConsumerPauseProposal{}
+	_ govtypes.Content = &ConsumerMetadataUpdateProposal{}
+	_ govtypes.Content = &ChangeConsumerRewardFractionProposal{}
 )
 
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeConsumerAddition)
 	govtypes.RegisterProposalType(ProposalTypeConsumerRemoval)
 	govtypes.RegisterProposalType(ProposalTypeEquivocation)
+	govtypes.RegisterProposalType(ProposalTypeValidatorLists)
+	govtypes.RegisterProposalType(ProposalTypeParametersUpdate)
+	govtypes.RegisterProposalType(ProposalTypeForceCompleteUnbonding)
+	govtypes.RegisterProposalType(ProposalTypeAdditionCancellation)
+	govtypes.RegisterProposalType(ProposalTypeChangeRewardDenoms)
+	govtypes.RegisterProposalType(ProposalTypeConsumerPause)
+	govtypes.RegisterProposalType(ProposalTypeMetadataUpdate)
+	govtypes.RegisterProposalType(ProposalTypeChangeRewardFraction)
 }
 
 // NewConsumerAdditionProposal creates a new consumer addition proposal.
@@ -82,17 +109,32 @@ func (cccp *ConsumerAdditionProposal) ValidateBasic() error {
 	if strings.TrimSpace(cccp.ChainId) == "" {
 		return sdkerrors.Wrap(ErrInvalidConsumerAdditionProposal, "consumer chain id must not be blank")
 	}
+	if len(cccp.ChainId) > tmtypes.MaxChainIDLen {
+		return sdkerrors.Wrapf(ErrInvalidConsumerAdditionProposal,
+			"consumer chain id cannot be longer than %d characters, got %d", tmtypes.MaxChainIDLen, len(cccp.ChainId))
+	}
 
 	if cccp.InitialHeight.IsZero() {
 		return sdkerrors.Wrap(ErrInvalidConsumerAdditionProposal, "initial height cannot be zero")
 	}
+	if cccp.InitialHeight.RevisionHeight == 0 {
+		return sdkerrors.Wrap(ErrInvalidConsumerAdditionProposal, "initial height revision height cannot be zero")
+	}
 
 	if len(cccp.GenesisHash) == 0 {
 		return sdkerrors.Wrap(ErrInvalidConsumerAdditionProposal, "genesis hash cannot be empty")
 	}
+	if len(cccp.GenesisHash) != sha256.Size {
+		return sdkerrors.Wrapf(ErrInvalidConsumerAdditionProposal,
+			"genesis hash must be a SHA-256 hash of %d bytes, got %d bytes", sha256.Size, len(cccp.GenesisHash))
+	}
 	if len(cccp.BinaryHash) == 0 {
 		return sdkerrors.Wrap(ErrInvalidConsumerAdditionProposal, "binary hash cannot be empty")
 	}
+	if len(cccp.BinaryHash) != sha256.Size {
+		return sdkerrors.Wrapf(ErrInvalidConsumerAdditionProposal,
+			"binary hash must be a SHA-256 hash of %d bytes, got %d bytes", sha256.Size, len(cccp.BinaryHash))
+	}
 
 	if cccp.SpawnTime.IsZero() {
 		return sdkerrors.Wrap(ErrInvalidConsumerAdditionProposal, "spawn time cannot be zero")
@@ -122,6 +164,29 @@ func (cccp *ConsumerAdditionProposal) ValidateBasic() error {
 		return sdkerrors.Wrap(ErrInvalidConsumerAdditionProposal, "unbonding period cannot be zero")
 	}
 
+	if cccp.PreferredRewardDenom != "" {
+		if err := sdk.ValidateDenom(cccp.PreferredRewardDenom); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidConsumerAdditionProposal, "preferred reward denom is invalid: %s", err)
+		}
+	}
+
+	if _, err := ParseValidatorList(cccp.ValidatorAllowlist); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidConsumerAdditionProposal, "validator allowlist is invalid: %s", err)
+	}
+	if _, err := ParseValidatorList(cccp.ValidatorDenylist); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidConsumerAdditionProposal, "validator denylist is invalid: %s", err)
+	}
+
+	if cccp.DowntimeJailDuration < 0 {
+		return sdkerrors.Wrap(ErrInvalidConsumerAdditionProposal, "downtime jail duration cannot be negative")
+	}
+
+	if cccp.Metadata != nil {
+		if err := cccp.Metadata.Validate(); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidConsumerAdditionProposal, "consumer metadata is invalid: %s", err)
+		}
+	}
+
 	return nil
 }
 
@@ -140,7 +205,14 @@ func (cccp *ConsumerAdditionProposal) String() string {
 	HistoricalEntries: %d
 	CcvTimeoutPeriod: %d
 	TransferTimeoutPeriod: %d
-	UnbondingPeriod: %d`,
+	UnbondingPeriod: %d
+	SendSlashConfirmations: %t
+	PreferredRewardDenom: %s
+	SlashDoubleSigns: %t
+	ValidatorAllowlist: %v
+	ValidatorDenylist: %v
+	DowntimeJailDuration: %s
+	TopN: %d`,
 		cccp.Title,
 		cccp.Description,
 		cccp.ChainId,
@@ -153,7 +225,14 @@ func (cccp *ConsumerAdditionProposal) String() string {
 		cccp.HistoricalEntries,
 		cccp.CcvTimeoutPeriod,
 		cccp.TransferTimeoutPeriod,
-		cccp.UnbondingPeriod)
+		cccp.UnbondingPeriod,
+		cccp.SendSlashConfirmations,
+		cccp.PreferredRewardDenom,
+		cccp.SlashDoubleSigns,
+		cccp.ValidatorAllowlist,
+		cccp.ValidatorDenylist,
+		cccp.DowntimeJailDuration,
+		cccp.TopN)
 }
 
 // NewConsumerRemovalProposal creates a new consumer removal proposal.
@@ -220,3 +299,302 @@ func (sp *EquivocationProposal) ValidateBasic() error {
 	}
 	return nil
 }
+
+// NewConsumerValidatorListsProposal creates a new consumer validator lists proposal.
+func NewConsumerValidatorListsProposal(title, description, chainID string, allowlist, denylist []string) govtypes.Content {
+	return &ConsumerValidatorListsProposal{
+		Title:              title,
+		Description:        description,
+		ChainId:            chainID,
+		ValidatorAllowlist: allowlist,
+		ValidatorDenylist:  denylist,
+	}
+}
+
+// ProposalRoute returns the routing key of a consumer validator lists proposal.
+func (vlp *ConsumerValidatorListsProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a consumer validator lists proposal.
+func (vlp *ConsumerValidatorListsProposal) ProposalType() string {
+	return ProposalTypeValidatorLists
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (vlp *ConsumerValidatorListsProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(vlp); err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(vlp.ChainId) == "" {
+		return sdkerrors.Wrap(ErrInvalidConsumerValidatorListsProp, "consumer chain id must not be blank")
+	}
+
+	if _, err := ParseValidatorList(vlp.ValidatorAllowlist); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidConsumerValidatorListsProp, "validator allowlist is invalid: %s", err)
+	}
+	if _, err := ParseValidatorList(vlp.ValidatorDenylist); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidConsumerValidatorListsProp, "validator denylist is invalid: %s", err)
+	}
+	return nil
+}
+
+// NewConsumerParametersUpdateProposal creates a new consumer parameters update proposal.
+func NewConsumerParametersUpdateProposal(title, description, chainID string,
+	consumerRedistributeFraction, softOptOutThreshold string, expectedRewardsPerWindow sdk.Coins,
+) govtypes.Content {
+	return &ConsumerParametersUpdateProposal{
+		Title:                        title,
+		Description:                  description,
+		ChainId:                      chainID,
+		ConsumerRedistributeFraction: consumerRedistributeFraction,
+		SoftOptOutThreshold:          softOptOutThreshold,
+		ExpectedRewardsPerWindow:     expectedRewardsPerWindow,
+	}
+}
+
+// ProposalRoute returns the routing key of a consumer parameters update proposal.
+func (pup *ConsumerParametersUpdateProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a consumer parameters update proposal.
+func (pup *ConsumerParametersUpdateProposal) ProposalType() string {
+	return ProposalTypeParametersUpdate
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (pup *ConsumerParametersUpdateProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(pup); err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(pup.ChainId) == "" {
+		return sdkerrors.Wrap(ErrInvalidConsumerParametersUpdateProp, "consumer chain id must not be blank")
+	}
+
+	if pup.ConsumerRedistributeFraction == "" && pup.SoftOptOutThreshold == "" && pup.ExpectedRewardsPerWindow.Empty() {
+		return sdkerrors.Wrap(ErrInvalidConsumerParametersUpdateProp, "at least one consumer parameter must be updated")
+	}
+
+	if err := pup.ConsumerParameters().Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidConsumerParametersUpdateProp, err.Error())
+	}
+	return nil
+}
+
+// ConsumerParameters returns the consumer parameters set by a consumer parameters update proposal.
+func (pup *ConsumerParametersUpdateProposal) ConsumerParameters() ConsumerParameters {
+	return ConsumerParameters{
+		ConsumerRedistributeFraction: pup.ConsumerRedistributeFraction,
+		SoftOptOutThreshold:          pup.SoftOptOutThreshold,
+		ExpectedRewardsPerWindow:     pup.ExpectedRewardsPerWindow,
+	}
+}
+
+// NewForceCompleteUnbondingProposal creates a new force complete unbonding proposal.
+func NewForceCompleteUnbondingProposal(title, description, chainID string) govtypes.Content {
+	return &ForceCompleteUnbondingProposal{
+		Title:       title,
+		Description: description,
+		ChainId:     chainID,
+	}
+}
+
+// ProposalRoute returns the routing key of a force complete unbonding proposal.
+func (fcp *ForceCompleteUnbondingProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a force complete unbonding proposal.
+func (fcp *ForceCompleteUnbondingProposal) ProposalType() string {
+	return ProposalTypeForceCompleteUnbonding
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (fcp *ForceCompleteUnbondingProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(fcp); err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(fcp.ChainId) == "" {
+		return sdkerrors.Wrap(ErrInvalidForceCompleteUnbondingProp, "consumer chain id must not be blank")
+	}
+	return nil
+}
+
+// NewConsumerAdditionCancellationProposal creates a new consumer addition cancellation proposal.
+func NewConsumerAdditionCancellationProposal(title, description, chainID string) govtypes.Content {
+	return &ConsumerAdditionCancellationProposal{
+		Title:       title,
+		Description: description,
+		ChainId:     chainID,
+	}
+}
+
+// ProposalRoute returns the routing key of a consumer addition cancellation proposal.
+func (cacp *ConsumerAdditionCancellationProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a consumer addition cancellation proposal.
+func (cacp *ConsumerAdditionCancellationProposal) ProposalType() string {
+	return ProposalTypeAdditionCancellation
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (cacp *ConsumerAdditionCancellationProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(cacp); err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(cacp.ChainId) == "" {
+		return sdkerrors.Wrap(ErrInvalidAdditionCancellationProp, "consumer chain id must not be blank")
+	}
+	return nil
+}
+
+// NewChangeRewardDenomsProposal creates a new change reward denoms proposal.
+func NewChangeRewardDenomsProposal(title, description string, denomsToAdd, denomsToRemove []string) govtypes.Content {
+	return &ChangeRewardDenomsProposal{
+		Title:          title,
+		Description:    description,
+		DenomsToAdd:    denomsToAdd,
+		DenomsToRemove: denomsToRemove,
+	}
+}
+
+// ProposalRoute returns the routing key of a change reward denoms proposal.
+func (crdp *ChangeRewardDenomsProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a change reward denoms proposal.
+func (crdp *ChangeRewardDenomsProposal) ProposalType() string {
+	return ProposalTypeChangeRewardDenoms
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (crdp *ChangeRewardDenomsProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(crdp); err != nil {
+		return err
+	}
+
+	if len(crdp.DenomsToAdd) == 0 && len(crdp.DenomsToRemove) == 0 {
+		return sdkerrors.Wrap(ErrInvalidChangeRewardDenomsProp, "no denoms to add or to remove")
+	}
+	seen := make(map[string]bool)
+	for _, denom := range append(append([]string{}, crdp.DenomsToAdd...), crdp.DenomsToRemove...) {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidChangeRewardDenomsProp, "invalid denom %s: %s", denom, err)
+		}
+		if seen[denom] {
+			return sdkerrors.Wrapf(ErrInvalidChangeRewardDenomsProp, "denom %s is listed more than once", denom)
+		}
+		seen[denom] = true
+	}
+	return nil
+}
+
+// NewConsumerPauseProposal creates a new consumer pause proposal.
+func NewConsumerPauseProposal(title, description, chainID string, pause bool) govtypes.Content {
+	return &ConsumerPauseProposal{
+		Title:       title,
+		Description: description,
+		ChainId:     chainID,
+		Pause:       pause,
+	}
+}
+
+// ProposalRoute returns the routing key of a consumer pause proposal.
+func (cpp *ConsumerPauseProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a consumer pause proposal.
+func (cpp *ConsumerPauseProposal) ProposalType() string {
+	return ProposalTypeConsumerPause
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (cpp *ConsumerPauseProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(cpp); err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(cpp.ChainId) == "" {
+		return sdkerrors.Wrap(ErrInvalidConsumerPauseProp, "consumer chain id must not be blank")
+	}
+	return nil
+}
+
+// NewConsumerMetadataUpdateProposal creates a new consumer metadata update proposal.
+func NewConsumerMetadataUpdateProposal(title, description, chainID string, metadata ConsumerMetadata) govtypes.Content {
+	return &ConsumerMetadataUpdateProposal{
+		Title:       title,
+		Description: description,
+		ChainId:     chainID,
+		Metadata:    metadata,
+	}
+}
+
+// ProposalRoute returns the routing key of a consumer metadata update proposal.
+func (mup *ConsumerMetadataUpdateProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a consumer metadata update proposal.
+func (mup *ConsumerMetadataUpdateProposal) ProposalType() string {
+	return ProposalTypeMetadataUpdate
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (mup *ConsumerMetadataUpdateProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(mup); err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(mup.ChainId) == "" {
+		return sdkerrors.Wrap(ErrInvalidConsumerMetadataUpdateProp, "consumer chain id must not be blank")
+	}
+
+	if err := mup.Metadata.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidConsumerMetadataUpdateProp, err.Error())
+	}
+	return nil
+}
+
+// NewChangeConsumerRewardFractionProposal creates a new change consumer reward fraction proposal.
+func NewChangeConsumerRewardFractionProposal(title, description, chainID, consumerRedistributeFraction string) govtypes.Content {
+	return &ChangeConsumerRewardFractionProposal{
+		Title:                        title,
+		Description:                  description,
+		ChainId:                      chainID,
+		ConsumerRedistributeFraction: consumerRedistributeFraction,
+	}
+}
+
+// ProposalRoute returns the routing key of a change consumer reward fraction proposal.
+func (crfp *ChangeConsumerRewardFractionProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a change consumer reward fraction proposal.
+func (crfp *ChangeConsumerRewardFractionProposal) ProposalType() string {
+	return ProposalTypeChangeRewardFraction
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (crfp *ChangeConsumerRewardFractionProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(crfp); err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(crfp.ChainId) == "" {
+		return sdkerrors.Wrap(ErrInvalidChangeRewardFractionProp, "consumer chain id must not be blank")
+	}
+
+	if err := ccvtypes.ValidateStringFraction(crfp.ConsumerRedistributeFraction); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidChangeRewardFractionProp, "consumer redistribute fraction is invalid: %s", err)
+	}
+	return nil
+}
+
+// ParseValidatorList parses the given bech32 consensus addresses of provider validators,
+// as used by the validator allowlist and denylist of a consumer chain
+func ParseValidatorList(addrs []string) ([]ProviderConsAddress, error) {
+	providerAddrs := make([]ProviderConsAddress, 0, len(addrs))
+	for _, addr := range addrs {
+		consAddr, err := sdk.ConsAddressFromBech32(addr)
+		if err != nil {
+			return nil, err
+		}
+		providerAddrs = append(providerAddrs, NewProviderConsAddress(consAddr))
+	}
+	return providerAddrs, nil
+}
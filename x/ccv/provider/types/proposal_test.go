@@ -2,6 +2,7 @@ package types_test
 
 import (
 	fmt "fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -12,11 +13,19 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	ibctmtypes "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
 	"github.com/cosmos/interchain-security/x/ccv/provider/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+// SHA-256 hashes used as the genesis and binary hashes of consumer addition proposals
+var (
+	genHash = tmhash.Sum([]byte("gen_hash"))
+	binHash = tmhash.Sum([]byte("bin_hash"))
 )
 
 func TestConsumerAdditionProposalValidateBasic(t *testing.T) {
@@ -29,7 +38,7 @@ func TestConsumerAdditionProposalValidateBasic(t *testing.T) {
 	}{
 		{
 			"success",
-			types.NewConsumerAdditionProposal("title", "description", "chainID", initialHeight, []byte("gen_hash"), []byte("bin_hash"), time.Now(),
+			types.NewConsumerAdditionProposal("title", "description", "chainID", initialHeight, genHash, binHash, time.Now(),
 				"0.75",
 				10,
 				10000,
@@ -41,7 +50,7 @@ func TestConsumerAdditionProposalValidateBasic(t *testing.T) {
 		},
 		{
 			"success with 0.0 fraction",
-			types.NewConsumerAdditionProposal("title", "description", "chainID", initialHeight, []byte("gen_hash"), []byte("bin_hash"), time.Now(),
+			types.NewConsumerAdditionProposal("title", "description", "chainID", initialHeight, genHash, binHash, time.Now(),
 				"0.0", // fraction can be 0.0 but not empty
 				10,
 				10000,
@@ -52,7 +61,7 @@ func TestConsumerAdditionProposalValidateBasic(t *testing.T) {
 		},
 		{
 			"fails validate abstract - empty title",
-			types.NewConsumerAdditionProposal(" ", "description", "chainID", initialHeight, []byte("gen_hash"), []byte("bin_hash"), time.Now(),
+			types.NewConsumerAdditionProposal(" ", "description", "chainID", initialHeight, genHash, binHash, time.Now(),
 				"0.75",
 				10,
 				10000,
@@ -63,7 +72,7 @@ func TestConsumerAdditionProposalValidateBasic(t *testing.T) {
 		},
 		{
 			"chainID is empty",
-			types.NewConsumerAdditionProposal("title", "description", " ", initialHeight, []byte("gen_hash"), []byte("bin_hash"), time.Now(),
+			types.NewConsumerAdditionProposal("title", "description", " ", initialHeight, genHash, binHash, time.Now(),
 				"0.75",
 				10,
 				10000,
@@ -79,8 +88,8 @@ func TestConsumerAdditionProposalValidateBasic(t *testing.T) {
 				Description:                       "description",
 				ChainId:                           "chainID",
 				InitialHeight:                     clienttypes.Height{},
-				GenesisHash:                       []byte("gen_hash"),
-				BinaryHash:                        []byte("bin_hash"),
+				GenesisHash:                       genHash,
+				BinaryHash:                        binHash,
 				SpawnTime:                         time.Now(),
 				BlocksPerDistributionTransmission: 10,
 				CcvTimeoutPeriod:                  100000000000,
@@ -91,9 +100,64 @@ func TestConsumerAdditionProposalValidateBasic(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"initial height revision height is zero",
+			types.NewConsumerAdditionProposal("title", "description", "chainID", clienttypes.NewHeight(2, 0), genHash, binHash, time.Now(),
+				"0.75",
+				10,
+				10000,
+				100000000000,
+				100000000000,
+				100000000000),
+			false,
+		},
+		{
+			"success with spawn time in the past",
+			types.NewConsumerAdditionProposal("title", "description", "chainID", initialHeight, genHash, binHash, time.Now().Add(-time.Hour),
+				"0.75",
+				10,
+				10000,
+				100000000000,
+				100000000000,
+				100000000000),
+			true,
+		},
+		{
+			"success with spawn time in the future",
+			types.NewConsumerAdditionProposal("title", "description", "chainID", initialHeight, genHash, binHash, time.Now().Add(time.Hour),
+				"0.75",
+				10,
+				10000,
+				100000000000,
+				100000000000,
+				100000000000),
+			true,
+		},
 		{
 			"genesis hash is empty",
-			types.NewConsumerAdditionProposal("title", "description", "chainID", initialHeight, []byte(""), []byte("bin_hash"), time.Now(),
+			types.NewConsumerAdditionProposal("title", "description", "chainID", initialHeight, []byte(""), binHash, time.Now(),
+				"0.75",
+				10,
+				10000,
+				100000000000,
+				100000000000,
+				100000000000),
+			false,
+		},
+		{
+			"genesis hash is undersized",
+			types.NewConsumerAdditionProposal("title", "description", "chainID", initialHeight, genHash[:31], binHash, time.Now(),
+				"0.75",
+				10,
+				10000,
+				100000000000,
+				100000000000,
+				100000000000),
+			false,
+		},
+		{
+			"genesis hash is oversized",
+			types.NewConsumerAdditionProposal("title", "description", "chainID", initialHeight, make([]byte, 1<<20), binHash, time.Now(),
 				"0.75",
 				10,
 				10000,
@@ -104,7 +168,29 @@ func TestConsumerAdditionProposalValidateBasic(t *testing.T) {
 		},
 		{
 			"binary hash is empty",
-			types.NewConsumerAdditionProposal("title", "description", "chainID", initialHeight, []byte("gen_hash"), []byte(""), time.Now(),
+			types.NewConsumerAdditionProposal("title", "description", "chainID", initialHeight, genHash, []byte(""), time.Now(),
+				"0.75",
+				10,
+				10000,
+				100000000000,
+				100000000000,
+				100000000000),
+			false,
+		},
+		{
+			"binary hash is undersized",
+			types.NewConsumerAdditionProposal("title", "description", "chainID", initialHeight, genHash, []byte("bin_hash"), time.Now(),
+				"0.75",
+				10,
+				10000,
+				100000000000,
+				100000000000,
+				100000000000),
+			false,
+		},
+		{
+			"binary hash is oversized",
+			types.NewConsumerAdditionProposal("title", "description", "chainID", initialHeight, genHash, append(binHash, 0), time.Now(),
 				"0.75",
 				10,
 				10000,
@@ -113,9 +199,31 @@ func TestConsumerAdditionProposalValidateBasic(t *testing.T) {
 				100000000000),
 			false,
 		},
+		{
+			"chain id is too long",
+			types.NewConsumerAdditionProposal("title", "description", strings.Repeat("c", 51), initialHeight, genHash, binHash, time.Now(),
+				"0.75",
+				10,
+				10000,
+				100000000000,
+				100000000000,
+				100000000000),
+			false,
+		},
+		{
+			"chain id of maximum length",
+			types.NewConsumerAdditionProposal("title", "description", strings.Repeat("c", 50), initialHeight, genHash, binHash, time.Now(),
+				"0.75",
+				10,
+				10000,
+				100000000000,
+				100000000000,
+				100000000000),
+			true,
+		},
 		{
 			"spawn time is zero",
-			types.NewConsumerAdditionProposal("title", "description", "chainID", initialHeight, []byte("gen_hash"), []byte("bin_hash"), time.Time{},
+			types.NewConsumerAdditionProposal("title", "description", "chainID", initialHeight, genHash, binHash, time.Time{},
 				"0.75",
 				10,
 				10000,
@@ -126,7 +234,7 @@ func TestConsumerAdditionProposalValidateBasic(t *testing.T) {
 		},
 		{
 			"consumer redistribution fraction is invalid",
-			types.NewConsumerAdditionProposal("title", "description", "chainID", initialHeight, []byte("gen_hash"), []byte("bin_hash"), time.Now(),
+			types.NewConsumerAdditionProposal("title", "description", "chainID", initialHeight, genHash, binHash, time.Now(),
 				"", // fraction can be 0.0 but not empty
 				10,
 				10000,
@@ -137,7 +245,7 @@ func TestConsumerAdditionProposalValidateBasic(t *testing.T) {
 		},
 		{
 			"blocks per distribution transmission is invalid",
-			types.NewConsumerAdditionProposal("title", "description", "chainID", initialHeight, []byte("gen_hash"), []byte("bin_hash"), time.Now(),
+			types.NewConsumerAdditionProposal("title", "description", "chainID", initialHeight, genHash, binHash, time.Now(),
 				"0.75",
 				0,
 				100000000000,
@@ -148,7 +256,7 @@ func TestConsumerAdditionProposalValidateBasic(t *testing.T) {
 		},
 		{
 			"historical entries is invalid",
-			types.NewConsumerAdditionProposal("title", "description", "chainID", initialHeight, []byte("gen_hash"), []byte("bin_hash"), time.Now(),
+			types.NewConsumerAdditionProposal("title", "description", "chainID", initialHeight, genHash, binHash, time.Now(),
 				"0.75",
 				10,
 				-2,
@@ -159,7 +267,7 @@ func TestConsumerAdditionProposalValidateBasic(t *testing.T) {
 		},
 		{
 			"ccv timeout period is invalid",
-			types.NewConsumerAdditionProposal("title", "description", "chainID", initialHeight, []byte("gen_hash"), []byte("bin_hash"), time.Now(),
+			types.NewConsumerAdditionProposal("title", "description", "chainID", initialHeight, genHash, binHash, time.Now(),
 				"0.75",
 				10,
 				10000,
@@ -170,7 +278,7 @@ func TestConsumerAdditionProposalValidateBasic(t *testing.T) {
 		},
 		{
 			"transfer timeout period is invalid",
-			types.NewConsumerAdditionProposal("title", "description", "chainID", initialHeight, []byte("gen_hash"), []byte("bin_hash"), time.Now(),
+			types.NewConsumerAdditionProposal("title", "description", "chainID", initialHeight, genHash, binHash, time.Now(),
 				"0.75",
 				10,
 				10000,
@@ -181,7 +289,7 @@ func TestConsumerAdditionProposalValidateBasic(t *testing.T) {
 		},
 		{
 			"unbonding period is invalid",
-			types.NewConsumerAdditionProposal("title", "description", "chainID", initialHeight, []byte("gen_hash"), []byte("bin_hash"), time.Now(),
+			types.NewConsumerAdditionProposal("title", "description", "chainID", initialHeight, genHash, binHash, time.Now(),
 				"0.75",
 				10,
 				10000,
@@ -190,6 +298,81 @@ func TestConsumerAdditionProposalValidateBasic(t *testing.T) {
 				0),
 			false,
 		},
+		{
+			"valid preferred reward denom",
+			func() *types.ConsumerAdditionProposal {
+				prop := types.NewConsumerAdditionProposal("title", "description", "chainID", initialHeight, genHash, binHash, time.Now(),
+					"0.75",
+					10,
+					10000,
+					100000000000,
+					100000000000,
+					100000000000).(*types.ConsumerAdditionProposal)
+				prop.PreferredRewardDenom = "ucon"
+				return prop
+			}(),
+			true,
+		},
+		{
+			"preferred reward denom is invalid",
+			func() *types.ConsumerAdditionProposal {
+				prop := types.NewConsumerAdditionProposal("title", "description", "chainID", initialHeight, genHash, binHash, time.Now(),
+					"0.75",
+					10,
+					10000,
+					100000000000,
+					100000000000,
+					100000000000).(*types.ConsumerAdditionProposal)
+				prop.PreferredRewardDenom = "1!con"
+				return prop
+			}(),
+			false,
+		},
+		{
+			"valid downtime jail duration",
+			func() *types.ConsumerAdditionProposal {
+				prop := types.NewConsumerAdditionProposal("title", "description", "chainID", initialHeight, genHash, binHash, time.Now(),
+					"0.75",
+					10,
+					10000,
+					100000000000,
+					100000000000,
+					100000000000).(*types.ConsumerAdditionProposal)
+				prop.DowntimeJailDuration = 24 * time.Hour
+				return prop
+			}(),
+			true,
+		},
+		{
+			"downtime jail duration is negative",
+			func() *types.ConsumerAdditionProposal {
+				prop := types.NewConsumerAdditionProposal("title", "description", "chainID", initialHeight, genHash, binHash, time.Now(),
+					"0.75",
+					10,
+					10000,
+					100000000000,
+					100000000000,
+					100000000000).(*types.ConsumerAdditionProposal)
+				prop.DowntimeJailDuration = -time.Second
+				return prop
+			}(),
+			false,
+		},
+		{
+			"consumer metadata name is oversized",
+			func() *types.ConsumerAdditionProposal {
+				prop := types.NewConsumerAdditionProposal("title", "description", "chainID", initialHeight, genHash, binHash, time.Now(),
+					"0.75",
+					10,
+					10000,
+					100000000000,
+					100000000000,
+					100000000000).(*types.ConsumerAdditionProposal)
+				prop.Metadata = &types.ConsumerMetadata{Name: strings.Repeat("a", types.MaxConsumerMetadataNameLength+1)}
+				return prop
+			}(),
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -204,7 +387,7 @@ func TestConsumerAdditionProposalValidateBasic(t *testing.T) {
 }
 
 func TestMarshalConsumerAdditionProposal(t *testing.T) {
-	content := types.NewConsumerAdditionProposal("title", "description", "chainID", clienttypes.NewHeight(0, 1), []byte("gen_hash"), []byte("bin_hash"), time.Now().UTC(),
+	content := types.NewConsumerAdditionProposal("title", "description", "chainID", clienttypes.NewHeight(0, 1), genHash, binHash, time.Now().UTC(),
 		"0.75",
 		10,
 		10000,
@@ -243,8 +426,8 @@ func TestConsumerAdditionProposalString(t *testing.T) {
 		"description",
 		"chainID",
 		initialHeight,
-		[]byte("gen_hash"),
-		[]byte("bin_hash"),
+		genHash,
+		binHash,
 		spawnTime,
 		"0.75",
 		10001,
@@ -266,13 +449,27 @@ func TestConsumerAdditionProposalString(t *testing.T) {
 	HistoricalEntries: %d
 	CcvTimeoutPeriod: %d
 	TransferTimeoutPeriod: %d
-	UnbondingPeriod: %d`, initialHeight, []byte("gen_hash"), []byte("bin_hash"), spawnTime,
+	UnbondingPeriod: %d
+	SendSlashConfirmations: %t
+	PreferredRewardDenom: %s
+	SlashDoubleSigns: %t
+	ValidatorAllowlist: %v
+	ValidatorDenylist: %v
+	DowntimeJailDuration: %s
+	TopN: %d`, initialHeight, genHash, binHash, spawnTime,
 		"0.75",
 		10001,
 		500000,
 		100000000000,
 		10000000000,
-		100000000000)
+		100000000000,
+		false,
+		"",
+		false,
+		[]string(nil),
+		[]string(nil),
+		time.Duration(0),
+		0)
 
 	require.Equal(t, expect, proposal.String(), "string method for ConsumerAdditionProposal returned unexpected string")
 }
@@ -332,3 +529,436 @@ func TestEquivocationProposalValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestConsumerValidatorListsProposalValidateBasic(t *testing.T) {
+	validAddr := sdk.ConsAddress([]byte("validator_address_1")).String()
+
+	tests := []struct {
+		name          string
+		proposal      govtypes.Content
+		expectedError bool
+	}{
+		{
+			name:          "fail: validate abstract - empty title",
+			proposal:      types.NewConsumerValidatorListsProposal("", "desc", "chainID", nil, nil),
+			expectedError: true,
+		},
+		{
+			name:          "fail: blank chain id",
+			proposal:      types.NewConsumerValidatorListsProposal("title", "desc", " ", nil, nil),
+			expectedError: true,
+		},
+		{
+			name:          "fail: invalid allowlist address",
+			proposal:      types.NewConsumerValidatorListsProposal("title", "desc", "chainID", []string{"addr"}, nil),
+			expectedError: true,
+		},
+		{
+			name:          "fail: invalid denylist address",
+			proposal:      types.NewConsumerValidatorListsProposal("title", "desc", "chainID", nil, []string{validAddr, "addr"}),
+			expectedError: true,
+		},
+		{
+			name:     "ok: empty lists",
+			proposal: types.NewConsumerValidatorListsProposal("title", "desc", "chainID", nil, nil),
+		},
+		{
+			name:     "ok",
+			proposal: types.NewConsumerValidatorListsProposal("title", "desc", "chainID", []string{validAddr}, []string{validAddr}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.proposal.ValidateBasic()
+			if tt.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestConsumerParametersUpdateProposalValidateBasic(t *testing.T) {
+	tests := []struct {
+		name          string
+		proposal      govtypes.Content
+		expectedError bool
+	}{
+		{
+			name:          "fail: validate abstract - empty title",
+			proposal:      types.NewConsumerParametersUpdateProposal("", "desc", "chainID", "0.75", "0.05", nil),
+			expectedError: true,
+		},
+		{
+			name:          "fail: blank chain id",
+			proposal:      types.NewConsumerParametersUpdateProposal("title", "desc", " ", "0.75", "0.05", nil),
+			expectedError: true,
+		},
+		{
+			name:          "fail: invalid consumer redistribute fraction",
+			proposal:      types.NewConsumerParametersUpdateProposal("title", "desc", "chainID", "1.1", "0.05", nil),
+			expectedError: true,
+		},
+		{
+			name:          "fail: no consumer parameter updated",
+			proposal:      types.NewConsumerParametersUpdateProposal("title", "desc", "chainID", "", "", nil),
+			expectedError: true,
+		},
+		{
+			name:          "fail: soft opt out threshold above the maximum",
+			proposal:      types.NewConsumerParametersUpdateProposal("title", "desc", "chainID", "0.75", "0.21", nil),
+			expectedError: true,
+		},
+		{
+			name: "fail: invalid expected rewards per window",
+			proposal: types.NewConsumerParametersUpdateProposal("title", "desc", "chainID", "0.75", "0.05",
+				sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)}}),
+			expectedError: true,
+		},
+		{
+			name: "ok: expected rewards per window",
+			proposal: types.NewConsumerParametersUpdateProposal("title", "desc", "chainID", "0.75", "0.05",
+				sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))),
+		},
+		{
+			name:     "ok: only the soft opt out threshold updated",
+			proposal: types.NewConsumerParametersUpdateProposal("title", "desc", "chainID", "", "0.05", nil),
+		},
+		{
+			name:     "ok: soft opt out disabled",
+			proposal: types.NewConsumerParametersUpdateProposal("title", "desc", "chainID", "1", "0", nil),
+		},
+		{
+			name:     "ok",
+			proposal: types.NewConsumerParametersUpdateProposal("title", "desc", "chainID", "0.75", "0.05", nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.proposal.ValidateBasic()
+			if tt.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestForceCompleteUnbondingProposalValidateBasic(t *testing.T) {
+	tests := []struct {
+		name          string
+		proposal      govtypes.Content
+		expectedError bool
+	}{
+		{
+			name:          "fail: validate abstract - empty title",
+			proposal:      types.NewForceCompleteUnbondingProposal("", "desc", "chainID"),
+			expectedError: true,
+		},
+		{
+			name:          "fail: blank chain id",
+			proposal:      types.NewForceCompleteUnbondingProposal("title", "desc", " "),
+			expectedError: true,
+		},
+		{
+			name:     "ok",
+			proposal: types.NewForceCompleteUnbondingProposal("title", "desc", "chainID"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.proposal.ValidateBasic()
+			if tt.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestConsumerAdditionCancellationProposalValidateBasic(t *testing.T) {
+	tests := []struct {
+		name          string
+		proposal      govtypes.Content
+		expectedError bool
+	}{
+		{
+			name:          "fail: validate abstract - empty title",
+			proposal:      types.NewConsumerAdditionCancellationProposal("", "desc", "chainID"),
+			expectedError: true,
+		},
+		{
+			name:          "fail: blank chain id",
+			proposal:      types.NewConsumerAdditionCancellationProposal("title", "desc", " "),
+			expectedError: true,
+		},
+		{
+			name:     "ok",
+			proposal: types.NewConsumerAdditionCancellationProposal("title", "desc", "chainID"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.proposal.ValidateBasic()
+			if tt.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestChangeRewardDenomsProposalValidateBasic(t *testing.T) {
+	ibcDenom := "ibc/3C3D7B3BE4ECC85A0E5B52A3AEC3B7DFC2AA9CA47C37821E57020D6807043BE9"
+	tests := []struct {
+		name          string
+		proposal      govtypes.Content
+		expectedError bool
+	}{
+		{
+			name:          "fail: validate abstract - empty title",
+			proposal:      types.NewChangeRewardDenomsProposal("", "desc", []string{ibcDenom}, nil),
+			expectedError: true,
+		},
+		{
+			name:          "fail: no denoms to add or to remove",
+			proposal:      types.NewChangeRewardDenomsProposal("title", "desc", nil, nil),
+			expectedError: true,
+		},
+		{
+			name:          "fail: invalid denom to add",
+			proposal:      types.NewChangeRewardDenomsProposal("title", "desc", []string{"!denom"}, nil),
+			expectedError: true,
+		},
+		{
+			name:          "fail: invalid denom to remove",
+			proposal:      types.NewChangeRewardDenomsProposal("title", "desc", nil, []string{""}),
+			expectedError: true,
+		},
+		{
+			name:          "fail: duplicate denom to add",
+			proposal:      types.NewChangeRewardDenomsProposal("title", "desc", []string{ibcDenom, ibcDenom}, nil),
+			expectedError: true,
+		},
+		{
+			name:          "fail: denom both added and removed",
+			proposal:      types.NewChangeRewardDenomsProposal("title", "desc", []string{ibcDenom}, []string{ibcDenom}),
+			expectedError: true,
+		},
+		{
+			name:     "ok",
+			proposal: types.NewChangeRewardDenomsProposal("title", "desc", []string{ibcDenom}, []string{"stake"}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.proposal.ValidateBasic()
+			if tt.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestConsumerPauseProposalValidateBasic(t *testing.T) {
+	tests := []struct {
+		name          string
+		proposal      govtypes.Content
+		expectedError bool
+	}{
+		{
+			name:          "fail: validate abstract - empty title",
+			proposal:      types.NewConsumerPauseProposal("", "desc", "chainID", true),
+			expectedError: true,
+		},
+		{
+			name:          "fail: blank chain id",
+			proposal:      types.NewConsumerPauseProposal("title", "desc", " ", true),
+			expectedError: true,
+		},
+		{
+			name:     "ok: pause",
+			proposal: types.NewConsumerPauseProposal("title", "desc", "chainID", true),
+		},
+		{
+			name:     "ok: resume",
+			proposal: types.NewConsumerPauseProposal("title", "desc", "chainID", false),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.proposal.ValidateBasic()
+			if tt.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestConsumerMetadataUpdateProposalValidateBasic(t *testing.T) {
+	metadata := types.ConsumerMetadata{
+		Name:        "FooChain",
+		Description: "Gonna be a great chain",
+		Website:     "https://foochain.io",
+		Contact:     "team@foochain.io",
+	}
+	withMetadata := func(update func(*types.ConsumerMetadata)) types.ConsumerMetadata {
+		m := metadata
+		update(&m)
+		return m
+	}
+
+	tests := []struct {
+		name          string
+		proposal      govtypes.Content
+		expectedError bool
+	}{
+		{
+			name:          "fail: validate abstract - empty title",
+			proposal:      types.NewConsumerMetadataUpdateProposal("", "desc", "chainID", metadata),
+			expectedError: true,
+		},
+		{
+			name:          "fail: blank chain id",
+			proposal:      types.NewConsumerMetadataUpdateProposal("title", "desc", " ", metadata),
+			expectedError: true,
+		},
+		{
+			name: "fail: oversized name",
+			proposal: types.NewConsumerMetadataUpdateProposal("title", "desc", "chainID", withMetadata(func(m *types.ConsumerMetadata) {
+				m.Name = strings.Repeat("a", types.MaxConsumerMetadataNameLength+1)
+			})),
+			expectedError: true,
+		},
+		{
+			name: "fail: oversized description",
+			proposal: types.NewConsumerMetadataUpdateProposal("title", "desc", "chainID", withMetadata(func(m *types.ConsumerMetadata) {
+				m.Description = strings.Repeat("a", types.MaxConsumerMetadataDescriptionLength+1)
+			})),
+			expectedError: true,
+		},
+		{
+			name: "fail: oversized website",
+			proposal: types.NewConsumerMetadataUpdateProposal("title", "desc", "chainID", withMetadata(func(m *types.ConsumerMetadata) {
+				m.Website = "https://" + strings.Repeat("a", types.MaxConsumerMetadataWebsiteLength) + ".io"
+			})),
+			expectedError: true,
+		},
+		{
+			name: "fail: oversized contact",
+			proposal: types.NewConsumerMetadataUpdateProposal("title", "desc", "chainID", withMetadata(func(m *types.ConsumerMetadata) {
+				m.Contact = strings.Repeat("a", types.MaxConsumerMetadataContactLength+1)
+			})),
+			expectedError: true,
+		},
+		{
+			name: "fail: website is not a URL",
+			proposal: types.NewConsumerMetadataUpdateProposal("title", "desc", "chainID", withMetadata(func(m *types.ConsumerMetadata) {
+				m.Website = "foochain.io"
+			})),
+			expectedError: true,
+		},
+		{
+			name: "fail: website is not an HTTP URL",
+			proposal: types.NewConsumerMetadataUpdateProposal("title", "desc", "chainID", withMetadata(func(m *types.ConsumerMetadata) {
+				m.Website = "ftp://foochain.io"
+			})),
+			expectedError: true,
+		},
+		{
+			name: "ok: fields of maximum length",
+			proposal: types.NewConsumerMetadataUpdateProposal("title", "desc", "chainID", withMetadata(func(m *types.ConsumerMetadata) {
+				m.Name = strings.Repeat("a", types.MaxConsumerMetadataNameLength)
+				m.Description = strings.Repeat("a", types.MaxConsumerMetadataDescriptionLength)
+				m.Contact = strings.Repeat("a", types.MaxConsumerMetadataContactLength)
+			})),
+		},
+		{
+			name:     "ok: empty metadata",
+			proposal: types.NewConsumerMetadataUpdateProposal("title", "desc", "chainID", types.ConsumerMetadata{}),
+		},
+		{
+			name:     "ok",
+			proposal: types.NewConsumerMetadataUpdateProposal("title", "desc", "chainID", metadata),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.proposal.ValidateBasic()
+			if tt.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestChangeConsumerRewardFractionProposalValidateBasic(t *testing.T) {
+	tests := []struct {
+		name          string
+		proposal      govtypes.Content
+		expectedError bool
+	}{
+		{
+			name:          "fail: validate abstract - empty title",
+			proposal:      types.NewChangeConsumerRewardFractionProposal("", "desc", "chainID", "0.5"),
+			expectedError: true,
+		},
+		{
+			name:          "fail: blank chain id",
+			proposal:      types.NewChangeConsumerRewardFractionProposal("title", "desc", " ", "0.5"),
+			expectedError: true,
+		},
+		{
+			name:          "fail: empty fraction",
+			proposal:      types.NewChangeConsumerRewardFractionProposal("title", "desc", "chainID", ""),
+			expectedError: true,
+		},
+		{
+			name:          "fail: fraction is not a decimal",
+			proposal:      types.NewChangeConsumerRewardFractionProposal("title", "desc", "chainID", "half"),
+			expectedError: true,
+		},
+		{
+			name:          "fail: negative fraction",
+			proposal:      types.NewChangeConsumerRewardFractionProposal("title", "desc", "chainID", "-0.1"),
+			expectedError: true,
+		},
+		{
+			name:          "fail: fraction greater than 1",
+			proposal:      types.NewChangeConsumerRewardFractionProposal("title", "desc", "chainID", "1.000000000000000001"),
+			expectedError: true,
+		},
+		{
+			name:     "ok: zero fraction",
+			proposal: types.NewChangeConsumerRewardFractionProposal("title", "desc", "chainID", "0"),
+		},
+		{
+			name:     "ok: fraction of 1",
+			proposal: types.NewChangeConsumerRewardFractionProposal("title", "desc", "chainID", "1"),
+		},
+		{
+			name:     "ok",
+			proposal: types.NewChangeConsumerRewardFractionProposal("title", "desc", "chainID", "0.5"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.proposal.ValidateBasic()
+			if tt.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
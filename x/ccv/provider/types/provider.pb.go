@@ -5,9 +5,13 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types2 "github.com/cosmos/cosmos-sdk/types"
 	types1 "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	types4 "github.com/cosmos/cosmos-sdk/x/staking/types"
 	types "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
-	types2 "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
+	types3 "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
+	types5 "github.com/cosmos/interchain-security/x/ccv/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
@@ -32,6 +36,46 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ConsumerPhase is the phase of the lifecycle a consumer chain is in
+type ConsumerPhase int32
+
+const (
+	// UNSPECIFIED phase
+	ConsumerPhaseUnspecified ConsumerPhase = 0
+	// the consumer addition proposal passed and the spawn time is not yet reached
+	ConsumerPhasePending ConsumerPhase = 1
+	// the consumer client is created and the CCV channel is not yet established
+	ConsumerPhaseInitialized ConsumerPhase = 2
+	// the CCV channel is established
+	ConsumerPhaseLaunched ConsumerPhase = 3
+	// the consumer removal proposal passed and the stop time is not yet reached
+	ConsumerPhaseStopping ConsumerPhase = 4
+)
+
+var ConsumerPhase_name = map[int32]string{
+	0: "CONSUMER_PHASE_UNSPECIFIED",
+	1: "CONSUMER_PHASE_PENDING",
+	2: "CONSUMER_PHASE_INITIALIZED",
+	3: "CONSUMER_PHASE_LAUNCHED",
+	4: "CONSUMER_PHASE_STOPPING",
+}
+
+var ConsumerPhase_value = map[string]int32{
+	"CONSUMER_PHASE_UNSPECIFIED": 0,
+	"CONSUMER_PHASE_PENDING":     1,
+	"CONSUMER_PHASE_INITIALIZED": 2,
+	"CONSUMER_PHASE_LAUNCHED":    3,
+	"CONSUMER_PHASE_STOPPING":    4,
+}
+
+func (x ConsumerPhase) String() string {
+	return proto.EnumName(ConsumerPhase_name, int32(x))
+}
+
+func (ConsumerPhase) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{0}
+}
+
 // ConsumerAdditionProposal is a governance proposal on the provider chain to spawn a new consumer chain.
 // If it passes, then all validators on the provider chain are expected to validate the consumer chain at spawn time
 // or get slashed. It is recommended that spawn time occurs after the proposal end time.
@@ -73,6 +117,32 @@ type ConsumerAdditionProposal struct {
 	// This param is a part of the cosmos sdk staking module. In the case of
 	// a ccv enabled consumer chain, the ccv module acts as the staking module.
 	HistoricalEntries int64 `protobuf:"varint,13,opt,name=historical_entries,json=historicalEntries,proto3" json:"historical_entries,omitempty"`
+	// If true, the provider sends a confirmation packet back to the consumer
+	// right after a slash request from the consumer was handled,
+	// instead of acknowledging the slash within the next VSC packet.
+	SendSlashConfirmations bool `protobuf:"varint,14,opt,name=send_slash_confirmations,json=sendSlashConfirmations,proto3" json:"send_slash_confirmations,omitempty"`
+	// The denom under which the provider tracks the rewards received from the consumer chain.
+	// If set, the IBC vouchers of this denom received as rewards from the consumer chain
+	// are labelled with this denom.
+	PreferredRewardDenom string `protobuf:"bytes,15,opt,name=preferred_reward_denom,json=preferredRewardDenom,proto3" json:"preferred_reward_denom,omitempty"`
+	// If true, the provider applies the double-sign slash packets received from the consumer chain,
+	// i.e., the validator is slashed, jailed and tombstoned. Otherwise, double-sign slash packets
+	// are only recorded in the slash log and must be executed via an equivocation proposal.
+	SlashDoubleSigns bool `protobuf:"varint,16,opt,name=slash_double_signs,json=slashDoubleSigns,proto3" json:"slash_double_signs,omitempty"`
+	// The consensus addresses of the provider validators allowed to validate the consumer chain.
+	// If not empty, only the listed validators are included in the consumer validator set.
+	ValidatorAllowlist []string `protobuf:"bytes,17,rep,name=validator_allowlist,json=validatorAllowlist,proto3" json:"validator_allowlist,omitempty"`
+	// The consensus addresses of the provider validators excluded from the consumer validator set.
+	ValidatorDenylist []string `protobuf:"bytes,18,rep,name=validator_denylist,json=validatorDenylist,proto3" json:"validator_denylist,omitempty"`
+	// The duration for which the validators are jailed for a downtime infraction on the consumer chain.
+	// If zero, the downtime jail duration of the provider slashing module applies.
+	DowntimeJailDuration time.Duration `protobuf:"bytes,19,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration"`
+	// The number of provider validators with the largest powers that validate the consumer chain.
+	// The other validators are opted out of validating it. If zero, all the validators validate it.
+	TopN uint32 `protobuf:"varint,20,opt,name=top_n,json=topN,proto3" json:"top_n,omitempty"`
+	// The descriptive metadata of the consumer chain, e.g., its name and website.
+	// It can be updated by a consumer metadata update proposal.
+	Metadata *ConsumerMetadata `protobuf:"bytes,21,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
 func (m *ConsumerAdditionProposal) Reset()      { *m = ConsumerAdditionProposal{} }
@@ -245,36 +315,35 @@ func (m *EquivocationProposal) GetEquivocations() []*types1.Equivocation {
 	return nil
 }
 
-// A persisted queue entry indicating that a slash packet data instance needs to be handled.
-// This type belongs in the "global" queue, to coordinate slash packet handling times between consumers.
-type GlobalSlashEntry struct {
-	// Block time that slash packet was received by provider chain.
-	// This field is used for store key iteration ordering.
-	RecvTime time.Time `protobuf:"bytes,1,opt,name=recv_time,json=recvTime,proto3,stdtime" json:"recv_time"`
-	// The consumer that sent a slash packet.
-	ConsumerChainID string `protobuf:"bytes,2,opt,name=consumer_chain_id,json=consumerChainId,proto3" json:"consumer_chain_id,omitempty"`
-	// The IBC sequence number of the recv packet.
-	// This field is used in the store key to ensure uniqueness.
-	IbcSeqNum uint64 `protobuf:"varint,3,opt,name=ibc_seq_num,json=ibcSeqNum,proto3" json:"ibc_seq_num,omitempty"`
-	// The provider's consensus address of the validator being slashed.
-	// This field is used to obtain validator power in HandleThrottleQueues.
-	//
-	// This field is not used in the store key, but is persisted in value bytes, see QueueGlobalSlashEntry.
-	ProviderValConsAddr *ProviderConsAddress `protobuf:"bytes,4,opt,name=provider_val_cons_addr,json=providerValConsAddr,proto3" json:"provider_val_cons_addr,omitempty"`
+// ConsumerValidatorListsProposal is a governance proposal on the provider chain to replace
+// the validator allowlist and denylist of a consumer chain.
+// If it passes, the validator set of the consumer chain is updated accordingly
+// in the next validator set change packet.
+type ConsumerValidatorListsProposal struct {
+	// the title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// the description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// the chain-id of the consumer chain
+	ChainId string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// the consensus addresses of the provider validators allowed to validate the consumer chain
+	ValidatorAllowlist []string `protobuf:"bytes,4,rep,name=validator_allowlist,json=validatorAllowlist,proto3" json:"validator_allowlist,omitempty"`
+	// the consensus addresses of the provider validators excluded from the consumer validator set
+	ValidatorDenylist []string `protobuf:"bytes,5,rep,name=validator_denylist,json=validatorDenylist,proto3" json:"validator_denylist,omitempty"`
 }
 
-func (m *GlobalSlashEntry) Reset()         { *m = GlobalSlashEntry{} }
-func (m *GlobalSlashEntry) String() string { return proto.CompactTextString(m) }
-func (*GlobalSlashEntry) ProtoMessage()    {}
-func (*GlobalSlashEntry) Descriptor() ([]byte, []int) {
+func (m *ConsumerValidatorListsProposal) Reset()         { *m = ConsumerValidatorListsProposal{} }
+func (m *ConsumerValidatorListsProposal) String() string { return proto.CompactTextString(m) }
+func (*ConsumerValidatorListsProposal) ProtoMessage()    {}
+func (*ConsumerValidatorListsProposal) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f22ec409a72b7b72, []int{3}
 }
-func (m *GlobalSlashEntry) XXX_Unmarshal(b []byte) error {
+func (m *ConsumerValidatorListsProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GlobalSlashEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ConsumerValidatorListsProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GlobalSlashEntry.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ConsumerValidatorListsProposal.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -284,82 +353,87 @@ func (m *GlobalSlashEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *GlobalSlashEntry) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GlobalSlashEntry.Merge(m, src)
+func (m *ConsumerValidatorListsProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerValidatorListsProposal.Merge(m, src)
 }
-func (m *GlobalSlashEntry) XXX_Size() int {
+func (m *ConsumerValidatorListsProposal) XXX_Size() int {
 	return m.Size()
 }
-func (m *GlobalSlashEntry) XXX_DiscardUnknown() {
-	xxx_messageInfo_GlobalSlashEntry.DiscardUnknown(m)
+func (m *ConsumerValidatorListsProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerValidatorListsProposal.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GlobalSlashEntry proto.InternalMessageInfo
+var xxx_messageInfo_ConsumerValidatorListsProposal proto.InternalMessageInfo
 
-func (m *GlobalSlashEntry) GetRecvTime() time.Time {
+func (m *ConsumerValidatorListsProposal) GetTitle() string {
 	if m != nil {
-		return m.RecvTime
+		return m.Title
 	}
-	return time.Time{}
+	return ""
 }
 
-func (m *GlobalSlashEntry) GetConsumerChainID() string {
+func (m *ConsumerValidatorListsProposal) GetDescription() string {
 	if m != nil {
-		return m.ConsumerChainID
+		return m.Description
 	}
 	return ""
 }
 
-func (m *GlobalSlashEntry) GetIbcSeqNum() uint64 {
+func (m *ConsumerValidatorListsProposal) GetChainId() string {
 	if m != nil {
-		return m.IbcSeqNum
+		return m.ChainId
 	}
-	return 0
+	return ""
 }
 
-func (m *GlobalSlashEntry) GetProviderValConsAddr() *ProviderConsAddress {
+func (m *ConsumerValidatorListsProposal) GetValidatorAllowlist() []string {
 	if m != nil {
-		return m.ProviderValConsAddr
+		return m.ValidatorAllowlist
 	}
 	return nil
 }
 
-// Params defines the parameters for CCV Provider module
-type Params struct {
-	TemplateClient *types2.ClientState `protobuf:"bytes,1,opt,name=template_client,json=templateClient,proto3" json:"template_client,omitempty"`
-	// TrustingPeriodFraction is used to compute the consumer and provider IBC client's TrustingPeriod from the chain defined UnbondingPeriod
-	TrustingPeriodFraction string `protobuf:"bytes,2,opt,name=trusting_period_fraction,json=trustingPeriodFraction,proto3" json:"trusting_period_fraction,omitempty"`
-	// Sent IBC packets will timeout after this duration
-	CcvTimeoutPeriod time.Duration `protobuf:"bytes,3,opt,name=ccv_timeout_period,json=ccvTimeoutPeriod,proto3,stdduration" json:"ccv_timeout_period"`
-	// The channel initialization (IBC channel opening handshake) will timeout after this duration
-	InitTimeoutPeriod time.Duration `protobuf:"bytes,4,opt,name=init_timeout_period,json=initTimeoutPeriod,proto3,stdduration" json:"init_timeout_period"`
-	// The VSC packets sent by the provider will timeout after this duration.
-	// Note that unlike ccv_timeout_period which is an IBC param,
-	// the vsc_timeout_period is a provider-side param that enables the provider
-	// to timeout VSC packets even when a consumer chain is not live.
-	VscTimeoutPeriod time.Duration `protobuf:"bytes,5,opt,name=vsc_timeout_period,json=vscTimeoutPeriod,proto3,stdduration" json:"vsc_timeout_period"`
-	// The period for which the slash meter is replenished
-	SlashMeterReplenishPeriod time.Duration `protobuf:"bytes,6,opt,name=slash_meter_replenish_period,json=slashMeterReplenishPeriod,proto3,stdduration" json:"slash_meter_replenish_period"`
-	// The fraction of total voting power that is replenished to the slash meter every replenish period.
-	// This param also serves as a maximum fraction of total voting power that the slash meter can hold.
-	SlashMeterReplenishFraction string `protobuf:"bytes,7,opt,name=slash_meter_replenish_fraction,json=slashMeterReplenishFraction,proto3" json:"slash_meter_replenish_fraction,omitempty"`
-	// The maximum amount of throttled slash or vsc matured packets
-	// that can be queued for a single consumer before the provider chain halts.
-	MaxThrottledPackets int64 `protobuf:"varint,8,opt,name=max_throttled_packets,json=maxThrottledPackets,proto3" json:"max_throttled_packets,omitempty"`
+func (m *ConsumerValidatorListsProposal) GetValidatorDenylist() []string {
+	if m != nil {
+		return m.ValidatorDenylist
+	}
+	return nil
 }
 
-func (m *Params) Reset()         { *m = Params{} }
-func (m *Params) String() string { return proto.CompactTextString(m) }
-func (*Params) ProtoMessage()    {}
-func (*Params) Descriptor() ([]byte, []int) {
+// ConsumerParametersUpdateProposal is a governance proposal on the provider chain to update
+// the parameters of a running consumer chain, overriding the provider params for that chain.
+// The parameters left empty keep their current values. If it passes, the soft opt out threshold
+// is applied from the next validator set change packet.
+type ConsumerParametersUpdateProposal struct {
+	// the title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// the description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// the chain-id of the consumer chain
+	ChainId string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// the fraction of the rewards allocation of the consumer chain that is distributed to the provider validators;
+	// empty to keep the current fraction
+	ConsumerRedistributeFraction string `protobuf:"bytes,4,opt,name=consumer_redistribute_fraction,json=consumerRedistributeFraction,proto3" json:"consumer_redistribute_fraction,omitempty"`
+	// the fraction of the total voting power held by the validators opted out of validating the consumer chain;
+	// empty to keep the current threshold
+	SoftOptOutThreshold string `protobuf:"bytes,5,opt,name=soft_opt_out_threshold,json=softOptOutThreshold,proto3" json:"soft_opt_out_threshold,omitempty"`
+	// the rewards the consumer chain is expected to send to the provider during every rewards window,
+	// in the denoms under which they are received on the provider; empty to keep the current expected rewards
+	ExpectedRewardsPerWindow github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=expected_rewards_per_window,json=expectedRewardsPerWindow,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"expected_rewards_per_window"`
+}
+
+func (m *ConsumerParametersUpdateProposal) Reset()         { *m = ConsumerParametersUpdateProposal{} }
+func (m *ConsumerParametersUpdateProposal) String() string { return proto.CompactTextString(m) }
+func (*ConsumerParametersUpdateProposal) ProtoMessage()    {}
+func (*ConsumerParametersUpdateProposal) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f22ec409a72b7b72, []int{4}
 }
-func (m *Params) XXX_Unmarshal(b []byte) error {
+func (m *ConsumerParametersUpdateProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ConsumerParametersUpdateProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ConsumerParametersUpdateProposal.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -369,91 +443,85 @@ func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Params) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Params.Merge(m, src)
+func (m *ConsumerParametersUpdateProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerParametersUpdateProposal.Merge(m, src)
 }
-func (m *Params) XXX_Size() int {
+func (m *ConsumerParametersUpdateProposal) XXX_Size() int {
 	return m.Size()
 }
-func (m *Params) XXX_DiscardUnknown() {
-	xxx_messageInfo_Params.DiscardUnknown(m)
+func (m *ConsumerParametersUpdateProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerParametersUpdateProposal.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Params proto.InternalMessageInfo
-
-func (m *Params) GetTemplateClient() *types2.ClientState {
-	if m != nil {
-		return m.TemplateClient
-	}
-	return nil
-}
+var xxx_messageInfo_ConsumerParametersUpdateProposal proto.InternalMessageInfo
 
-func (m *Params) GetTrustingPeriodFraction() string {
+func (m *ConsumerParametersUpdateProposal) GetTitle() string {
 	if m != nil {
-		return m.TrustingPeriodFraction
+		return m.Title
 	}
 	return ""
 }
 
-func (m *Params) GetCcvTimeoutPeriod() time.Duration {
-	if m != nil {
-		return m.CcvTimeoutPeriod
-	}
-	return 0
-}
-
-func (m *Params) GetInitTimeoutPeriod() time.Duration {
+func (m *ConsumerParametersUpdateProposal) GetDescription() string {
 	if m != nil {
-		return m.InitTimeoutPeriod
+		return m.Description
 	}
-	return 0
+	return ""
 }
 
-func (m *Params) GetVscTimeoutPeriod() time.Duration {
+func (m *ConsumerParametersUpdateProposal) GetChainId() string {
 	if m != nil {
-		return m.VscTimeoutPeriod
+		return m.ChainId
 	}
-	return 0
+	return ""
 }
 
-func (m *Params) GetSlashMeterReplenishPeriod() time.Duration {
+func (m *ConsumerParametersUpdateProposal) GetConsumerRedistributeFraction() string {
 	if m != nil {
-		return m.SlashMeterReplenishPeriod
+		return m.ConsumerRedistributeFraction
 	}
-	return 0
+	return ""
 }
 
-func (m *Params) GetSlashMeterReplenishFraction() string {
+func (m *ConsumerParametersUpdateProposal) GetSoftOptOutThreshold() string {
 	if m != nil {
-		return m.SlashMeterReplenishFraction
+		return m.SoftOptOutThreshold
 	}
 	return ""
 }
 
-func (m *Params) GetMaxThrottledPackets() int64 {
+func (m *ConsumerParametersUpdateProposal) GetExpectedRewardsPerWindow() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.MaxThrottledPackets
+		return m.ExpectedRewardsPerWindow
 	}
-	return 0
+	return nil
 }
 
-type HandshakeMetadata struct {
-	ProviderFeePoolAddr string `protobuf:"bytes,1,opt,name=provider_fee_pool_addr,json=providerFeePoolAddr,proto3" json:"provider_fee_pool_addr,omitempty"`
-	Version             string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+// ForceCompleteUnbondingProposal is a governance proposal on the provider chain to stop waiting
+// on a consumer chain that will never mature the outstanding unbonding operations, e.g., since it halted.
+// If it passes, the consumer chain is removed from all the unbonding operations waiting on it,
+// and the unbonding operations that are not waiting on any other consumer chain complete.
+type ForceCompleteUnbondingProposal struct {
+	// the title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// the description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// the chain-id of the consumer chain
+	ChainId string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *HandshakeMetadata) Reset()         { *m = HandshakeMetadata{} }
-func (m *HandshakeMetadata) String() string { return proto.CompactTextString(m) }
-func (*HandshakeMetadata) ProtoMessage()    {}
-func (*HandshakeMetadata) Descriptor() ([]byte, []int) {
+func (m *ForceCompleteUnbondingProposal) Reset()         { *m = ForceCompleteUnbondingProposal{} }
+func (m *ForceCompleteUnbondingProposal) String() string { return proto.CompactTextString(m) }
+func (*ForceCompleteUnbondingProposal) ProtoMessage()    {}
+func (*ForceCompleteUnbondingProposal) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f22ec409a72b7b72, []int{5}
 }
-func (m *HandshakeMetadata) XXX_Unmarshal(b []byte) error {
+func (m *ForceCompleteUnbondingProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *HandshakeMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ForceCompleteUnbondingProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_HandshakeMetadata.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ForceCompleteUnbondingProposal.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -463,50 +531,63 @@ func (m *HandshakeMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *HandshakeMetadata) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HandshakeMetadata.Merge(m, src)
+func (m *ForceCompleteUnbondingProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForceCompleteUnbondingProposal.Merge(m, src)
 }
-func (m *HandshakeMetadata) XXX_Size() int {
+func (m *ForceCompleteUnbondingProposal) XXX_Size() int {
 	return m.Size()
 }
-func (m *HandshakeMetadata) XXX_DiscardUnknown() {
-	xxx_messageInfo_HandshakeMetadata.DiscardUnknown(m)
+func (m *ForceCompleteUnbondingProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForceCompleteUnbondingProposal.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_HandshakeMetadata proto.InternalMessageInfo
+var xxx_messageInfo_ForceCompleteUnbondingProposal proto.InternalMessageInfo
 
-func (m *HandshakeMetadata) GetProviderFeePoolAddr() string {
+func (m *ForceCompleteUnbondingProposal) GetTitle() string {
 	if m != nil {
-		return m.ProviderFeePoolAddr
+		return m.Title
 	}
 	return ""
 }
 
-func (m *HandshakeMetadata) GetVersion() string {
+func (m *ForceCompleteUnbondingProposal) GetDescription() string {
 	if m != nil {
-		return m.Version
+		return m.Description
 	}
 	return ""
 }
 
-// SlashAcks contains cons addresses of consumer chain validators
-// successfully slashed on the provider chain
-type SlashAcks struct {
-	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+func (m *ForceCompleteUnbondingProposal) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
 }
 
-func (m *SlashAcks) Reset()         { *m = SlashAcks{} }
-func (m *SlashAcks) String() string { return proto.CompactTextString(m) }
-func (*SlashAcks) ProtoMessage()    {}
-func (*SlashAcks) Descriptor() ([]byte, []int) {
+// ConsumerAdditionCancellationProposal is a governance proposal on the provider chain to cancel
+// a pending consumer addition proposal, i.e., a consumer addition proposal whose spawn time did not elapse yet.
+// If it passes, the pending consumer addition proposal is removed and the consumer chain is not spawned.
+type ConsumerAdditionCancellationProposal struct {
+	// the title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// the description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// the chain-id of the consumer chain
+	ChainId string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *ConsumerAdditionCancellationProposal) Reset()         { *m = ConsumerAdditionCancellationProposal{} }
+func (m *ConsumerAdditionCancellationProposal) String() string { return proto.CompactTextString(m) }
+func (*ConsumerAdditionCancellationProposal) ProtoMessage()    {}
+func (*ConsumerAdditionCancellationProposal) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f22ec409a72b7b72, []int{6}
 }
-func (m *SlashAcks) XXX_Unmarshal(b []byte) error {
+func (m *ConsumerAdditionCancellationProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SlashAcks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ConsumerAdditionCancellationProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SlashAcks.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ConsumerAdditionCancellationProposal.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -516,43 +597,65 @@ func (m *SlashAcks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *SlashAcks) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SlashAcks.Merge(m, src)
+func (m *ConsumerAdditionCancellationProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerAdditionCancellationProposal.Merge(m, src)
 }
-func (m *SlashAcks) XXX_Size() int {
+func (m *ConsumerAdditionCancellationProposal) XXX_Size() int {
 	return m.Size()
 }
-func (m *SlashAcks) XXX_DiscardUnknown() {
-	xxx_messageInfo_SlashAcks.DiscardUnknown(m)
+func (m *ConsumerAdditionCancellationProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerAdditionCancellationProposal.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SlashAcks proto.InternalMessageInfo
+var xxx_messageInfo_ConsumerAdditionCancellationProposal proto.InternalMessageInfo
 
-func (m *SlashAcks) GetAddresses() []string {
+func (m *ConsumerAdditionCancellationProposal) GetTitle() string {
 	if m != nil {
-		return m.Addresses
+		return m.Title
 	}
-	return nil
+	return ""
 }
 
-// ConsumerAdditionProposals holds pending governance proposals on the provider chain to spawn a new chain.
-type ConsumerAdditionProposals struct {
-	// proposals waiting for spawn_time to pass
-	Pending []*ConsumerAdditionProposal `protobuf:"bytes,1,rep,name=pending,proto3" json:"pending,omitempty"`
+func (m *ConsumerAdditionCancellationProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
 }
 
-func (m *ConsumerAdditionProposals) Reset()         { *m = ConsumerAdditionProposals{} }
-func (m *ConsumerAdditionProposals) String() string { return proto.CompactTextString(m) }
-func (*ConsumerAdditionProposals) ProtoMessage()    {}
-func (*ConsumerAdditionProposals) Descriptor() ([]byte, []int) {
+func (m *ConsumerAdditionCancellationProposal) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+// ChangeRewardDenomsProposal is a governance proposal on the provider chain to change the denoms
+// registered as consumer reward denoms. Only the tokens of registered denoms received from consumer chains
+// are added to the rewards allocation of the consumer chains, and thus distributed to the fee collector.
+type ChangeRewardDenomsProposal struct {
+	// the title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// the description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// the reward denoms to register
+	DenomsToAdd []string `protobuf:"bytes,3,rep,name=denoms_to_add,json=denomsToAdd,proto3" json:"denoms_to_add,omitempty"`
+	// the reward denoms to unregister
+	DenomsToRemove []string `protobuf:"bytes,4,rep,name=denoms_to_remove,json=denomsToRemove,proto3" json:"denoms_to_remove,omitempty"`
+}
+
+func (m *ChangeRewardDenomsProposal) Reset()         { *m = ChangeRewardDenomsProposal{} }
+func (m *ChangeRewardDenomsProposal) String() string { return proto.CompactTextString(m) }
+func (*ChangeRewardDenomsProposal) ProtoMessage()    {}
+func (*ChangeRewardDenomsProposal) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f22ec409a72b7b72, []int{7}
 }
-func (m *ConsumerAdditionProposals) XXX_Unmarshal(b []byte) error {
+func (m *ChangeRewardDenomsProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ConsumerAdditionProposals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ChangeRewardDenomsProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ConsumerAdditionProposals.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ChangeRewardDenomsProposal.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -562,43 +665,73 @@ func (m *ConsumerAdditionProposals) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *ConsumerAdditionProposals) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ConsumerAdditionProposals.Merge(m, src)
+func (m *ChangeRewardDenomsProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeRewardDenomsProposal.Merge(m, src)
 }
-func (m *ConsumerAdditionProposals) XXX_Size() int {
+func (m *ChangeRewardDenomsProposal) XXX_Size() int {
 	return m.Size()
 }
-func (m *ConsumerAdditionProposals) XXX_DiscardUnknown() {
-	xxx_messageInfo_ConsumerAdditionProposals.DiscardUnknown(m)
+func (m *ChangeRewardDenomsProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangeRewardDenomsProposal.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ConsumerAdditionProposals proto.InternalMessageInfo
+var xxx_messageInfo_ChangeRewardDenomsProposal proto.InternalMessageInfo
 
-func (m *ConsumerAdditionProposals) GetPending() []*ConsumerAdditionProposal {
+func (m *ChangeRewardDenomsProposal) GetTitle() string {
 	if m != nil {
-		return m.Pending
+		return m.Title
 	}
-	return nil
+	return ""
 }
 
-// ConsumerRemovalProposals holds pending governance proposals on the provider chain to remove (and stop) a consumer chain.
-type ConsumerRemovalProposals struct {
-	// proposals waiting for stop_time to pass
-	Pending []*ConsumerRemovalProposal `protobuf:"bytes,1,rep,name=pending,proto3" json:"pending,omitempty"`
+func (m *ChangeRewardDenomsProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
 }
 
-func (m *ConsumerRemovalProposals) Reset()         { *m = ConsumerRemovalProposals{} }
-func (m *ConsumerRemovalProposals) String() string { return proto.CompactTextString(m) }
-func (*ConsumerRemovalProposals) ProtoMessage()    {}
-func (*ConsumerRemovalProposals) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{8}
-}
-func (m *ConsumerRemovalProposals) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+func (m *ChangeRewardDenomsProposal) GetDenomsToAdd() []string {
+	if m != nil {
+		return m.DenomsToAdd
+	}
+	return nil
 }
-func (m *ConsumerRemovalProposals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+
+func (m *ChangeRewardDenomsProposal) GetDenomsToRemove() []string {
+	if m != nil {
+		return m.DenomsToRemove
+	}
+	return nil
+}
+
+// ConsumerPauseProposal is a governance proposal on the provider chain to pause or resume
+// a validating consumer chain, e.g., during a coordinated upgrade of the consumer chain.
+// While a consumer chain is paused, the provider stops sending it validator set updates,
+// but its CCV channel stays open and the updates are queued until the consumer chain is resumed.
+type ConsumerPauseProposal struct {
+	// the title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// the description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// the chain-id of the consumer chain
+	ChainId string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// true to pause the consumer chain, false to resume it
+	Pause bool `protobuf:"varint,4,opt,name=pause,proto3" json:"pause,omitempty"`
+}
+
+func (m *ConsumerPauseProposal) Reset()         { *m = ConsumerPauseProposal{} }
+func (m *ConsumerPauseProposal) String() string { return proto.CompactTextString(m) }
+func (*ConsumerPauseProposal) ProtoMessage()    {}
+func (*ConsumerPauseProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{8}
+}
+func (m *ConsumerPauseProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerPauseProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ConsumerRemovalProposals.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ConsumerPauseProposal.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -608,42 +741,71 @@ func (m *ConsumerRemovalProposals) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *ConsumerRemovalProposals) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ConsumerRemovalProposals.Merge(m, src)
+func (m *ConsumerPauseProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerPauseProposal.Merge(m, src)
 }
-func (m *ConsumerRemovalProposals) XXX_Size() int {
+func (m *ConsumerPauseProposal) XXX_Size() int {
 	return m.Size()
 }
-func (m *ConsumerRemovalProposals) XXX_DiscardUnknown() {
-	xxx_messageInfo_ConsumerRemovalProposals.DiscardUnknown(m)
+func (m *ConsumerPauseProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerPauseProposal.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ConsumerRemovalProposals proto.InternalMessageInfo
+var xxx_messageInfo_ConsumerPauseProposal proto.InternalMessageInfo
 
-func (m *ConsumerRemovalProposals) GetPending() []*ConsumerRemovalProposal {
+func (m *ConsumerPauseProposal) GetTitle() string {
 	if m != nil {
-		return m.Pending
+		return m.Title
 	}
-	return nil
+	return ""
 }
 
-type ChannelToChain struct {
-	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-	ChainId   string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+func (m *ConsumerPauseProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
 }
 
-func (m *ChannelToChain) Reset()         { *m = ChannelToChain{} }
-func (m *ChannelToChain) String() string { return proto.CompactTextString(m) }
-func (*ChannelToChain) ProtoMessage()    {}
-func (*ChannelToChain) Descriptor() ([]byte, []int) {
+func (m *ConsumerPauseProposal) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ConsumerPauseProposal) GetPause() bool {
+	if m != nil {
+		return m.Pause
+	}
+	return false
+}
+
+// ConsumerMetadataUpdateProposal is a governance proposal on the provider chain to update
+// the descriptive metadata of a consumer chain, e.g., its name and website.
+type ConsumerMetadataUpdateProposal struct {
+	// the title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// the description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// the chain-id of the consumer chain
+	ChainId string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// the metadata replacing the current metadata of the consumer chain
+	Metadata ConsumerMetadata `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata"`
+}
+
+func (m *ConsumerMetadataUpdateProposal) Reset()         { *m = ConsumerMetadataUpdateProposal{} }
+func (m *ConsumerMetadataUpdateProposal) String() string { return proto.CompactTextString(m) }
+func (*ConsumerMetadataUpdateProposal) ProtoMessage()    {}
+func (*ConsumerMetadataUpdateProposal) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f22ec409a72b7b72, []int{9}
 }
-func (m *ChannelToChain) XXX_Unmarshal(b []byte) error {
+func (m *ConsumerMetadataUpdateProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ChannelToChain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ConsumerMetadataUpdateProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ChannelToChain.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ConsumerMetadataUpdateProposal.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -653,51 +815,73 @@ func (m *ChannelToChain) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *ChannelToChain) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChannelToChain.Merge(m, src)
+func (m *ConsumerMetadataUpdateProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerMetadataUpdateProposal.Merge(m, src)
 }
-func (m *ChannelToChain) XXX_Size() int {
+func (m *ConsumerMetadataUpdateProposal) XXX_Size() int {
 	return m.Size()
 }
-func (m *ChannelToChain) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChannelToChain.DiscardUnknown(m)
+func (m *ConsumerMetadataUpdateProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerMetadataUpdateProposal.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChannelToChain proto.InternalMessageInfo
+var xxx_messageInfo_ConsumerMetadataUpdateProposal proto.InternalMessageInfo
 
-func (m *ChannelToChain) GetChannelId() string {
+func (m *ConsumerMetadataUpdateProposal) GetTitle() string {
 	if m != nil {
-		return m.ChannelId
+		return m.Title
 	}
 	return ""
 }
 
-func (m *ChannelToChain) GetChainId() string {
+func (m *ConsumerMetadataUpdateProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *ConsumerMetadataUpdateProposal) GetChainId() string {
 	if m != nil {
 		return m.ChainId
 	}
 	return ""
 }
 
-// VscUnbondingOps contains the IDs of unbonding operations that are waiting for
-// at least one VSCMaturedPacket with vscID from a consumer chain
-type VscUnbondingOps struct {
-	VscId          uint64   `protobuf:"varint,1,opt,name=vsc_id,json=vscId,proto3" json:"vsc_id,omitempty"`
-	UnbondingOpIds []uint64 `protobuf:"varint,2,rep,packed,name=unbonding_op_ids,json=unbondingOpIds,proto3" json:"unbonding_op_ids,omitempty"`
+func (m *ConsumerMetadataUpdateProposal) GetMetadata() ConsumerMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return ConsumerMetadata{}
 }
 
-func (m *VscUnbondingOps) Reset()         { *m = VscUnbondingOps{} }
-func (m *VscUnbondingOps) String() string { return proto.CompactTextString(m) }
-func (*VscUnbondingOps) ProtoMessage()    {}
-func (*VscUnbondingOps) Descriptor() ([]byte, []int) {
+// ChangeConsumerRewardFractionProposal is a governance proposal on the provider chain to change
+// the fraction of the rewards allocation of a running consumer chain that is distributed to the
+// provider validators. The other parameters of the consumer chain are left unchanged.
+// If it passes, the fraction is applied from the next rewards distribution.
+type ChangeConsumerRewardFractionProposal struct {
+	// the title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// the description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// the chain-id of the consumer chain
+	ChainId string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// the fraction of the rewards allocation of the consumer chain that is distributed to the provider validators
+	ConsumerRedistributeFraction string `protobuf:"bytes,4,opt,name=consumer_redistribute_fraction,json=consumerRedistributeFraction,proto3" json:"consumer_redistribute_fraction,omitempty"`
+}
+
+func (m *ChangeConsumerRewardFractionProposal) Reset()         { *m = ChangeConsumerRewardFractionProposal{} }
+func (m *ChangeConsumerRewardFractionProposal) String() string { return proto.CompactTextString(m) }
+func (*ChangeConsumerRewardFractionProposal) ProtoMessage()    {}
+func (*ChangeConsumerRewardFractionProposal) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f22ec409a72b7b72, []int{10}
 }
-func (m *VscUnbondingOps) XXX_Unmarshal(b []byte) error {
+func (m *ChangeConsumerRewardFractionProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *VscUnbondingOps) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ChangeConsumerRewardFractionProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_VscUnbondingOps.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ChangeConsumerRewardFractionProposal.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -707,52 +891,76 @@ func (m *VscUnbondingOps) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *VscUnbondingOps) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VscUnbondingOps.Merge(m, src)
+func (m *ChangeConsumerRewardFractionProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeConsumerRewardFractionProposal.Merge(m, src)
 }
-func (m *VscUnbondingOps) XXX_Size() int {
+func (m *ChangeConsumerRewardFractionProposal) XXX_Size() int {
 	return m.Size()
 }
-func (m *VscUnbondingOps) XXX_DiscardUnknown() {
-	xxx_messageInfo_VscUnbondingOps.DiscardUnknown(m)
+func (m *ChangeConsumerRewardFractionProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangeConsumerRewardFractionProposal.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VscUnbondingOps proto.InternalMessageInfo
+var xxx_messageInfo_ChangeConsumerRewardFractionProposal proto.InternalMessageInfo
 
-func (m *VscUnbondingOps) GetVscId() uint64 {
+func (m *ChangeConsumerRewardFractionProposal) GetTitle() string {
 	if m != nil {
-		return m.VscId
+		return m.Title
 	}
-	return 0
+	return ""
 }
 
-func (m *VscUnbondingOps) GetUnbondingOpIds() []uint64 {
+func (m *ChangeConsumerRewardFractionProposal) GetDescription() string {
 	if m != nil {
-		return m.UnbondingOpIds
+		return m.Description
 	}
-	return nil
+	return ""
 }
 
-// UnbondingOp contains the ids of consumer chains that need to unbond before
-// the unbonding operation with the given ID can unbond
-type UnbondingOp struct {
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// consumer chains that are still unbonding
-	UnbondingConsumerChains []string `protobuf:"bytes,2,rep,name=unbonding_consumer_chains,json=unbondingConsumerChains,proto3" json:"unbonding_consumer_chains,omitempty"`
+func (m *ChangeConsumerRewardFractionProposal) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
 }
 
-func (m *UnbondingOp) Reset()         { *m = UnbondingOp{} }
-func (m *UnbondingOp) String() string { return proto.CompactTextString(m) }
-func (*UnbondingOp) ProtoMessage()    {}
-func (*UnbondingOp) Descriptor() ([]byte, []int) {
+func (m *ChangeConsumerRewardFractionProposal) GetConsumerRedistributeFraction() string {
+	if m != nil {
+		return m.ConsumerRedistributeFraction
+	}
+	return ""
+}
+
+// A persisted queue entry indicating that a slash packet data instance needs to be handled.
+// This type belongs in the "global" queue, to coordinate slash packet handling times between consumers.
+type GlobalSlashEntry struct {
+	// Block time that slash packet was received by provider chain.
+	// This field is used for store key iteration ordering.
+	RecvTime time.Time `protobuf:"bytes,1,opt,name=recv_time,json=recvTime,proto3,stdtime" json:"recv_time"`
+	// The consumer that sent a slash packet.
+	ConsumerChainID string `protobuf:"bytes,2,opt,name=consumer_chain_id,json=consumerChainId,proto3" json:"consumer_chain_id,omitempty"`
+	// The IBC sequence number of the recv packet.
+	// This field is used in the store key to ensure uniqueness.
+	IbcSeqNum uint64 `protobuf:"varint,3,opt,name=ibc_seq_num,json=ibcSeqNum,proto3" json:"ibc_seq_num,omitempty"`
+	// The provider's consensus address of the validator being slashed.
+	// This field is used to obtain validator power in HandleThrottleQueues.
+	//
+	// This field is not used in the store key, but is persisted in value bytes, see QueueGlobalSlashEntry.
+	ProviderValConsAddr *ProviderConsAddress `protobuf:"bytes,4,opt,name=provider_val_cons_addr,json=providerValConsAddr,proto3" json:"provider_val_cons_addr,omitempty"`
+}
+
+func (m *GlobalSlashEntry) Reset()         { *m = GlobalSlashEntry{} }
+func (m *GlobalSlashEntry) String() string { return proto.CompactTextString(m) }
+func (*GlobalSlashEntry) ProtoMessage()    {}
+func (*GlobalSlashEntry) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f22ec409a72b7b72, []int{11}
 }
-func (m *UnbondingOp) XXX_Unmarshal(b []byte) error {
+func (m *GlobalSlashEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UnbondingOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GlobalSlashEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UnbondingOp.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GlobalSlashEntry.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -762,49 +970,133 @@ func (m *UnbondingOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *UnbondingOp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UnbondingOp.Merge(m, src)
+func (m *GlobalSlashEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GlobalSlashEntry.Merge(m, src)
 }
-func (m *UnbondingOp) XXX_Size() int {
+func (m *GlobalSlashEntry) XXX_Size() int {
 	return m.Size()
 }
-func (m *UnbondingOp) XXX_DiscardUnknown() {
-	xxx_messageInfo_UnbondingOp.DiscardUnknown(m)
+func (m *GlobalSlashEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_GlobalSlashEntry.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UnbondingOp proto.InternalMessageInfo
+var xxx_messageInfo_GlobalSlashEntry proto.InternalMessageInfo
 
-func (m *UnbondingOp) GetId() uint64 {
+func (m *GlobalSlashEntry) GetRecvTime() time.Time {
 	if m != nil {
-		return m.Id
+		return m.RecvTime
+	}
+	return time.Time{}
+}
+
+func (m *GlobalSlashEntry) GetConsumerChainID() string {
+	if m != nil {
+		return m.ConsumerChainID
+	}
+	return ""
+}
+
+func (m *GlobalSlashEntry) GetIbcSeqNum() uint64 {
+	if m != nil {
+		return m.IbcSeqNum
 	}
 	return 0
 }
 
-func (m *UnbondingOp) GetUnbondingConsumerChains() []string {
+func (m *GlobalSlashEntry) GetProviderValConsAddr() *ProviderConsAddress {
 	if m != nil {
-		return m.UnbondingConsumerChains
+		return m.ProviderValConsAddr
 	}
 	return nil
 }
 
-type InitTimeoutTimestamp struct {
-	ChainId   string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	Timestamp uint64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+// Params defines the parameters for CCV Provider module
+type Params struct {
+	TemplateClient *types3.ClientState `protobuf:"bytes,1,opt,name=template_client,json=templateClient,proto3" json:"template_client,omitempty"`
+	// TrustingPeriodFraction is used to compute the consumer and provider IBC client's TrustingPeriod from the chain defined UnbondingPeriod
+	TrustingPeriodFraction string `protobuf:"bytes,2,opt,name=trusting_period_fraction,json=trustingPeriodFraction,proto3" json:"trusting_period_fraction,omitempty"`
+	// Sent IBC packets will timeout after this duration
+	CcvTimeoutPeriod time.Duration `protobuf:"bytes,3,opt,name=ccv_timeout_period,json=ccvTimeoutPeriod,proto3,stdduration" json:"ccv_timeout_period"`
+	// The channel initialization (IBC channel opening handshake) will timeout after this duration
+	InitTimeoutPeriod time.Duration `protobuf:"bytes,4,opt,name=init_timeout_period,json=initTimeoutPeriod,proto3,stdduration" json:"init_timeout_period"`
+	// The VSC packets sent by the provider will timeout after this duration.
+	// Note that unlike ccv_timeout_period which is an IBC param,
+	// the vsc_timeout_period is a provider-side param that enables the provider
+	// to timeout VSC packets even when a consumer chain is not live.
+	VscTimeoutPeriod time.Duration `protobuf:"bytes,5,opt,name=vsc_timeout_period,json=vscTimeoutPeriod,proto3,stdduration" json:"vsc_timeout_period"`
+	// The period for which the slash meter is replenished
+	SlashMeterReplenishPeriod time.Duration `protobuf:"bytes,6,opt,name=slash_meter_replenish_period,json=slashMeterReplenishPeriod,proto3,stdduration" json:"slash_meter_replenish_period"`
+	// The fraction of total voting power that is replenished to the slash meter every replenish period.
+	// This param also serves as a maximum fraction of total voting power that the slash meter can hold.
+	SlashMeterReplenishFraction string `protobuf:"bytes,7,opt,name=slash_meter_replenish_fraction,json=slashMeterReplenishFraction,proto3" json:"slash_meter_replenish_fraction,omitempty"`
+	// The maximum amount of throttled slash or vsc matured packets
+	// that can be queued for a single consumer before the provider chain halts.
+	MaxThrottledPackets int64 `protobuf:"varint,8,opt,name=max_throttled_packets,json=maxThrottledPackets,proto3" json:"max_throttled_packets,omitempty"`
+	// The fraction of the rewards received from each consumer chain that is distributed
+	// to the fee collector, at the beginning of the block after they are received.
+	ConsumerRedistributeFraction string `protobuf:"bytes,9,opt,name=consumer_redistribute_fraction,json=consumerRedistributeFraction,proto3" json:"consumer_redistribute_fraction,omitempty"`
+	// The maximum number of times the provider retries to apply a slash packet
+	// whose validator is not found, before the slash packet is archived as failed.
+	MaxSlashRetries int64 `protobuf:"varint,10,opt,name=max_slash_retries,json=maxSlashRetries,proto3" json:"max_slash_retries,omitempty"`
+	// The period during which the provider keeps trying to send packets to a consumer chain
+	// whose client is expired or frozen, before the CCV channel is closed and the consumer
+	// chain is stopped. This gives a window to recover the client.
+	ClientExpirationGracePeriod time.Duration `protobuf:"bytes,11,opt,name=client_expiration_grace_period,json=clientExpirationGracePeriod,proto3,stdduration" json:"client_expiration_grace_period"`
+	// The number of most recent valset update IDs whose block heights are kept by the provider.
+	// Older block heights are pruned once no unbonding operation references their valset update ID.
+	HistoricalValsetEntries int64 `protobuf:"varint,12,opt,name=historical_valset_entries,json=historicalValsetEntries,proto3" json:"historical_valset_entries,omitempty"`
+	// The fraction of the total voting power, held by the validators with the smallest powers,
+	// whose validators are opted out of validating the consumer chains. Opted out validators are
+	// not in the validator sets sent to the consumer chains and are never jailed for downtime
+	// on the consumer chains. It is set as a string in range [0, 0.2], and zero disables the opt out.
+	SoftOptOutThreshold string `protobuf:"bytes,13,opt,name=soft_opt_out_threshold,json=softOptOutThreshold,proto3" json:"soft_opt_out_threshold,omitempty"`
+	// The period over which the rewards received from every consumer chain are compared
+	// to the rewards the consumer chain is expected to send, if any.
+	ConsumerRewardsWindowPeriod time.Duration `protobuf:"bytes,15,opt,name=consumer_rewards_window_period,json=consumerRewardsWindowPeriod,proto3,stdduration" json:"consumer_rewards_window_period"`
+	// The fraction of the stake of a validator that is slashed for a double-sign infraction
+	// reported by a consumer chain, instead of the double-sign slash fraction of the slashing module.
+	SlashFractionDoubleSign string `protobuf:"bytes,16,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3" json:"slash_fraction_double_sign,omitempty"`
+	// The fraction of the stake of a validator that is slashed for a downtime infraction
+	// reported by a consumer chain, in addition to the validator being jailed.
+	// Zero, the default, only jails the validator.
+	SlashFractionDowntime string `protobuf:"bytes,17,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3" json:"slash_fraction_downtime,omitempty"`
+	// The maximum number of unbonding operations that can wait for VSCMaturedPackets
+	// from a single consumer chain. Once the cap is reached, new unbonding operations
+	// no longer wait for the consumer chain. Zero, the default, disables the cap.
+	MaxUnbondingOpsPerChain int64 `protobuf:"varint,18,opt,name=max_unbonding_ops_per_chain,json=maxUnbondingOpsPerChain,proto3" json:"max_unbonding_ops_per_chain,omitempty"`
+	// If true, the provider logs, for audit purposes, the validator power changes
+	// of every VSC packet sent to a consumer chain. Disabled by default.
+	LogValsetUpdateDiffs bool `protobuf:"varint,19,opt,name=log_valset_update_diffs,json=logValsetUpdateDiffs,proto3" json:"log_valset_update_diffs,omitempty"`
+	// The period during which the slash acks of a consumer chain are batched before they are
+	// sent in a VSC packet without validator updates, unless the batch reaches its size cap
+	// or is included in a VSC packet with validator updates before.
+	// Zero, the default, sends the slash acks in the block they are appended.
+	SlashAckBatchPeriod time.Duration `protobuf:"bytes,20,opt,name=slash_ack_batch_period,json=slashAckBatchPeriod,proto3,stdduration" json:"slash_ack_batch_period"`
+	// The period after which a consumer chain the provider did not receive any packet or
+	// acknowledgement from is reported as inactive. Zero, the default, disables the reporting.
+	ConsumerLivenessWindow time.Duration `protobuf:"bytes,21,opt,name=consumer_liveness_window,json=consumerLivenessWindow,proto3,stdduration" json:"consumer_liveness_window"`
+	// The types of the light clients that the CCV channels to the consumer chains can be built on.
+	// The default only allows Tendermint light clients. Since the provider always creates
+	// Tendermint clients to the consumer chains, "07-tendermint" must remain allowed.
+	AllowedConsumerClientTypes []string `protobuf:"bytes,22,rep,name=allowed_consumer_client_types,json=allowedConsumerClientTypes,proto3" json:"allowed_consumer_client_types,omitempty"`
+	// The fraction of the trusting period of a consumer client below which the time left
+	// before the client expires is reported, so that the client can be updated in time.
+	// Zero, the default, disables the reporting.
+	ClientExpiryWarningFraction string `protobuf:"bytes,23,opt,name=client_expiry_warning_fraction,json=clientExpiryWarningFraction,proto3" json:"client_expiry_warning_fraction,omitempty"`
 }
 
-func (m *InitTimeoutTimestamp) Reset()         { *m = InitTimeoutTimestamp{} }
-func (m *InitTimeoutTimestamp) String() string { return proto.CompactTextString(m) }
-func (*InitTimeoutTimestamp) ProtoMessage()    {}
-func (*InitTimeoutTimestamp) Descriptor() ([]byte, []int) {
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f22ec409a72b7b72, []int{12}
 }
-func (m *InitTimeoutTimestamp) XXX_Unmarshal(b []byte) error {
+func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *InitTimeoutTimestamp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_InitTimeoutTimestamp.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -814,146 +1106,189 @@ func (m *InitTimeoutTimestamp) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *InitTimeoutTimestamp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InitTimeoutTimestamp.Merge(m, src)
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
 }
-func (m *InitTimeoutTimestamp) XXX_Size() int {
+func (m *Params) XXX_Size() int {
 	return m.Size()
 }
-func (m *InitTimeoutTimestamp) XXX_DiscardUnknown() {
-	xxx_messageInfo_InitTimeoutTimestamp.DiscardUnknown(m)
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InitTimeoutTimestamp proto.InternalMessageInfo
+var xxx_messageInfo_Params proto.InternalMessageInfo
 
-func (m *InitTimeoutTimestamp) GetChainId() string {
+func (m *Params) GetTemplateClient() *types3.ClientState {
 	if m != nil {
-		return m.ChainId
+		return m.TemplateClient
 	}
-	return ""
+	return nil
 }
 
-func (m *InitTimeoutTimestamp) GetTimestamp() uint64 {
+func (m *Params) GetTrustingPeriodFraction() string {
 	if m != nil {
-		return m.Timestamp
+		return m.TrustingPeriodFraction
 	}
-	return 0
+	return ""
 }
 
-type VscSendTimestamp struct {
-	VscId     uint64    `protobuf:"varint,1,opt,name=vsc_id,json=vscId,proto3" json:"vsc_id,omitempty"`
-	Timestamp time.Time `protobuf:"bytes,2,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+func (m *Params) GetCcvTimeoutPeriod() time.Duration {
+	if m != nil {
+		return m.CcvTimeoutPeriod
+	}
+	return 0
 }
 
-func (m *VscSendTimestamp) Reset()         { *m = VscSendTimestamp{} }
-func (m *VscSendTimestamp) String() string { return proto.CompactTextString(m) }
-func (*VscSendTimestamp) ProtoMessage()    {}
-func (*VscSendTimestamp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{13}
+func (m *Params) GetInitTimeoutPeriod() time.Duration {
+	if m != nil {
+		return m.InitTimeoutPeriod
+	}
+	return 0
 }
-func (m *VscSendTimestamp) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *Params) GetVscTimeoutPeriod() time.Duration {
+	if m != nil {
+		return m.VscTimeoutPeriod
+	}
+	return 0
 }
-func (m *VscSendTimestamp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_VscSendTimestamp.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *Params) GetSlashMeterReplenishPeriod() time.Duration {
+	if m != nil {
+		return m.SlashMeterReplenishPeriod
 	}
+	return 0
 }
-func (m *VscSendTimestamp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VscSendTimestamp.Merge(m, src)
+
+func (m *Params) GetSlashMeterReplenishFraction() string {
+	if m != nil {
+		return m.SlashMeterReplenishFraction
+	}
+	return ""
 }
-func (m *VscSendTimestamp) XXX_Size() int {
-	return m.Size()
+
+func (m *Params) GetMaxThrottledPackets() int64 {
+	if m != nil {
+		return m.MaxThrottledPackets
+	}
+	return 0
 }
-func (m *VscSendTimestamp) XXX_DiscardUnknown() {
-	xxx_messageInfo_VscSendTimestamp.DiscardUnknown(m)
+
+func (m *Params) GetConsumerRedistributeFraction() string {
+	if m != nil {
+		return m.ConsumerRedistributeFraction
+	}
+	return ""
 }
 
-var xxx_messageInfo_VscSendTimestamp proto.InternalMessageInfo
+func (m *Params) GetMaxSlashRetries() int64 {
+	if m != nil {
+		return m.MaxSlashRetries
+	}
+	return 0
+}
 
-func (m *VscSendTimestamp) GetVscId() uint64 {
+func (m *Params) GetClientExpirationGracePeriod() time.Duration {
 	if m != nil {
-		return m.VscId
+		return m.ClientExpirationGracePeriod
 	}
 	return 0
 }
 
-func (m *VscSendTimestamp) GetTimestamp() time.Time {
+func (m *Params) GetHistoricalValsetEntries() int64 {
 	if m != nil {
-		return m.Timestamp
+		return m.HistoricalValsetEntries
 	}
-	return time.Time{}
+	return 0
 }
 
-// A validator's assigned consensus address for a consumer chain.
-// Note this type is for type safety within provider code, consumer code uses normal sdk.ConsAddress,
-// since there's no notion of provider vs consumer address.
-type ConsumerConsAddress struct {
-	Address []byte `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+func (m *Params) GetSoftOptOutThreshold() string {
+	if m != nil {
+		return m.SoftOptOutThreshold
+	}
+	return ""
 }
 
-func (m *ConsumerConsAddress) Reset()      { *m = ConsumerConsAddress{} }
-func (*ConsumerConsAddress) ProtoMessage() {}
-func (*ConsumerConsAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{14}
+func (m *Params) GetConsumerRewardsWindowPeriod() time.Duration {
+	if m != nil {
+		return m.ConsumerRewardsWindowPeriod
+	}
+	return 0
 }
-func (m *ConsumerConsAddress) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *Params) GetSlashFractionDoubleSign() string {
+	if m != nil {
+		return m.SlashFractionDoubleSign
+	}
+	return ""
 }
-func (m *ConsumerConsAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_ConsumerConsAddress.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *Params) GetSlashFractionDowntime() string {
+	if m != nil {
+		return m.SlashFractionDowntime
 	}
+	return ""
 }
-func (m *ConsumerConsAddress) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ConsumerConsAddress.Merge(m, src)
+
+func (m *Params) GetMaxUnbondingOpsPerChain() int64 {
+	if m != nil {
+		return m.MaxUnbondingOpsPerChain
+	}
+	return 0
 }
-func (m *ConsumerConsAddress) XXX_Size() int {
-	return m.Size()
+
+func (m *Params) GetLogValsetUpdateDiffs() bool {
+	if m != nil {
+		return m.LogValsetUpdateDiffs
+	}
+	return false
 }
-func (m *ConsumerConsAddress) XXX_DiscardUnknown() {
-	xxx_messageInfo_ConsumerConsAddress.DiscardUnknown(m)
+
+func (m *Params) GetSlashAckBatchPeriod() time.Duration {
+	if m != nil {
+		return m.SlashAckBatchPeriod
+	}
+	return 0
 }
 
-var xxx_messageInfo_ConsumerConsAddress proto.InternalMessageInfo
+func (m *Params) GetConsumerLivenessWindow() time.Duration {
+	if m != nil {
+		return m.ConsumerLivenessWindow
+	}
+	return 0
+}
 
-func (m *ConsumerConsAddress) GetAddress() []byte {
+func (m *Params) GetAllowedConsumerClientTypes() []string {
 	if m != nil {
-		return m.Address
+		return m.AllowedConsumerClientTypes
 	}
 	return nil
 }
 
-// A validator's consensus address on the provider chain
-type ProviderConsAddress struct {
-	Address []byte `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+func (m *Params) GetClientExpiryWarningFraction() string {
+	if m != nil {
+		return m.ClientExpiryWarningFraction
+	}
+	return ""
 }
 
-func (m *ProviderConsAddress) Reset()      { *m = ProviderConsAddress{} }
-func (*ProviderConsAddress) ProtoMessage() {}
-func (*ProviderConsAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{15}
+type HandshakeMetadata struct {
+	ProviderFeePoolAddr string `protobuf:"bytes,1,opt,name=provider_fee_pool_addr,json=providerFeePoolAddr,proto3" json:"provider_fee_pool_addr,omitempty"`
+	Version             string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
 }
-func (m *ProviderConsAddress) XXX_Unmarshal(b []byte) error {
+
+func (m *HandshakeMetadata) Reset()         { *m = HandshakeMetadata{} }
+func (m *HandshakeMetadata) String() string { return proto.CompactTextString(m) }
+func (*HandshakeMetadata) ProtoMessage()    {}
+func (*HandshakeMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{13}
+}
+func (m *HandshakeMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ProviderConsAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *HandshakeMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ProviderConsAddress.Marshal(b, m, deterministic)
+		return xxx_messageInfo_HandshakeMetadata.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -963,42 +1298,53 @@ func (m *ProviderConsAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *ProviderConsAddress) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ProviderConsAddress.Merge(m, src)
+func (m *HandshakeMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HandshakeMetadata.Merge(m, src)
 }
-func (m *ProviderConsAddress) XXX_Size() int {
+func (m *HandshakeMetadata) XXX_Size() int {
 	return m.Size()
 }
-func (m *ProviderConsAddress) XXX_DiscardUnknown() {
-	xxx_messageInfo_ProviderConsAddress.DiscardUnknown(m)
+func (m *HandshakeMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_HandshakeMetadata.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ProviderConsAddress proto.InternalMessageInfo
+var xxx_messageInfo_HandshakeMetadata proto.InternalMessageInfo
 
-func (m *ProviderConsAddress) GetAddress() []byte {
+func (m *HandshakeMetadata) GetProviderFeePoolAddr() string {
 	if m != nil {
-		return m.Address
+		return m.ProviderFeePoolAddr
 	}
-	return nil
+	return ""
 }
 
-// AddressList contains a list of consumer consensus addresses
-type ConsumerAddressList struct {
-	Addresses []*ConsumerConsAddress `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+func (m *HandshakeMetadata) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
 }
 
-func (m *ConsumerAddressList) Reset()         { *m = ConsumerAddressList{} }
-func (m *ConsumerAddressList) String() string { return proto.CompactTextString(m) }
-func (*ConsumerAddressList) ProtoMessage()    {}
-func (*ConsumerAddressList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{16}
+// ConsumerHandshakeMetadata is the version the consumer chain proposes
+// on the CCV channel handshake, unless it proposes a plain version
+type ConsumerHandshakeMetadata struct {
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// the hex encoded SHA-256 hash of the consumer genesis the consumer chain booted from,
+	// see the Hash method of the consumer GenesisState
+	GenesisHash string `protobuf:"bytes,2,opt,name=genesis_hash,json=genesisHash,proto3" json:"genesis_hash,omitempty"`
 }
-func (m *ConsumerAddressList) XXX_Unmarshal(b []byte) error {
+
+func (m *ConsumerHandshakeMetadata) Reset()         { *m = ConsumerHandshakeMetadata{} }
+func (m *ConsumerHandshakeMetadata) String() string { return proto.CompactTextString(m) }
+func (*ConsumerHandshakeMetadata) ProtoMessage()    {}
+func (*ConsumerHandshakeMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{14}
+}
+func (m *ConsumerHandshakeMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ConsumerAddressList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ConsumerHandshakeMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ConsumerAddressList.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ConsumerHandshakeMetadata.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1008,43 +1354,56 @@ func (m *ConsumerAddressList) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *ConsumerAddressList) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ConsumerAddressList.Merge(m, src)
+func (m *ConsumerHandshakeMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerHandshakeMetadata.Merge(m, src)
 }
-func (m *ConsumerAddressList) XXX_Size() int {
+func (m *ConsumerHandshakeMetadata) XXX_Size() int {
 	return m.Size()
 }
-func (m *ConsumerAddressList) XXX_DiscardUnknown() {
-	xxx_messageInfo_ConsumerAddressList.DiscardUnknown(m)
+func (m *ConsumerHandshakeMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerHandshakeMetadata.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ConsumerAddressList proto.InternalMessageInfo
+var xxx_messageInfo_ConsumerHandshakeMetadata proto.InternalMessageInfo
 
-func (m *ConsumerAddressList) GetAddresses() []*ConsumerConsAddress {
+func (m *ConsumerHandshakeMetadata) GetVersion() string {
 	if m != nil {
-		return m.Addresses
+		return m.Version
 	}
-	return nil
+	return ""
 }
 
-type KeyAssignmentReplacement struct {
-	ProviderAddr *ProviderConsAddress `protobuf:"bytes,1,opt,name=provider_addr,json=providerAddr,proto3" json:"provider_addr,omitempty"`
-	PrevCKey     *crypto.PublicKey    `protobuf:"bytes,2,opt,name=prev_c_key,json=prevCKey,proto3" json:"prev_c_key,omitempty"`
-	Power        int64                `protobuf:"varint,3,opt,name=power,proto3" json:"power,omitempty"`
+func (m *ConsumerHandshakeMetadata) GetGenesisHash() string {
+	if m != nil {
+		return m.GenesisHash
+	}
+	return ""
 }
 
-func (m *KeyAssignmentReplacement) Reset()         { *m = KeyAssignmentReplacement{} }
-func (m *KeyAssignmentReplacement) String() string { return proto.CompactTextString(m) }
-func (*KeyAssignmentReplacement) ProtoMessage()    {}
-func (*KeyAssignmentReplacement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{17}
+// SlashAcks contains cons addresses of consumer chain validators
+// successfully slashed on the provider chain
+type SlashAcks struct {
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// The infraction types of the slashed validators, i.e., infractions[i] is the infraction
+	// type of addresses[i]. Addresses without infraction type were slashed for downtime.
+	Infractions []types4.InfractionType `protobuf:"varint,2,rep,packed,name=infractions,proto3,enum=cosmos.staking.v1beta1.InfractionType" json:"infractions,omitempty"`
+	// The block time at which the first of the slash acks was appended,
+	// used to batch the slash acks. Not set for slash acks stored before batching.
+	BatchStartTime *time.Time `protobuf:"bytes,3,opt,name=batch_start_time,json=batchStartTime,proto3,stdtime" json:"batch_start_time,omitempty"`
 }
-func (m *KeyAssignmentReplacement) XXX_Unmarshal(b []byte) error {
+
+func (m *SlashAcks) Reset()         { *m = SlashAcks{} }
+func (m *SlashAcks) String() string { return proto.CompactTextString(m) }
+func (*SlashAcks) ProtoMessage()    {}
+func (*SlashAcks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{15}
+}
+func (m *SlashAcks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *KeyAssignmentReplacement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SlashAcks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_KeyAssignmentReplacement.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SlashAcks.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1054,59 +1413,57 @@ func (m *KeyAssignmentReplacement) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *KeyAssignmentReplacement) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_KeyAssignmentReplacement.Merge(m, src)
+func (m *SlashAcks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashAcks.Merge(m, src)
 }
-func (m *KeyAssignmentReplacement) XXX_Size() int {
+func (m *SlashAcks) XXX_Size() int {
 	return m.Size()
 }
-func (m *KeyAssignmentReplacement) XXX_DiscardUnknown() {
-	xxx_messageInfo_KeyAssignmentReplacement.DiscardUnknown(m)
+func (m *SlashAcks) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashAcks.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_KeyAssignmentReplacement proto.InternalMessageInfo
+var xxx_messageInfo_SlashAcks proto.InternalMessageInfo
 
-func (m *KeyAssignmentReplacement) GetProviderAddr() *ProviderConsAddress {
+func (m *SlashAcks) GetAddresses() []string {
 	if m != nil {
-		return m.ProviderAddr
+		return m.Addresses
 	}
 	return nil
 }
 
-func (m *KeyAssignmentReplacement) GetPrevCKey() *crypto.PublicKey {
+func (m *SlashAcks) GetInfractions() []types4.InfractionType {
 	if m != nil {
-		return m.PrevCKey
+		return m.Infractions
 	}
 	return nil
 }
 
-func (m *KeyAssignmentReplacement) GetPower() int64 {
+func (m *SlashAcks) GetBatchStartTime() *time.Time {
 	if m != nil {
-		return m.Power
+		return m.BatchStartTime
 	}
-	return 0
+	return nil
 }
 
-// Used to serialize the ValidatorConsumerPubKey index from key assignment
-// ValidatorConsumerPubKey: (chainID, providerAddr consAddr) -> consumerKey tmprotocrypto.PublicKey
-type ValidatorConsumerPubKey struct {
-	ChainId      string               `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	ProviderAddr *ProviderConsAddress `protobuf:"bytes,2,opt,name=provider_addr,json=providerAddr,proto3" json:"provider_addr,omitempty"`
-	ConsumerKey  *crypto.PublicKey    `protobuf:"bytes,3,opt,name=consumer_key,json=consumerKey,proto3" json:"consumer_key,omitempty"`
+// ConsumerAdditionProposals holds pending governance proposals on the provider chain to spawn a new chain.
+type ConsumerAdditionProposals struct {
+	// proposals waiting for spawn_time to pass
+	Pending []*ConsumerAdditionProposal `protobuf:"bytes,1,rep,name=pending,proto3" json:"pending,omitempty"`
 }
 
-func (m *ValidatorConsumerPubKey) Reset()         { *m = ValidatorConsumerPubKey{} }
-func (m *ValidatorConsumerPubKey) String() string { return proto.CompactTextString(m) }
-func (*ValidatorConsumerPubKey) ProtoMessage()    {}
-func (*ValidatorConsumerPubKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{18}
+func (m *ConsumerAdditionProposals) Reset()         { *m = ConsumerAdditionProposals{} }
+func (m *ConsumerAdditionProposals) String() string { return proto.CompactTextString(m) }
+func (*ConsumerAdditionProposals) ProtoMessage()    {}
+func (*ConsumerAdditionProposals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{16}
 }
-func (m *ValidatorConsumerPubKey) XXX_Unmarshal(b []byte) error {
+func (m *ConsumerAdditionProposals) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ValidatorConsumerPubKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ConsumerAdditionProposals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ValidatorConsumerPubKey.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ConsumerAdditionProposals.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1116,59 +1473,88 @@ func (m *ValidatorConsumerPubKey) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *ValidatorConsumerPubKey) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidatorConsumerPubKey.Merge(m, src)
+func (m *ConsumerAdditionProposals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerAdditionProposals.Merge(m, src)
 }
-func (m *ValidatorConsumerPubKey) XXX_Size() int {
+func (m *ConsumerAdditionProposals) XXX_Size() int {
 	return m.Size()
 }
-func (m *ValidatorConsumerPubKey) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidatorConsumerPubKey.DiscardUnknown(m)
+func (m *ConsumerAdditionProposals) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerAdditionProposals.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ValidatorConsumerPubKey proto.InternalMessageInfo
+var xxx_messageInfo_ConsumerAdditionProposals proto.InternalMessageInfo
 
-func (m *ValidatorConsumerPubKey) GetChainId() string {
+func (m *ConsumerAdditionProposals) GetPending() []*ConsumerAdditionProposal {
 	if m != nil {
-		return m.ChainId
+		return m.Pending
 	}
-	return ""
+	return nil
 }
 
-func (m *ValidatorConsumerPubKey) GetProviderAddr() *ProviderConsAddress {
-	if m != nil {
-		return m.ProviderAddr
+// ConsumerRemovalProposals holds pending governance proposals on the provider chain to remove (and stop) a consumer chain.
+type ConsumerRemovalProposals struct {
+	// proposals waiting for stop_time to pass
+	Pending []*ConsumerRemovalProposal `protobuf:"bytes,1,rep,name=pending,proto3" json:"pending,omitempty"`
+}
+
+func (m *ConsumerRemovalProposals) Reset()         { *m = ConsumerRemovalProposals{} }
+func (m *ConsumerRemovalProposals) String() string { return proto.CompactTextString(m) }
+func (*ConsumerRemovalProposals) ProtoMessage()    {}
+func (*ConsumerRemovalProposals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{17}
+}
+func (m *ConsumerRemovalProposals) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerRemovalProposals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerRemovalProposals.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *ConsumerRemovalProposals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerRemovalProposals.Merge(m, src)
+}
+func (m *ConsumerRemovalProposals) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerRemovalProposals) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerRemovalProposals.DiscardUnknown(m)
 }
 
-func (m *ValidatorConsumerPubKey) GetConsumerKey() *crypto.PublicKey {
+var xxx_messageInfo_ConsumerRemovalProposals proto.InternalMessageInfo
+
+func (m *ConsumerRemovalProposals) GetPending() []*ConsumerRemovalProposal {
 	if m != nil {
-		return m.ConsumerKey
+		return m.Pending
 	}
 	return nil
 }
 
-// Used to serialize the ValidatorConsumerAddr index from key assignment
-// ValidatorByConsumerAddr: (chainID, consumerAddr consAddr) -> providerAddr consAddr
-type ValidatorByConsumerAddr struct {
-	ChainId      string               `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	ConsumerAddr *ConsumerConsAddress `protobuf:"bytes,2,opt,name=consumer_addr,json=consumerAddr,proto3" json:"consumer_addr,omitempty"`
-	ProviderAddr *ProviderConsAddress `protobuf:"bytes,3,opt,name=provider_addr,json=providerAddr,proto3" json:"provider_addr,omitempty"`
+type ChannelToChain struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	ChainId   string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *ValidatorByConsumerAddr) Reset()         { *m = ValidatorByConsumerAddr{} }
-func (m *ValidatorByConsumerAddr) String() string { return proto.CompactTextString(m) }
-func (*ValidatorByConsumerAddr) ProtoMessage()    {}
-func (*ValidatorByConsumerAddr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{19}
+func (m *ChannelToChain) Reset()         { *m = ChannelToChain{} }
+func (m *ChannelToChain) String() string { return proto.CompactTextString(m) }
+func (*ChannelToChain) ProtoMessage()    {}
+func (*ChannelToChain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{18}
 }
-func (m *ValidatorByConsumerAddr) XXX_Unmarshal(b []byte) error {
+func (m *ChannelToChain) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ValidatorByConsumerAddr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ChannelToChain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ValidatorByConsumerAddr.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ChannelToChain.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1178,59 +1564,51 @@ func (m *ValidatorByConsumerAddr) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *ValidatorByConsumerAddr) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidatorByConsumerAddr.Merge(m, src)
+func (m *ChannelToChain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelToChain.Merge(m, src)
 }
-func (m *ValidatorByConsumerAddr) XXX_Size() int {
+func (m *ChannelToChain) XXX_Size() int {
 	return m.Size()
 }
-func (m *ValidatorByConsumerAddr) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidatorByConsumerAddr.DiscardUnknown(m)
+func (m *ChannelToChain) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelToChain.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ValidatorByConsumerAddr proto.InternalMessageInfo
+var xxx_messageInfo_ChannelToChain proto.InternalMessageInfo
 
-func (m *ValidatorByConsumerAddr) GetChainId() string {
+func (m *ChannelToChain) GetChannelId() string {
 	if m != nil {
-		return m.ChainId
+		return m.ChannelId
 	}
 	return ""
 }
 
-func (m *ValidatorByConsumerAddr) GetConsumerAddr() *ConsumerConsAddress {
-	if m != nil {
-		return m.ConsumerAddr
-	}
-	return nil
-}
-
-func (m *ValidatorByConsumerAddr) GetProviderAddr() *ProviderConsAddress {
+func (m *ChannelToChain) GetChainId() string {
 	if m != nil {
-		return m.ProviderAddr
+		return m.ChainId
 	}
-	return nil
+	return ""
 }
 
-// Used to serialize the ConsumerAddrsToPrune index from key assignment
-// ConsumerAddrsToPrune: (chainID, vscID uint64) -> consumerAddrs AddressList
-type ConsumerAddrsToPrune struct {
-	ChainId       string               `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	VscId         uint64               `protobuf:"varint,2,opt,name=vsc_id,json=vscId,proto3" json:"vsc_id,omitempty"`
-	ConsumerAddrs *ConsumerAddressList `protobuf:"bytes,3,opt,name=consumer_addrs,json=consumerAddrs,proto3" json:"consumer_addrs,omitempty"`
+// VscUnbondingOps contains the IDs of unbonding operations that are waiting for
+// at least one VSCMaturedPacket with vscID from a consumer chain
+type VscUnbondingOps struct {
+	VscId          uint64   `protobuf:"varint,1,opt,name=vsc_id,json=vscId,proto3" json:"vsc_id,omitempty"`
+	UnbondingOpIds []uint64 `protobuf:"varint,2,rep,packed,name=unbonding_op_ids,json=unbondingOpIds,proto3" json:"unbonding_op_ids,omitempty"`
 }
 
-func (m *ConsumerAddrsToPrune) Reset()         { *m = ConsumerAddrsToPrune{} }
-func (m *ConsumerAddrsToPrune) String() string { return proto.CompactTextString(m) }
-func (*ConsumerAddrsToPrune) ProtoMessage()    {}
-func (*ConsumerAddrsToPrune) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{20}
+func (m *VscUnbondingOps) Reset()         { *m = VscUnbondingOps{} }
+func (m *VscUnbondingOps) String() string { return proto.CompactTextString(m) }
+func (*VscUnbondingOps) ProtoMessage()    {}
+func (*VscUnbondingOps) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{19}
 }
-func (m *ConsumerAddrsToPrune) XXX_Unmarshal(b []byte) error {
+func (m *VscUnbondingOps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ConsumerAddrsToPrune) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *VscUnbondingOps) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ConsumerAddrsToPrune.Marshal(b, m, deterministic)
+		return xxx_messageInfo_VscUnbondingOps.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1240,1638 +1618,7481 @@ func (m *ConsumerAddrsToPrune) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *ConsumerAddrsToPrune) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ConsumerAddrsToPrune.Merge(m, src)
+func (m *VscUnbondingOps) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VscUnbondingOps.Merge(m, src)
 }
-func (m *ConsumerAddrsToPrune) XXX_Size() int {
+func (m *VscUnbondingOps) XXX_Size() int {
 	return m.Size()
 }
-func (m *ConsumerAddrsToPrune) XXX_DiscardUnknown() {
-	xxx_messageInfo_ConsumerAddrsToPrune.DiscardUnknown(m)
+func (m *VscUnbondingOps) XXX_DiscardUnknown() {
+	xxx_messageInfo_VscUnbondingOps.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ConsumerAddrsToPrune proto.InternalMessageInfo
-
-func (m *ConsumerAddrsToPrune) GetChainId() string {
-	if m != nil {
-		return m.ChainId
-	}
-	return ""
-}
+var xxx_messageInfo_VscUnbondingOps proto.InternalMessageInfo
 
-func (m *ConsumerAddrsToPrune) GetVscId() uint64 {
+func (m *VscUnbondingOps) GetVscId() uint64 {
 	if m != nil {
 		return m.VscId
 	}
 	return 0
 }
 
-func (m *ConsumerAddrsToPrune) GetConsumerAddrs() *ConsumerAddressList {
+func (m *VscUnbondingOps) GetUnbondingOpIds() []uint64 {
 	if m != nil {
-		return m.ConsumerAddrs
+		return m.UnbondingOpIds
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*ConsumerAdditionProposal)(nil), "interchain_security.ccv.provider.v1.ConsumerAdditionProposal")
-	proto.RegisterType((*ConsumerRemovalProposal)(nil), "interchain_security.ccv.provider.v1.ConsumerRemovalProposal")
-	proto.RegisterType((*EquivocationProposal)(nil), "interchain_security.ccv.provider.v1.EquivocationProposal")
-	proto.RegisterType((*GlobalSlashEntry)(nil), "interchain_security.ccv.provider.v1.GlobalSlashEntry")
-	proto.RegisterType((*Params)(nil), "interchain_security.ccv.provider.v1.Params")
-	proto.RegisterType((*HandshakeMetadata)(nil), "interchain_security.ccv.provider.v1.HandshakeMetadata")
-	proto.RegisterType((*SlashAcks)(nil), "interchain_security.ccv.provider.v1.SlashAcks")
-	proto.RegisterType((*ConsumerAdditionProposals)(nil), "interchain_security.ccv.provider.v1.ConsumerAdditionProposals")
-	proto.RegisterType((*ConsumerRemovalProposals)(nil), "interchain_security.ccv.provider.v1.ConsumerRemovalProposals")
-	proto.RegisterType((*ChannelToChain)(nil), "interchain_security.ccv.provider.v1.ChannelToChain")
-	proto.RegisterType((*VscUnbondingOps)(nil), "interchain_security.ccv.provider.v1.VscUnbondingOps")
-	proto.RegisterType((*UnbondingOp)(nil), "interchain_security.ccv.provider.v1.UnbondingOp")
-	proto.RegisterType((*InitTimeoutTimestamp)(nil), "interchain_security.ccv.provider.v1.InitTimeoutTimestamp")
-	proto.RegisterType((*VscSendTimestamp)(nil), "interchain_security.ccv.provider.v1.VscSendTimestamp")
-	proto.RegisterType((*ConsumerConsAddress)(nil), "interchain_security.ccv.provider.v1.ConsumerConsAddress")
-	proto.RegisterType((*ProviderConsAddress)(nil), "interchain_security.ccv.provider.v1.ProviderConsAddress")
-	proto.RegisterType((*ConsumerAddressList)(nil), "interchain_security.ccv.provider.v1.ConsumerAddressList")
-	proto.RegisterType((*KeyAssignmentReplacement)(nil), "interchain_security.ccv.provider.v1.KeyAssignmentReplacement")
-	proto.RegisterType((*ValidatorConsumerPubKey)(nil), "interchain_security.ccv.provider.v1.ValidatorConsumerPubKey")
-	proto.RegisterType((*ValidatorByConsumerAddr)(nil), "interchain_security.ccv.provider.v1.ValidatorByConsumerAddr")
-	proto.RegisterType((*ConsumerAddrsToPrune)(nil), "interchain_security.ccv.provider.v1.ConsumerAddrsToPrune")
+// UnbondingOp contains the ids of consumer chains that need to unbond before
+// the unbonding operation with the given ID can unbond
+type UnbondingOp struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// consumer chains that are still unbonding
+	UnbondingConsumerChains []string `protobuf:"bytes,2,rep,name=unbonding_consumer_chains,json=unbondingConsumerChains,proto3" json:"unbonding_consumer_chains,omitempty"`
+	// token balance of the unbonding entry at the time the unbonding was initiated
+	Balance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=balance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"balance"`
 }
 
-func init() {
-	proto.RegisterFile("interchain_security/ccv/provider/v1/provider.proto", fileDescriptor_f22ec409a72b7b72)
+func (m *UnbondingOp) Reset()         { *m = UnbondingOp{} }
+func (m *UnbondingOp) String() string { return proto.CompactTextString(m) }
+func (*UnbondingOp) ProtoMessage()    {}
+func (*UnbondingOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{20}
 }
-
-var fileDescriptor_f22ec409a72b7b72 = []byte{
-	// 1570 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6e, 0x1b, 0xc9,
-	0x11, 0xd6, 0x90, 0xd4, 0x0f, 0x9b, 0xfa, 0x1d, 0xc9, 0x2b, 0xca, 0x51, 0x28, 0xee, 0xe4, 0x07,
-	0x0c, 0x82, 0x1d, 0x42, 0x5a, 0x2c, 0xb0, 0x10, 0x12, 0x2c, 0x24, 0x7a, 0x77, 0xa5, 0x28, 0xbb,
-	0xe6, 0x8e, 0x18, 0x05, 0x49, 0x10, 0x0c, 0x7a, 0x7a, 0x5a, 0x64, 0x43, 0x33, 0xd3, 0xe3, 0xee,
-	0x9e, 0x59, 0xf3, 0x0d, 0x72, 0x5c, 0x20, 0x97, 0x05, 0x72, 0xf1, 0x25, 0x87, 0x9c, 0xf2, 0x1a,
-	0x06, 0x72, 0xf1, 0x21, 0x87, 0x9c, 0x9c, 0x40, 0x7e, 0x03, 0x3f, 0x41, 0xd0, 0x3d, 0xbf, 0xa4,
-	0x69, 0x9b, 0x82, 0xbd, 0xb7, 0xe9, 0xea, 0xaa, 0xaf, 0xba, 0xaa, 0xab, 0xbe, 0x6a, 0x12, 0x1c,
-	0x91, 0x40, 0x60, 0x86, 0x46, 0x90, 0x04, 0x36, 0xc7, 0x28, 0x62, 0x44, 0x8c, 0xbb, 0x08, 0xc5,
-	0xdd, 0x90, 0xd1, 0x98, 0xb8, 0x98, 0x75, 0xe3, 0xc3, 0xfc, 0xdb, 0x0c, 0x19, 0x15, 0x54, 0xff,
-	0xc9, 0x0c, 0x1b, 0x13, 0xa1, 0xd8, 0xcc, 0xf5, 0xe2, 0xc3, 0xfb, 0x3b, 0x43, 0x3a, 0xa4, 0x4a,
-	0xbf, 0x2b, 0xbf, 0x12, 0xd3, 0xfb, 0x07, 0x43, 0x4a, 0x87, 0x1e, 0xee, 0xaa, 0x95, 0x13, 0x5d,
-	0x77, 0x05, 0xf1, 0x31, 0x17, 0xd0, 0x0f, 0x53, 0x85, 0xd6, 0xb4, 0x82, 0x1b, 0x31, 0x28, 0x08,
-	0x0d, 0x32, 0x00, 0xe2, 0xa0, 0x2e, 0xa2, 0x0c, 0x77, 0x91, 0x47, 0x70, 0x20, 0xe4, 0xf1, 0x92,
-	0xaf, 0x54, 0xa1, 0x2b, 0x15, 0x3c, 0x32, 0x1c, 0x89, 0x44, 0xcc, 0xbb, 0x02, 0x07, 0x2e, 0x66,
-	0x3e, 0x49, 0x94, 0x8b, 0x55, 0x6a, 0xb0, 0x5f, 0xda, 0x47, 0x6c, 0x1c, 0x0a, 0xda, 0xbd, 0xc1,
-	0x63, 0x9e, 0xee, 0xfe, 0x1c, 0x51, 0xee, 0x53, 0xde, 0xc5, 0x32, 0xb0, 0x00, 0xe1, 0x6e, 0x7c,
-	0xe8, 0x60, 0x01, 0x0f, 0x73, 0x41, 0xa2, 0x67, 0xfc, 0x75, 0x09, 0x34, 0x7b, 0x34, 0xe0, 0x91,
-	0x8f, 0xd9, 0x89, 0xeb, 0x12, 0x79, 0xe4, 0x3e, 0xa3, 0x21, 0xe5, 0xd0, 0xd3, 0x77, 0xc0, 0xa2,
-	0x20, 0xc2, 0xc3, 0x4d, 0xad, 0xad, 0x75, 0xea, 0x56, 0xb2, 0xd0, 0xdb, 0xa0, 0xe1, 0x62, 0x8e,
-	0x18, 0x09, 0xa5, 0x72, 0xb3, 0xa2, 0xf6, 0xca, 0x22, 0x7d, 0x0f, 0xac, 0x24, 0x59, 0x26, 0x6e,
-	0xb3, 0xaa, 0xb6, 0x97, 0xd5, 0xfa, 0xdc, 0xd5, 0xbf, 0x04, 0xeb, 0x24, 0x20, 0x82, 0x40, 0xcf,
-	0x1e, 0x61, 0x19, 0x6d, 0xb3, 0xd6, 0xd6, 0x3a, 0x8d, 0xa3, 0xfb, 0x26, 0x71, 0x90, 0x29, 0x13,
-	0x64, 0xa6, 0x69, 0x89, 0x0f, 0xcd, 0x33, 0xa5, 0x71, 0x5a, 0x7b, 0xfa, 0xfc, 0x60, 0xc1, 0x5a,
-	0x4b, 0xed, 0x12, 0xa1, 0xfe, 0x21, 0x58, 0x1d, 0xe2, 0x00, 0x73, 0xc2, 0xed, 0x11, 0xe4, 0xa3,
-	0xe6, 0x62, 0x5b, 0xeb, 0xac, 0x5a, 0x8d, 0x54, 0x76, 0x06, 0xf9, 0x48, 0x3f, 0x00, 0x0d, 0x87,
-	0x04, 0x90, 0x8d, 0x13, 0x8d, 0x25, 0xa5, 0x01, 0x12, 0x91, 0x52, 0xe8, 0x01, 0xc0, 0x43, 0xf8,
-	0x6d, 0x60, 0xcb, 0xdb, 0x6c, 0x2e, 0xa7, 0x07, 0x49, 0x6e, 0xd2, 0xcc, 0x6e, 0xd2, 0x1c, 0x64,
-	0x57, 0x7d, 0xba, 0x22, 0x0f, 0xf2, 0xdd, 0x7f, 0x0f, 0x34, 0xab, 0xae, 0xec, 0xe4, 0x8e, 0xfe,
-	0x35, 0xd8, 0x8c, 0x02, 0x87, 0x06, 0x2e, 0x09, 0x86, 0x76, 0x88, 0x19, 0xa1, 0x6e, 0x73, 0x45,
-	0x41, 0xed, 0xbd, 0x02, 0xf5, 0x20, 0x2d, 0x8a, 0x04, 0xe9, 0x7b, 0x89, 0xb4, 0x91, 0x1b, 0xf7,
-	0x95, 0xad, 0xfe, 0x0d, 0xd0, 0x11, 0x8a, 0xd5, 0x91, 0x68, 0x24, 0x32, 0xc4, 0xfa, 0xfc, 0x88,
-	0x9b, 0x08, 0xc5, 0x83, 0xc4, 0x3a, 0x85, 0xfc, 0x13, 0xd8, 0x15, 0x0c, 0x06, 0xfc, 0x1a, 0xb3,
-	0x69, 0x5c, 0x30, 0x3f, 0xee, 0xbd, 0x0c, 0x63, 0x12, 0xfc, 0x0c, 0xb4, 0x51, 0x5a, 0x40, 0x36,
-	0xc3, 0x2e, 0xe1, 0x82, 0x11, 0x27, 0x92, 0xb6, 0xf6, 0x35, 0x83, 0x48, 0xd5, 0x48, 0x43, 0x15,
-	0x41, 0x2b, 0xd3, 0xb3, 0x26, 0xd4, 0xbe, 0x48, 0xb5, 0xf4, 0x87, 0xe0, 0xa7, 0x8e, 0x47, 0xd1,
-	0x0d, 0x97, 0x87, 0xb3, 0x27, 0x90, 0x94, 0x6b, 0x9f, 0x70, 0x2e, 0xd1, 0x56, 0xdb, 0x5a, 0xa7,
-	0x6a, 0x7d, 0x98, 0xe8, 0xf6, 0x31, 0x7b, 0x50, 0xd2, 0x1c, 0x94, 0x14, 0xf5, 0x8f, 0x80, 0x3e,
-	0x22, 0x5c, 0x50, 0x46, 0x10, 0xf4, 0x6c, 0x1c, 0x08, 0x46, 0x30, 0x6f, 0xae, 0x29, 0xf3, 0xad,
-	0x62, 0xe7, 0xf3, 0x64, 0xe3, 0x78, 0xe5, 0x2f, 0x4f, 0x0e, 0x16, 0xbe, 0x7f, 0x72, 0xb0, 0x60,
-	0xfc, 0x53, 0x03, 0xbb, 0xbd, 0xfc, 0xb0, 0x3e, 0x8d, 0xa1, 0xf7, 0x43, 0x36, 0xc5, 0x09, 0xa8,
-	0x73, 0x41, 0xc3, 0xa4, 0x0c, 0x6b, 0x77, 0x28, 0xc3, 0x15, 0x69, 0x26, 0x37, 0x8c, 0xbf, 0x69,
-	0x60, 0xe7, 0xf3, 0x47, 0x11, 0x89, 0x29, 0x82, 0xef, 0xa5, 0x87, 0x2f, 0xc0, 0x1a, 0x2e, 0xe1,
-	0xf1, 0x66, 0xb5, 0x5d, 0xed, 0x34, 0x8e, 0x7e, 0x66, 0x26, 0xc4, 0x62, 0xe6, 0x3c, 0x92, 0x12,
-	0x8b, 0x59, 0xf6, 0x6e, 0x4d, 0xda, 0x1a, 0x7f, 0xaf, 0x80, 0xcd, 0x2f, 0x3d, 0xea, 0x40, 0xef,
-	0xd2, 0x83, 0x7c, 0x24, 0x13, 0x3e, 0x96, 0x51, 0x33, 0x9c, 0x56, 0xba, 0x3a, 0xdd, 0xdc, 0x51,
-	0x4b, 0x33, 0xd5, 0x7b, 0x9f, 0x81, 0xad, 0xbc, 0xf6, 0xf2, 0xe4, 0xaa, 0x60, 0x4e, 0xb7, 0x6f,
-	0x9f, 0x1f, 0x6c, 0x64, 0x77, 0xd8, 0x53, 0x89, 0x7e, 0x60, 0x6d, 0xa0, 0x09, 0x81, 0xab, 0xb7,
-	0x40, 0x83, 0x38, 0xc8, 0xe6, 0xf8, 0x91, 0x1d, 0x44, 0xbe, 0xba, 0x97, 0x9a, 0x55, 0x27, 0x0e,
-	0xba, 0xc4, 0x8f, 0xbe, 0x8e, 0x7c, 0xdd, 0x07, 0x1f, 0x64, 0xc3, 0xc1, 0x8e, 0xa1, 0x67, 0x4b,
-	0x7b, 0x1b, 0xba, 0x2e, 0x4b, 0xaf, 0xe9, 0x53, 0x73, 0x8e, 0x99, 0x62, 0xf6, 0xd3, 0x6f, 0x79,
-	0x9c, 0x13, 0xd7, 0x65, 0x98, 0x73, 0x6b, 0x3b, 0x53, 0xb8, 0x82, 0x5e, 0x26, 0x37, 0x5e, 0xd6,
-	0xc0, 0x52, 0x1f, 0x32, 0xe8, 0x73, 0x7d, 0x00, 0x36, 0x04, 0xf6, 0x43, 0x0f, 0x0a, 0x6c, 0x27,
-	0x8c, 0x98, 0xe6, 0xe8, 0x97, 0x8a, 0x29, 0xcb, 0x93, 0xc2, 0x2c, 0xcd, 0x86, 0xf8, 0xd0, 0xec,
-	0x29, 0xe9, 0xa5, 0x80, 0x02, 0x5b, 0xeb, 0x19, 0x46, 0x22, 0xd4, 0x3f, 0x05, 0x4d, 0xc1, 0x22,
-	0x2e, 0x0a, 0xae, 0x2a, 0x9a, 0x34, 0x29, 0x82, 0x0f, 0xb2, 0xfd, 0xa4, 0xbd, 0xf3, 0xe6, 0x9c,
-	0x4d, 0x4b, 0xd5, 0x77, 0xa1, 0xa5, 0x4b, 0xb0, 0x2d, 0x39, 0x7d, 0x1a, 0xb3, 0x36, 0x3f, 0xe6,
-	0x96, 0xb4, 0x9f, 0x04, 0xfd, 0x06, 0xe8, 0x31, 0x47, 0xd3, 0x98, 0x8b, 0x77, 0x38, 0x67, 0xcc,
-	0xd1, 0x24, 0xa4, 0x0b, 0xf6, 0xb9, 0x2c, 0x5b, 0xdb, 0xc7, 0x42, 0x91, 0x5c, 0xe8, 0xe1, 0x80,
-	0xf0, 0x51, 0x06, 0xbe, 0x34, 0x3f, 0xf8, 0x9e, 0x02, 0xfa, 0x4a, 0xe2, 0x58, 0x19, 0x4c, 0xea,
-	0xa5, 0x07, 0x5a, 0xb3, 0xbd, 0xe4, 0x17, 0xb4, 0xac, 0x2e, 0xe8, 0x47, 0x33, 0x20, 0xf2, 0x5b,
-	0x3a, 0x02, 0xf7, 0x7c, 0xf8, 0xd8, 0x16, 0x23, 0x46, 0x85, 0xf0, 0xb0, 0x6b, 0x87, 0x10, 0xdd,
-	0x60, 0xc1, 0xd5, 0x44, 0xaa, 0x5a, 0xdb, 0x3e, 0x7c, 0x3c, 0xc8, 0xf6, 0xfa, 0xc9, 0x96, 0xe1,
-	0x80, 0xad, 0x33, 0x18, 0xb8, 0x7c, 0x04, 0x6f, 0xf0, 0x57, 0x58, 0x40, 0x17, 0x0a, 0xa8, 0x7f,
-	0x5c, 0x2a, 0xfc, 0x6b, 0x8c, 0xed, 0x90, 0x52, 0x2f, 0x29, 0xfc, 0x84, 0x47, 0xf2, 0xf2, 0xfd,
-	0x02, 0xe3, 0x3e, 0xa5, 0x9e, 0x2c, 0x5f, 0xbd, 0x09, 0x96, 0x63, 0xcc, 0x78, 0x51, 0x4c, 0xd9,
-	0xd2, 0xf8, 0x05, 0xa8, 0xab, 0xce, 0x3f, 0x41, 0x37, 0x5c, 0xdf, 0x07, 0x75, 0x98, 0x74, 0x01,
-	0xe6, 0x4d, 0xad, 0x5d, 0xed, 0xd4, 0xad, 0x42, 0x60, 0x08, 0xb0, 0xf7, 0xba, 0x07, 0x09, 0xd7,
-	0x7f, 0x0f, 0x96, 0x43, 0xac, 0xa6, 0xa5, 0x32, 0x6c, 0x1c, 0xfd, 0x7a, 0xae, 0x06, 0x7c, 0x1d,
-	0xa0, 0x95, 0xa1, 0x19, 0xac, 0x78, 0x06, 0x4d, 0x11, 0x3e, 0xd7, 0xaf, 0xa6, 0x9d, 0xfe, 0xea,
-	0x4e, 0x4e, 0xa7, 0xf0, 0x0a, 0x9f, 0xbf, 0x01, 0xeb, 0xbd, 0x11, 0x0c, 0x02, 0xec, 0x0d, 0xa8,
-	0x22, 0x24, 0xfd, 0xc7, 0x00, 0xa0, 0x44, 0x22, 0x89, 0x2c, 0xc9, 0x74, 0x3d, 0x95, 0x9c, 0xbb,
-	0x13, 0x23, 0xa4, 0x32, 0x31, 0x42, 0x0c, 0x0b, 0x6c, 0x5c, 0x71, 0xf4, 0xbb, 0xec, 0x2d, 0xf1,
-	0x30, 0xe4, 0xfa, 0x3d, 0xb0, 0x24, 0x3b, 0x21, 0x05, 0xaa, 0x59, 0x8b, 0x31, 0x47, 0xe7, 0xae,
-	0xde, 0x29, 0xbf, 0x57, 0x68, 0x68, 0x13, 0x97, 0x37, 0x2b, 0xed, 0x6a, 0xa7, 0x66, 0xad, 0x47,
-	0x85, 0xf9, 0xb9, 0xcb, 0x8d, 0x3f, 0x80, 0x46, 0x09, 0x50, 0x5f, 0x07, 0x95, 0x1c, 0xab, 0x42,
-	0x5c, 0xfd, 0x18, 0xec, 0x15, 0x40, 0x93, 0x34, 0x9c, 0x20, 0xd6, 0xad, 0xdd, 0x5c, 0x61, 0x82,
-	0x89, 0xb9, 0xf1, 0x10, 0xec, 0x9c, 0x17, 0xad, 0x9b, 0x93, 0xfc, 0x44, 0x84, 0xda, 0xe4, 0x90,
-	0xdc, 0x07, 0xf5, 0xfc, 0xd1, 0xad, 0xa2, 0xaf, 0x59, 0x85, 0xc0, 0xf0, 0xc1, 0xe6, 0x15, 0x47,
-	0x97, 0x38, 0x70, 0x0b, 0xb0, 0xd7, 0x24, 0xe0, 0x74, 0x1a, 0x68, 0xee, 0x47, 0x5f, 0xe1, 0xee,
-	0x13, 0xb0, 0x9d, 0x47, 0x54, 0x90, 0xba, 0x6c, 0x80, 0xb4, 0x90, 0x95, 0xcb, 0x55, 0x2b, 0x5b,
-	0x1e, 0xd7, 0xd4, 0xbb, 0xe2, 0x13, 0xb0, 0x3d, 0x63, 0x16, 0xbc, 0xd5, 0xcc, 0x2f, 0xbc, 0xa5,
-	0x26, 0xbf, 0x25, 0x5c, 0xe8, 0x57, 0xd3, 0x7d, 0x34, 0xef, 0x3c, 0x9a, 0x71, 0xf4, 0x72, 0x07,
-	0xfe, 0x4b, 0x03, 0xcd, 0x0b, 0x3c, 0x3e, 0xe1, 0x9c, 0x0c, 0x03, 0x1f, 0x07, 0x42, 0xf2, 0x0c,
-	0x44, 0x58, 0x7e, 0xea, 0x7f, 0x06, 0x6b, 0x39, 0x31, 0xe4, 0x7c, 0xf0, 0x2e, 0x83, 0x70, 0x35,
-	0x53, 0x50, 0x14, 0x72, 0x0c, 0x40, 0xc8, 0x70, 0x6c, 0x23, 0xfb, 0x06, 0x8f, 0xd3, 0xdb, 0xd9,
-	0x2f, 0x0f, 0xb8, 0xe4, 0xa7, 0x8e, 0xd9, 0x8f, 0x1c, 0x8f, 0xa0, 0x0b, 0x3c, 0xb6, 0x56, 0xa4,
-	0x7e, 0xef, 0x02, 0x8f, 0xe5, 0x53, 0x27, 0xa4, 0xdf, 0x62, 0xa6, 0xa6, 0x52, 0xd5, 0x4a, 0x16,
-	0xc6, 0xbf, 0x35, 0xb0, 0x7b, 0x05, 0x3d, 0xe2, 0x42, 0x41, 0x59, 0x16, 0x79, 0x3f, 0x72, 0xa4,
-	0xc5, 0x1b, 0xca, 0xed, 0x95, 0x38, 0x2b, 0xef, 0x35, 0xce, 0xcf, 0xc0, 0x6a, 0xde, 0x32, 0x32,
-	0xd2, 0xea, 0x1c, 0x91, 0x36, 0x32, 0x8b, 0x0b, 0x3c, 0x36, 0x5e, 0x96, 0xc3, 0x3a, 0x1d, 0x97,
-	0xeb, 0xe3, 0x2d, 0x61, 0xe5, 0x7e, 0xef, 0x1c, 0xd6, 0xac, 0xba, 0xc9, 0xc3, 0x50, 0x9e, 0x5f,
-	0xc9, 0x5a, 0xf5, 0x7d, 0x66, 0xcd, 0xf8, 0x87, 0x06, 0x76, 0xca, 0x91, 0xf2, 0x01, 0xed, 0xb3,
-	0x28, 0xc0, 0x6f, 0x8a, 0xb8, 0x60, 0x81, 0x4a, 0x99, 0x05, 0x6c, 0xb0, 0x3e, 0x91, 0x08, 0x7e,
-	0xa7, 0xa3, 0xce, 0x68, 0x47, 0x6b, 0xad, 0x9c, 0x09, 0x7e, 0x3a, 0x78, 0x7a, 0xdb, 0xd2, 0x9e,
-	0xdd, 0xb6, 0xb4, 0xff, 0xdd, 0xb6, 0xb4, 0xef, 0x5e, 0xb4, 0x16, 0x9e, 0xbd, 0x68, 0x2d, 0xfc,
-	0xe7, 0x45, 0x6b, 0xe1, 0x8f, 0xc7, 0x43, 0x22, 0x46, 0x91, 0x63, 0x22, 0xea, 0x77, 0xd3, 0x9f,
-	0xe9, 0x85, 0xcf, 0x8f, 0xf2, 0x7f, 0x33, 0x1e, 0x4f, 0xfe, 0x9f, 0x21, 0xc6, 0x21, 0xe6, 0xce,
-	0x92, 0x62, 0xa8, 0x8f, 0xff, 0x1f, 0x00, 0x00, 0xff, 0xff, 0xc0, 0x0a, 0x32, 0xf6, 0x00, 0x11,
-	0x00, 0x00,
+func (m *UnbondingOp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *ConsumerAdditionProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *UnbondingOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnbondingOp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *ConsumerAdditionProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *UnbondingOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbondingOp.Merge(m, src)
+}
+func (m *UnbondingOp) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnbondingOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbondingOp.DiscardUnknown(m)
 }
 
-func (m *ConsumerAdditionProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.HistoricalEntries != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.HistoricalEntries))
-		i--
-		dAtA[i] = 0x68
-	}
-	if m.BlocksPerDistributionTransmission != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.BlocksPerDistributionTransmission))
-		i--
-		dAtA[i] = 0x60
-	}
-	if len(m.ConsumerRedistributionFraction) > 0 {
-		i -= len(m.ConsumerRedistributionFraction)
-		copy(dAtA[i:], m.ConsumerRedistributionFraction)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerRedistributionFraction)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TransferTimeoutPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TransferTimeoutPeriod):])
-	if err1 != nil {
-		return 0, err1
-	}
-	i -= n1
-	i = encodeVarintProvider(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x52
-	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.CcvTimeoutPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.CcvTimeoutPeriod):])
-	if err2 != nil {
-		return 0, err2
-	}
-	i -= n2
-	i = encodeVarintProvider(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x4a
-	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UnbondingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnbondingPeriod):])
-	if err3 != nil {
-		return 0, err3
-	}
-	i -= n3
-	i = encodeVarintProvider(dAtA, i, uint64(n3))
-	i--
-	dAtA[i] = 0x42
-	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SpawnTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SpawnTime):])
-	if err4 != nil {
-		return 0, err4
-	}
-	i -= n4
-	i = encodeVarintProvider(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0x3a
-	if len(m.BinaryHash) > 0 {
-		i -= len(m.BinaryHash)
-		copy(dAtA[i:], m.BinaryHash)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.BinaryHash)))
-		i--
-		dAtA[i] = 0x32
+var xxx_messageInfo_UnbondingOp proto.InternalMessageInfo
+
+func (m *UnbondingOp) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if len(m.GenesisHash) > 0 {
-		i -= len(m.GenesisHash)
-		copy(dAtA[i:], m.GenesisHash)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.GenesisHash)))
-		i--
-		dAtA[i] = 0x2a
+	return 0
+}
+
+func (m *UnbondingOp) GetUnbondingConsumerChains() []string {
+	if m != nil {
+		return m.UnbondingConsumerChains
 	}
-	{
-		size, err := m.InitialHeight.MarshalToSizedBuffer(dAtA[:i])
+	return nil
+}
+
+type InitTimeoutTimestamp struct {
+	ChainId   string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Timestamp uint64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *InitTimeoutTimestamp) Reset()         { *m = InitTimeoutTimestamp{} }
+func (m *InitTimeoutTimestamp) String() string { return proto.CompactTextString(m) }
+func (*InitTimeoutTimestamp) ProtoMessage()    {}
+func (*InitTimeoutTimestamp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{21}
+}
+func (m *InitTimeoutTimestamp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InitTimeoutTimestamp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InitTimeoutTimestamp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintProvider(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *InitTimeoutTimestamp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitTimeoutTimestamp.Merge(m, src)
+}
+func (m *InitTimeoutTimestamp) XXX_Size() int {
+	return m.Size()
+}
+func (m *InitTimeoutTimestamp) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitTimeoutTimestamp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InitTimeoutTimestamp proto.InternalMessageInfo
+
+func (m *InitTimeoutTimestamp) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ConsumerRemovalProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *InitTimeoutTimestamp) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ConsumerRemovalProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type VscSendTimestamp struct {
+	VscId     uint64    `protobuf:"varint,1,opt,name=vsc_id,json=vscId,proto3" json:"vsc_id,omitempty"`
+	Timestamp time.Time `protobuf:"bytes,2,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
 }
 
-func (m *ConsumerRemovalProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StopTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StopTime):])
-	if err6 != nil {
-		return 0, err6
-	}
-	i -= n6
-	i = encodeVarintProvider(dAtA, i, uint64(n6))
-	i--
-	dAtA[i] = 0x22
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+func (m *VscSendTimestamp) Reset()         { *m = VscSendTimestamp{} }
+func (m *VscSendTimestamp) String() string { return proto.CompactTextString(m) }
+func (*VscSendTimestamp) ProtoMessage()    {}
+func (*VscSendTimestamp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{22}
+}
+func (m *VscSendTimestamp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VscSendTimestamp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VscSendTimestamp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *VscSendTimestamp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VscSendTimestamp.Merge(m, src)
+}
+func (m *VscSendTimestamp) XXX_Size() int {
+	return m.Size()
+}
+func (m *VscSendTimestamp) XXX_DiscardUnknown() {
+	xxx_messageInfo_VscSendTimestamp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VscSendTimestamp proto.InternalMessageInfo
+
+func (m *VscSendTimestamp) GetVscId() uint64 {
+	if m != nil {
+		return m.VscId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EquivocationProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VscSendTimestamp) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *EquivocationProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// A validator's assigned consensus address for a consumer chain.
+// Note this type is for type safety within provider code, consumer code uses normal sdk.ConsAddress,
+// since there's no notion of provider vs consumer address.
+type ConsumerConsAddress struct {
+	Address []byte `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *EquivocationProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Equivocations) > 0 {
-		for iNdEx := len(m.Equivocations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Equivocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintProvider(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *ConsumerConsAddress) Reset()      { *m = ConsumerConsAddress{} }
+func (*ConsumerConsAddress) ProtoMessage() {}
+func (*ConsumerConsAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{23}
+}
+func (m *ConsumerConsAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerConsAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerConsAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *ConsumerConsAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerConsAddress.Merge(m, src)
+}
+func (m *ConsumerConsAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerConsAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerConsAddress.DiscardUnknown(m)
 }
 
-func (m *GlobalSlashEntry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ConsumerConsAddress proto.InternalMessageInfo
+
+func (m *ConsumerConsAddress) GetAddress() []byte {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GlobalSlashEntry) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// A validator's consensus address on the provider chain
+type ProviderConsAddress struct {
+	Address []byte `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *GlobalSlashEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ProviderValConsAddr != nil {
-		{
-			size, err := m.ProviderValConsAddr.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProvider(dAtA, i, uint64(size))
+func (m *ProviderConsAddress) Reset()      { *m = ProviderConsAddress{} }
+func (*ProviderConsAddress) ProtoMessage() {}
+func (*ProviderConsAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{24}
+}
+func (m *ProviderConsAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderConsAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderConsAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.IbcSeqNum != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.IbcSeqNum))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.ConsumerChainID) > 0 {
-		i -= len(m.ConsumerChainID)
-		copy(dAtA[i:], m.ConsumerChainID)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerChainID)))
-		i--
-		dAtA[i] = 0x12
-	}
-	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.RecvTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.RecvTime):])
-	if err8 != nil {
-		return 0, err8
+		return b[:n], nil
 	}
-	i -= n8
-	i = encodeVarintProvider(dAtA, i, uint64(n8))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *ProviderConsAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderConsAddress.Merge(m, src)
+}
+func (m *ProviderConsAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderConsAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderConsAddress.DiscardUnknown(m)
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ProviderConsAddress proto.InternalMessageInfo
+
+func (m *ProviderConsAddress) GetAddress() []byte {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ConsumerAddressList contains a list of consumer consensus addresses
+type ConsumerAddressList struct {
+	Addresses []*ConsumerConsAddress `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MaxThrottledPackets != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.MaxThrottledPackets))
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.SlashMeterReplenishFraction) > 0 {
-		i -= len(m.SlashMeterReplenishFraction)
-		copy(dAtA[i:], m.SlashMeterReplenishFraction)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.SlashMeterReplenishFraction)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.SlashMeterReplenishPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.SlashMeterReplenishPeriod):])
-	if err9 != nil {
-		return 0, err9
-	}
-	i -= n9
-	i = encodeVarintProvider(dAtA, i, uint64(n9))
-	i--
-	dAtA[i] = 0x32
-	n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VscTimeoutPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VscTimeoutPeriod):])
-	if err10 != nil {
-		return 0, err10
-	}
-	i -= n10
-	i = encodeVarintProvider(dAtA, i, uint64(n10))
-	i--
-	dAtA[i] = 0x2a
-	n11, err11 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.InitTimeoutPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.InitTimeoutPeriod):])
-	if err11 != nil {
-		return 0, err11
-	}
-	i -= n11
-	i = encodeVarintProvider(dAtA, i, uint64(n11))
-	i--
-	dAtA[i] = 0x22
-	n12, err12 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.CcvTimeoutPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.CcvTimeoutPeriod):])
-	if err12 != nil {
-		return 0, err12
-	}
-	i -= n12
-	i = encodeVarintProvider(dAtA, i, uint64(n12))
-	i--
-	dAtA[i] = 0x1a
-	if len(m.TrustingPeriodFraction) > 0 {
-		i -= len(m.TrustingPeriodFraction)
-		copy(dAtA[i:], m.TrustingPeriodFraction)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.TrustingPeriodFraction)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.TemplateClient != nil {
-		{
-			size, err := m.TemplateClient.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProvider(dAtA, i, uint64(size))
+func (m *ConsumerAddressList) Reset()         { *m = ConsumerAddressList{} }
+func (m *ConsumerAddressList) String() string { return proto.CompactTextString(m) }
+func (*ConsumerAddressList) ProtoMessage()    {}
+func (*ConsumerAddressList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{25}
+}
+func (m *ConsumerAddressList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerAddressList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerAddressList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ConsumerAddressList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerAddressList.Merge(m, src)
+}
+func (m *ConsumerAddressList) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerAddressList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerAddressList.DiscardUnknown(m)
 }
 
-func (m *HandshakeMetadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ConsumerAddressList proto.InternalMessageInfo
+
+func (m *ConsumerAddressList) GetAddresses() []*ConsumerConsAddress {
+	if m != nil {
+		return m.Addresses
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *HandshakeMetadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type KeyAssignmentReplacement struct {
+	ProviderAddr *ProviderConsAddress `protobuf:"bytes,1,opt,name=provider_addr,json=providerAddr,proto3" json:"provider_addr,omitempty"`
+	PrevCKey     *crypto.PublicKey    `protobuf:"bytes,2,opt,name=prev_c_key,json=prevCKey,proto3" json:"prev_c_key,omitempty"`
+	Power        int64                `protobuf:"varint,3,opt,name=power,proto3" json:"power,omitempty"`
 }
 
-func (m *HandshakeMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ProviderFeePoolAddr) > 0 {
-		i -= len(m.ProviderFeePoolAddr)
-		copy(dAtA[i:], m.ProviderFeePoolAddr)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ProviderFeePoolAddr)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *KeyAssignmentReplacement) Reset()         { *m = KeyAssignmentReplacement{} }
+func (m *KeyAssignmentReplacement) String() string { return proto.CompactTextString(m) }
+func (*KeyAssignmentReplacement) ProtoMessage()    {}
+func (*KeyAssignmentReplacement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{26}
 }
-
-func (m *SlashAcks) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *KeyAssignmentReplacement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyAssignmentReplacement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeyAssignmentReplacement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *KeyAssignmentReplacement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyAssignmentReplacement.Merge(m, src)
+}
+func (m *KeyAssignmentReplacement) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyAssignmentReplacement) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyAssignmentReplacement.DiscardUnknown(m)
 }
 
-func (m *SlashAcks) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_KeyAssignmentReplacement proto.InternalMessageInfo
+
+func (m *KeyAssignmentReplacement) GetProviderAddr() *ProviderConsAddress {
+	if m != nil {
+		return m.ProviderAddr
+	}
+	return nil
 }
 
-func (m *SlashAcks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintProvider(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *KeyAssignmentReplacement) GetPrevCKey() *crypto.PublicKey {
+	if m != nil {
+		return m.PrevCKey
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ConsumerAdditionProposals) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *KeyAssignmentReplacement) GetPower() int64 {
+	if m != nil {
+		return m.Power
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ConsumerAdditionProposals) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Used to serialize the ValidatorConsumerPubKey index from key assignment
+// ValidatorConsumerPubKey: (chainID, providerAddr consAddr) -> consumerKey tmprotocrypto.PublicKey
+type ValidatorConsumerPubKey struct {
+	ChainId      string               `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ProviderAddr *ProviderConsAddress `protobuf:"bytes,2,opt,name=provider_addr,json=providerAddr,proto3" json:"provider_addr,omitempty"`
+	ConsumerKey  *crypto.PublicKey    `protobuf:"bytes,3,opt,name=consumer_key,json=consumerKey,proto3" json:"consumer_key,omitempty"`
 }
 
-func (m *ConsumerAdditionProposals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Pending) > 0 {
-		for iNdEx := len(m.Pending) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pending[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintProvider(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *ValidatorConsumerPubKey) Reset()         { *m = ValidatorConsumerPubKey{} }
+func (m *ValidatorConsumerPubKey) String() string { return proto.CompactTextString(m) }
+func (*ValidatorConsumerPubKey) ProtoMessage()    {}
+func (*ValidatorConsumerPubKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{27}
+}
+func (m *ValidatorConsumerPubKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorConsumerPubKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorConsumerPubKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *ConsumerRemovalProposals) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ValidatorConsumerPubKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorConsumerPubKey.Merge(m, src)
+}
+func (m *ValidatorConsumerPubKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorConsumerPubKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorConsumerPubKey.DiscardUnknown(m)
 }
 
-func (m *ConsumerRemovalProposals) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ValidatorConsumerPubKey proto.InternalMessageInfo
+
+func (m *ValidatorConsumerPubKey) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
 }
 
-func (m *ConsumerRemovalProposals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Pending) > 0 {
-		for iNdEx := len(m.Pending) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pending[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintProvider(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *ValidatorConsumerPubKey) GetProviderAddr() *ProviderConsAddress {
+	if m != nil {
+		return m.ProviderAddr
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ChannelToChain) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ValidatorConsumerPubKey) GetConsumerKey() *crypto.PublicKey {
+	if m != nil {
+		return m.ConsumerKey
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ChannelToChain) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ValidatorJailRecord records the valset update ID at which
+// a validator was jailed for a downtime infraction on a consumer chain
+type ValidatorJailRecord struct {
+	ProviderAddr *ProviderConsAddress `protobuf:"bytes,1,opt,name=provider_addr,json=providerAddr,proto3" json:"provider_addr,omitempty"`
+	VscId        uint64               `protobuf:"varint,2,opt,name=vsc_id,json=vscId,proto3" json:"vsc_id,omitempty"`
 }
 
-func (m *ChannelToChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
+func (m *ValidatorJailRecord) Reset()         { *m = ValidatorJailRecord{} }
+func (m *ValidatorJailRecord) String() string { return proto.CompactTextString(m) }
+func (*ValidatorJailRecord) ProtoMessage()    {}
+func (*ValidatorJailRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{28}
+}
+func (m *ValidatorJailRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorJailRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorJailRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ValidatorJailRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorJailRecord.Merge(m, src)
+}
+func (m *ValidatorJailRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorJailRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorJailRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorJailRecord proto.InternalMessageInfo
+
+func (m *ValidatorJailRecord) GetProviderAddr() *ProviderConsAddress {
+	if m != nil {
+		return m.ProviderAddr
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *VscUnbondingOps) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ValidatorJailRecord) GetVscId() uint64 {
+	if m != nil {
+		return m.VscId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *VscUnbondingOps) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ValidatorInfractionHeight records the provider block height of the last downtime infraction
+// of a validator on a consumer chain that was applied
+type ValidatorInfractionHeight struct {
+	ProviderAddr     *ProviderConsAddress `protobuf:"bytes,1,opt,name=provider_addr,json=providerAddr,proto3" json:"provider_addr,omitempty"`
+	InfractionHeight uint64               `protobuf:"varint,2,opt,name=infraction_height,json=infractionHeight,proto3" json:"infraction_height,omitempty"`
 }
 
-func (m *VscUnbondingOps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UnbondingOpIds) > 0 {
-		dAtA15 := make([]byte, len(m.UnbondingOpIds)*10)
-		var j14 int
-		for _, num := range m.UnbondingOpIds {
-			for num >= 1<<7 {
-				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j14++
-			}
-			dAtA15[j14] = uint8(num)
-			j14++
-		}
-		i -= j14
-		copy(dAtA[i:], dAtA15[:j14])
-		i = encodeVarintProvider(dAtA, i, uint64(j14))
-		i--
-		dAtA[i] = 0x12
+func (m *ValidatorInfractionHeight) Reset()         { *m = ValidatorInfractionHeight{} }
+func (m *ValidatorInfractionHeight) String() string { return proto.CompactTextString(m) }
+func (*ValidatorInfractionHeight) ProtoMessage()    {}
+func (*ValidatorInfractionHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{29}
+}
+func (m *ValidatorInfractionHeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorInfractionHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorInfractionHeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.VscId != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.VscId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *ValidatorInfractionHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorInfractionHeight.Merge(m, src)
+}
+func (m *ValidatorInfractionHeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorInfractionHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorInfractionHeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorInfractionHeight proto.InternalMessageInfo
+
+func (m *ValidatorInfractionHeight) GetProviderAddr() *ProviderConsAddress {
+	if m != nil {
+		return m.ProviderAddr
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *UnbondingOp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ValidatorInfractionHeight) GetInfractionHeight() uint64 {
+	if m != nil {
+		return m.InfractionHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *UnbondingOp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ConsumerActivity records the provider block at which the provider last heard from a consumer chain,
+// i.e., received a packet or an acknowledgement from it, or completed the handshake of its CCV channel
+type ConsumerActivity struct {
+	Height int64     `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Time   time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
 }
 
-func (m *UnbondingOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UnbondingConsumerChains) > 0 {
-		for iNdEx := len(m.UnbondingConsumerChains) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.UnbondingConsumerChains[iNdEx])
-			copy(dAtA[i:], m.UnbondingConsumerChains[iNdEx])
-			i = encodeVarintProvider(dAtA, i, uint64(len(m.UnbondingConsumerChains[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+func (m *ConsumerActivity) Reset()         { *m = ConsumerActivity{} }
+func (m *ConsumerActivity) String() string { return proto.CompactTextString(m) }
+func (*ConsumerActivity) ProtoMessage()    {}
+func (*ConsumerActivity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{30}
+}
+func (m *ConsumerActivity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerActivity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerActivity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Id != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *ConsumerActivity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerActivity.Merge(m, src)
+}
+func (m *ConsumerActivity) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerActivity) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerActivity.DiscardUnknown(m)
 }
 
-func (m *InitTimeoutTimestamp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ConsumerActivity proto.InternalMessageInfo
+
+func (m *ConsumerActivity) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *InitTimeoutTimestamp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ConsumerActivity) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
 }
 
-func (m *InitTimeoutTimestamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Timestamp != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// Used to serialize the ValidatorConsumerAddr index from key assignment
+// ValidatorByConsumerAddr: (chainID, consumerAddr consAddr) -> providerAddr consAddr
+type ValidatorByConsumerAddr struct {
+	ChainId      string               `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ConsumerAddr *ConsumerConsAddress `protobuf:"bytes,2,opt,name=consumer_addr,json=consumerAddr,proto3" json:"consumer_addr,omitempty"`
+	ProviderAddr *ProviderConsAddress `protobuf:"bytes,3,opt,name=provider_addr,json=providerAddr,proto3" json:"provider_addr,omitempty"`
 }
 
-func (m *VscSendTimestamp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ValidatorByConsumerAddr) Reset()         { *m = ValidatorByConsumerAddr{} }
+func (m *ValidatorByConsumerAddr) String() string { return proto.CompactTextString(m) }
+func (*ValidatorByConsumerAddr) ProtoMessage()    {}
+func (*ValidatorByConsumerAddr) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{31}
+}
+func (m *ValidatorByConsumerAddr) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorByConsumerAddr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorByConsumerAddr.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *VscSendTimestamp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ValidatorByConsumerAddr) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorByConsumerAddr.Merge(m, src)
+}
+func (m *ValidatorByConsumerAddr) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorByConsumerAddr) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorByConsumerAddr.DiscardUnknown(m)
 }
 
-func (m *VscSendTimestamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
-	if err16 != nil {
-		return 0, err16
+var xxx_messageInfo_ValidatorByConsumerAddr proto.InternalMessageInfo
+
+func (m *ValidatorByConsumerAddr) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	i -= n16
-	i = encodeVarintProvider(dAtA, i, uint64(n16))
-	i--
-	dAtA[i] = 0x12
-	if m.VscId != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.VscId))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *ValidatorByConsumerAddr) GetConsumerAddr() *ConsumerConsAddress {
+	if m != nil {
+		return m.ConsumerAddr
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ConsumerConsAddress) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ValidatorByConsumerAddr) GetProviderAddr() *ProviderConsAddress {
+	if m != nil {
+		return m.ProviderAddr
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ConsumerConsAddress) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Used to serialize the ConsumerAddrsToPrune index from key assignment
+// ConsumerAddrsToPrune: (chainID, vscID uint64) -> consumerAddrs AddressList
+type ConsumerAddrsToPrune struct {
+	ChainId       string               `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	VscId         uint64               `protobuf:"varint,2,opt,name=vsc_id,json=vscId,proto3" json:"vsc_id,omitempty"`
+	ConsumerAddrs *ConsumerAddressList `protobuf:"bytes,3,opt,name=consumer_addrs,json=consumerAddrs,proto3" json:"consumer_addrs,omitempty"`
 }
 
-func (m *ConsumerConsAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (m *ConsumerAddrsToPrune) Reset()         { *m = ConsumerAddrsToPrune{} }
+func (m *ConsumerAddrsToPrune) String() string { return proto.CompactTextString(m) }
+func (*ConsumerAddrsToPrune) ProtoMessage()    {}
+func (*ConsumerAddrsToPrune) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{32}
+}
+func (m *ConsumerAddrsToPrune) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerAddrsToPrune) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerAddrsToPrune.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ConsumerAddrsToPrune) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerAddrsToPrune.Merge(m, src)
+}
+func (m *ConsumerAddrsToPrune) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerAddrsToPrune) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerAddrsToPrune.DiscardUnknown(m)
 }
 
-func (m *ProviderConsAddress) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ConsumerAddrsToPrune proto.InternalMessageInfo
+
+func (m *ConsumerAddrsToPrune) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ProviderConsAddress) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ConsumerAddrsToPrune) GetVscId() uint64 {
+	if m != nil {
+		return m.VscId
+	}
+	return 0
 }
 
-func (m *ProviderConsAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (m *ConsumerAddrsToPrune) GetConsumerAddrs() *ConsumerAddressList {
+	if m != nil {
+		return m.ConsumerAddrs
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ConsumerAddressList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// ConsumerRewardsAllocation stores the rewards received from a consumer chain
+// that are held in the consumer rewards pool and not yet distributed
+type ConsumerRewardsAllocation struct {
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+	// ToDistribute defines the part of the rewards that is distributed to the fee collector
+	// in the next block, i.e., the redistribute fraction of the rewards received since then
+	ToDistribute github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=to_distribute,json=toDistribute,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"to_distribute"`
 }
 
-func (m *ConsumerAddressList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ConsumerRewardsAllocation) Reset()         { *m = ConsumerRewardsAllocation{} }
+func (m *ConsumerRewardsAllocation) String() string { return proto.CompactTextString(m) }
+func (*ConsumerRewardsAllocation) ProtoMessage()    {}
+func (*ConsumerRewardsAllocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{33}
 }
-
-func (m *ConsumerAddressList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Addresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintProvider(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *ConsumerRewardsAllocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerRewardsAllocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerRewardsAllocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *KeyAssignmentReplacement) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ConsumerRewardsAllocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerRewardsAllocation.Merge(m, src)
 }
-
-func (m *KeyAssignmentReplacement) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ConsumerRewardsAllocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerRewardsAllocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerRewardsAllocation.DiscardUnknown(m)
 }
 
-func (m *KeyAssignmentReplacement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Power != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.Power))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.PrevCKey != nil {
-		{
-			size, err := m.PrevCKey.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProvider(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ProviderAddr != nil {
-		{
-			size, err := m.ProviderAddr.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProvider(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_ConsumerRewardsAllocation proto.InternalMessageInfo
+
+func (m *ConsumerRewardsAllocation) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ValidatorConsumerPubKey) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ConsumerRewardsAllocation) GetToDistribute() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ToDistribute
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ValidatorConsumerPubKey) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SlashRetry is a slash packet received from a consumer chain that could not be applied
+// because its validator was not found, together with the number of failed retries
+type SlashRetry struct {
+	ChainId string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Data    types5.SlashPacketData `protobuf:"bytes,2,opt,name=data,proto3" json:"data"`
+	Retries int64                  `protobuf:"varint,3,opt,name=retries,proto3" json:"retries,omitempty"`
 }
 
-func (m *ValidatorConsumerPubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ConsumerKey != nil {
-		{
-			size, err := m.ConsumerKey.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProvider(dAtA, i, uint64(size))
+func (m *SlashRetry) Reset()         { *m = SlashRetry{} }
+func (m *SlashRetry) String() string { return proto.CompactTextString(m) }
+func (*SlashRetry) ProtoMessage()    {}
+func (*SlashRetry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{34}
+}
+func (m *SlashRetry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashRetry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashRetry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if m.ProviderAddr != nil {
-		{
-			size, err := m.ProviderAddr.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProvider(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+}
+func (m *SlashRetry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashRetry.Merge(m, src)
+}
+func (m *SlashRetry) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashRetry) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashRetry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashRetry proto.InternalMessageInfo
+
+func (m *SlashRetry) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *SlashRetry) GetData() types5.SlashPacketData {
+	if m != nil {
+		return m.Data
 	}
-	return len(dAtA) - i, nil
+	return types5.SlashPacketData{}
 }
 
-func (m *ValidatorByConsumerAddr) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SlashRetry) GetRetries() int64 {
+	if m != nil {
+		return m.Retries
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ValidatorByConsumerAddr) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ConsumerValidator is a validator of the last validator set the provider sent to a consumer chain
+type ConsumerValidator struct {
+	ProviderAddr *ProviderConsAddress `protobuf:"bytes,1,opt,name=provider_addr,json=providerAddr,proto3" json:"provider_addr,omitempty"`
+	// the consensus public key assigned to the validator on the consumer chain,
+	// empty if the validator uses its provider consensus key
+	ConsumerKey *crypto.PublicKey `protobuf:"bytes,2,opt,name=consumer_key,json=consumerKey,proto3" json:"consumer_key,omitempty"`
+	Power       int64             `protobuf:"varint,3,opt,name=power,proto3" json:"power,omitempty"`
 }
 
-func (m *ValidatorByConsumerAddr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ProviderAddr != nil {
-		{
-			size, err := m.ProviderAddr.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProvider(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.ConsumerAddr != nil {
-		{
-			size, err := m.ConsumerAddr.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProvider(dAtA, i, uint64(size))
+func (m *ConsumerValidator) Reset()         { *m = ConsumerValidator{} }
+func (m *ConsumerValidator) String() string { return proto.CompactTextString(m) }
+func (*ConsumerValidator) ProtoMessage()    {}
+func (*ConsumerValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{35}
+}
+func (m *ConsumerValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ConsumerValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerValidator.Merge(m, src)
+}
+func (m *ConsumerValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerValidator.DiscardUnknown(m)
 }
 
-func (m *ConsumerAddrsToPrune) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ConsumerValidator proto.InternalMessageInfo
+
+func (m *ConsumerValidator) GetProviderAddr() *ProviderConsAddress {
+	if m != nil {
+		return m.ProviderAddr
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ConsumerAddrsToPrune) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ConsumerValidator) GetConsumerKey() *crypto.PublicKey {
+	if m != nil {
+		return m.ConsumerKey
+	}
+	return nil
 }
 
-func (m *ConsumerAddrsToPrune) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ConsumerAddrs != nil {
-		{
-			size, err := m.ConsumerAddrs.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProvider(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.VscId != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.VscId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+func (m *ConsumerValidator) GetPower() int64 {
+	if m != nil {
+		return m.Power
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintProvider(dAtA []byte, offset int, v uint64) int {
-	offset -= sovProvider(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+// ConsumerParameters are the parameters of a consumer chain set by a
+// consumer parameters update proposal, overriding the provider params.
+// The empty fields are not overridden and default to the provider params.
+type ConsumerParameters struct {
+	ConsumerRedistributeFraction string                                   `protobuf:"bytes,1,opt,name=consumer_redistribute_fraction,json=consumerRedistributeFraction,proto3" json:"consumer_redistribute_fraction,omitempty"`
+	SoftOptOutThreshold          string                                   `protobuf:"bytes,2,opt,name=soft_opt_out_threshold,json=softOptOutThreshold,proto3" json:"soft_opt_out_threshold,omitempty"`
+	ExpectedRewardsPerWindow     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=expected_rewards_per_window,json=expectedRewardsPerWindow,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"expected_rewards_per_window"`
 }
-func (m *ConsumerAdditionProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	l = m.InitialHeight.Size()
-	n += 1 + l + sovProvider(uint64(l))
-	l = len(m.GenesisHash)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	l = len(m.BinaryHash)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.SpawnTime)
-	n += 1 + l + sovProvider(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnbondingPeriod)
-	n += 1 + l + sovProvider(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.CcvTimeoutPeriod)
-	n += 1 + l + sovProvider(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.TransferTimeoutPeriod)
-	n += 1 + l + sovProvider(uint64(l))
-	l = len(m.ConsumerRedistributionFraction)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	if m.BlocksPerDistributionTransmission != 0 {
-		n += 1 + sovProvider(uint64(m.BlocksPerDistributionTransmission))
-	}
-	if m.HistoricalEntries != 0 {
-		n += 1 + sovProvider(uint64(m.HistoricalEntries))
+
+func (m *ConsumerParameters) Reset()         { *m = ConsumerParameters{} }
+func (m *ConsumerParameters) String() string { return proto.CompactTextString(m) }
+func (*ConsumerParameters) ProtoMessage()    {}
+func (*ConsumerParameters) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{36}
+}
+func (m *ConsumerParameters) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerParameters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerParameters.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *ConsumerParameters) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerParameters.Merge(m, src)
+}
+func (m *ConsumerParameters) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerParameters) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerParameters.DiscardUnknown(m)
 }
 
-func (m *ConsumerRemovalProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+var xxx_messageInfo_ConsumerParameters proto.InternalMessageInfo
+
+func (m *ConsumerParameters) GetConsumerRedistributeFraction() string {
+	if m != nil {
+		return m.ConsumerRedistributeFraction
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StopTime)
-	n += 1 + l + sovProvider(uint64(l))
-	return n
+	return ""
 }
 
-func (m *EquivocationProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+func (m *ConsumerParameters) GetSoftOptOutThreshold() string {
+	if m != nil {
+		return m.SoftOptOutThreshold
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	return ""
+}
+
+func (m *ConsumerParameters) GetExpectedRewardsPerWindow() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ExpectedRewardsPerWindow
 	}
-	if len(m.Equivocations) > 0 {
-		for _, e := range m.Equivocations {
-			l = e.Size()
-			n += 1 + l + sovProvider(uint64(l))
+	return nil
+}
+
+// ConsumerMetadata is the descriptive metadata of a consumer chain, improving its discoverability,
+// e.g., in explorers. It has no effect on the provider.
+type ConsumerMetadata struct {
+	// the human-readable name of the consumer chain
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the description of the consumer chain
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// the URL of the website of the consumer chain
+	Website string `protobuf:"bytes,3,opt,name=website,proto3" json:"website,omitempty"`
+	// the contact information of the consumer chain team, e.g., an email address
+	Contact string `protobuf:"bytes,4,opt,name=contact,proto3" json:"contact,omitempty"`
+}
+
+func (m *ConsumerMetadata) Reset()         { *m = ConsumerMetadata{} }
+func (m *ConsumerMetadata) String() string { return proto.CompactTextString(m) }
+func (*ConsumerMetadata) ProtoMessage()    {}
+func (*ConsumerMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{37}
+}
+func (m *ConsumerMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *ConsumerMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerMetadata.Merge(m, src)
+}
+func (m *ConsumerMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerMetadata.DiscardUnknown(m)
 }
 
-func (m *GlobalSlashEntry) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.RecvTime)
-	n += 1 + l + sovProvider(uint64(l))
-	l = len(m.ConsumerChainID)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	if m.IbcSeqNum != 0 {
-		n += 1 + sovProvider(uint64(m.IbcSeqNum))
-	}
-	if m.ProviderValConsAddr != nil {
-		l = m.ProviderValConsAddr.Size()
-		n += 1 + l + sovProvider(uint64(l))
+var xxx_messageInfo_ConsumerMetadata proto.InternalMessageInfo
+
+func (m *ConsumerMetadata) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return n
+	return ""
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TemplateClient != nil {
-		l = m.TemplateClient.Size()
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	l = len(m.TrustingPeriodFraction)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.CcvTimeoutPeriod)
-	n += 1 + l + sovProvider(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.InitTimeoutPeriod)
-	n += 1 + l + sovProvider(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.VscTimeoutPeriod)
-	n += 1 + l + sovProvider(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.SlashMeterReplenishPeriod)
-	n += 1 + l + sovProvider(uint64(l))
-	l = len(m.SlashMeterReplenishFraction)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	if m.MaxThrottledPackets != 0 {
-		n += 1 + sovProvider(uint64(m.MaxThrottledPackets))
+func (m *ConsumerMetadata) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	return n
+	return ""
 }
 
-func (m *HandshakeMetadata) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ProviderFeePoolAddr)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+func (m *ConsumerMetadata) GetWebsite() string {
+	if m != nil {
+		return m.Website
 	}
-	return n
+	return ""
 }
 
-func (m *SlashAcks) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovProvider(uint64(l))
-		}
+func (m *ConsumerMetadata) GetContact() string {
+	if m != nil {
+		return m.Contact
 	}
-	return n
+	return ""
 }
 
-func (m *ConsumerAdditionProposals) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Pending) > 0 {
-		for _, e := range m.Pending {
-			l = e.Size()
-			n += 1 + l + sovProvider(uint64(l))
-		}
-	}
-	return n
+// ConsumerRewardsWindow tracks the rewards received from a consumer chain during
+// the current rewards window and the last completed one
+type ConsumerRewardsWindow struct {
+	// the time at which the current window started
+	StartTime time.Time `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// the rewards received since the start of the current window
+	Received github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=received,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"received"`
+	// whether a window was completed since the CCV channel was established
+	LastWindowCompleted bool `protobuf:"varint,3,opt,name=last_window_completed,json=lastWindowCompleted,proto3" json:"last_window_completed,omitempty"`
+	// the rewards received during the last completed window
+	LastWindowReceived github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=last_window_received,json=lastWindowReceived,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"last_window_received"`
 }
 
-func (m *ConsumerRemovalProposals) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Pending) > 0 {
-		for _, e := range m.Pending {
-			l = e.Size()
-			n += 1 + l + sovProvider(uint64(l))
+func (m *ConsumerRewardsWindow) Reset()         { *m = ConsumerRewardsWindow{} }
+func (m *ConsumerRewardsWindow) String() string { return proto.CompactTextString(m) }
+func (*ConsumerRewardsWindow) ProtoMessage()    {}
+func (*ConsumerRewardsWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{38}
+}
+func (m *ConsumerRewardsWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerRewardsWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerRewardsWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *ConsumerRewardsWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerRewardsWindow.Merge(m, src)
+}
+func (m *ConsumerRewardsWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerRewardsWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerRewardsWindow.DiscardUnknown(m)
 }
 
-func (m *ChannelToChain) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+var xxx_messageInfo_ConsumerRewardsWindow proto.InternalMessageInfo
+
+func (m *ConsumerRewardsWindow) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	return n
+	return time.Time{}
 }
 
-func (m *VscUnbondingOps) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.VscId != 0 {
-		n += 1 + sovProvider(uint64(m.VscId))
-	}
-	if len(m.UnbondingOpIds) > 0 {
-		l = 0
-		for _, e := range m.UnbondingOpIds {
-			l += sovProvider(uint64(e))
-		}
-		n += 1 + sovProvider(uint64(l)) + l
+func (m *ConsumerRewardsWindow) GetReceived() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Received
 	}
-	return n
+	return nil
 }
 
-func (m *UnbondingOp) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovProvider(uint64(m.Id))
-	}
-	if len(m.UnbondingConsumerChains) > 0 {
-		for _, s := range m.UnbondingConsumerChains {
-			l = len(s)
-			n += 1 + l + sovProvider(uint64(l))
-		}
+func (m *ConsumerRewardsWindow) GetLastWindowCompleted() bool {
+	if m != nil {
+		return m.LastWindowCompleted
 	}
-	return n
+	return false
 }
 
-func (m *InitTimeoutTimestamp) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	if m.Timestamp != 0 {
-		n += 1 + sovProvider(uint64(m.Timestamp))
+func (m *ConsumerRewardsWindow) GetLastWindowReceived() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.LastWindowReceived
 	}
-	return n
+	return nil
 }
 
-func (m *VscSendTimestamp) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.VscId != 0 {
-		n += 1 + sovProvider(uint64(m.VscId))
-	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
-	n += 1 + l + sovProvider(uint64(l))
-	return n
+func init() {
+	proto.RegisterEnum("interchain_security.ccv.provider.v1.ConsumerPhase", ConsumerPhase_name, ConsumerPhase_value)
+	proto.RegisterType((*ConsumerAdditionProposal)(nil), "interchain_security.ccv.provider.v1.ConsumerAdditionProposal")
+	proto.RegisterType((*ConsumerRemovalProposal)(nil), "interchain_security.ccv.provider.v1.ConsumerRemovalProposal")
+	proto.RegisterType((*EquivocationProposal)(nil), "interchain_security.ccv.provider.v1.EquivocationProposal")
+	proto.RegisterType((*ConsumerValidatorListsProposal)(nil), "interchain_security.ccv.provider.v1.ConsumerValidatorListsProposal")
+	proto.RegisterType((*ConsumerParametersUpdateProposal)(nil), "interchain_security.ccv.provider.v1.ConsumerParametersUpdateProposal")
+	proto.RegisterType((*ForceCompleteUnbondingProposal)(nil), "interchain_security.ccv.provider.v1.ForceCompleteUnbondingProposal")
+	proto.RegisterType((*ConsumerAdditionCancellationProposal)(nil), "interchain_security.ccv.provider.v1.ConsumerAdditionCancellationProposal")
+	proto.RegisterType((*ChangeRewardDenomsProposal)(nil), "interchain_security.ccv.provider.v1.ChangeRewardDenomsProposal")
+	proto.RegisterType((*ConsumerPauseProposal)(nil), "interchain_security.ccv.provider.v1.ConsumerPauseProposal")
+	proto.RegisterType((*ConsumerMetadataUpdateProposal)(nil), "interchain_security.ccv.provider.v1.ConsumerMetadataUpdateProposal")
+	proto.RegisterType((*ChangeConsumerRewardFractionProposal)(nil), "interchain_security.ccv.provider.v1.ChangeConsumerRewardFractionProposal")
+	proto.RegisterType((*GlobalSlashEntry)(nil), "interchain_security.ccv.provider.v1.GlobalSlashEntry")
+	proto.RegisterType((*Params)(nil), "interchain_security.ccv.provider.v1.Params")
+	proto.RegisterType((*HandshakeMetadata)(nil), "interchain_security.ccv.provider.v1.HandshakeMetadata")
+	proto.RegisterType((*ConsumerHandshakeMetadata)(nil), "interchain_security.ccv.provider.v1.ConsumerHandshakeMetadata")
+	proto.RegisterType((*SlashAcks)(nil), "interchain_security.ccv.provider.v1.SlashAcks")
+	proto.RegisterType((*ConsumerAdditionProposals)(nil), "interchain_security.ccv.provider.v1.ConsumerAdditionProposals")
+	proto.RegisterType((*ConsumerRemovalProposals)(nil), "interchain_security.ccv.provider.v1.ConsumerRemovalProposals")
+	proto.RegisterType((*ChannelToChain)(nil), "interchain_security.ccv.provider.v1.ChannelToChain")
+	proto.RegisterType((*VscUnbondingOps)(nil), "interchain_security.ccv.provider.v1.VscUnbondingOps")
+	proto.RegisterType((*UnbondingOp)(nil), "interchain_security.ccv.provider.v1.UnbondingOp")
+	proto.RegisterType((*InitTimeoutTimestamp)(nil), "interchain_security.ccv.provider.v1.InitTimeoutTimestamp")
+	proto.RegisterType((*VscSendTimestamp)(nil), "interchain_security.ccv.provider.v1.VscSendTimestamp")
+	proto.RegisterType((*ConsumerConsAddress)(nil), "interchain_security.ccv.provider.v1.ConsumerConsAddress")
+	proto.RegisterType((*ProviderConsAddress)(nil), "interchain_security.ccv.provider.v1.ProviderConsAddress")
+	proto.RegisterType((*ConsumerAddressList)(nil), "interchain_security.ccv.provider.v1.ConsumerAddressList")
+	proto.RegisterType((*KeyAssignmentReplacement)(nil), "interchain_security.ccv.provider.v1.KeyAssignmentReplacement")
+	proto.RegisterType((*ValidatorConsumerPubKey)(nil), "interchain_security.ccv.provider.v1.ValidatorConsumerPubKey")
+	proto.RegisterType((*ValidatorJailRecord)(nil), "interchain_security.ccv.provider.v1.ValidatorJailRecord")
+	proto.RegisterType((*ValidatorInfractionHeight)(nil), "interchain_security.ccv.provider.v1.ValidatorInfractionHeight")
+	proto.RegisterType((*ConsumerActivity)(nil), "interchain_security.ccv.provider.v1.ConsumerActivity")
+	proto.RegisterType((*ValidatorByConsumerAddr)(nil), "interchain_security.ccv.provider.v1.ValidatorByConsumerAddr")
+	proto.RegisterType((*ConsumerAddrsToPrune)(nil), "interchain_security.ccv.provider.v1.ConsumerAddrsToPrune")
+	proto.RegisterType((*ConsumerRewardsAllocation)(nil), "interchain_security.ccv.provider.v1.ConsumerRewardsAllocation")
+	proto.RegisterType((*SlashRetry)(nil), "interchain_security.ccv.provider.v1.SlashRetry")
+	proto.RegisterType((*ConsumerValidator)(nil), "interchain_security.ccv.provider.v1.ConsumerValidator")
+	proto.RegisterType((*ConsumerParameters)(nil), "interchain_security.ccv.provider.v1.ConsumerParameters")
+	proto.RegisterType((*ConsumerMetadata)(nil), "interchain_security.ccv.provider.v1.ConsumerMetadata")
+	proto.RegisterType((*ConsumerRewardsWindow)(nil), "interchain_security.ccv.provider.v1.ConsumerRewardsWindow")
 }
 
-func (m *ConsumerConsAddress) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	return n
+func init() {
+	proto.RegisterFile("interchain_security/ccv/provider/v1/provider.proto", fileDescriptor_f22ec409a72b7b72)
 }
 
-func (m *ProviderConsAddress) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	return n
+var fileDescriptor_f22ec409a72b7b72 = []byte{
+	// 3021 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x3a, 0x4b, 0x8f, 0x1b, 0xc7,
+	0x99, 0xd3, 0x1c, 0x4a, 0x33, 0xac, 0x79, 0x71, 0x6a, 0x5e, 0x3d, 0x23, 0x99, 0x43, 0xf7, 0x6a,
+	0x8d, 0x81, 0xbd, 0x26, 0x57, 0xf2, 0x6a, 0x61, 0x68, 0xbd, 0x30, 0xe6, 0x25, 0x89, 0x92, 0x3c,
+	0xa2, 0x7b, 0x46, 0x92, 0xd7, 0x0b, 0xa3, 0x51, 0xec, 0xae, 0x21, 0x6b, 0xa7, 0xd9, 0xd5, 0xee,
+	0x2a, 0x72, 0xc4, 0x45, 0x02, 0xe4, 0x68, 0x28, 0x17, 0x1f, 0x0d, 0x24, 0x06, 0x8c, 0x18, 0x41,
+	0x90, 0x5c, 0x72, 0xcc, 0x31, 0x57, 0x07, 0xc9, 0xc1, 0x40, 0x7c, 0x08, 0x7c, 0xb0, 0x03, 0xf9,
+	0x1f, 0x04, 0x39, 0xe4, 0x12, 0x20, 0xa8, 0xaa, 0xae, 0xee, 0x26, 0x87, 0x63, 0x73, 0x22, 0x4d,
+	0x90, 0x13, 0xbb, 0xea, 0x7b, 0x54, 0xd5, 0xf7, 0x7d, 0xf5, 0xbd, 0x8a, 0xe0, 0x1a, 0x09, 0x38,
+	0x8e, 0xdc, 0x16, 0x22, 0x81, 0xc3, 0xb0, 0xdb, 0x89, 0x08, 0xef, 0x55, 0x5d, 0xb7, 0x5b, 0x0d,
+	0x23, 0xda, 0x25, 0x1e, 0x8e, 0xaa, 0xdd, 0xab, 0xc9, 0x77, 0x25, 0x8c, 0x28, 0xa7, 0xf0, 0x5f,
+	0x86, 0xd0, 0x54, 0x5c, 0xb7, 0x5b, 0x49, 0xf0, 0xba, 0x57, 0xd7, 0x16, 0x9b, 0xb4, 0x49, 0x25,
+	0x7e, 0x55, 0x7c, 0x29, 0xd2, 0xb5, 0xf5, 0x26, 0xa5, 0x4d, 0x1f, 0x57, 0xe5, 0xa8, 0xd1, 0x39,
+	0xac, 0x72, 0xd2, 0xc6, 0x8c, 0xa3, 0x76, 0x18, 0x23, 0x94, 0x06, 0x11, 0xbc, 0x4e, 0x84, 0x38,
+	0xa1, 0x81, 0x66, 0x40, 0x1a, 0x6e, 0xd5, 0xa5, 0x11, 0xae, 0xba, 0x3e, 0xc1, 0x01, 0x17, 0xdb,
+	0x53, 0x5f, 0x31, 0x42, 0x55, 0x20, 0xf8, 0xa4, 0xd9, 0xe2, 0x6a, 0x9a, 0x55, 0x39, 0x0e, 0x3c,
+	0x1c, 0xb5, 0x89, 0x42, 0x4e, 0x47, 0x31, 0xc1, 0xe5, 0x0c, 0xdc, 0x8d, 0x7a, 0x21, 0xa7, 0xd5,
+	0x23, 0xdc, 0x63, 0x31, 0xf4, 0x25, 0x97, 0xb2, 0x36, 0x65, 0x55, 0x2c, 0x0e, 0x16, 0xb8, 0xb8,
+	0xda, 0xbd, 0xda, 0xc0, 0x1c, 0x5d, 0x4d, 0x26, 0xf4, 0xbe, 0x63, 0xbc, 0x06, 0x62, 0x29, 0x8e,
+	0x4b, 0x89, 0xde, 0xf7, 0x95, 0xd3, 0xe4, 0x2c, 0xf6, 0xef, 0x76, 0x35, 0x56, 0xcc, 0x85, 0x71,
+	0x74, 0x44, 0x82, 0x66, 0xc2, 0x28, 0x1e, 0x2b, 0x2c, 0xeb, 0x8b, 0x02, 0x30, 0xb7, 0x69, 0xc0,
+	0x3a, 0x6d, 0x1c, 0x6d, 0x7a, 0x1e, 0x11, 0xe2, 0xa9, 0x47, 0x34, 0xa4, 0x0c, 0xf9, 0x70, 0x11,
+	0x5c, 0xe0, 0x84, 0xfb, 0xd8, 0x34, 0xca, 0xc6, 0x46, 0xc1, 0x56, 0x03, 0x58, 0x06, 0x53, 0x1e,
+	0x66, 0x6e, 0x44, 0x42, 0x81, 0x6c, 0xe6, 0x24, 0x2c, 0x3b, 0x05, 0x57, 0xc1, 0xa4, 0xda, 0x1d,
+	0xf1, 0xcc, 0x71, 0x09, 0x9e, 0x90, 0xe3, 0x9a, 0x07, 0x6f, 0x81, 0x59, 0x12, 0x10, 0x4e, 0x90,
+	0xef, 0xb4, 0xb0, 0x90, 0xac, 0x99, 0x2f, 0x1b, 0x1b, 0x53, 0xd7, 0xd6, 0x2a, 0xa4, 0xe1, 0x56,
+	0x84, 0x32, 0x2a, 0xb1, 0x0a, 0xba, 0x57, 0x2b, 0xb7, 0x25, 0xc6, 0x56, 0xfe, 0xb3, 0xaf, 0xd6,
+	0xc7, 0xec, 0x99, 0x98, 0x4e, 0x4d, 0xc2, 0x17, 0xc1, 0x74, 0x13, 0x07, 0x98, 0x11, 0xe6, 0xb4,
+	0x10, 0x6b, 0x99, 0x17, 0xca, 0xc6, 0xc6, 0xb4, 0x3d, 0x15, 0xcf, 0xdd, 0x46, 0xac, 0x05, 0xd7,
+	0xc1, 0x54, 0x83, 0x04, 0x28, 0xea, 0x29, 0x8c, 0x8b, 0x12, 0x03, 0xa8, 0x29, 0x89, 0xb0, 0x0d,
+	0x00, 0x0b, 0xd1, 0x71, 0xe0, 0x08, 0xcb, 0x31, 0x27, 0xe2, 0x8d, 0x28, 0xab, 0xa9, 0x68, 0xab,
+	0xa9, 0x1c, 0x68, 0xb3, 0xda, 0x9a, 0x14, 0x1b, 0xf9, 0xf0, 0xeb, 0x75, 0xc3, 0x2e, 0x48, 0x3a,
+	0x01, 0x81, 0x7b, 0xa0, 0xd8, 0x09, 0x1a, 0x34, 0xf0, 0x48, 0xd0, 0x74, 0x42, 0x1c, 0x11, 0xea,
+	0x99, 0x93, 0x92, 0xd5, 0xea, 0x09, 0x56, 0x3b, 0xb1, 0x01, 0x2a, 0x4e, 0x1f, 0x09, 0x4e, 0x73,
+	0x09, 0x71, 0x5d, 0xd2, 0xc2, 0xb7, 0x01, 0x74, 0xdd, 0xae, 0xdc, 0x12, 0xed, 0x70, 0xcd, 0xb1,
+	0x30, 0x3a, 0xc7, 0xa2, 0xeb, 0x76, 0x0f, 0x14, 0x75, 0xcc, 0xf2, 0x7f, 0xc1, 0x0a, 0x8f, 0x50,
+	0xc0, 0x0e, 0x71, 0x34, 0xc8, 0x17, 0x8c, 0xce, 0x77, 0x49, 0xf3, 0xe8, 0x67, 0x7e, 0x1b, 0x94,
+	0xdd, 0xd8, 0x80, 0x9c, 0x08, 0x7b, 0x84, 0xf1, 0x88, 0x34, 0x3a, 0x82, 0xd6, 0x39, 0x8c, 0x90,
+	0x2b, 0x3e, 0xcc, 0x29, 0x69, 0x04, 0x25, 0x8d, 0x67, 0xf7, 0xa1, 0xdd, 0x8c, 0xb1, 0xe0, 0x7d,
+	0x70, 0xa5, 0xe1, 0x53, 0xf7, 0x88, 0x89, 0xcd, 0x39, 0x7d, 0x9c, 0xe4, 0xd2, 0x6d, 0xc2, 0x98,
+	0xe0, 0x36, 0x5d, 0x36, 0x36, 0xc6, 0xed, 0x17, 0x15, 0x6e, 0x1d, 0x47, 0x3b, 0x19, 0xcc, 0x83,
+	0x0c, 0x22, 0x7c, 0x15, 0xc0, 0x16, 0x61, 0x9c, 0x46, 0xc4, 0x45, 0xbe, 0x83, 0x03, 0x1e, 0x11,
+	0xcc, 0xcc, 0x19, 0x49, 0x3e, 0x9f, 0x42, 0x76, 0x15, 0x00, 0xbe, 0x0e, 0x4c, 0x86, 0x03, 0xcf,
+	0x61, 0x3e, 0x62, 0x2d, 0xc7, 0xa5, 0xc1, 0x21, 0x89, 0xda, 0x52, 0x0a, 0xcc, 0x9c, 0x2d, 0x1b,
+	0x1b, 0x93, 0xf6, 0xb2, 0x80, 0xef, 0x0b, 0xf0, 0x76, 0x16, 0x0a, 0xff, 0x03, 0x2c, 0x87, 0x11,
+	0x3e, 0xc4, 0x51, 0x84, 0x3d, 0x27, 0xc2, 0xc7, 0x28, 0xf2, 0x1c, 0x0f, 0x07, 0xb4, 0x6d, 0xce,
+	0xc9, 0x93, 0x2f, 0x26, 0x50, 0x5b, 0x02, 0x77, 0x04, 0x0c, 0xfe, 0x1b, 0x80, 0x6a, 0x29, 0x8f,
+	0x76, 0x1a, 0x3e, 0x76, 0x18, 0x69, 0x06, 0xcc, 0x2c, 0xca, 0x95, 0x8a, 0x12, 0xb2, 0x23, 0x01,
+	0xfb, 0x62, 0x1e, 0x56, 0xc1, 0x42, 0x17, 0xf9, 0xc4, 0x43, 0x9c, 0x46, 0x0e, 0xf2, 0x7d, 0x7a,
+	0xec, 0x13, 0xc6, 0xcd, 0xf9, 0xf2, 0xf8, 0x46, 0xc1, 0x86, 0x09, 0x68, 0x53, 0x43, 0xc4, 0xe9,
+	0x53, 0x02, 0x0f, 0x07, 0x3d, 0x89, 0x0f, 0x25, 0xfe, 0x7c, 0x02, 0xd9, 0x89, 0x01, 0xf0, 0x7f,
+	0xc0, 0xb2, 0x47, 0x8f, 0x03, 0x61, 0x1f, 0xce, 0xff, 0x21, 0xe2, 0x3b, 0xda, 0x5b, 0x9a, 0x0b,
+	0xa3, 0xdb, 0xc8, 0xa2, 0x66, 0x71, 0x07, 0x11, 0x5f, 0xc3, 0xe1, 0x02, 0xb8, 0xc0, 0x69, 0xe8,
+	0x04, 0xe6, 0x62, 0xd9, 0xd8, 0x98, 0xb1, 0xf3, 0x9c, 0x86, 0x7b, 0xf0, 0x6d, 0x30, 0xd9, 0xc6,
+	0x1c, 0x79, 0x88, 0x23, 0x73, 0x49, 0xae, 0x70, 0xbd, 0x32, 0x42, 0x30, 0xa8, 0x68, 0x6f, 0xf5,
+	0x56, 0x4c, 0x6c, 0x27, 0x6c, 0x6e, 0x4c, 0x7e, 0xf0, 0xc9, 0xfa, 0xd8, 0x47, 0x9f, 0xac, 0x8f,
+	0x59, 0xbf, 0x34, 0xc0, 0xca, 0x76, 0x62, 0x6d, 0x6d, 0xda, 0x45, 0xfe, 0x79, 0x7a, 0xb5, 0x4d,
+	0x50, 0x60, 0xe2, 0x84, 0xd2, 0x8f, 0xe4, 0xcf, 0xe0, 0x47, 0x26, 0x05, 0x99, 0x00, 0x58, 0x3f,
+	0x32, 0xc0, 0xe2, 0xee, 0xfb, 0x1d, 0xd2, 0xa5, 0x2e, 0x7a, 0x2e, 0x4e, 0xf8, 0x2e, 0x98, 0xc1,
+	0x19, 0x7e, 0xcc, 0x1c, 0x2f, 0x8f, 0x6f, 0x4c, 0x5d, 0xfb, 0xd7, 0x8a, 0x8a, 0x0b, 0x95, 0x24,
+	0xe8, 0xc4, 0x81, 0xa1, 0x92, 0x5d, 0xdd, 0xee, 0xa7, 0xb5, 0x7e, 0x6f, 0x80, 0x92, 0x96, 0xe7,
+	0x43, 0x6d, 0x3a, 0xf7, 0x08, 0xe3, 0xec, 0x3c, 0xc5, 0x7a, 0x8a, 0xc9, 0xe7, 0xcf, 0x68, 0xf2,
+	0x17, 0x4e, 0x31, 0x79, 0xeb, 0xaf, 0x39, 0x50, 0xd6, 0xa7, 0xaa, 0xa3, 0x08, 0xb5, 0x31, 0xc7,
+	0x11, 0x7b, 0x10, 0x7a, 0x88, 0xe3, 0xf3, 0x3c, 0xd7, 0x0e, 0x28, 0x0d, 0x73, 0x99, 0x38, 0x75,
+	0x98, 0x79, 0x49, 0x70, 0x79, 0x88, 0xc3, 0xc4, 0x89, 0xbb, 0x7c, 0x0d, 0x2c, 0x33, 0x7a, 0xc8,
+	0x1d, 0x1a, 0x72, 0x47, 0x78, 0x74, 0xde, 0x8a, 0x30, 0x6b, 0x51, 0xdf, 0x93, 0xb1, 0xb0, 0x60,
+	0x2f, 0x08, 0xe8, 0xfd, 0x90, 0xdf, 0xef, 0xf0, 0x03, 0x0d, 0x82, 0x4f, 0x0c, 0x70, 0x09, 0x3f,
+	0x0e, 0xb1, 0xcb, 0x13, 0x4f, 0xa5, 0xdc, 0xed, 0x31, 0x09, 0x3c, 0x7a, 0x6c, 0x5e, 0x94, 0x46,
+	0xb2, 0xaa, 0x8d, 0x44, 0xa4, 0x20, 0x89, 0x81, 0x6c, 0x53, 0x12, 0x6c, 0xfd, 0xbb, 0xb0, 0xdd,
+	0x5f, 0x7c, 0xbd, 0xbe, 0xd1, 0x24, 0xbc, 0xd5, 0x69, 0x54, 0x5c, 0xda, 0xae, 0xc6, 0x99, 0x86,
+	0xfa, 0x79, 0x95, 0x79, 0x47, 0x55, 0xde, 0x0b, 0x31, 0x93, 0x04, 0xcc, 0x36, 0xf5, 0x7a, 0xca,
+	0xf7, 0x09, 0x8f, 0xfd, 0x48, 0x2e, 0x66, 0x31, 0x50, 0xba, 0x49, 0x23, 0x17, 0x6f, 0xd3, 0x76,
+	0xe8, 0x63, 0x8e, 0x1f, 0x24, 0xa1, 0xf0, 0xfc, 0x84, 0x6f, 0xf5, 0xc0, 0x95, 0xc1, 0x84, 0x67,
+	0x1b, 0x05, 0x2e, 0xf6, 0x7d, 0x74, 0xce, 0xc9, 0x8f, 0xf5, 0x13, 0x03, 0xac, 0x6d, 0xb7, 0x50,
+	0xd0, 0xc4, 0x99, 0x30, 0xf0, 0xec, 0x37, 0xc8, 0x02, 0x33, 0x32, 0xd8, 0x30, 0x87, 0x53, 0x07,
+	0x79, 0x9e, 0xbc, 0xe9, 0x12, 0x47, 0x4c, 0x1e, 0xd0, 0x4d, 0xcf, 0x83, 0x1b, 0xa0, 0x98, 0xe2,
+	0x44, 0xc2, 0x23, 0xe2, 0xf8, 0x1e, 0xcd, 0x6a, 0x34, 0xe9, 0x27, 0xb1, 0xf5, 0x03, 0x03, 0x2c,
+	0xa5, 0x97, 0xa2, 0xc3, 0xce, 0xf5, 0x26, 0x2c, 0x82, 0x0b, 0xa1, 0x58, 0x43, 0x1a, 0xfc, 0xa4,
+	0xad, 0x06, 0xd6, 0xef, 0x32, 0xde, 0x46, 0xbb, 0xf9, 0xf3, 0xbf, 0x95, 0x8f, 0x32, 0x01, 0x29,
+	0xff, 0x0c, 0x01, 0x29, 0xce, 0x57, 0x13, 0x66, 0xd6, 0xaf, 0x0d, 0x70, 0x45, 0xa9, 0x3d, 0x0d,
+	0x49, 0x42, 0xfd, 0xfa, 0x26, 0xff, 0xd3, 0xbb, 0x1a, 0xeb, 0xa7, 0x39, 0x50, 0xbc, 0xe5, 0xd3,
+	0x06, 0xf2, 0x65, 0xf2, 0x23, 0x12, 0xa6, 0x9e, 0x08, 0x7a, 0x11, 0x8e, 0x33, 0x55, 0xd3, 0x38,
+	0x4b, 0xd0, 0x13, 0x64, 0x02, 0x00, 0xdf, 0x04, 0xf3, 0xc9, 0xee, 0x92, 0x13, 0xc8, 0x03, 0x6e,
+	0x2d, 0x3c, 0xfd, 0x6a, 0x7d, 0x4e, 0xcb, 0x6b, 0x5b, 0x9e, 0x66, 0xc7, 0x9e, 0x73, 0xfb, 0x26,
+	0x3c, 0x58, 0x02, 0x53, 0xa4, 0xe1, 0x3a, 0x0c, 0xbf, 0xef, 0x04, 0x9d, 0xb6, 0x3c, 0x7c, 0xde,
+	0x2e, 0x90, 0x86, 0xbb, 0x8f, 0xdf, 0xdf, 0xeb, 0xb4, 0x61, 0x1b, 0x2c, 0x6b, 0x55, 0x39, 0x5d,
+	0xe4, 0x8b, 0xa4, 0x8e, 0x89, 0x2b, 0x12, 0xc5, 0x1a, 0x7e, 0x7d, 0x24, 0x0d, 0xd7, 0xe3, 0x6f,
+	0xb1, 0x9d, 0x4d, 0xcf, 0x8b, 0x30, 0x63, 0xf6, 0x82, 0x46, 0x78, 0x88, 0x7c, 0x3d, 0x6f, 0x7d,
+	0x39, 0x0d, 0x2e, 0xca, 0x40, 0xc2, 0xe0, 0x01, 0x98, 0xe3, 0xb8, 0x1d, 0xfa, 0x88, 0x63, 0x47,
+	0x55, 0x34, 0xb1, 0x8c, 0x5e, 0x91, 0x95, 0x4e, 0xb6, 0xaa, 0xac, 0x64, 0xea, 0x48, 0x61, 0x4f,
+	0x72, 0x76, 0x9f, 0x23, 0x8e, 0xed, 0x59, 0xcd, 0x43, 0x4d, 0x8a, 0x14, 0x95, 0x47, 0x1d, 0xc6,
+	0xd3, 0x5a, 0x23, 0x55, 0xa4, 0x32, 0x8c, 0x65, 0x0d, 0x57, 0xe9, 0x79, 0x12, 0x2d, 0x86, 0x97,
+	0x15, 0xe3, 0xcf, 0x52, 0x56, 0xec, 0x83, 0x05, 0x12, 0x10, 0x3e, 0xc8, 0x33, 0x3f, 0x3a, 0xcf,
+	0x79, 0x41, 0xdf, 0xcf, 0xf4, 0x6d, 0x00, 0xbb, 0xcc, 0x1d, 0xe4, 0x79, 0xe1, 0x0c, 0xfb, 0xec,
+	0x32, 0xb7, 0x9f, 0xa5, 0x07, 0x2e, 0xab, 0x3c, 0x5b, 0xc6, 0x77, 0x27, 0xc2, 0xa1, 0x8f, 0x03,
+	0xc2, 0x5a, 0x9a, 0xf9, 0xc5, 0xd1, 0x99, 0xaf, 0x4a, 0x46, 0x6f, 0x09, 0x3e, 0xb6, 0x66, 0x13,
+	0xaf, 0xb2, 0x0d, 0x4a, 0xc3, 0x57, 0x49, 0x14, 0x34, 0x21, 0x15, 0x74, 0x69, 0x08, 0x8b, 0x44,
+	0x4b, 0xd7, 0xc0, 0x52, 0x1b, 0x3d, 0x16, 0xa1, 0x9c, 0x72, 0xee, 0x63, 0xcf, 0x09, 0x91, 0x7b,
+	0x84, 0x39, 0x93, 0x15, 0xe5, 0xb8, 0xbd, 0xd0, 0x46, 0x8f, 0x0f, 0x34, 0xac, 0xae, 0x40, 0x23,
+	0x5c, 0xf1, 0xc2, 0x08, 0xd9, 0xc4, 0xcb, 0x60, 0x5e, 0xac, 0xac, 0x8e, 0x10, 0x61, 0x55, 0x2a,
+	0x01, 0xb9, 0xea, 0x5c, 0x1b, 0x3d, 0x96, 0xf7, 0xde, 0x56, 0xd3, 0xb0, 0x05, 0x4a, 0xca, 0x74,
+	0x1d, 0xfc, 0x38, 0x24, 0x4a, 0x48, 0x4e, 0x33, 0x42, 0x2e, 0xd6, 0x22, 0x9d, 0x1a, 0x5d, 0xa4,
+	0x97, 0x14, 0xab, 0xdd, 0x84, 0xd3, 0x2d, 0xc1, 0x28, 0x16, 0xea, 0x0d, 0xb0, 0x9a, 0xa9, 0xe0,
+	0xba, 0xc8, 0x67, 0x98, 0x27, 0x85, 0x9c, 0xaa, 0x03, 0x57, 0x52, 0x84, 0x87, 0x12, 0xae, 0xcb,
+	0xb9, 0xd3, 0xf3, 0xa3, 0x99, 0xd3, 0xf3, 0xa3, 0x56, 0x9f, 0x30, 0x55, 0x7a, 0xa4, 0x52, 0x23,
+	0x7d, 0xb4, 0xb9, 0xb3, 0x1c, 0xad, 0xcf, 0xdf, 0x33, 0x95, 0xf6, 0xc4, 0x47, 0xfb, 0x2f, 0xb0,
+	0xa6, 0x84, 0xad, 0xd5, 0x94, 0x2d, 0x03, 0x65, 0x15, 0x58, 0xb0, 0x57, 0x24, 0x86, 0xd6, 0x51,
+	0x5a, 0x0d, 0xc2, 0xff, 0x04, 0x2b, 0x27, 0x88, 0x55, 0xe1, 0x65, 0xce, 0x4b, 0xca, 0xa5, 0x01,
+	0x4a, 0x05, 0x84, 0x6f, 0x80, 0x4b, 0x42, 0xcb, 0x69, 0xc3, 0x82, 0x86, 0x2a, 0xfd, 0x93, 0x1e,
+	0xd0, 0x84, 0x4a, 0xa2, 0x6d, 0xf4, 0x38, 0x49, 0xc5, 0xee, 0x87, 0xac, 0x1e, 0xfb, 0x5b, 0x78,
+	0x1d, 0xac, 0xf8, 0xb4, 0xa9, 0xd5, 0xd0, 0x91, 0x11, 0xd9, 0xf1, 0xc8, 0xe1, 0x21, 0x93, 0x35,
+	0xe2, 0xa4, 0xbd, 0xe8, 0xd3, 0xa6, 0x52, 0x82, 0x0a, 0xd7, 0x3b, 0x02, 0x06, 0xdf, 0x01, 0xcb,
+	0x6a, 0xb3, 0xc8, 0x3d, 0x72, 0x1a, 0x88, 0xbb, 0xc9, 0xcd, 0x5b, 0x1c, 0x5d, 0x96, 0x0b, 0x92,
+	0xc5, 0xa6, 0x7b, 0xb4, 0x25, 0x18, 0xc4, 0x32, 0x7c, 0x0f, 0x98, 0x89, 0xb6, 0x7c, 0xd2, 0xc5,
+	0x01, 0x66, 0x5a, 0x5d, 0xe6, 0xd2, 0xe8, 0xbc, 0x97, 0x35, 0x93, 0x7b, 0x31, 0x0f, 0xa5, 0x28,
+	0xb8, 0x09, 0x5e, 0x90, 0x55, 0x07, 0xf6, 0x9c, 0x34, 0x4c, 0x29, 0xc3, 0x97, 0x09, 0xae, 0xb9,
+	0x2c, 0x33, 0xa8, 0xb5, 0x18, 0x29, 0x89, 0x56, 0x12, 0xe5, 0x40, 0x60, 0x08, 0xaf, 0x90, 0xbd,
+	0x2a, 0x3d, 0xe7, 0x18, 0x45, 0x81, 0x10, 0x7c, 0x72, 0x39, 0x57, 0x94, 0x57, 0xc8, 0xdc, 0x82,
+	0xde, 0x23, 0x85, 0xa3, 0xb5, 0x77, 0x27, 0x3f, 0x39, 0x5b, 0x9c, 0xb3, 0x1a, 0x60, 0xfe, 0x36,
+	0x0a, 0x3c, 0xd6, 0x42, 0x47, 0x58, 0xe7, 0x1a, 0xc2, 0xc8, 0x93, 0x00, 0x77, 0x88, 0xb1, 0x13,
+	0x52, 0xea, 0xab, 0x00, 0xa7, 0x72, 0x88, 0x24, 0x4c, 0xdd, 0xc4, 0xb8, 0x4e, 0xa9, 0x2f, 0xc2,
+	0x14, 0x34, 0xc1, 0x44, 0x17, 0x47, 0x2c, 0x0d, 0x1a, 0x7a, 0x68, 0xbd, 0x03, 0x56, 0xf5, 0x29,
+	0x4e, 0xae, 0x95, 0x21, 0x33, 0xfa, 0xc8, 0x4e, 0x34, 0xe3, 0xe2, 0x1c, 0x25, 0xd3, 0x8c, 0x13,
+	0x49, 0x50, 0x61, 0x3f, 0x56, 0x21, 0x83, 0x97, 0x41, 0x01, 0xa9, 0x40, 0x8a, 0x99, 0x69, 0x48,
+	0x29, 0xa6, 0x13, 0xf0, 0x36, 0x98, 0x22, 0x81, 0x16, 0x10, 0x33, 0x73, 0xe5, 0xf1, 0x8d, 0xd9,
+	0x6b, 0x2f, 0xe9, 0x9a, 0x44, 0x37, 0x30, 0x75, 0x59, 0x52, 0x4b, 0x50, 0x85, 0xc8, 0xed, 0x2c,
+	0x29, 0xbc, 0x03, 0x8a, 0xca, 0xe0, 0x18, 0x47, 0x91, 0x8a, 0x54, 0xe6, 0xf8, 0x77, 0xa6, 0x2a,
+	0x79, 0x99, 0xa6, 0xcc, 0x4a, 0xca, 0x7d, 0x41, 0x28, 0x2b, 0x74, 0x0e, 0x56, 0x07, 0x0b, 0x07,
+	0x9d, 0xb9, 0x31, 0xf8, 0x08, 0x4c, 0x84, 0x58, 0x5e, 0x18, 0x79, 0x9c, 0xa9, 0x6b, 0xff, 0x7d,
+	0xa6, 0xdc, 0x71, 0x90, 0xa1, 0xad, 0xb9, 0x59, 0x51, 0xda, 0x9f, 0x1d, 0x68, 0x64, 0x30, 0xf8,
+	0x70, 0x70, 0xd1, 0x37, 0xce, 0xb4, 0xe8, 0x00, 0xbf, 0x74, 0xcd, 0x3b, 0x60, 0x56, 0xe4, 0xab,
+	0x01, 0xf6, 0x0f, 0xa8, 0xba, 0xf9, 0x2f, 0x00, 0xe0, 0xaa, 0x19, 0x91, 0xa1, 0x29, 0xed, 0x17,
+	0xe2, 0x99, 0x9a, 0xd7, 0x97, 0x80, 0xe6, 0xfa, 0x6b, 0x1e, 0x1b, 0xcc, 0x3d, 0x64, 0x6e, 0xd6,
+	0x9d, 0xc0, 0x25, 0x70, 0x51, 0x84, 0xf8, 0x98, 0x51, 0xde, 0xbe, 0xd0, 0x65, 0x6e, 0x4d, 0x96,
+	0x28, 0x59, 0xbf, 0xe4, 0x10, 0x4f, 0xa9, 0x3e, 0x6f, 0xcf, 0x76, 0x52, 0xf2, 0x9a, 0xc7, 0xac,
+	0x4f, 0x0d, 0x30, 0x95, 0xe1, 0x08, 0x67, 0x41, 0x2e, 0x61, 0x96, 0x23, 0x32, 0x6a, 0xa4, 0x9c,
+	0xfa, 0x13, 0x4c, 0xc5, 0xb2, 0x60, 0xaf, 0x24, 0x08, 0x7d, 0x39, 0xa6, 0xb0, 0xbd, 0x89, 0x06,
+	0xf2, 0x45, 0x41, 0xa8, 0x52, 0xe9, 0xad, 0x8a, 0x70, 0x13, 0x5f, 0x7e, 0xb5, 0xfe, 0xd2, 0x08,
+	0x05, 0x6f, 0x2d, 0xe0, 0xb6, 0x26, 0xb7, 0xee, 0x83, 0xc5, 0x5a, 0x9a, 0xde, 0x24, 0xd6, 0xd5,
+	0x27, 0x2c, 0xa3, 0x3f, 0x5b, 0xbf, 0x0c, 0x0a, 0xc9, 0x23, 0x86, 0x14, 0x64, 0xde, 0x4e, 0x27,
+	0xac, 0x36, 0x28, 0x3e, 0x64, 0xee, 0x3e, 0x0e, 0xbc, 0x94, 0xd9, 0x29, 0xb2, 0xdc, 0x1a, 0x64,
+	0x34, 0x72, 0x63, 0x3b, 0x5d, 0xee, 0x3a, 0x58, 0x48, 0x64, 0x93, 0x26, 0xbe, 0xc2, 0x0b, 0xc4,
+	0x37, 0x55, 0x2e, 0x39, 0x6d, 0xeb, 0xe1, 0x8d, 0xbc, 0x6c, 0xbd, 0x5d, 0x07, 0x0b, 0x43, 0xf2,
+	0xe5, 0xef, 0x24, 0x6b, 0xa7, 0xab, 0xc5, 0x24, 0xa2, 0xbd, 0x04, 0x1f, 0x0e, 0x3a, 0x8a, 0x51,
+	0x73, 0xf6, 0x21, 0x5b, 0xcf, 0xb8, 0x18, 0xeb, 0xb7, 0x06, 0x30, 0xef, 0xe2, 0xde, 0x26, 0x13,
+	0xd1, 0xb6, 0x8d, 0x03, 0x2e, 0x72, 0x31, 0xe4, 0x62, 0xf1, 0x09, 0xdf, 0x03, 0x33, 0x89, 0x53,
+	0x4d, 0x7c, 0xe9, 0xb3, 0x14, 0x0b, 0xd3, 0x1a, 0x41, 0x4c, 0xc0, 0x1b, 0x00, 0x84, 0x11, 0xee,
+	0x3a, 0xae, 0x73, 0x84, 0x7b, 0xb1, 0x76, 0x2e, 0x67, 0x8b, 0x00, 0xf5, 0x74, 0x54, 0xa9, 0x77,
+	0x1a, 0x3e, 0x71, 0xef, 0xe2, 0x9e, 0x3d, 0x29, 0xf0, 0xb7, 0xef, 0xe2, 0x9e, 0x2c, 0x98, 0xe9,
+	0x31, 0x8e, 0xa4, 0x71, 0x8e, 0xdb, 0x6a, 0x60, 0x7d, 0x61, 0x80, 0x95, 0xa4, 0x2d, 0x97, 0x14,
+	0xef, 0x9d, 0x86, 0xa0, 0xf8, 0x16, 0x73, 0x3b, 0x71, 0xce, 0xdc, 0x73, 0x3d, 0xe7, 0x9b, 0x60,
+	0x3a, 0xb9, 0x7c, 0xe2, 0xa4, 0xe3, 0x23, 0x9c, 0x74, 0x4a, 0x53, 0xdc, 0xc5, 0x3d, 0xeb, 0x87,
+	0x06, 0x58, 0x48, 0x8e, 0x25, 0x3a, 0xca, 0x36, 0x76, 0x69, 0xe4, 0x9d, 0xb7, 0x7e, 0xd2, 0x3b,
+	0x95, 0xcb, 0xdc, 0x29, 0xeb, 0x67, 0x06, 0x58, 0x4d, 0x76, 0x93, 0x06, 0x9d, 0xf8, 0x3d, 0xea,
+	0x9c, 0xf7, 0xf4, 0x0a, 0x98, 0x4f, 0xe3, 0x9a, 0x7e, 0x3a, 0x53, 0xdb, 0x2b, 0x92, 0x81, 0xbd,
+	0x58, 0x1e, 0x28, 0x26, 0x77, 0xc9, 0xe5, 0xa4, 0x4b, 0x78, 0x0f, 0x2e, 0x83, 0x8b, 0x31, 0x95,
+	0x21, 0x2d, 0x27, 0x1e, 0xc1, 0xd7, 0x41, 0x5e, 0x46, 0xc5, 0xb3, 0x38, 0x09, 0x49, 0x61, 0xfd,
+	0x29, 0x6b, 0x74, 0x5b, 0xbd, 0xec, 0xed, 0xfd, 0x0e, 0xa3, 0x4b, 0xac, 0xe2, 0xcc, 0x46, 0x37,
+	0xec, 0x56, 0x27, 0x46, 0x26, 0x57, 0x3e, 0xa1, 0x87, 0xf1, 0xe7, 0xa9, 0x07, 0xeb, 0xe7, 0x06,
+	0x58, 0xcc, 0x9e, 0x94, 0x1d, 0xd0, 0x7a, 0xd4, 0x09, 0xf0, 0xb7, 0x9d, 0x78, 0xb8, 0x3d, 0x41,
+	0x07, 0xcc, 0xf6, 0x09, 0x82, 0x9d, 0x69, 0xab, 0x43, 0x9c, 0xa5, 0x3d, 0x93, 0x95, 0x04, 0xb3,
+	0xfe, 0x6c, 0xa4, 0x19, 0x4b, 0x5c, 0x81, 0x88, 0x56, 0xb9, 0xea, 0xe9, 0x43, 0x0c, 0x26, 0xe2,
+	0x02, 0xc7, 0x34, 0x9e, 0x7f, 0xd3, 0x57, 0xf3, 0x86, 0x21, 0x98, 0xe1, 0x34, 0x7d, 0xcc, 0xc3,
+	0x66, 0xee, 0xf9, 0x2f, 0x36, 0xcd, 0x69, 0xf2, 0x06, 0x88, 0xad, 0x0f, 0x0c, 0x00, 0x92, 0x72,
+	0xf5, 0x5b, 0xfd, 0xdf, 0x2e, 0xc8, 0xcb, 0x6e, 0x5f, 0x4e, 0x37, 0x66, 0x4e, 0x91, 0x7b, 0xf7,
+	0x6a, 0x45, 0x32, 0x54, 0x15, 0xf7, 0x4e, 0xda, 0xe3, 0xcb, 0xeb, 0xbc, 0x58, 0x17, 0xcc, 0xca,
+	0x2b, 0xeb, 0xa1, 0xf5, 0x1b, 0x03, 0xcc, 0x9f, 0x78, 0x36, 0x39, 0x6f, 0x57, 0x31, 0xe8, 0x76,
+	0x73, 0x67, 0x74, 0xbb, 0xa7, 0xc4, 0x98, 0x1f, 0xe7, 0x00, 0x3c, 0xf9, 0x58, 0x32, 0x42, 0xf7,
+	0xc1, 0x78, 0xa6, 0xb7, 0x8c, 0xdc, 0xdf, 0xff, 0x96, 0x31, 0xfe, 0x8f, 0x7c, 0xcb, 0xf8, 0x5e,
+	0xea, 0x73, 0x93, 0x82, 0x09, 0x82, 0x7c, 0x80, 0xda, 0xba, 0x9d, 0x2b, 0xbf, 0x47, 0xe8, 0xe6,
+	0x9a, 0x60, 0xe2, 0x18, 0x37, 0x18, 0xe1, 0x58, 0x37, 0x73, 0xe3, 0xa1, 0x80, 0xb8, 0x34, 0xe0,
+	0xc8, 0xe5, 0x71, 0xd7, 0x56, 0x0f, 0xad, 0xbf, 0xe4, 0xd2, 0xa6, 0x7d, 0x5f, 0xb3, 0x41, 0xfe,
+	0xc7, 0x21, 0xad, 0x7d, 0x8c, 0x33, 0xfd, 0xc7, 0x41, 0x97, 0x3e, 0xb0, 0x09, 0x44, 0xcf, 0x16,
+	0x93, 0x2e, 0xf6, 0xce, 0xe3, 0xfe, 0x26, 0xcc, 0x45, 0xff, 0xcb, 0x47, 0x8c, 0xeb, 0x96, 0x8b,
+	0x1b, 0x3f, 0x0c, 0xa9, 0x46, 0xe5, 0xa4, 0xbd, 0x20, 0x80, 0xea, 0x60, 0xfa, 0xcd, 0xc8, 0x83,
+	0xdf, 0x07, 0x8b, 0x59, 0x9a, 0x64, 0xa3, 0xf9, 0xe7, 0xbf, 0x51, 0x98, 0xae, 0x6f, 0xc7, 0xcb,
+	0xbc, 0xfc, 0xab, 0x1c, 0x98, 0x49, 0xee, 0x45, 0x0b, 0x31, 0xd1, 0x64, 0x59, 0xdb, 0xbe, 0xbf,
+	0xb7, 0xff, 0xe0, 0xad, 0x5d, 0xdb, 0xa9, 0xdf, 0xde, 0xdc, 0xdf, 0x75, 0x1e, 0xec, 0xed, 0xd7,
+	0x77, 0xb7, 0x6b, 0x37, 0x6b, 0xbb, 0x3b, 0xc5, 0xb1, 0xb5, 0xcb, 0x4f, 0x3e, 0x2e, 0x9b, 0x7d,
+	0x24, 0x0f, 0x02, 0x16, 0x62, 0x97, 0x1c, 0x12, 0xec, 0x89, 0xff, 0x12, 0x0c, 0x50, 0xd7, 0x77,
+	0xf7, 0x76, 0x6a, 0x7b, 0xb7, 0x8a, 0xc6, 0x9a, 0xf9, 0xe4, 0xe3, 0xf2, 0x62, 0x1f, 0x65, 0x5d,
+	0x95, 0x6c, 0x43, 0xd6, 0xac, 0xed, 0xd5, 0x0e, 0x6a, 0x9b, 0xf7, 0x6a, 0xef, 0xee, 0xee, 0x14,
+	0x73, 0x43, 0xd6, 0xac, 0xa9, 0xbf, 0xd3, 0x90, 0xff, 0xc7, 0x9e, 0x68, 0x27, 0x0d, 0x50, 0xdf,
+	0xdb, 0x7c, 0xb0, 0xb7, 0x7d, 0x7b, 0x77, 0xa7, 0x38, 0xbe, 0xb6, 0xfa, 0xe4, 0xe3, 0xf2, 0x52,
+	0x1f, 0xe9, 0x3d, 0xd4, 0x09, 0xdc, 0xd6, 0x50, 0xba, 0xfd, 0x83, 0xfb, 0xf5, 0xba, 0xd8, 0x6c,
+	0x7e, 0x08, 0xdd, 0x3e, 0xa7, 0x61, 0x48, 0x82, 0xe6, 0x5a, 0xfe, 0x83, 0x4f, 0x4b, 0x63, 0x5b,
+	0x07, 0x9f, 0x3d, 0x2d, 0x19, 0x9f, 0x3f, 0x2d, 0x19, 0x7f, 0x7c, 0x5a, 0x32, 0x3e, 0xfc, 0xa6,
+	0x34, 0xf6, 0xf9, 0x37, 0xa5, 0xb1, 0x3f, 0x7c, 0x53, 0x1a, 0x7b, 0xf7, 0xc6, 0x49, 0x8d, 0xa4,
+	0xbe, 0xf1, 0xd5, 0xe4, 0x3f, 0x4f, 0x8f, 0xfb, 0xff, 0x5d, 0x26, 0x35, 0xd5, 0xb8, 0x28, 0x8d,
+	0xfa, 0xb5, 0xbf, 0x0d, 0x00, 0x00, 0xd8, 0xaf, 0x2d, 0x8e, 0x26, 0x00, 0x00,
 }
 
-func (m *ConsumerAddressList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Addresses) > 0 {
-		for _, e := range m.Addresses {
-			l = e.Size()
-			n += 1 + l + sovProvider(uint64(l))
-		}
+func (m *ConsumerAdditionProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *KeyAssignmentReplacement) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ProviderAddr != nil {
-		l = m.ProviderAddr.Size()
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	if m.PrevCKey != nil {
-		l = m.PrevCKey.Size()
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	if m.Power != 0 {
-		n += 1 + sovProvider(uint64(m.Power))
-	}
-	return n
+func (m *ConsumerAdditionProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ValidatorConsumerPubKey) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ConsumerAdditionProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProvider(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
 	}
-	if m.ProviderAddr != nil {
-		l = m.ProviderAddr.Size()
-		n += 1 + l + sovProvider(uint64(l))
+	if m.TopN != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.TopN))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
 	}
-	if m.ConsumerKey != nil {
-		l = m.ConsumerKey.Size()
-		n += 1 + l + sovProvider(uint64(l))
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration):])
+	if err2 != nil {
+		return 0, err2
 	}
-	return n
-}
-
-func (m *ValidatorByConsumerAddr) Size() (n int) {
-	if m == nil {
-		return 0
+	i -= n2
+	i = encodeVarintProvider(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	if len(m.ValidatorDenylist) > 0 {
+		for iNdEx := len(m.ValidatorDenylist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ValidatorDenylist[iNdEx])
+			copy(dAtA[i:], m.ValidatorDenylist[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.ValidatorDenylist[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if len(m.ValidatorAllowlist) > 0 {
+		for iNdEx := len(m.ValidatorAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ValidatorAllowlist[iNdEx])
+			copy(dAtA[i:], m.ValidatorAllowlist[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.ValidatorAllowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
 	}
-	if m.ConsumerAddr != nil {
-		l = m.ConsumerAddr.Size()
-		n += 1 + l + sovProvider(uint64(l))
+	if m.SlashDoubleSigns {
+		i--
+		if m.SlashDoubleSigns {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
 	}
-	if m.ProviderAddr != nil {
-		l = m.ProviderAddr.Size()
-		n += 1 + l + sovProvider(uint64(l))
+	if len(m.PreferredRewardDenom) > 0 {
+		i -= len(m.PreferredRewardDenom)
+		copy(dAtA[i:], m.PreferredRewardDenom)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.PreferredRewardDenom)))
+		i--
+		dAtA[i] = 0x7a
 	}
-	return n
+	if m.SendSlashConfirmations {
+		i--
+		if m.SendSlashConfirmations {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.HistoricalEntries != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.HistoricalEntries))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.BlocksPerDistributionTransmission != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.BlocksPerDistributionTransmission))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.ConsumerRedistributionFraction) > 0 {
+		i -= len(m.ConsumerRedistributionFraction)
+		copy(dAtA[i:], m.ConsumerRedistributionFraction)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerRedistributionFraction)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TransferTimeoutPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TransferTimeoutPeriod):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintProvider(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x52
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.CcvTimeoutPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.CcvTimeoutPeriod):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintProvider(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x4a
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UnbondingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnbondingPeriod):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintProvider(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x42
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SpawnTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SpawnTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintProvider(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.BinaryHash) > 0 {
+		i -= len(m.BinaryHash)
+		copy(dAtA[i:], m.BinaryHash)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.BinaryHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.GenesisHash) > 0 {
+		i -= len(m.GenesisHash)
+		copy(dAtA[i:], m.GenesisHash)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.GenesisHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.InitialHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProvider(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ConsumerAddrsToPrune) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ConsumerRemovalProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerRemovalProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerRemovalProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StopTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StopTime):])
+	if err8 != nil {
+		return 0, err8
 	}
-	if m.VscId != 0 {
-		n += 1 + sovProvider(uint64(m.VscId))
+	i -= n8
+	i = encodeVarintProvider(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x22
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.ConsumerAddrs != nil {
-		l = m.ConsumerAddrs.Size()
-		n += 1 + l + sovProvider(uint64(l))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovProvider(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *EquivocationProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozProvider(x uint64) (n int) {
-	return sovProvider(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *EquivocationProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowProvider
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+
+func (m *EquivocationProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Equivocations) > 0 {
+		for iNdEx := len(m.Equivocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Equivocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProvider(dAtA, i, uint64(size))
 			}
+			i--
+			dAtA[i] = 0x1a
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerAdditionProposal: wiretype end group for non-group")
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerValidatorListsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerValidatorListsProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerValidatorListsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorDenylist) > 0 {
+		for iNdEx := len(m.ValidatorDenylist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ValidatorDenylist[iNdEx])
+			copy(dAtA[i:], m.ValidatorDenylist[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.ValidatorDenylist[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
 		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerAdditionProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+	}
+	if len(m.ValidatorAllowlist) > 0 {
+		for iNdEx := len(m.ValidatorAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ValidatorAllowlist[iNdEx])
+			copy(dAtA[i:], m.ValidatorAllowlist[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.ValidatorAllowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x22
 		}
-		switch fieldNum {
-		case 1:
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerParametersUpdateProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerParametersUpdateProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerParametersUpdateProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExpectedRewardsPerWindow) > 0 {
+		for iNdEx := len(m.ExpectedRewardsPerWindow) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExpectedRewardsPerWindow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProvider(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.SoftOptOutThreshold) > 0 {
+		i -= len(m.SoftOptOutThreshold)
+		copy(dAtA[i:], m.SoftOptOutThreshold)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.SoftOptOutThreshold)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ConsumerRedistributeFraction) > 0 {
+		i -= len(m.ConsumerRedistributeFraction)
+		copy(dAtA[i:], m.ConsumerRedistributeFraction)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerRedistributeFraction)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForceCompleteUnbondingProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForceCompleteUnbondingProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForceCompleteUnbondingProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerAdditionCancellationProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerAdditionCancellationProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerAdditionCancellationProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChangeRewardDenomsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChangeRewardDenomsProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChangeRewardDenomsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenomsToRemove) > 0 {
+		for iNdEx := len(m.DenomsToRemove) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DenomsToRemove[iNdEx])
+			copy(dAtA[i:], m.DenomsToRemove[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.DenomsToRemove[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DenomsToAdd) > 0 {
+		for iNdEx := len(m.DenomsToAdd) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DenomsToAdd[iNdEx])
+			copy(dAtA[i:], m.DenomsToAdd[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.DenomsToAdd[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerPauseProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerPauseProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerPauseProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pause {
+		i--
+		if m.Pause {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerMetadataUpdateProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerMetadataUpdateProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerMetadataUpdateProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProvider(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChangeConsumerRewardFractionProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChangeConsumerRewardFractionProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChangeConsumerRewardFractionProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerRedistributeFraction) > 0 {
+		i -= len(m.ConsumerRedistributeFraction)
+		copy(dAtA[i:], m.ConsumerRedistributeFraction)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerRedistributeFraction)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GlobalSlashEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GlobalSlashEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GlobalSlashEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProviderValConsAddr != nil {
+		{
+			size, err := m.ProviderValConsAddr.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProvider(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.IbcSeqNum != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.IbcSeqNum))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ConsumerChainID) > 0 {
+		i -= len(m.ConsumerChainID)
+		copy(dAtA[i:], m.ConsumerChainID)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.RecvTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.RecvTime):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintProvider(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientExpiryWarningFraction) > 0 {
+		i -= len(m.ClientExpiryWarningFraction)
+		copy(dAtA[i:], m.ClientExpiryWarningFraction)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ClientExpiryWarningFraction)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if len(m.AllowedConsumerClientTypes) > 0 {
+		for iNdEx := len(m.AllowedConsumerClientTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedConsumerClientTypes[iNdEx])
+			copy(dAtA[i:], m.AllowedConsumerClientTypes[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.AllowedConsumerClientTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	n12, err12 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ConsumerLivenessWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ConsumerLivenessWindow):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintProvider(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	n13, err13 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.SlashAckBatchPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.SlashAckBatchPeriod):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintProvider(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	if m.LogValsetUpdateDiffs {
+		i--
+		if m.LogValsetUpdateDiffs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.MaxUnbondingOpsPerChain != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.MaxUnbondingOpsPerChain))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.SlashFractionDowntime) > 0 {
+		i -= len(m.SlashFractionDowntime)
+		copy(dAtA[i:], m.SlashFractionDowntime)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.SlashFractionDowntime)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.SlashFractionDoubleSign) > 0 {
+		i -= len(m.SlashFractionDoubleSign)
+		copy(dAtA[i:], m.SlashFractionDoubleSign)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.SlashFractionDoubleSign)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	n14, err14 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ConsumerRewardsWindowPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ConsumerRewardsWindowPeriod):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintProvider(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x7a
+	if len(m.SoftOptOutThreshold) > 0 {
+		i -= len(m.SoftOptOutThreshold)
+		copy(dAtA[i:], m.SoftOptOutThreshold)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.SoftOptOutThreshold)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.HistoricalValsetEntries != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.HistoricalValsetEntries))
+		i--
+		dAtA[i] = 0x60
+	}
+	n15, err15 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ClientExpirationGracePeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ClientExpirationGracePeriod):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintProvider(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x5a
+	if m.MaxSlashRetries != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.MaxSlashRetries))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.ConsumerRedistributeFraction) > 0 {
+		i -= len(m.ConsumerRedistributeFraction)
+		copy(dAtA[i:], m.ConsumerRedistributeFraction)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerRedistributeFraction)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.MaxThrottledPackets != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.MaxThrottledPackets))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.SlashMeterReplenishFraction) > 0 {
+		i -= len(m.SlashMeterReplenishFraction)
+		copy(dAtA[i:], m.SlashMeterReplenishFraction)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.SlashMeterReplenishFraction)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	n16, err16 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.SlashMeterReplenishPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.SlashMeterReplenishPeriod):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintProvider(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x32
+	n17, err17 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VscTimeoutPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VscTimeoutPeriod):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintProvider(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x2a
+	n18, err18 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.InitTimeoutPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.InitTimeoutPeriod):])
+	if err18 != nil {
+		return 0, err18
+	}
+	i -= n18
+	i = encodeVarintProvider(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0x22
+	n19, err19 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.CcvTimeoutPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.CcvTimeoutPeriod):])
+	if err19 != nil {
+		return 0, err19
+	}
+	i -= n19
+	i = encodeVarintProvider(dAtA, i, uint64(n19))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TrustingPeriodFraction) > 0 {
+		i -= len(m.TrustingPeriodFraction)
+		copy(dAtA[i:], m.TrustingPeriodFraction)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.TrustingPeriodFraction)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TemplateClient != nil {
+		{
+			size, err := m.TemplateClient.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProvider(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HandshakeMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HandshakeMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HandshakeMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProviderFeePoolAddr) > 0 {
+		i -= len(m.ProviderFeePoolAddr)
+		copy(dAtA[i:], m.ProviderFeePoolAddr)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ProviderFeePoolAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerHandshakeMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerHandshakeMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerHandshakeMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GenesisHash) > 0 {
+		i -= len(m.GenesisHash)
+		copy(dAtA[i:], m.GenesisHash)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.GenesisHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlashAcks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashAcks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashAcks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BatchStartTime != nil {
+		n21, err21 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.BatchStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.BatchStartTime):])
+		if err21 != nil {
+			return 0, err21
+		}
+		i -= n21
+		i = encodeVarintProvider(dAtA, i, uint64(n21))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Infractions) > 0 {
+		dAtA23 := make([]byte, len(m.Infractions)*10)
+		var j22 int
+		for _, num := range m.Infractions {
+			for num >= 1<<7 {
+				dAtA23[j22] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j22++
+			}
+			dAtA23[j22] = uint8(num)
+			j22++
+		}
+		i -= j22
+		copy(dAtA[i:], dAtA23[:j22])
+		i = encodeVarintProvider(dAtA, i, uint64(j22))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerAdditionProposals) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerAdditionProposals) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerAdditionProposals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pending) > 0 {
+		for iNdEx := len(m.Pending) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pending[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProvider(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerRemovalProposals) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerRemovalProposals) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerRemovalProposals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pending) > 0 {
+		for iNdEx := len(m.Pending) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pending[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProvider(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelToChain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelToChain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelToChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VscUnbondingOps) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VscUnbondingOps) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VscUnbondingOps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UnbondingOpIds) > 0 {
+		dAtA25 := make([]byte, len(m.UnbondingOpIds)*10)
+		var j24 int
+		for _, num := range m.UnbondingOpIds {
+			for num >= 1<<7 {
+				dAtA25[j24] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j24++
+			}
+			dAtA25[j24] = uint8(num)
+			j24++
+		}
+		i -= j24
+		copy(dAtA[i:], dAtA25[:j24])
+		i = encodeVarintProvider(dAtA, i, uint64(j24))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.VscId != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.VscId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnbondingOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnbondingOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbondingOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProvider(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.UnbondingConsumerChains) > 0 {
+		for iNdEx := len(m.UnbondingConsumerChains) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UnbondingConsumerChains[iNdEx])
+			copy(dAtA[i:], m.UnbondingConsumerChains[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.UnbondingConsumerChains[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Id != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InitTimeoutTimestamp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InitTimeoutTimestamp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InitTimeoutTimestamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VscSendTimestamp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VscSendTimestamp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VscSendTimestamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n26, err26 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err26 != nil {
+		return 0, err26
+	}
+	i -= n26
+	i = encodeVarintProvider(dAtA, i, uint64(n26))
+	i--
+	dAtA[i] = 0x12
+	if m.VscId != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.VscId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerConsAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerConsAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerConsAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderConsAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderConsAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderConsAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerAddressList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerAddressList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerAddressList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Addresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProvider(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KeyAssignmentReplacement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyAssignmentReplacement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyAssignmentReplacement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Power != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PrevCKey != nil {
+		{
+			size, err := m.PrevCKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProvider(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProviderAddr != nil {
+		{
+			size, err := m.ProviderAddr.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProvider(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorConsumerPubKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorConsumerPubKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorConsumerPubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConsumerKey != nil {
+		{
+			size, err := m.ConsumerKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProvider(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ProviderAddr != nil {
+		{
+			size, err := m.ProviderAddr.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProvider(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorJailRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorJailRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorJailRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VscId != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.VscId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProviderAddr != nil {
+		{
+			size, err := m.ProviderAddr.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProvider(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorInfractionHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorInfractionHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorInfractionHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InfractionHeight != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.InfractionHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProviderAddr != nil {
+		{
+			size, err := m.ProviderAddr.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProvider(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerActivity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerActivity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerActivity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n33, err33 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err33 != nil {
+		return 0, err33
+	}
+	i -= n33
+	i = encodeVarintProvider(dAtA, i, uint64(n33))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorByConsumerAddr) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorByConsumerAddr) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorByConsumerAddr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProviderAddr != nil {
+		{
+			size, err := m.ProviderAddr.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProvider(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ConsumerAddr != nil {
+		{
+			size, err := m.ConsumerAddr.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProvider(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerAddrsToPrune) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerAddrsToPrune) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerAddrsToPrune) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConsumerAddrs != nil {
+		{
+			size, err := m.ConsumerAddrs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProvider(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.VscId != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.VscId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerRewardsAllocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerRewardsAllocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerRewardsAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ToDistribute) > 0 {
+		for iNdEx := len(m.ToDistribute) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ToDistribute[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProvider(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProvider(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlashRetry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashRetry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashRetry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Retries != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Retries))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProvider(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Power != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ConsumerKey != nil {
+		{
+			size, err := m.ConsumerKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProvider(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProviderAddr != nil {
+		{
+			size, err := m.ProviderAddr.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProvider(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerParameters) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerParameters) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerParameters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExpectedRewardsPerWindow) > 0 {
+		for iNdEx := len(m.ExpectedRewardsPerWindow) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExpectedRewardsPerWindow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProvider(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SoftOptOutThreshold) > 0 {
+		i -= len(m.SoftOptOutThreshold)
+		copy(dAtA[i:], m.SoftOptOutThreshold)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.SoftOptOutThreshold)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerRedistributeFraction) > 0 {
+		i -= len(m.ConsumerRedistributeFraction)
+		copy(dAtA[i:], m.ConsumerRedistributeFraction)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerRedistributeFraction)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contact) > 0 {
+		i -= len(m.Contact)
+		copy(dAtA[i:], m.Contact)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Contact)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Website) > 0 {
+		i -= len(m.Website)
+		copy(dAtA[i:], m.Website)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Website)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerRewardsWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerRewardsWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerRewardsWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LastWindowReceived) > 0 {
+		for iNdEx := len(m.LastWindowReceived) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LastWindowReceived[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProvider(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.LastWindowCompleted {
+		i--
+		if m.LastWindowCompleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Received) > 0 {
+		for iNdEx := len(m.Received) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Received[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProvider(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n40, err40 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err40 != nil {
+		return 0, err40
+	}
+	i -= n40
+	i = encodeVarintProvider(dAtA, i, uint64(n40))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProvider(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProvider(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ConsumerAdditionProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = m.InitialHeight.Size()
+	n += 1 + l + sovProvider(uint64(l))
+	l = len(m.GenesisHash)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.BinaryHash)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.SpawnTime)
+	n += 1 + l + sovProvider(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnbondingPeriod)
+	n += 1 + l + sovProvider(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.CcvTimeoutPeriod)
+	n += 1 + l + sovProvider(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.TransferTimeoutPeriod)
+	n += 1 + l + sovProvider(uint64(l))
+	l = len(m.ConsumerRedistributionFraction)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.BlocksPerDistributionTransmission != 0 {
+		n += 1 + sovProvider(uint64(m.BlocksPerDistributionTransmission))
+	}
+	if m.HistoricalEntries != 0 {
+		n += 1 + sovProvider(uint64(m.HistoricalEntries))
+	}
+	if m.SendSlashConfirmations {
+		n += 2
+	}
+	l = len(m.PreferredRewardDenom)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.SlashDoubleSigns {
+		n += 3
+	}
+	if len(m.ValidatorAllowlist) > 0 {
+		for _, s := range m.ValidatorAllowlist {
+			l = len(s)
+			n += 2 + l + sovProvider(uint64(l))
+		}
+	}
+	if len(m.ValidatorDenylist) > 0 {
+		for _, s := range m.ValidatorDenylist {
+			l = len(s)
+			n += 2 + l + sovProvider(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration)
+	n += 2 + l + sovProvider(uint64(l))
+	if m.TopN != 0 {
+		n += 2 + sovProvider(uint64(m.TopN))
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 2 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *ConsumerRemovalProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StopTime)
+	n += 1 + l + sovProvider(uint64(l))
+	return n
+}
+
+func (m *EquivocationProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if len(m.Equivocations) > 0 {
+		for _, e := range m.Equivocations {
+			l = e.Size()
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConsumerValidatorListsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if len(m.ValidatorAllowlist) > 0 {
+		for _, s := range m.ValidatorAllowlist {
+			l = len(s)
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	if len(m.ValidatorDenylist) > 0 {
+		for _, s := range m.ValidatorDenylist {
+			l = len(s)
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConsumerParametersUpdateProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ConsumerRedistributeFraction)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.SoftOptOutThreshold)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if len(m.ExpectedRewardsPerWindow) > 0 {
+		for _, e := range m.ExpectedRewardsPerWindow {
+			l = e.Size()
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ForceCompleteUnbondingProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *ConsumerAdditionCancellationProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *ChangeRewardDenomsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if len(m.DenomsToAdd) > 0 {
+		for _, s := range m.DenomsToAdd {
+			l = len(s)
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	if len(m.DenomsToRemove) > 0 {
+		for _, s := range m.DenomsToRemove {
+			l = len(s)
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConsumerPauseProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.Pause {
+		n += 2
+	}
+	return n
+}
+
+func (m *ConsumerMetadataUpdateProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovProvider(uint64(l))
+	return n
+}
+
+func (m *ChangeConsumerRewardFractionProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ConsumerRedistributeFraction)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *GlobalSlashEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.RecvTime)
+	n += 1 + l + sovProvider(uint64(l))
+	l = len(m.ConsumerChainID)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.IbcSeqNum != 0 {
+		n += 1 + sovProvider(uint64(m.IbcSeqNum))
+	}
+	if m.ProviderValConsAddr != nil {
+		l = m.ProviderValConsAddr.Size()
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TemplateClient != nil {
+		l = m.TemplateClient.Size()
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.TrustingPeriodFraction)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.CcvTimeoutPeriod)
+	n += 1 + l + sovProvider(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.InitTimeoutPeriod)
+	n += 1 + l + sovProvider(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.VscTimeoutPeriod)
+	n += 1 + l + sovProvider(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.SlashMeterReplenishPeriod)
+	n += 1 + l + sovProvider(uint64(l))
+	l = len(m.SlashMeterReplenishFraction)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.MaxThrottledPackets != 0 {
+		n += 1 + sovProvider(uint64(m.MaxThrottledPackets))
+	}
+	l = len(m.ConsumerRedistributeFraction)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.MaxSlashRetries != 0 {
+		n += 1 + sovProvider(uint64(m.MaxSlashRetries))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ClientExpirationGracePeriod)
+	n += 1 + l + sovProvider(uint64(l))
+	if m.HistoricalValsetEntries != 0 {
+		n += 1 + sovProvider(uint64(m.HistoricalValsetEntries))
+	}
+	l = len(m.SoftOptOutThreshold)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ConsumerRewardsWindowPeriod)
+	n += 1 + l + sovProvider(uint64(l))
+	l = len(m.SlashFractionDoubleSign)
+	if l > 0 {
+		n += 2 + l + sovProvider(uint64(l))
+	}
+	l = len(m.SlashFractionDowntime)
+	if l > 0 {
+		n += 2 + l + sovProvider(uint64(l))
+	}
+	if m.MaxUnbondingOpsPerChain != 0 {
+		n += 2 + sovProvider(uint64(m.MaxUnbondingOpsPerChain))
+	}
+	if m.LogValsetUpdateDiffs {
+		n += 3
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.SlashAckBatchPeriod)
+	n += 2 + l + sovProvider(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ConsumerLivenessWindow)
+	n += 2 + l + sovProvider(uint64(l))
+	if len(m.AllowedConsumerClientTypes) > 0 {
+		for _, s := range m.AllowedConsumerClientTypes {
+			l = len(s)
+			n += 2 + l + sovProvider(uint64(l))
+		}
+	}
+	l = len(m.ClientExpiryWarningFraction)
+	if l > 0 {
+		n += 2 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *HandshakeMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderFeePoolAddr)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *ConsumerHandshakeMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.GenesisHash)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *SlashAcks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	if len(m.Infractions) > 0 {
+		l = 0
+		for _, e := range m.Infractions {
+			l += sovProvider(uint64(e))
+		}
+		n += 1 + sovProvider(uint64(l)) + l
+	}
+	if m.BatchStartTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.BatchStartTime)
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *ConsumerAdditionProposals) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pending) > 0 {
+		for _, e := range m.Pending {
+			l = e.Size()
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConsumerRemovalProposals) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pending) > 0 {
+		for _, e := range m.Pending {
+			l = e.Size()
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChannelToChain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *VscUnbondingOps) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VscId != 0 {
+		n += 1 + sovProvider(uint64(m.VscId))
+	}
+	if len(m.UnbondingOpIds) > 0 {
+		l = 0
+		for _, e := range m.UnbondingOpIds {
+			l += sovProvider(uint64(e))
+		}
+		n += 1 + sovProvider(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *UnbondingOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovProvider(uint64(m.Id))
+	}
+	if len(m.UnbondingConsumerChains) > 0 {
+		for _, s := range m.UnbondingConsumerChains {
+			l = len(s)
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	l = m.Balance.Size()
+	n += 1 + l + sovProvider(uint64(l))
+	return n
+}
+
+func (m *InitTimeoutTimestamp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovProvider(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *VscSendTimestamp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VscId != 0 {
+		n += 1 + sovProvider(uint64(m.VscId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovProvider(uint64(l))
+	return n
+}
+
+func (m *ConsumerConsAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *ProviderConsAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *ConsumerAddressList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, e := range m.Addresses {
+			l = e.Size()
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *KeyAssignmentReplacement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProviderAddr != nil {
+		l = m.ProviderAddr.Size()
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.PrevCKey != nil {
+		l = m.PrevCKey.Size()
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovProvider(uint64(m.Power))
+	}
+	return n
+}
+
+func (m *ValidatorConsumerPubKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.ProviderAddr != nil {
+		l = m.ProviderAddr.Size()
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.ConsumerKey != nil {
+		l = m.ConsumerKey.Size()
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorJailRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProviderAddr != nil {
+		l = m.ProviderAddr.Size()
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.VscId != 0 {
+		n += 1 + sovProvider(uint64(m.VscId))
+	}
+	return n
+}
+
+func (m *ValidatorInfractionHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProviderAddr != nil {
+		l = m.ProviderAddr.Size()
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.InfractionHeight != 0 {
+		n += 1 + sovProvider(uint64(m.InfractionHeight))
+	}
+	return n
+}
+
+func (m *ConsumerActivity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovProvider(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovProvider(uint64(l))
+	return n
+}
+
+func (m *ValidatorByConsumerAddr) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.ConsumerAddr != nil {
+		l = m.ConsumerAddr.Size()
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.ProviderAddr != nil {
+		l = m.ProviderAddr.Size()
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *ConsumerAddrsToPrune) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.VscId != 0 {
+		n += 1 + sovProvider(uint64(m.VscId))
+	}
+	if m.ConsumerAddrs != nil {
+		l = m.ConsumerAddrs.Size()
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *ConsumerRewardsAllocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	if len(m.ToDistribute) > 0 {
+		for _, e := range m.ToDistribute {
+			l = e.Size()
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SlashRetry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = m.Data.Size()
+	n += 1 + l + sovProvider(uint64(l))
+	if m.Retries != 0 {
+		n += 1 + sovProvider(uint64(m.Retries))
+	}
+	return n
+}
+
+func (m *ConsumerValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProviderAddr != nil {
+		l = m.ProviderAddr.Size()
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.ConsumerKey != nil {
+		l = m.ConsumerKey.Size()
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovProvider(uint64(m.Power))
+	}
+	return n
+}
+
+func (m *ConsumerParameters) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerRedistributeFraction)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.SoftOptOutThreshold)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if len(m.ExpectedRewardsPerWindow) > 0 {
+		for _, e := range m.ExpectedRewardsPerWindow {
+			l = e.Size()
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConsumerMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.Website)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.Contact)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *ConsumerRewardsWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovProvider(uint64(l))
+	if len(m.Received) > 0 {
+		for _, e := range m.Received {
+			l = e.Size()
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	if m.LastWindowCompleted {
+		n += 2
+	}
+	if len(m.LastWindowReceived) > 0 {
+		for _, e := range m.LastWindowReceived {
+			l = e.Size()
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovProvider(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProvider(x uint64) (n int) {
+	return sovProvider(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerAdditionProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerAdditionProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisHash = append(m.GenesisHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.GenesisHash == nil {
+				m.GenesisHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BinaryHash = append(m.BinaryHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BinaryHash == nil {
+				m.BinaryHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpawnTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.SpawnTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.UnbondingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CcvTimeoutPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.CcvTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferTimeoutPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.TransferTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerRedistributionFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerRedistributionFraction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerDistributionTransmission", wireType)
+			}
+			m.BlocksPerDistributionTransmission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksPerDistributionTransmission |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
+			}
+			m.HistoricalEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoricalEntries |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendSlashConfirmations", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SendSlashConfirmations = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreferredRewardDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreferredRewardDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashDoubleSigns", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SlashDoubleSigns = bool(v != 0)
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAllowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAllowlist = append(m.ValidatorAllowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDenylist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorDenylist = append(m.ValidatorDenylist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DowntimeJailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopN", wireType)
+			}
+			m.TopN = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopN |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &ConsumerMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerRemovalProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerRemovalProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerRemovalProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StopTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EquivocationProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EquivocationProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EquivocationProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Equivocations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Equivocations = append(m.Equivocations, &types1.Equivocation{})
+			if err := m.Equivocations[len(m.Equivocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerValidatorListsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerValidatorListsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerValidatorListsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAllowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAllowlist = append(m.ValidatorAllowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDenylist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorDenylist = append(m.ValidatorDenylist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerParametersUpdateProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerParametersUpdateProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerParametersUpdateProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerRedistributeFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerRedistributeFraction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SoftOptOutThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SoftOptOutThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedRewardsPerWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectedRewardsPerWindow = append(m.ExpectedRewardsPerWindow, types2.Coin{})
+			if err := m.ExpectedRewardsPerWindow[len(m.ExpectedRewardsPerWindow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForceCompleteUnbondingProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForceCompleteUnbondingProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForceCompleteUnbondingProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerAdditionCancellationProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerAdditionCancellationProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerAdditionCancellationProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChangeRewardDenomsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChangeRewardDenomsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChangeRewardDenomsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomsToAdd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomsToAdd = append(m.DenomsToAdd, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomsToRemove", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomsToRemove = append(m.DenomsToRemove, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerPauseProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerPauseProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerPauseProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pause", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pause = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerMetadataUpdateProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerMetadataUpdateProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerMetadataUpdateProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChangeConsumerRewardFractionProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChangeConsumerRewardFractionProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChangeConsumerRewardFractionProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerRedistributeFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerRedistributeFraction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GlobalSlashEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GlobalSlashEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GlobalSlashEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.RecvTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcSeqNum", wireType)
+			}
+			m.IbcSeqNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IbcSeqNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderValConsAddr", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProviderValConsAddr == nil {
+				m.ProviderValConsAddr = &ProviderConsAddress{}
+			}
+			if err := m.ProviderValConsAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateClient", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TemplateClient == nil {
+				m.TemplateClient = &types3.ClientState{}
+			}
+			if err := m.TemplateClient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustingPeriodFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrustingPeriodFraction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CcvTimeoutPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.CcvTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitTimeoutPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.InitTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VscTimeoutPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VscTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashMeterReplenishPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.SlashMeterReplenishPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashMeterReplenishFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashMeterReplenishFraction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxThrottledPackets", wireType)
+			}
+			m.MaxThrottledPackets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxThrottledPackets |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerRedistributeFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerRedistributeFraction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlashRetries", wireType)
+			}
+			m.MaxSlashRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSlashRetries |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientExpirationGracePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ClientExpirationGracePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalValsetEntries", wireType)
+			}
+			m.HistoricalValsetEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoricalValsetEntries |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SoftOptOutThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SoftOptOutThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerRewardsWindowPeriod", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -2881,27 +9102,28 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ConsumerRewardsWindowPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDoubleSign", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2929,11 +9151,11 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.SlashFractionDoubleSign = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDowntime", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2961,13 +9183,13 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.SlashFractionDowntime = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialHeight", wireType)
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnbondingOpsPerChain", wireType)
 			}
-			var msglen int
+			m.MaxUnbondingOpsPerChain = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -2977,30 +9199,16 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxUnbondingOpsPerChain |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.InitialHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisHash", wireType)
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogValsetUpdateDiffs", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -3010,31 +9218,17 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GenesisHash = append(m.GenesisHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.GenesisHash == nil {
-				m.GenesisHash = []byte{}
-			}
-			iNdEx = postIndex
-		case 6:
+			m.LogValsetUpdateDiffs = bool(v != 0)
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BinaryHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashAckBatchPeriod", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -3044,29 +9238,28 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BinaryHash = append(m.BinaryHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.BinaryHash == nil {
-				m.BinaryHash = []byte{}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.SlashAckBatchPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpawnTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerLivenessWindow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3093,15 +9286,15 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.SpawnTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ConsumerLivenessWindow, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedConsumerClientTypes", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -3111,30 +9304,29 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.UnbondingPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AllowedConsumerClientTypes = append(m.AllowedConsumerClientTypes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 9:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CcvTimeoutPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientExpiryWarningFraction", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -3144,30 +9336,79 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.CcvTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
+			m.ClientExpiryWarningFraction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 10:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HandshakeMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HandshakeMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HandshakeMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferTimeoutPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderFeePoolAddr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -3177,28 +9418,27 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.TransferTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ProviderFeePoolAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerRedistributionFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3226,13 +9466,63 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerRedistributionFraction = string(dAtA[iNdEx:postIndex])
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerDistributionTransmission", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.BlocksPerDistributionTransmission = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerHandshakeMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerHandshakeMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerHandshakeMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -3242,16 +9532,29 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlocksPerDistributionTransmission |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
 			}
-			m.HistoricalEntries = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisHash", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -3261,11 +9564,24 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HistoricalEntries |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProvider(dAtA[iNdEx:])
@@ -3287,7 +9603,7 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConsumerRemovalProposal) Unmarshal(dAtA []byte) error {
+func (m *SlashAcks) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3310,15 +9626,15 @@ func (m *ConsumerRemovalProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerRemovalProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: SlashAcks: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerRemovalProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SlashAcks: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3346,45 +9662,82 @@ func (m *ConsumerRemovalProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
+			if wireType == 0 {
+				var v types4.InfractionType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProvider
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= types4.InfractionType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.Infractions = append(m.Infractions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProvider
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthProvider
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthProvider
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				if elementCount != 0 && len(m.Infractions) == 0 {
+					m.Infractions = make([]types4.InfractionType, 0, elementCount)
 				}
+				for iNdEx < postIndex {
+					var v types4.InfractionType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProvider
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= types4.InfractionType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Infractions = append(m.Infractions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Infractions", wireType)
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchStartTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -3394,27 +9747,81 @@ func (m *ConsumerRemovalProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			if m.BatchStartTime == nil {
+				m.BatchStartTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.BatchStartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerAdditionProposals) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerAdditionProposals: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerAdditionProposals: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StopTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3441,7 +9848,8 @@ func (m *ConsumerRemovalProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StopTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.Pending = append(m.Pending, &ConsumerAdditionProposal{})
+			if err := m.Pending[len(m.Pending)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3466,7 +9874,7 @@ func (m *ConsumerRemovalProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EquivocationProposal) Unmarshal(dAtA []byte) error {
+func (m *ConsumerRemovalProposals) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3489,17 +9897,17 @@ func (m *EquivocationProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EquivocationProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerRemovalProposals: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EquivocationProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerRemovalProposals: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -3509,27 +9917,79 @@ func (m *EquivocationProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Pending = append(m.Pending, &ConsumerRemovalProposal{})
+			if err := m.Pending[len(m.Pending)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelToChain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelToChain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelToChain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3557,13 +10017,13 @@ func (m *EquivocationProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Equivocations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -3573,25 +10033,23 @@ func (m *EquivocationProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Equivocations = append(m.Equivocations, &types1.Equivocation{})
-			if err := m.Equivocations[len(m.Equivocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3614,7 +10072,7 @@ func (m *EquivocationProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GlobalSlashEntry) Unmarshal(dAtA []byte) error {
+func (m *VscUnbondingOps) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3637,17 +10095,17 @@ func (m *GlobalSlashEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GlobalSlashEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: VscUnbondingOps: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GlobalSlashEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VscUnbondingOps: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecvTime", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VscId", wireType)
 			}
-			var msglen int
+			m.VscId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -3657,28 +10115,159 @@ func (m *GlobalSlashEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.VscId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthProvider
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProvider
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.UnbondingOpIds = append(m.UnbondingOpIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProvider
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthProvider
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthProvider
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.UnbondingOpIds) == 0 {
+					m.UnbondingOpIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProvider
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.UnbondingOpIds = append(m.UnbondingOpIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOpIds", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthProvider
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.RecvTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbondingOp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingOp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingOp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerChainID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingConsumerChains", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3706,32 +10295,13 @@ func (m *GlobalSlashEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerChainID = string(dAtA[iNdEx:postIndex])
+			m.UnbondingConsumerChains = append(m.UnbondingConsumerChains, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcSeqNum", wireType)
-			}
-			m.IbcSeqNum = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.IbcSeqNum |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderValConsAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -3741,25 +10311,23 @@ func (m *GlobalSlashEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProviderValConsAddr == nil {
-				m.ProviderValConsAddr = &ProviderConsAddress{}
-			}
-			if err := m.ProviderValConsAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3784,7 +10352,7 @@ func (m *GlobalSlashEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *InitTimeoutTimestamp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3806,52 +10374,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		}
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateClient", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TemplateClient == nil {
-				m.TemplateClient = &types2.ClientState{}
-			}
-			if err := m.TemplateClient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		if wireType == 4 {
+			return fmt.Errorf("proto: InitTimeoutTimestamp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InitTimeoutTimestamp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrustingPeriodFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3879,13 +10411,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TrustingPeriodFraction = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CcvTimeoutPeriod", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			var msglen int
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -3895,30 +10427,66 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Timestamp |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthProvider
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthProvider
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.CcvTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VscSendTimestamp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitTimeoutPeriod", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VscSendTimestamp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VscSendTimestamp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VscId", wireType)
+			}
+			m.VscId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -3928,28 +10496,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.VscId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.InitTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VscTimeoutPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3976,48 +10530,65 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VscTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashMeterReplenishPeriod", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerConsAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.SlashMeterReplenishPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 7:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerConsAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerConsAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashMeterReplenishFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -4027,43 +10598,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SlashMeterReplenishFraction = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxThrottledPackets", wireType)
-			}
-			m.MaxThrottledPackets = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxThrottledPackets |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProvider(dAtA[iNdEx:])
@@ -4085,7 +10639,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HandshakeMetadata) Unmarshal(dAtA []byte) error {
+func (m *ProviderConsAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4108,49 +10662,17 @@ func (m *HandshakeMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HandshakeMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProviderConsAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HandshakeMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProviderConsAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderFeePoolAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProviderFeePoolAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -4160,23 +10682,25 @@ func (m *HandshakeMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4199,7 +10723,7 @@ func (m *HandshakeMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SlashAcks) Unmarshal(dAtA []byte) error {
+func (m *ConsumerAddressList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4222,17 +10746,17 @@ func (m *SlashAcks) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SlashAcks: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerAddressList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SlashAcks: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerAddressList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -4242,23 +10766,25 @@ func (m *SlashAcks) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.Addresses = append(m.Addresses, &ConsumerConsAddress{})
+			if err := m.Addresses[len(m.Addresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4281,7 +10807,7 @@ func (m *SlashAcks) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConsumerAdditionProposals) Unmarshal(dAtA []byte) error {
+func (m *KeyAssignmentReplacement) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4304,15 +10830,15 @@ func (m *ConsumerAdditionProposals) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerAdditionProposals: wiretype end group for non-group")
+			return fmt.Errorf("proto: KeyAssignmentReplacement: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerAdditionProposals: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KeyAssignmentReplacement: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddr", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4339,11 +10865,68 @@ func (m *ConsumerAdditionProposals) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pending = append(m.Pending, &ConsumerAdditionProposal{})
-			if err := m.Pending[len(m.Pending)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ProviderAddr == nil {
+				m.ProviderAddr = &ProviderConsAddress{}
+			}
+			if err := m.ProviderAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevCKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PrevCKey == nil {
+				m.PrevCKey = &crypto.PublicKey{}
+			}
+			if err := m.PrevCKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProvider(dAtA[iNdEx:])
@@ -4365,7 +10948,7 @@ func (m *ConsumerAdditionProposals) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConsumerRemovalProposals) Unmarshal(dAtA []byte) error {
+func (m *ValidatorConsumerPubKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4388,15 +10971,47 @@ func (m *ConsumerRemovalProposals) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerRemovalProposals: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorConsumerPubKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerRemovalProposals: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorConsumerPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddr", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4423,8 +11038,46 @@ func (m *ConsumerRemovalProposals) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pending = append(m.Pending, &ConsumerRemovalProposal{})
-			if err := m.Pending[len(m.Pending)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ProviderAddr == nil {
+				m.ProviderAddr = &ProviderConsAddress{}
+			}
+			if err := m.ProviderAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsumerKey == nil {
+				m.ConsumerKey = &crypto.PublicKey{}
+			}
+			if err := m.ConsumerKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4449,7 +11102,7 @@ func (m *ConsumerRemovalProposals) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChannelToChain) Unmarshal(dAtA []byte) error {
+func (m *ValidatorJailRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4472,17 +11125,17 @@ func (m *ChannelToChain) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChannelToChain: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorJailRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChannelToChain: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorJailRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddr", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -4492,29 +11145,33 @@ func (m *ChannelToChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			if m.ProviderAddr == nil {
+				m.ProviderAddr = &ProviderConsAddress{}
+			}
+			if err := m.ProviderAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VscId", wireType)
 			}
-			var stringLen uint64
+			m.VscId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -4524,24 +11181,11 @@ func (m *ChannelToChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.VscId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProvider(dAtA[iNdEx:])
@@ -4563,7 +11207,7 @@ func (m *ChannelToChain) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VscUnbondingOps) Unmarshal(dAtA []byte) error {
+func (m *ValidatorInfractionHeight) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4586,17 +11230,53 @@ func (m *VscUnbondingOps) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VscUnbondingOps: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorInfractionHeight: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VscUnbondingOps: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorInfractionHeight: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddr", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProviderAddr == nil {
+				m.ProviderAddr = &ProviderConsAddress{}
+			}
+			if err := m.ProviderAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VscId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InfractionHeight", wireType)
 			}
-			m.VscId = 0
+			m.InfractionHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -4606,87 +11286,11 @@ func (m *VscUnbondingOps) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VscId |= uint64(b&0x7F) << shift
+				m.InfractionHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowProvider
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.UnbondingOpIds = append(m.UnbondingOpIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowProvider
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthProvider
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthProvider
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.UnbondingOpIds) == 0 {
-					m.UnbondingOpIds = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowProvider
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.UnbondingOpIds = append(m.UnbondingOpIds, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOpIds", wireType)
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProvider(dAtA[iNdEx:])
@@ -4708,7 +11312,7 @@ func (m *VscUnbondingOps) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnbondingOp) Unmarshal(dAtA []byte) error {
+func (m *ConsumerActivity) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4731,17 +11335,17 @@ func (m *UnbondingOp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnbondingOp: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerActivity: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbondingOp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerActivity: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.Id = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -4751,16 +11355,16 @@ func (m *UnbondingOp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingConsumerChains", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -4770,23 +11374,24 @@ func (m *UnbondingOp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnbondingConsumerChains = append(m.UnbondingConsumerChains, string(dAtA[iNdEx:postIndex]))
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4809,7 +11414,7 @@ func (m *UnbondingOp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InitTimeoutTimestamp) Unmarshal(dAtA []byte) error {
+func (m *ValidatorByConsumerAddr) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4832,10 +11437,10 @@ func (m *InitTimeoutTimestamp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InitTimeoutTimestamp: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorByConsumerAddr: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InitTimeoutTimestamp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorByConsumerAddr: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4871,10 +11476,10 @@ func (m *InitTimeoutTimestamp) Unmarshal(dAtA []byte) error {
 			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAddr", wireType)
 			}
-			m.Timestamp = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -4884,11 +11489,64 @@ func (m *InitTimeoutTimestamp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsumerAddr == nil {
+				m.ConsumerAddr = &ConsumerConsAddress{}
+			}
+			if err := m.ConsumerAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddr", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProviderAddr == nil {
+				m.ProviderAddr = &ProviderConsAddress{}
+			}
+			if err := m.ProviderAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProvider(dAtA[iNdEx:])
@@ -4910,7 +11568,7 @@ func (m *InitTimeoutTimestamp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VscSendTimestamp) Unmarshal(dAtA []byte) error {
+func (m *ConsumerAddrsToPrune) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4933,13 +11591,45 @@ func (m *VscSendTimestamp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VscSendTimestamp: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerAddrsToPrune: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VscSendTimestamp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerAddrsToPrune: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field VscId", wireType)
 			}
@@ -4958,9 +11648,9 @@ func (m *VscSendTimestamp) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAddrs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4987,7 +11677,10 @@ func (m *VscSendTimestamp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+			if m.ConsumerAddrs == nil {
+				m.ConsumerAddrs = &ConsumerAddressList{}
+			}
+			if err := m.ConsumerAddrs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5012,7 +11705,7 @@ func (m *VscSendTimestamp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConsumerConsAddress) Unmarshal(dAtA []byte) error {
+func (m *ConsumerRewardsAllocation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5035,17 +11728,17 @@ func (m *ConsumerConsAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerConsAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerRewardsAllocation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerConsAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerRewardsAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -5055,24 +11748,58 @@ func (m *ConsumerConsAddress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types2.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToDistribute", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
-			if m.Address == nil {
-				m.Address = []byte{}
+			m.ToDistribute = append(m.ToDistribute, types2.Coin{})
+			if err := m.ToDistribute[len(m.ToDistribute)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -5096,7 +11823,7 @@ func (m *ConsumerConsAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProviderConsAddress) Unmarshal(dAtA []byte) error {
+func (m *SlashRetry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5119,17 +11846,17 @@ func (m *ProviderConsAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProviderConsAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: SlashRetry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProviderConsAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SlashRetry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -5139,79 +11866,27 @@ func (m *ProviderConsAddress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
-			if m.Address == nil {
-				m.Address = []byte{}
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipProvider(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ConsumerAddressList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowProvider
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerAddressList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerAddressList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5238,11 +11913,29 @@ func (m *ConsumerAddressList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, &ConsumerConsAddress{})
-			if err := m.Addresses[len(m.Addresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			}
+			m.Retries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Retries |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProvider(dAtA[iNdEx:])
@@ -5264,7 +11957,7 @@ func (m *ConsumerAddressList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KeyAssignmentReplacement) Unmarshal(dAtA []byte) error {
+func (m *ConsumerValidator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5287,10 +11980,10 @@ func (m *KeyAssignmentReplacement) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KeyAssignmentReplacement: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerValidator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeyAssignmentReplacement: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerValidator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5331,7 +12024,7 @@ func (m *KeyAssignmentReplacement) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevCKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5358,10 +12051,10 @@ func (m *KeyAssignmentReplacement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PrevCKey == nil {
-				m.PrevCKey = &crypto.PublicKey{}
+			if m.ConsumerKey == nil {
+				m.ConsumerKey = &crypto.PublicKey{}
 			}
-			if err := m.PrevCKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ConsumerKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5405,7 +12098,7 @@ func (m *KeyAssignmentReplacement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorConsumerPubKey) Unmarshal(dAtA []byte) error {
+func (m *ConsumerParameters) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5428,15 +12121,15 @@ func (m *ValidatorConsumerPubKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorConsumerPubKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerParameters: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorConsumerPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerParameters: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerRedistributeFraction", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5464,13 +12157,13 @@ func (m *ValidatorConsumerPubKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.ConsumerRedistributeFraction = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SoftOptOutThreshold", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -5480,31 +12173,27 @@ func (m *ValidatorConsumerPubKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProviderAddr == nil {
-				m.ProviderAddr = &ProviderConsAddress{}
-			}
-			if err := m.ProviderAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SoftOptOutThreshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedRewardsPerWindow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5531,10 +12220,8 @@ func (m *ValidatorConsumerPubKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsumerKey == nil {
-				m.ConsumerKey = &crypto.PublicKey{}
-			}
-			if err := m.ConsumerKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ExpectedRewardsPerWindow = append(m.ExpectedRewardsPerWindow, types2.Coin{})
+			if err := m.ExpectedRewardsPerWindow[len(m.ExpectedRewardsPerWindow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5559,7 +12246,7 @@ func (m *ValidatorConsumerPubKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorByConsumerAddr) Unmarshal(dAtA []byte) error {
+func (m *ConsumerMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5582,15 +12269,15 @@ func (m *ValidatorByConsumerAddr) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorByConsumerAddr: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorByConsumerAddr: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5618,13 +12305,13 @@ func (m *ValidatorByConsumerAddr) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -5634,33 +12321,29 @@ func (m *ValidatorByConsumerAddr) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsumerAddr == nil {
-				m.ConsumerAddr = &ConsumerConsAddress{}
-			}
-			if err := m.ConsumerAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -5670,27 +12353,55 @@ func (m *ValidatorByConsumerAddr) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProviderAddr == nil {
-				m.ProviderAddr = &ProviderConsAddress{}
+			m.Website = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contact", wireType)
 			}
-			if err := m.ProviderAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
 			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contact = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5713,7 +12424,7 @@ func (m *ValidatorByConsumerAddr) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConsumerAddrsToPrune) Unmarshal(dAtA []byte) error {
+func (m *ConsumerRewardsWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5736,17 +12447,17 @@ func (m *ConsumerAddrsToPrune) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerAddrsToPrune: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerRewardsWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerAddrsToPrune: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerRewardsWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -5756,29 +12467,30 @@ func (m *ConsumerAddrsToPrune) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VscId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Received", wireType)
 			}
-			m.VscId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -5788,14 +12500,49 @@ func (m *ConsumerAddrsToPrune) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VscId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Received = append(m.Received, types2.Coin{})
+			if err := m.Received[len(m.Received)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastWindowCompleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LastWindowCompleted = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAddrs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastWindowReceived", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5822,10 +12569,8 @@ func (m *ConsumerAddrsToPrune) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsumerAddrs == nil {
-				m.ConsumerAddrs = &ConsumerAddressList{}
-			}
-			if err := m.ConsumerAddrs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LastWindowReceived = append(m.LastWindowReceived, types2.Coin{})
+			if err := m.LastWindowReceived[len(m.LastWindowReceived)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
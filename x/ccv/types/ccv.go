@@ -76,6 +76,29 @@ func (vdt SlashPacketData) GetBytes() []byte {
 	return valDowntimeBytes
 }
 
+// MaxConsumerRemovalReasonLength is the maximum length of the reason of a ConsumerRemoval packet
+const MaxConsumerRemovalReasonLength = 256
+
+func NewConsumerRemovalPacketData(reason string) *ConsumerRemovalPacketData {
+	return &ConsumerRemovalPacketData{
+		Reason: reason,
+	}
+}
+
+// ValidateBasic is used for validating the ConsumerRemoval packet data.
+func (crp ConsumerRemovalPacketData) ValidateBasic() error {
+	if len(crp.Reason) > MaxConsumerRemovalReasonLength {
+		return sdkerrors.Wrapf(ErrInvalidPacketData,
+			"reason cannot be longer than %d characters, got %d", MaxConsumerRemovalReasonLength, len(crp.Reason))
+	}
+	return nil
+}
+
+func (crp ConsumerRemovalPacketData) GetBytes() []byte {
+	bytes := ModuleCdc.MustMarshalJSON(&crp)
+	return bytes
+}
+
 func (cp ConsumerPacketData) ValidateBasic() (err error) {
 	switch cp.Type {
 	case VscMaturedPacket:
@@ -92,6 +115,13 @@ func (cp ConsumerPacketData) ValidateBasic() (err error) {
 			return fmt.Errorf("invalid consumer packet data: SlashPacketData data cannot be empty")
 		}
 		err = slashPacket.ValidateBasic()
+	case ConsumerRemovalPacket:
+		// validate ConsumerRemovalPacket
+		removalPacket := cp.GetConsumerRemovalPacketData()
+		if removalPacket == nil {
+			return fmt.Errorf("invalid consumer packet data: ConsumerRemovalPacketData data cannot be empty")
+		}
+		err = removalPacket.ValidateBasic()
 	default:
 		err = fmt.Errorf("invalid consumer packet type: %q", cp.Type)
 	}
@@ -103,3 +133,43 @@ func (cp ConsumerPacketData) GetBytes() []byte {
 	bytes := ModuleCdc.MustMarshalJSON(&cp)
 	return bytes
 }
+
+// CompatibleVersions are the CCV versions accepted during the channel handshake.
+// When the CCV version is bumped, the previous version must remain in this list
+// for as long as consumer chains running it can still open a CCV channel.
+var CompatibleVersions = []string{Version}
+
+// ValidateVersion validates a CCV version received during the channel handshake.
+// A well-formed version is a positive integer without leading zeros, e.g., "1".
+// Malformed versions are rejected independently of whether they are supported.
+func ValidateVersion(version string) error {
+	if !isWellFormedVersion(version) {
+		return sdkerrors.Wrapf(ErrInvalidVersion, "malformed version: %q, expected a positive integer", version)
+	}
+	if !IsCompatibleVersion(version) {
+		return sdkerrors.Wrapf(ErrInvalidVersion, "unsupported version: got %s, expected one of %v", version, CompatibleVersions)
+	}
+	return nil
+}
+
+// IsCompatibleVersion returns whether the given CCV version is one of the compatible versions
+func IsCompatibleVersion(version string) bool {
+	for _, v := range CompatibleVersions {
+		if v == version {
+			return true
+		}
+	}
+	return false
+}
+
+func isWellFormedVersion(version string) bool {
+	if version == "" || version[0] == '0' {
+		return false
+	}
+	for _, c := range version {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
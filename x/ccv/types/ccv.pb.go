@@ -35,18 +35,22 @@ const (
 	SlashPacket ConsumerPacketDataType = 1
 	// VSCMatured packet
 	VscMaturedPacket ConsumerPacketDataType = 2
+	// ConsumerRemoval packet
+	ConsumerRemovalPacket ConsumerPacketDataType = 3
 )
 
 var ConsumerPacketDataType_name = map[int32]string{
 	0: "CONSUMER_PACKET_TYPE_UNSPECIFIED",
 	1: "CONSUMER_PACKET_TYPE_SLASH",
 	2: "CONSUMER_PACKET_TYPE_VSCM",
+	3: "CONSUMER_PACKET_TYPE_REMOVAL",
 }
 
 var ConsumerPacketDataType_value = map[string]int32{
 	"CONSUMER_PACKET_TYPE_UNSPECIFIED": 0,
 	"CONSUMER_PACKET_TYPE_SLASH":       1,
 	"CONSUMER_PACKET_TYPE_VSCM":        2,
+	"CONSUMER_PACKET_TYPE_REMOVAL":     3,
 }
 
 func (x ConsumerPacketDataType) String() string {
@@ -281,6 +285,54 @@ func (m *SlashPacketData) GetInfraction() types1.InfractionType {
 	return types1.InfractionEmpty
 }
 
+// This packet is sent from the consumer chain to the provider chain
+// to request that the consumer chain is removed from the provider,
+// i.e., that the consumer chain voluntarily leaves interchain security.
+type ConsumerRemovalPacketData struct {
+	// the reason the consumer chain leaves, for informational purposes only
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *ConsumerRemovalPacketData) Reset()         { *m = ConsumerRemovalPacketData{} }
+func (m *ConsumerRemovalPacketData) String() string { return proto.CompactTextString(m) }
+func (*ConsumerRemovalPacketData) ProtoMessage()    {}
+func (*ConsumerRemovalPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68bd5f3242e6f29c, []int{4}
+}
+func (m *ConsumerRemovalPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerRemovalPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerRemovalPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumerRemovalPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerRemovalPacketData.Merge(m, src)
+}
+func (m *ConsumerRemovalPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerRemovalPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerRemovalPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerRemovalPacketData proto.InternalMessageInfo
+
+func (m *ConsumerRemovalPacketData) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 // MaturedUnbondingOps defines a list of ids corresponding to ids of matured unbonding operations.
 type MaturedUnbondingOps struct {
 	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
@@ -290,7 +342,7 @@ func (m *MaturedUnbondingOps) Reset()         { *m = MaturedUnbondingOps{} }
 func (m *MaturedUnbondingOps) String() string { return proto.CompactTextString(m) }
 func (*MaturedUnbondingOps) ProtoMessage()    {}
 func (*MaturedUnbondingOps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68bd5f3242e6f29c, []int{4}
+	return fileDescriptor_68bd5f3242e6f29c, []int{5}
 }
 func (m *MaturedUnbondingOps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -332,6 +384,7 @@ type ConsumerPacketData struct {
 	// Types that are valid to be assigned to Data:
 	//	*ConsumerPacketData_SlashPacketData
 	//	*ConsumerPacketData_VscMaturedPacketData
+	//	*ConsumerPacketData_ConsumerRemovalPacketData
 	Data isConsumerPacketData_Data `protobuf_oneof:"data"`
 }
 
@@ -339,7 +392,7 @@ func (m *ConsumerPacketData) Reset()         { *m = ConsumerPacketData{} }
 func (m *ConsumerPacketData) String() string { return proto.CompactTextString(m) }
 func (*ConsumerPacketData) ProtoMessage()    {}
 func (*ConsumerPacketData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68bd5f3242e6f29c, []int{5}
+	return fileDescriptor_68bd5f3242e6f29c, []int{6}
 }
 func (m *ConsumerPacketData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -380,9 +433,13 @@ type ConsumerPacketData_SlashPacketData struct {
 type ConsumerPacketData_VscMaturedPacketData struct {
 	VscMaturedPacketData *VSCMaturedPacketData `protobuf:"bytes,3,opt,name=vscMaturedPacketData,proto3,oneof" json:"vscMaturedPacketData,omitempty"`
 }
+type ConsumerPacketData_ConsumerRemovalPacketData struct {
+	ConsumerRemovalPacketData *ConsumerRemovalPacketData `protobuf:"bytes,4,opt,name=consumerRemovalPacketData,proto3,oneof" json:"consumerRemovalPacketData,omitempty"`
+}
 
-func (*ConsumerPacketData_SlashPacketData) isConsumerPacketData_Data()      {}
-func (*ConsumerPacketData_VscMaturedPacketData) isConsumerPacketData_Data() {}
+func (*ConsumerPacketData_SlashPacketData) isConsumerPacketData_Data()           {}
+func (*ConsumerPacketData_VscMaturedPacketData) isConsumerPacketData_Data()      {}
+func (*ConsumerPacketData_ConsumerRemovalPacketData) isConsumerPacketData_Data() {}
 
 func (m *ConsumerPacketData) GetData() isConsumerPacketData_Data {
 	if m != nil {
@@ -412,11 +469,19 @@ func (m *ConsumerPacketData) GetVscMaturedPacketData() *VSCMaturedPacketData {
 	return nil
 }
 
+func (m *ConsumerPacketData) GetConsumerRemovalPacketData() *ConsumerRemovalPacketData {
+	if x, ok := m.GetData().(*ConsumerPacketData_ConsumerRemovalPacketData); ok {
+		return x.ConsumerRemovalPacketData
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ConsumerPacketData) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*ConsumerPacketData_SlashPacketData)(nil),
 		(*ConsumerPacketData_VscMaturedPacketData)(nil),
+		(*ConsumerPacketData_ConsumerRemovalPacketData)(nil),
 	}
 }
 
@@ -429,7 +494,7 @@ func (m *ConsumerPacketDataList) Reset()         { *m = ConsumerPacketDataList{}
 func (m *ConsumerPacketDataList) String() string { return proto.CompactTextString(m) }
 func (*ConsumerPacketDataList) ProtoMessage()    {}
 func (*ConsumerPacketDataList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68bd5f3242e6f29c, []int{6}
+	return fileDescriptor_68bd5f3242e6f29c, []int{7}
 }
 func (m *ConsumerPacketDataList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -471,6 +536,7 @@ func init() {
 	proto.RegisterType((*ValidatorSetChangePackets)(nil), "interchain_security.ccv.v1.ValidatorSetChangePackets")
 	proto.RegisterType((*VSCMaturedPacketData)(nil), "interchain_security.ccv.v1.VSCMaturedPacketData")
 	proto.RegisterType((*SlashPacketData)(nil), "interchain_security.ccv.v1.SlashPacketData")
+	proto.RegisterType((*ConsumerRemovalPacketData)(nil), "interchain_security.ccv.v1.ConsumerRemovalPacketData")
 	proto.RegisterType((*MaturedUnbondingOps)(nil), "interchain_security.ccv.v1.MaturedUnbondingOps")
 	proto.RegisterType((*ConsumerPacketData)(nil), "interchain_security.ccv.v1.ConsumerPacketData")
 	proto.RegisterType((*ConsumerPacketDataList)(nil), "interchain_security.ccv.v1.ConsumerPacketDataList")
@@ -481,51 +547,55 @@ func init() {
 }
 
 var fileDescriptor_68bd5f3242e6f29c = []byte{
-	// 696 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x6e, 0xda, 0x4a,
-	0x14, 0xc6, 0xed, 0x60, 0x45, 0xca, 0x20, 0x25, 0x8e, 0x2f, 0xf7, 0x8a, 0xf8, 0xde, 0xeb, 0x58,
-	0x56, 0xd4, 0xa2, 0x56, 0xb5, 0x8b, 0xb3, 0xa9, 0xda, 0x4d, 0x03, 0x21, 0x02, 0xe5, 0x1f, 0xb2,
-	0x43, 0xaa, 0x76, 0x63, 0x0d, 0xf6, 0x04, 0x46, 0x80, 0x8d, 0x3c, 0x83, 0x55, 0xde, 0xa0, 0xca,
-	0xaa, 0x2f, 0x90, 0x55, 0xd5, 0x07, 0xe9, 0x2e, 0xcb, 0xec, 0x9a, 0x55, 0x54, 0x25, 0x6f, 0xd0,
-	0x27, 0xa8, 0x3c, 0x18, 0x42, 0xc0, 0x41, 0xca, 0x8a, 0xe1, 0xcc, 0x39, 0x1f, 0x7c, 0xbf, 0xf9,
-	0x74, 0xc0, 0x16, 0xf6, 0x29, 0x0a, 0xdd, 0x36, 0xc4, 0xbe, 0x43, 0x90, 0x3b, 0x08, 0x31, 0x1d,
-	0x1a, 0xae, 0x1b, 0x19, 0x51, 0x31, 0xfe, 0xd0, 0xfb, 0x61, 0x40, 0x03, 0x49, 0x4e, 0xe9, 0xd2,
-	0xe3, 0xeb, 0xa8, 0x28, 0x6f, 0xb9, 0x01, 0xe9, 0x05, 0xc4, 0x20, 0x14, 0x76, 0xb0, 0xdf, 0x32,
-	0xa2, 0x62, 0x13, 0x51, 0x58, 0x1c, 0x7f, 0x1f, 0x29, 0xc8, 0xb9, 0x56, 0xd0, 0x0a, 0xd8, 0xd1,
-	0x88, 0x4f, 0x49, 0xf5, 0x5f, 0x8a, 0x7c, 0x0f, 0x85, 0x3d, 0xec, 0x53, 0x03, 0x36, 0x5d, 0x6c,
-	0xd0, 0x61, 0x1f, 0x91, 0xd1, 0xa5, 0x76, 0xcd, 0x83, 0xff, 0x4e, 0x61, 0x17, 0x7b, 0x90, 0x06,
-	0xa1, 0x8d, 0x68, 0xb9, 0x0d, 0xfd, 0x16, 0xaa, 0x43, 0xb7, 0x83, 0xe8, 0x2e, 0xa4, 0x50, 0x0a,
-	0xc0, 0x7a, 0x34, 0xbe, 0x77, 0x06, 0x7d, 0x0f, 0x52, 0x44, 0xf2, 0xbc, 0x9a, 0x29, 0x64, 0x4d,
-	0x55, 0xbf, 0x57, 0xd6, 0x63, 0x65, 0x7d, 0xa2, 0xd4, 0x60, 0x8d, 0x25, 0xf5, 0xf2, 0x66, 0x93,
-	0xfb, 0x7d, 0xb3, 0x99, 0x1f, 0xc2, 0x5e, 0xf7, 0xad, 0x36, 0x27, 0xa4, 0x59, 0x62, 0xf4, 0x70,
-	0x84, 0x48, 0x05, 0x10, 0xd7, 0x08, 0xa2, 0x49, 0x93, 0x83, 0xbd, 0xfc, 0x92, 0xca, 0x17, 0x04,
-	0x6b, 0x75, 0x54, 0x1f, 0x35, 0xd6, 0x3c, 0xe9, 0x7f, 0x00, 0x48, 0x17, 0x92, 0xb6, 0x03, 0xdd,
-	0x0e, 0xc9, 0x67, 0xd4, 0x4c, 0x61, 0xc5, 0x5a, 0x61, 0x95, 0x1d, 0xb7, 0x43, 0xb4, 0x00, 0x6c,
-	0x3c, 0xe6, 0x8c, 0x48, 0x16, 0x10, 0xba, 0x98, 0xd0, 0xc4, 0xc9, 0x1b, 0xfd, 0x71, 0xf6, 0xfa,
-	0x22, 0x3c, 0x25, 0x21, 0x76, 0x68, 0x31, 0x2d, 0xed, 0x3d, 0xc8, 0x9d, 0xda, 0xe5, 0x43, 0x48,
-	0x07, 0x21, 0xf2, 0xa6, 0x10, 0xa6, 0x39, 0xe2, 0xd3, 0x1c, 0x69, 0x3f, 0x79, 0xb0, 0x66, 0xc7,
-	0x06, 0xa6, 0xa6, 0x2d, 0xb0, 0x32, 0x61, 0xc4, 0xc6, 0xb2, 0xa6, 0xfc, 0x38, 0xf8, 0x52, 0x3e,
-	0x41, 0x2e, 0xce, 0x20, 0xd7, 0xac, 0x7b, 0x99, 0x27, 0x30, 0xde, 0x03, 0x00, 0xfb, 0x67, 0x21,
-	0x74, 0x29, 0x0e, 0xfc, 0x7c, 0x46, 0xe5, 0x0b, 0xab, 0xe6, 0x33, 0x7d, 0x94, 0x46, 0x7d, 0x9c,
-	0xbe, 0x24, 0x8d, 0x7a, 0x6d, 0xd2, 0x79, 0x32, 0xec, 0x23, 0x6b, 0x6a, 0x52, 0x7b, 0x0e, 0xfe,
-	0x4a, 0xc0, 0x34, 0xfc, 0x66, 0xe0, 0x7b, 0xd8, 0x6f, 0x1d, 0xf7, 0x89, 0x24, 0x82, 0x0c, 0xf6,
-	0x46, 0x79, 0x12, 0xac, 0xf8, 0xa8, 0x7d, 0x5f, 0x02, 0x52, 0x39, 0xf0, 0xc9, 0xa0, 0x87, 0xc2,
-	0x29, 0x0a, 0x7b, 0x40, 0x88, 0x63, 0xcb, 0x00, 0xac, 0x9a, 0xe6, 0xa2, 0xf7, 0x9a, 0x9f, 0x66,
-	0xff, 0x86, 0xcd, 0x4b, 0x1f, 0xc0, 0x1a, 0x79, 0x08, 0x98, 0x19, 0xcf, 0x9a, 0x2f, 0x17, 0x49,
-	0xce, 0xbc, 0x49, 0x95, 0xb3, 0x66, 0x55, 0xa4, 0x33, 0x90, 0x8b, 0x88, 0x3b, 0xf7, 0xf8, 0x0c,
-	0x59, 0xd6, 0x7c, 0xbd, 0x30, 0x60, 0x29, 0xa1, 0xa9, 0x72, 0x56, 0xaa, 0x5e, 0x69, 0x19, 0x08,
-	0x1e, 0xa4, 0x50, 0x6b, 0x82, 0x7f, 0xe6, 0x8d, 0x1e, 0x60, 0x42, 0xa5, 0xea, 0x83, 0x68, 0xeb,
-	0x4f, 0x43, 0x35, 0x1d, 0xe8, 0x17, 0x3f, 0xf8, 0xb4, 0x1f, 0x89, 0x69, 0x4a, 0xef, 0x80, 0x5a,
-	0x3e, 0x3e, 0xb2, 0x1b, 0x87, 0x15, 0xcb, 0xa9, 0xef, 0x94, 0xf7, 0x2b, 0x27, 0xce, 0xc9, 0xc7,
-	0x7a, 0xc5, 0x69, 0x1c, 0xd9, 0xf5, 0x4a, 0xb9, 0xb6, 0x57, 0xab, 0xec, 0x8a, 0x9c, 0xfc, 0xf7,
-	0xf9, 0x85, 0xba, 0xde, 0xf0, 0x49, 0x1f, 0xb9, 0xf8, 0x0c, 0x8f, 0x7d, 0x48, 0x06, 0x90, 0x53,
-	0x87, 0xed, 0x83, 0x1d, 0xbb, 0x2a, 0xf2, 0xf2, 0xda, 0xf9, 0x85, 0x9a, 0x9d, 0x62, 0x2e, 0x6d,
-	0x83, 0x8d, 0xd4, 0x81, 0x98, 0x9c, 0xb8, 0x24, 0xe7, 0xce, 0x2f, 0x54, 0xf1, 0x74, 0x86, 0x96,
-	0x2c, 0x7c, 0xf9, 0xa6, 0x70, 0xa5, 0xfd, 0xcb, 0x5b, 0x85, 0xbf, 0xba, 0x55, 0xf8, 0x5f, 0xb7,
-	0x0a, 0xff, 0xf5, 0x4e, 0xe1, 0xae, 0xee, 0x14, 0xee, 0xfa, 0x4e, 0xe1, 0x3e, 0x15, 0x5b, 0x98,
-	0xb6, 0x07, 0x4d, 0xdd, 0x0d, 0x7a, 0x46, 0xb2, 0x5e, 0xef, 0x51, 0xbd, 0x9a, 0xec, 0xe9, 0xcf,
-	0x6c, 0x53, 0xb3, 0x9d, 0xd9, 0x5c, 0x66, 0x4b, 0x73, 0xfb, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x25, 0xaf, 0xdb, 0xaa, 0xd1, 0x05, 0x00, 0x00,
+	// 757 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xdf, 0x6a, 0xe2, 0x58,
+	0x1c, 0x4e, 0x6a, 0x28, 0x78, 0x84, 0x36, 0xcd, 0xda, 0xa2, 0xd9, 0xae, 0x0d, 0xa1, 0xec, 0xca,
+	0x2e, 0x9b, 0xac, 0x96, 0x85, 0x65, 0x7b, 0xb3, 0x6a, 0x2d, 0x4a, 0xff, 0xc9, 0xb1, 0xba, 0xcc,
+	0xdc, 0xc8, 0x31, 0x39, 0xd5, 0x83, 0x9a, 0x48, 0xce, 0x31, 0x8c, 0x6f, 0x30, 0x78, 0x35, 0x0f,
+	0x30, 0x5e, 0xcd, 0xcb, 0xf4, 0xb2, 0x77, 0xd3, 0xab, 0x32, 0xb4, 0x6f, 0x30, 0x0f, 0x30, 0x0c,
+	0x89, 0xd1, 0x5a, 0x8d, 0x32, 0xbd, 0x32, 0x39, 0xe7, 0xf7, 0x7d, 0xf2, 0xfd, 0x09, 0x3f, 0x70,
+	0x48, 0x2c, 0x86, 0x1d, 0xa3, 0x8d, 0x88, 0xd5, 0xa0, 0xd8, 0x18, 0x38, 0x84, 0x0d, 0x75, 0xc3,
+	0x70, 0x75, 0x37, 0xe3, 0xfd, 0x68, 0x7d, 0xc7, 0x66, 0xb6, 0x24, 0x87, 0x4c, 0x69, 0xde, 0xb5,
+	0x9b, 0x91, 0x0f, 0x0d, 0x9b, 0xf6, 0x6c, 0xaa, 0x53, 0x86, 0x3a, 0xc4, 0x6a, 0xe9, 0x6e, 0xa6,
+	0x89, 0x19, 0xca, 0x4c, 0xdf, 0x27, 0x0c, 0x72, 0xbc, 0x65, 0xb7, 0x6c, 0xff, 0x51, 0xf7, 0x9e,
+	0x82, 0xd3, 0x9f, 0x19, 0xb6, 0x4c, 0xec, 0xf4, 0x88, 0xc5, 0x74, 0xd4, 0x34, 0x88, 0xce, 0x86,
+	0x7d, 0x4c, 0x27, 0x97, 0xea, 0x3d, 0x0f, 0xf6, 0xeb, 0xa8, 0x4b, 0x4c, 0xc4, 0x6c, 0xa7, 0x8a,
+	0x59, 0xa1, 0x8d, 0xac, 0x16, 0xae, 0x20, 0xa3, 0x83, 0xd9, 0x09, 0x62, 0x48, 0xb2, 0xc1, 0x8e,
+	0x3b, 0xbd, 0x6f, 0x0c, 0xfa, 0x26, 0x62, 0x98, 0x26, 0x78, 0x25, 0x92, 0x8e, 0x65, 0x15, 0xed,
+	0x99, 0x59, 0xf3, 0x98, 0xb5, 0x19, 0x53, 0xcd, 0x1f, 0xcc, 0x2b, 0xb7, 0x0f, 0x07, 0xdc, 0xd7,
+	0x87, 0x83, 0xc4, 0x10, 0xf5, 0xba, 0xff, 0xaa, 0x4b, 0x44, 0x2a, 0x14, 0xdd, 0x97, 0x10, 0x2a,
+	0xa5, 0x81, 0x77, 0x46, 0x31, 0x0b, 0x86, 0x1a, 0xc4, 0x4c, 0x6c, 0x28, 0x7c, 0x5a, 0x80, 0x5b,
+	0x93, 0xf3, 0xc9, 0x60, 0xd9, 0x94, 0x7e, 0x01, 0x80, 0x76, 0x11, 0x6d, 0x37, 0x90, 0xd1, 0xa1,
+	0x89, 0x88, 0x12, 0x49, 0x47, 0x61, 0xd4, 0x3f, 0xc9, 0x19, 0x1d, 0xaa, 0xda, 0x20, 0xb9, 0x4a,
+	0x19, 0x95, 0x20, 0x10, 0xba, 0x84, 0xb2, 0x40, 0xc9, 0x3f, 0xda, 0x6a, 0xef, 0xb5, 0x75, 0xf6,
+	0xe4, 0x05, 0x4f, 0x21, 0xf4, 0xb9, 0xd4, 0xff, 0x40, 0xbc, 0x5e, 0x2d, 0x5c, 0x20, 0x36, 0x70,
+	0xb0, 0x39, 0x67, 0x61, 0x98, 0x22, 0x3e, 0x4c, 0x91, 0xfa, 0x99, 0x07, 0xdb, 0x55, 0x4f, 0xc0,
+	0x1c, 0x1a, 0x82, 0xe8, 0xcc, 0x23, 0x1f, 0x16, 0xcb, 0xca, 0xab, 0x8d, 0xcf, 0x27, 0x02, 0xcb,
+	0xc5, 0x05, 0xcb, 0x55, 0xf8, 0x4c, 0xf3, 0x0a, 0x8f, 0x4f, 0x01, 0x20, 0xd6, 0x8d, 0x83, 0x0c,
+	0x46, 0x6c, 0x2b, 0x11, 0x51, 0xf8, 0xf4, 0x56, 0xf6, 0x57, 0x6d, 0xd2, 0x46, 0x6d, 0xda, 0xbe,
+	0xa0, 0x8d, 0x5a, 0x79, 0x36, 0x79, 0x3d, 0xec, 0x63, 0x38, 0x87, 0x54, 0x8f, 0x40, 0xb2, 0x60,
+	0x5b, 0x74, 0xd0, 0xc3, 0x0e, 0xc4, 0x3d, 0xdb, 0x45, 0xdd, 0x39, 0x89, 0x7b, 0x60, 0xd3, 0xc1,
+	0x88, 0xda, 0x96, 0xaf, 0x2f, 0x0a, 0x83, 0x37, 0xf5, 0x37, 0xf0, 0x53, 0xe0, 0x66, 0xcd, 0x6a,
+	0xda, 0x96, 0x49, 0xac, 0xd6, 0x55, 0x9f, 0x4a, 0x22, 0x88, 0x10, 0x73, 0x52, 0x42, 0x01, 0x7a,
+	0x8f, 0xea, 0xc7, 0x08, 0x90, 0xa6, 0xf4, 0x73, 0xbc, 0xa7, 0x40, 0xf0, 0xba, 0xee, 0xb3, 0x6e,
+	0x65, 0xb3, 0xeb, 0x42, 0x5e, 0x46, 0xfb, 0x12, 0x7c, 0xbc, 0xf4, 0x3f, 0xd8, 0xa6, 0x2f, 0x53,
+	0xf1, 0xdd, 0x8a, 0x65, 0xff, 0x58, 0x47, 0xb9, 0x10, 0x64, 0x89, 0x83, 0x8b, 0x2c, 0xd2, 0x0d,
+	0x88, 0xbb, 0xd4, 0x58, 0x6a, 0x8c, 0xef, 0x73, 0x2c, 0xfb, 0xd7, 0xda, 0x56, 0x86, 0x34, 0xad,
+	0xc4, 0xc1, 0x50, 0x3e, 0x69, 0x00, 0x92, 0xc6, 0x2a, 0xf7, 0x13, 0x82, 0xff, 0x67, 0x7f, 0xff,
+	0x88, 0x3b, 0x4b, 0xe0, 0x12, 0x07, 0x57, 0x33, 0xe7, 0x37, 0x81, 0x60, 0x22, 0x86, 0xd4, 0x26,
+	0xd8, 0x5b, 0xf6, 0xf7, 0x9c, 0x50, 0x26, 0x95, 0x5e, 0x7c, 0x86, 0xda, 0xeb, 0x12, 0x9a, 0xff,
+	0xf8, 0x7e, 0xff, 0xc6, 0x83, 0xbd, 0xf0, 0x10, 0xa5, 0x63, 0xa0, 0x14, 0xae, 0x2e, 0xab, 0xb5,
+	0x8b, 0x22, 0x6c, 0x54, 0x72, 0x85, 0xb3, 0xe2, 0x75, 0xe3, 0xfa, 0x4d, 0xa5, 0xd8, 0xa8, 0x5d,
+	0x56, 0x2b, 0xc5, 0x42, 0xf9, 0xb4, 0x5c, 0x3c, 0x11, 0x39, 0x79, 0x77, 0x34, 0x56, 0x76, 0x6a,
+	0x16, 0xed, 0x63, 0x83, 0xdc, 0x90, 0xa9, 0x7d, 0x92, 0x0e, 0xe4, 0x50, 0x70, 0xf5, 0x3c, 0x57,
+	0x2d, 0x89, 0xbc, 0xbc, 0x3d, 0x1a, 0x2b, 0xb1, 0xb9, 0xa8, 0x25, 0xaf, 0xe9, 0x61, 0x00, 0x2f,
+	0x30, 0x71, 0x43, 0x8e, 0x8f, 0xc6, 0x8a, 0x58, 0x5f, 0x08, 0x49, 0x3a, 0x06, 0xfb, 0xa1, 0x20,
+	0x58, 0xbc, 0xb8, 0xaa, 0xe7, 0xce, 0xc5, 0x88, 0x9c, 0x1c, 0x8d, 0x95, 0xdd, 0xd0, 0x1c, 0x64,
+	0xe1, 0xfd, 0xa7, 0x14, 0x97, 0x3f, 0xbb, 0x7d, 0x4c, 0xf1, 0x77, 0x8f, 0x29, 0xfe, 0xcb, 0x63,
+	0x8a, 0xff, 0xf0, 0x94, 0xe2, 0xee, 0x9e, 0x52, 0xdc, 0xfd, 0x53, 0x8a, 0x7b, 0x9b, 0x69, 0x11,
+	0xd6, 0x1e, 0x34, 0x35, 0xc3, 0xee, 0xe9, 0xc1, 0x1e, 0x79, 0xf6, 0xf9, 0xcf, 0xd9, 0x42, 0x7a,
+	0xe7, 0xaf, 0x24, 0x7f, 0x39, 0x34, 0x37, 0xfd, 0xed, 0x70, 0xf4, 0x7d, 0x00, 0xb2, 0x39, 0x15,
+	0x29, 0xba, 0x06, 0x00, 0x00,
 }
 
 func (m *ValidatorSetChangePacketData) Marshal() (dAtA []byte, err error) {
@@ -687,6 +757,36 @@ func (m *SlashPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ConsumerRemovalPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerRemovalPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerRemovalPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintCcv(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MaturedUnbondingOps) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -807,6 +907,27 @@ func (m *ConsumerPacketData_VscMaturedPacketData) MarshalToSizedBuffer(dAtA []by
 	}
 	return len(dAtA) - i, nil
 }
+func (m *ConsumerPacketData_ConsumerRemovalPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerPacketData_ConsumerRemovalPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ConsumerRemovalPacketData != nil {
+		{
+			size, err := m.ConsumerRemovalPacketData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCcv(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
 func (m *ConsumerPacketDataList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -923,6 +1044,19 @@ func (m *SlashPacketData) Size() (n int) {
 	return n
 }
 
+func (m *ConsumerRemovalPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovCcv(uint64(l))
+	}
+	return n
+}
+
 func (m *MaturedUnbondingOps) Size() (n int) {
 	if m == nil {
 		return 0
@@ -978,6 +1112,18 @@ func (m *ConsumerPacketData_VscMaturedPacketData) Size() (n int) {
 	}
 	return n
 }
+func (m *ConsumerPacketData_ConsumerRemovalPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConsumerRemovalPacketData != nil {
+		l = m.ConsumerRemovalPacketData.Size()
+		n += 1 + l + sovCcv(uint64(l))
+	}
+	return n
+}
 func (m *ConsumerPacketDataList) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1408,6 +1554,88 @@ func (m *SlashPacketData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ConsumerRemovalPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCcv
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerRemovalPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerRemovalPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCcv
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCcv
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCcv
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCcv(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCcv
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MaturedUnbondingOps) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1652,6 +1880,41 @@ func (m *ConsumerPacketData) Unmarshal(dAtA []byte) error {
 			}
 			m.Data = &ConsumerPacketData_VscMaturedPacketData{v}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerRemovalPacketData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCcv
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCcv
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCcv
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ConsumerRemovalPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Data = &ConsumerPacketData_ConsumerRemovalPacketData{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCcv(dAtA[iNdEx:])
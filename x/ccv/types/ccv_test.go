@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"strings"
 	"testing"
 
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
@@ -90,3 +91,41 @@ func TestMarshalPacketData(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, vpd, recovered, "unmarshaled packet data does not equal original value")
 }
+
+func TestValidateVersion(t *testing.T) {
+	cases := []struct {
+		name      string
+		version   string
+		expError  bool
+		malformed bool
+	}{
+		{"well-formed supported version", types.Version, false, false},
+		{"well-formed unsupported version", "2", true, false},
+		{"well-formed unsupported multi-digit version", "10", true, false},
+		{"malformed empty version", "", true, true},
+		{"malformed version with leading zero", "01", true, true},
+		{"malformed zero version", "0", true, true},
+		{"malformed version with prefix", "v1", true, true},
+		{"malformed version with suffix", "1.0", true, true},
+		{"malformed negative version", "-1", true, true},
+		{"malformed version with whitespace", " 1", true, true},
+	}
+
+	for _, c := range cases {
+		err := types.ValidateVersion(c.version)
+		if !c.expError {
+			require.NoError(t, err, c.name)
+			continue
+		}
+		require.ErrorIs(t, err, types.ErrInvalidVersion, c.name)
+		require.Equal(t, c.malformed, strings.Contains(err.Error(), "malformed version"), c.name)
+	}
+
+	// a newer version is accepted once it is added to the compatible versions,
+	// while the previous version remains accepted
+	defer func(versions []string) { types.CompatibleVersions = versions }(types.CompatibleVersions)
+	types.CompatibleVersions = append([]string{}, types.Version, "2")
+	require.NoError(t, types.ValidateVersion(types.Version))
+	require.NoError(t, types.ValidateVersion("2"))
+	require.ErrorIs(t, types.ValidateVersion("3"), types.ErrInvalidVersion)
+}
@@ -25,4 +25,7 @@ var (
 	ErrClientNotFound           = sdkerrors.Register(ModuleName, 18, "client not found")
 	ErrDuplicateConsumerChain   = sdkerrors.Register(ModuleName, 19, "consumer chain already exists")
 	ErrConsumerChainNotFound    = sdkerrors.Register(ModuleName, 20, "consumer chain not found")
+	ErrInvalidatedChannel       = sdkerrors.Register(ModuleName, 21, "CCV channel was invalidated")
+	ErrValidatingChannel        = sdkerrors.Register(ModuleName, 22, "CCV channel is already validating")
+	ErrInvalidGenesisHash       = sdkerrors.Register(ModuleName, 23, "consumer genesis hash does not match")
 )
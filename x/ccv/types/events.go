@@ -8,10 +8,25 @@ const (
 	EventTypeFeeTransferChannelOpened = "fee_transfer_channel_opened"
 	EventTypeConsumerClientCreated    = "consumer_client_created"
 	EventTypeAssignConsumerKey        = "assign_consumer_key"
+	EventTypeConsumerInitTimeout      = "consumer_init_timeout"
+	EventTypeCCVChannelInvalidated    = "ccv_channel_invalidated"
+	EventTypeConsumerMisbehaviour     = "consumer_misbehaviour"
+	EventTypeUpdateConsumerParameters = "update_consumer_parameters"
+	EventTypeConsumerRewardsShortfall = "consumer_rewards_shortfall"
+	EventTypeForceCompleteUnbonding   = "force_complete_unbonding"
+	EventTypeUnbondingOpsCapExceeded  = "unbonding_ops_cap_exceeded"
+	EventTypeSlashAcksCapExceeded     = "slash_acks_cap_exceeded"
+	EventTypeCancelConsumerAddition   = "cancel_consumer_addition"
+	EventTypeChangeRewardDenoms       = "change_reward_denoms"
+	EventTypeConsumerPaused           = "consumer_paused"
+	EventTypeConsumerResumed          = "consumer_resumed"
+	EventTypeUpdateConsumerMetadata   = "update_consumer_metadata"
+	EventTypeConsumerClientExpiring   = "consumer_client_expiring"
 
 	EventTypeExecuteConsumerChainSlash = "execute_consumer_chain_slash"
 	EventTypeFeeDistribution           = "fee_distribution"
 	EventTypeConsumerSlashRequest      = "consumer_slash_request"
+	EventTypeConsumerRemovalRequest    = "consumer_removal_request"
 	EventTypeVSCMatured                = "vsc_matured"
 
 	AttributeKeyAckSuccess = "success"
@@ -32,6 +47,26 @@ const (
 	AttributeUnbondingPeriod          = "unbonding_period"
 	AttributeProviderValidatorAddress = "provider_validator_address"
 	AttributeConsumerConsensusPubKey  = "consumer_consensus_pub_key"
+	AttributeClientStatus             = "client_status"
+	AttributeClientID                 = "client_id"
+	AttributeSubmitterAddress         = "submitter_address"
+	AttributeUnbondingOpIDs           = "unbonding_op_ids"
+	AttributeCompletedUnbondingOpIDs  = "completed_unbonding_op_ids"
+	AttributePendingUnbondingOps      = "pending_unbonding_ops"
+	AttributeMaxUnbondingOpsPerChain  = "max_unbonding_ops_per_chain"
+	AttributeClientExpiryTime         = "client_expiry_time"
+	AttributeTimeToExpiry             = "time_to_expiry"
+
+	AttributeConsumerRedistributeFraction     = "consumer_redistribute_fraction"
+	AttributePrevConsumerRedistributeFraction = "previous_consumer_redistribute_fraction"
+	AttributeSoftOptOutThreshold              = "soft_opt_out_threshold"
+	AttributePrevSoftOptOutThreshold          = "previous_soft_opt_out_threshold"
+	AttributeExpectedRewardsPerWindow         = "expected_rewards_per_window"
+	AttributeReceivedRewards                  = "received_rewards"
+	AttributeRewardsShortfall                 = "rewards_shortfall"
+	AttributeDenomsToAdd                      = "denoms_to_add"
+	AttributeDenomsToRemove                   = "denoms_to_remove"
+	AttributeRemovalReason                    = "removal_reason"
 
 	AttributeDistributionCurrentHeight = "current_distribution_height"
 	AttributeDistributionNextHeight    = "next_distribution_height"
@@ -55,7 +55,7 @@ func TMCryptoPublicKeyToConsAddr(k tmprotocrypto.PublicKey) (sdk.ConsAddress, er
 }
 
 // SendIBCPacket sends an IBC packet with packetData
-// over the source channelID and portID
+// over the source channelID and portID, and returns the sequence number of the sent packet
 func SendIBCPacket(
 	ctx sdk.Context,
 	scopedKeeper ccv.ScopedKeeper,
@@ -64,20 +64,20 @@ func SendIBCPacket(
 	portID string,
 	packetData []byte,
 	timeoutPeriod time.Duration,
-) error {
+) (uint64, error) {
 	channel, ok := channelKeeper.GetChannel(ctx, portID, channelID)
 	if !ok {
-		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "channel not found for channel ID: %s", channelID)
+		return 0, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "channel not found for channel ID: %s", channelID)
 	}
 	channelCap, ok := scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(portID, channelID))
 	if !ok {
-		return sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+		return 0, sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
 	}
 
 	// get the next sequence
 	sequence, found := channelKeeper.GetNextSequenceSend(ctx, portID, channelID)
 	if !found {
-		return sdkerrors.Wrapf(
+		return 0, sdkerrors.Wrapf(
 			channeltypes.ErrSequenceSendNotFound,
 			"source port: %s, source channel: %s", portID, channelID,
 		)
@@ -89,7 +89,10 @@ func SendIBCPacket(
 		clienttypes.Height{}, uint64(ctx.BlockTime().Add(timeoutPeriod).UnixNano()),
 	)
 
-	return channelKeeper.SendPacket(ctx, channelCap, packet)
+	if err := channelKeeper.SendPacket(ctx, channelCap, packet); err != nil {
+		return 0, err
+	}
+	return sequence, nil
 }
 
 // AppendMany appends a variable number of byte slices together